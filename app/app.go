@@ -27,6 +27,9 @@ import (
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -106,6 +109,9 @@ import (
 const (
 	AccountAddressPrefix = "spn"
 	Name                 = "spn"
+
+	// VoucherDenomsUpgradeName is the name of the upgrade migrating the campaign module to the voucher denoms of its version 2
+	VoucherDenomsUpgradeName = "v0.2.0-voucher-denoms"
 )
 
 // this line is used by starport scaffolding # stargate/wasm/app/enabledProposals
@@ -145,6 +151,7 @@ var (
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		feegrantmodule.AppModuleBasic{},
+		authzmodule.AppModuleBasic{},
 		ibc.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
@@ -167,6 +174,7 @@ var (
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 		campaignmoduletypes.ModuleName: {authtypes.Minter, authtypes.Burner},
+		launchmoduletypes.ModuleName:   {authtypes.Burner},
 	}
 )
 
@@ -194,6 +202,7 @@ type App struct {
 	cdc               *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -218,6 +227,7 @@ type App struct {
 	EvidenceKeeper   evidencekeeper.Keeper
 	TransferKeeper   ibctransferkeeper.Keeper
 	FeeGrantKeeper   feegrantkeeper.Keeper
+	AuthzKeeper      authzkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -230,7 +240,8 @@ type App struct {
 	ProfileKeeper  profilemodulekeeper.Keeper
 
 	// the module manager
-	mm *module.Manager
+	mm           *module.Manager
+	configurator module.Configurator
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -271,6 +282,7 @@ func New(
 		ibchost.StoreKey,
 		upgradetypes.StoreKey,
 		feegrant.StoreKey,
+		authzkeeper.StoreKey,
 		evidencetypes.StoreKey,
 		ibctransfertypes.StoreKey,
 		capabilitytypes.StoreKey,
@@ -287,6 +299,7 @@ func New(
 		cdc:               cdc,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -332,6 +345,7 @@ func New(
 	)
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AuthKeeper)
+	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 
 	// register the staking hooks
@@ -361,7 +375,6 @@ func New(
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.AuthKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
-	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -387,19 +400,34 @@ func New(
 		keys[launchmoduletypes.MemStoreKey],
 		app.GetSubspace(launchmoduletypes.ModuleName),
 		app.ProfileKeeper,
+		app.BankKeeper,
+		app.FeeGrantKeeper,
+		app.DistrKeeper,
+		encodingConfig.TxConfig,
 	)
 
 	campaignKeeper := campaignmodulekeeper.NewKeeper(
 		appCodec,
 		keys[campaignmoduletypes.StoreKey],
 		keys[campaignmoduletypes.MemStoreKey],
+		app.GetSubspace(campaignmoduletypes.ModuleName),
 		&app.LaunchKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
 		app.ProfileKeeper,
 	)
 	app.CampaignKeeper = *campaignKeeper
 	app.LaunchKeeper.SetCampaignKeeper(campaignKeeper)
 
+	// Launch and campaign modules can prevent the deletion of a coordinator managing active objects
+	app.ProfileKeeper.SetHooks(profilemoduletypes.NewMultiProfileHooks(
+		app.LaunchKeeper.ProfileHooks(),
+		app.CampaignKeeper.ProfileHooks(),
+	))
+
+	// The transfer module is created once the campaign keeper is initialized to check voucher transfers
+	transferModule := newVoucherTransferModule(app.TransferKeeper, app.CampaignKeeper)
+
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
 	// Create static IBC router, add transfer route, then set and seal it
@@ -427,6 +455,7 @@ func New(
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AuthKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AuthKeeper, app.BankKeeper, app.interfaceRegistry),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AuthKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AuthKeeper),
@@ -458,6 +487,8 @@ func New(
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
 		feegrant.ModuleName,
+		launchmoduletypes.ModuleName,
+		campaignmoduletypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -479,6 +510,7 @@ func New(
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		authz.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 		campaignmoduletypes.ModuleName,
 		launchmoduletypes.ModuleName,
@@ -487,7 +519,9 @@ func New(
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
+	app.setUpgradeHandlers()
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	app.sm = module.NewSimulationManager(
@@ -495,6 +529,7 @@ func New(
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AuthKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AuthKeeper, app.BankKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AuthKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AuthKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AuthKeeper, app.BankKeeper),
@@ -555,6 +590,18 @@ func (app *App) Name() string { return app.BaseApp.Name() }
 // GetBaseApp returns the base app of the application
 func (app App) GetBaseApp() *baseapp.BaseApp { return app.BaseApp }
 
+// GetStakingKeeper returns the staking keeper of the application
+func (app App) GetStakingKeeper() stakingkeeper.Keeper { return app.StakingKeeper }
+
+// GetIBCKeeper returns the IBC keeper of the application
+func (app App) GetIBCKeeper() *ibckeeper.Keeper { return app.IBCKeeper }
+
+// GetScopedIBCKeeper returns the scoped IBC keeper of the application
+func (app App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper { return app.ScopedIBCKeeper }
+
+// GetTxConfig returns the transaction config of the application
+func (app App) GetTxConfig() client.TxConfig { return app.txConfig }
+
 // BeginBlocker application updates every begin block
 func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
@@ -571,9 +618,26 @@ func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.Res
 	if err := tmjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
+// setUpgradeHandlers registers the handlers running the in-place store migrations of the upgrades
+func (app *App) setUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		VoucherDenomsUpgradeName,
+		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			// the chains initialized without a module version map are at the current version of every module
+			// but the campaign module, which is migrated from its version 1
+			if len(fromVM) == 0 {
+				fromVM = app.mm.GetVersionMap()
+				fromVM[campaignmoduletypes.ModuleName] = 1
+			}
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+}
+
 // LoadHeight loads a particular height
 func (app *App) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
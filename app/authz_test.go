@@ -0,0 +1,174 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	ibctesting "github.com/cosmos/ibc-go/testing"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/app"
+	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestAuthzCoordinatorActions(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	spnApp := chainA.App.(*app.App)
+	grantee := chainA.SenderAccount.GetAddress()
+	granter := sample.AccAddress()
+	coordAddr := granter.String()
+
+	// handleMsg handles the message through the router of the application
+	handleMsg := func(msg sdk.Msg) (*sdk.Result, error) {
+		handler := spnApp.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler)
+		return handler(chainA.GetContext(), msg)
+	}
+
+	// requireUnauthorized checks the error is an authz unauthorized error, authz wraps a copy of the error
+	// so the ABCI code is compared
+	requireUnauthorized := func(t *testing.T, err error) {
+		_, code, _ := sdkerrors.ABCIInfo(err, false)
+		require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), code)
+	}
+
+	// grant grants the execution of the message type on behalf of the coordinator to the grantee
+	grant := func(msg sdk.Msg, expiration time.Time) {
+		require.NoError(t, spnApp.AuthzKeeper.SaveGrant(
+			chainA.GetContext(),
+			grantee,
+			granter,
+			authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)),
+			expiration,
+		))
+		coordinator.CommitBlock(chainA)
+	}
+
+	// create the coordinator with a chain and a campaign
+	launchID := spnApp.LaunchKeeper.GetChainCounter(chainA.GetContext())
+	campaignID := spnApp.CampaignKeeper.GetCampaignCounter(chainA.GetContext())
+	msgCreateCoordinator := sample.MsgCreateCoordinator(coordAddr)
+	_, err := handleMsg(&msgCreateCoordinator)
+	require.NoError(t, err)
+	msgCreateChain := sample.MsgCreateChain(coordAddr, "", false, 0)
+	_, err = handleMsg(&msgCreateChain)
+	require.NoError(t, err)
+	msgCreateCampaign := sample.MsgCreateCampaign(coordAddr)
+	_, err = handleMsg(&msgCreateCampaign)
+	require.NoError(t, err)
+	coordinator.CommitBlock(chainA)
+
+	expiration := chainA.GetContext().BlockTime().Add(time.Hour)
+
+	// appendRequest appends a pending request to the chain
+	appendRequest := func() uint64 {
+		requestID := spnApp.LaunchKeeper.AppendRequest(
+			chainA.GetContext(),
+			sample.Request(launchID, sample.Address()),
+		)
+		coordinator.CommitBlock(chainA)
+		return requestID
+	}
+
+	t.Run("should prevent the grantee from settling requests without grant", func(t *testing.T) {
+		requestID := appendRequest()
+
+		_, err := handleMsg(launchtypes.NewMsgSettleRequest(grantee.String(), launchID, requestID, true, ""))
+		require.ErrorIs(t, err, profiletypes.ErrCoordAddressNotFound)
+
+		msgExec := authz.NewMsgExec(grantee, []sdk.Msg{
+			launchtypes.NewMsgSettleRequest(coordAddr, launchID, requestID, true, ""),
+		})
+		_, err = handleMsg(&msgExec)
+		requireUnauthorized(t, err)
+	})
+
+	t.Run("should allow the grantee to settle requests on behalf of the coordinator", func(t *testing.T) {
+		grant(&launchtypes.MsgSettleRequest{}, expiration)
+		requestID := appendRequest()
+
+		msgExec := authz.NewMsgExec(grantee, []sdk.Msg{
+			launchtypes.NewMsgSettleRequest(coordAddr, launchID, requestID, true, ""),
+		})
+		_, err := chainA.SendMsgs(&msgExec)
+		require.NoError(t, err)
+
+		request, found := spnApp.LaunchKeeper.GetRequest(chainA.GetContext(), launchID, requestID)
+		require.True(t, found)
+		require.Equal(t, launchtypes.Request_APPROVED, request.Status)
+	})
+
+	t.Run("should prevent the grantee from executing a message type not granted", func(t *testing.T) {
+		msgExec := authz.NewMsgExec(grantee, []sdk.Msg{
+			launchtypes.NewMsgTriggerLaunch(coordAddr, launchID, launchtypes.DefaultMinLaunchTime),
+		})
+		_, err := handleMsg(&msgExec)
+		requireUnauthorized(t, err)
+	})
+
+	t.Run("should prevent the grantee from executing a message for another coordinator", func(t *testing.T) {
+		otherCoordAddr := sample.Address()
+		msgCreateCoordinator := sample.MsgCreateCoordinator(otherCoordAddr)
+		_, err := handleMsg(&msgCreateCoordinator)
+		require.NoError(t, err)
+		coordinator.CommitBlock(chainA)
+
+		// the message is granted by the coordinator but signed by another coordinator
+		msgExec := authz.NewMsgExec(grantee, []sdk.Msg{
+			launchtypes.NewMsgSettleRequest(otherCoordAddr, launchID, appendRequest(), true, ""),
+		})
+		_, err = handleMsg(&msgExec)
+		requireUnauthorized(t, err)
+	})
+
+	t.Run("should prevent the grantee from settling requests with an expired grant", func(t *testing.T) {
+		grant(&launchtypes.MsgSettleRequest{}, chainA.GetContext().BlockTime().Add(time.Second))
+		requestID := appendRequest()
+		coordinator.IncrementTimeBy(time.Minute)
+		coordinator.CommitBlock(chainA)
+
+		msgExec := authz.NewMsgExec(grantee, []sdk.Msg{
+			launchtypes.NewMsgSettleRequest(coordAddr, launchID, requestID, true, ""),
+		})
+		_, err := handleMsg(&msgExec)
+		requireUnauthorized(t, err)
+
+		request, found := spnApp.LaunchKeeper.GetRequest(chainA.GetContext(), launchID, requestID)
+		require.True(t, found)
+		require.Equal(t, launchtypes.Request_PENDING, request.Status)
+	})
+
+	t.Run("should allow the grantee to mint vouchers on behalf of the coordinator", func(t *testing.T) {
+		grant(&campaigntypes.MsgMintVouchers{}, expiration)
+		shares := sample.Shares()
+
+		msgExec := authz.NewMsgExec(grantee, []sdk.Msg{
+			campaigntypes.NewMsgMintVouchers(coordAddr, campaignID, shares),
+		})
+		_, err := chainA.SendMsgs(&msgExec)
+		require.NoError(t, err)
+
+		// the vouchers are minted for the coordinator
+		vouchers, err := campaigntypes.SharesToVouchers(shares, campaignID)
+		require.NoError(t, err)
+		balance := spnApp.BankKeeper.GetAllBalances(chainA.GetContext(), granter)
+		require.True(t, balance.IsAllGTE(vouchers))
+		require.True(t, spnApp.BankKeeper.GetAllBalances(chainA.GetContext(), grantee).AmountOf(vouchers[0].Denom).IsZero())
+	})
+
+	t.Run("should prevent the grantee from executing profile messages of the coordinator without grant", func(t *testing.T) {
+		msgExec := authz.NewMsgExec(grantee, []sdk.Msg{
+			profiletypes.NewMsgDeleteCoordinator(coordAddr),
+		})
+		_, err := handleMsg(&msgExec)
+		requireUnauthorized(t, err)
+	})
+}
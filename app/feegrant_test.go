@@ -0,0 +1,150 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	ibctesting "github.com/cosmos/ibc-go/testing"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/app"
+	"github.com/tendermint/spn/testutil/sample"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+// deliverWithFeeGranter delivers a transaction signed by the provided key with the fees paid by the fee granter
+func deliverWithFeeGranter(
+	t *testing.T,
+	chain *ibctesting.TestChain,
+	priv cryptotypes.PrivKey,
+	feeGranter sdk.AccAddress,
+	fee sdk.Coins,
+	msgs ...sdk.Msg,
+) error {
+	spnApp := chain.App.(*app.App)
+	acc := spnApp.AuthKeeper.GetAccount(chain.GetContext(), sdk.AccAddress(priv.PubKey().Address()))
+	require.NotNil(t, acc)
+
+	txBuilder := chain.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetFeeGranter(feeGranter)
+	txBuilder.SetGasLimit(helpers.DefaultGenTxGas)
+
+	// the signer info must be set before generating the sign bytes
+	signMode := chain.TxConfig.SignModeHandler().DefaultMode()
+	sig := signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: acc.GetSequence(),
+	}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	signBytes, err := chain.TxConfig.SignModeHandler().GetSignBytes(signMode, authsign.SignerData{
+		ChainID:       chain.ChainID,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+	}, txBuilder.GetTx())
+	require.NoError(t, err)
+	sig.Data.(*signing.SingleSignatureData).Signature, err = priv.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	_, _, err = spnApp.Deliver(chain.TxConfig.TxEncoder(), txBuilder.GetTx())
+	chain.Coordinator.CommitBlock(chain)
+	return err
+}
+
+func TestRequestAllowance(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	spnApp := chainA.App.(*app.App)
+	coordAddr := chainA.SenderAccount.GetAddress()
+	validatorKey := secp256k1.GenPrivKey()
+	validatorAddr := sdk.AccAddress(validatorKey.PubKey().Address())
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// create the coordinator with two chains
+	msgCreateCoordinator := sample.MsgCreateCoordinator(coordAddr.String())
+	_, err := chainA.SendMsgs(&msgCreateCoordinator)
+	require.NoError(t, err)
+	launchID := spnApp.LaunchKeeper.GetChainCounter(chainA.GetContext())
+	msgCreateChain := sample.MsgCreateChain(coordAddr.String(), "", false, 0)
+	_, err = chainA.SendMsgs(&msgCreateChain)
+	require.NoError(t, err)
+	otherLaunchID := spnApp.LaunchKeeper.GetChainCounter(chainA.GetContext())
+	_, err = chainA.SendMsgs(&msgCreateChain)
+	require.NoError(t, err)
+
+	// the coordinator sponsors the requests of the validator for the first chain
+	_, err = chainA.SendMsgs(launchtypes.NewMsgGrantRequestAllowance(
+		coordAddr.String(),
+		launchID,
+		validatorAddr.String(),
+		spendLimit,
+		0,
+	))
+	require.NoError(t, err)
+
+	t.Run("should allow the validator to send requests with the fees paid by the coordinator", func(t *testing.T) {
+		fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+		coordBalance := spnApp.BankKeeper.GetAllBalances(chainA.GetContext(), coordAddr)
+		requestID := spnApp.LaunchKeeper.GetRequestCounter(chainA.GetContext(), launchID)
+
+		err := deliverWithFeeGranter(t, chainA, validatorKey, coordAddr, fee,
+			launchtypes.NewMsgRequestAddAccount(validatorAddr.String(), launchID, sample.Coins()),
+		)
+		require.NoError(t, err)
+
+		_, found := spnApp.LaunchKeeper.GetRequest(chainA.GetContext(), launchID, requestID)
+		require.True(t, found)
+		require.True(t, spnApp.BankKeeper.GetAllBalances(chainA.GetContext(), validatorAddr).IsZero())
+		require.True(t, coordBalance.Sub(fee).IsEqual(spnApp.BankKeeper.GetAllBalances(chainA.GetContext(), coordAddr)))
+
+		// the spend limit of the allowance is decreased
+		grant, err := spnApp.FeeGrantKeeper.GetAllowance(chainA.GetContext(), coordAddr, validatorAddr)
+		require.NoError(t, err)
+		allowance, ok := grant.(*launchtypes.LaunchRequestAllowance)
+		require.True(t, ok)
+		basicAllowance, err := allowance.GetFeeAllowance()
+		require.NoError(t, err)
+		require.Equal(t, spendLimit.Sub(fee), basicAllowance.(*feegrant.BasicAllowance).SpendLimit)
+	})
+
+	t.Run("should prevent the validator from sending requests for another chain", func(t *testing.T) {
+		err := deliverWithFeeGranter(t, chainA, validatorKey, coordAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			launchtypes.NewMsgRequestAddAccount(validatorAddr.String(), otherLaunchID, sample.Coins()),
+		)
+		require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+	})
+
+	t.Run("should prevent the validator from paying fees above the spend limit", func(t *testing.T) {
+		err := deliverWithFeeGranter(t, chainA, validatorKey, coordAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60)),
+			launchtypes.NewMsgRequestAddAccount(validatorAddr.String(), launchID, sample.Coins()),
+		)
+		require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+	})
+	t.Run("should prevent the validator from sending requests once the launch is triggered", func(t *testing.T) {
+		msgTriggerLaunch := sample.MsgTriggerLaunch(coordAddr.String(), launchID)
+		_, err := chainA.SendMsgs(&msgTriggerLaunch)
+		require.NoError(t, err)
+
+		// the allowance is closed and its record removed
+		_, found := spnApp.LaunchKeeper.GetRequestAllowanceGrant(chainA.GetContext(), launchID, validatorAddr.String())
+		require.False(t, found)
+		grant, err := spnApp.FeeGrantKeeper.GetAllowance(chainA.GetContext(), coordAddr, validatorAddr)
+		require.NoError(t, err)
+		require.True(t, grant.(*launchtypes.LaunchRequestAllowance).LaunchTriggered)
+
+		err = deliverWithFeeGranter(t, chainA, validatorKey, coordAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			launchtypes.NewMsgRequestAddAccount(validatorAddr.String(), launchID, sample.Coins()),
+		)
+		require.ErrorIs(t, err, launchtypes.ErrTriggeredLaunch)
+	})
+}
@@ -0,0 +1,195 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/testing"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spm/cosmoscmd"
+	"github.com/tendermint/spn/app"
+	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// setupTestingApp initializes the application for the IBC testing chains
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encoding := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	spnApp := app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		5,
+		encoding,
+		simapp.EmptyAppOptions{},
+	).(*app.App)
+	return spnApp, app.NewDefaultGenesisState(encoding.Marshaler)
+}
+
+// newTransferPath returns a path between the transfer modules of two chains
+func newTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	return path
+}
+
+// relayTransfer relays the transfer of a coin from the source endpoint to its counterparty
+func relayTransfer(
+	t *testing.T,
+	path *ibctesting.Path,
+	source *ibctesting.Endpoint,
+	denomPath string,
+	amount uint64,
+	sequence uint64,
+	timeoutHeight clienttypes.Height,
+) channeltypes.Packet {
+	destination := source.Counterparty
+	packetData := ibctransfertypes.NewFungibleTokenPacketData(
+		denomPath,
+		amount,
+		source.Chain.SenderAccount.GetAddress().String(),
+		destination.Chain.SenderAccount.GetAddress().String(),
+	)
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		source.ChannelConfig.PortID,
+		source.ChannelID,
+		destination.ChannelConfig.PortID,
+		destination.ChannelID,
+		timeoutHeight,
+		0,
+	)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, path.RelayPacket(packet, ack.Acknowledgement()))
+	return packet
+}
+
+func TestVoucherTransfer(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+	path := newTransferPath(chainA, chainB)
+	coordinator.Setup(path)
+
+	spnApp := chainA.App.(*app.App)
+	senderA := chainA.SenderAccount.GetAddress()
+	senderB := chainB.SenderAccount.GetAddress()
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+
+	// createCampaignWithVouchers creates a campaign and mints vouchers for the sender of chain A
+	createCampaignWithVouchers := func(voucherTransferDisabled bool) sdk.Coin {
+		ctx := chainA.GetContext()
+		campaign := sample.Campaign(0)
+		campaign.VoucherTransferDisabled = voucherTransferDisabled
+		campaignID := spnApp.CampaignKeeper.AppendCampaign(ctx, campaign)
+
+		voucher := sdk.NewCoin(campaigntypes.VoucherDenom(campaignID, "foo"), sdk.NewInt(1000))
+		require.NoError(t, spnApp.BankKeeper.MintCoins(ctx, campaigntypes.ModuleName, sdk.NewCoins(voucher)))
+		require.NoError(t, spnApp.BankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			campaigntypes.ModuleName,
+			senderA,
+			sdk.NewCoins(voucher),
+		))
+		coordinator.CommitBlock(chainA)
+		return voucher
+	}
+
+	t.Run("should allow transferring vouchers to another chain and back", func(t *testing.T) {
+		voucher := createCampaignWithVouchers(false)
+
+		// send the vouchers from chain A to chain B
+		msg := ibctransfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			voucher,
+			senderA.String(),
+			senderB.String(),
+			timeoutHeight,
+			0,
+		)
+		_, err := chainA.SendMsgs(msg)
+		require.NoError(t, err)
+		packet := relayTransfer(t, path, path.EndpointA, voucher.Denom, voucher.Amount.Uint64(), 1, timeoutHeight)
+
+		// the vouchers are escrowed on chain A and received as IBC vouchers on chain B
+		require.True(t, spnApp.BankKeeper.GetBalance(chainA.GetContext(), senderA, voucher.Denom).IsZero())
+		voucherTrace := ibctransfertypes.ParseDenomTrace(
+			ibctransfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), voucher.Denom),
+		)
+		require.Equal(t, voucher.Denom, voucherTrace.BaseDenom)
+		ibcVoucher := sdk.NewCoin(voucherTrace.IBCDenom(), voucher.Amount)
+		require.Equal(
+			t,
+			ibcVoucher,
+			chainB.App.(*app.App).BankKeeper.GetBalance(chainB.GetContext(), senderB, ibcVoucher.Denom),
+		)
+
+		// send the vouchers back from chain B to chain A
+		msg = ibctransfertypes.NewMsgTransfer(
+			path.EndpointB.ChannelConfig.PortID,
+			path.EndpointB.ChannelID,
+			ibcVoucher,
+			senderB.String(),
+			senderA.String(),
+			timeoutHeight,
+			0,
+		)
+		_, err = chainB.SendMsgs(msg)
+		require.NoError(t, err)
+		relayTransfer(t, path, path.EndpointB, voucherTrace.GetFullDenomPath(), voucher.Amount.Uint64(), 1, timeoutHeight)
+
+		// the vouchers are unescrowed on chain A
+		require.Equal(t, voucher, spnApp.BankKeeper.GetBalance(chainA.GetContext(), senderA, voucher.Denom))
+		require.True(t, chainB.App.(*app.App).BankKeeper.GetBalance(chainB.GetContext(), senderB, ibcVoucher.Denom).IsZero())
+		escrow := ibctransfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		require.True(t, spnApp.BankKeeper.GetBalance(chainA.GetContext(), escrow, voucher.Denom).IsZero())
+	})
+
+	t.Run("should prevent transferring vouchers of a campaign with disabled voucher transfer", func(t *testing.T) {
+		voucher := createCampaignWithVouchers(true)
+		msg := ibctransfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			voucher,
+			senderA.String(),
+			senderB.String(),
+			timeoutHeight,
+			0,
+		)
+
+		// the message is handled through the router of the application to check the transfer module wiring
+		handler := spnApp.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler)
+		_, err := handler(chainA.GetContext(), msg)
+		require.ErrorIs(t, err, campaigntypes.ErrVoucherTransferDisabled)
+
+		// the vouchers can be transferred once the mainnet is initialized
+		campaignID, err := campaigntypes.VoucherCampaign(voucher.Denom)
+		require.NoError(t, err)
+		campaign, found := spnApp.CampaignKeeper.GetCampaign(chainA.GetContext(), campaignID)
+		require.True(t, found)
+		campaign.MainnetInitialized = true
+		spnApp.CampaignKeeper.SetCampaign(chainA.GetContext(), campaign)
+		coordinator.CommitBlock(chainA)
+
+		_, err = chainA.SendMsgs(msg)
+		require.NoError(t, err)
+		relayTransfer(t, path, path.EndpointA, voucher.Denom, voucher.Amount.Uint64(), 2, timeoutHeight)
+		require.True(t, spnApp.BankKeeper.GetBalance(chainA.GetContext(), senderA, voucher.Denom).IsZero())
+	})
+}
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/ibc-go/modules/apps/transfer"
+	ibctransferkeeper "github.com/cosmos/ibc-go/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	campaignmodulekeeper "github.com/tendermint/spn/x/campaign/keeper"
+)
+
+// voucherTransferModule is the IBC transfer module preventing the transfer of the vouchers
+// of the campaigns with disabled voucher transfer
+type voucherTransferModule struct {
+	transfer.AppModule
+	keeper         ibctransferkeeper.Keeper
+	campaignKeeper campaignmodulekeeper.Keeper
+}
+
+func newVoucherTransferModule(
+	keeper ibctransferkeeper.Keeper,
+	campaignKeeper campaignmodulekeeper.Keeper,
+) voucherTransferModule {
+	return voucherTransferModule{
+		AppModule:      transfer.NewAppModule(keeper),
+		keeper:         keeper,
+		campaignKeeper: campaignKeeper,
+	}
+}
+
+// RegisterServices registers the IBC transfer services with the voucher transfer check on the message server
+func (am voucherTransferModule) RegisterServices(cfg module.Configurator) {
+	ibctransfertypes.RegisterMsgServer(
+		cfg.MsgServer(),
+		campaignmodulekeeper.NewVoucherTransferMsgServer(am.campaignKeeper, am.keeper),
+	)
+	ibctransfertypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
@@ -0,0 +1,45 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctesting "github.com/cosmos/ibc-go/testing"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/app"
+	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestVoucherDenomsUpgrade(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	spnApp := chainA.App.(*app.App)
+	ctx := chainA.GetContext()
+
+	// the module versions are recorded on chain initialization
+	require.EqualValues(t, 2, spnApp.UpgradeKeeper.GetModuleVersionMap(ctx)[campaigntypes.ModuleName])
+
+	// legacy vouchers held by an account of a chain with the version 1 of the campaign module
+	holder := sample.AccAddress()
+	legacy := sdk.NewCoins(sdk.NewCoin(
+		campaigntypes.LegacyVoucherPrefix+"0"+campaigntypes.LegacyVoucherSeparator+"foo",
+		sdk.NewInt(100),
+	))
+	require.NoError(t, spnApp.BankKeeper.MintCoins(ctx, campaigntypes.ModuleName, legacy))
+	require.NoError(t, spnApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, campaigntypes.ModuleName, holder, legacy))
+	spnApp.UpgradeKeeper.SetModuleVersionMap(ctx, module.VersionMap{campaigntypes.ModuleName: 1})
+
+	plan := upgradetypes.Plan{Name: app.VoucherDenomsUpgradeName, Height: ctx.BlockHeight()}
+	require.True(t, spnApp.UpgradeKeeper.HasHandler(plan.Name))
+	spnApp.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+
+	require.EqualValues(t, 2, spnApp.UpgradeKeeper.GetModuleVersionMap(ctx)[campaigntypes.ModuleName])
+	require.True(t, spnApp.BankKeeper.GetAllBalances(ctx, holder).IsEqual(sdk.NewCoins(
+		sdk.NewCoin(campaigntypes.VoucherDenom(0, "foo"), sdk.NewInt(100)),
+	)))
+}
@@ -0,0 +1,77 @@
+// Package numbers defines helper methods to parse lists of numbers provided by users
+package numbers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ListSeparator  = ","
+	RangeSeparator = "-"
+
+	// MaxListSize is the maximum count of numbers a list can contain
+	MaxListSize = 10000
+)
+
+var ErrInvalidList = errors.New("invalid number list, format must be a comma separated list of numbers or ranges like 1-50,52")
+
+// ParseList returns the numbers of a comma separated list of numbers and ranges
+// A range <start>-<end> includes both start and end, e.g. "1-3,5" returns [1, 2, 3, 5]
+// The function returns an error if the list is invalid or contains a number twice
+func ParseList(list string) ([]uint64, error) {
+	var (
+		numbers []uint64
+		parsed  = make(map[uint64]struct{})
+	)
+	for _, item := range strings.Split(list, ListSeparator) {
+		item = strings.TrimSpace(item)
+		start, end, err := parseItem(item)
+		if err != nil {
+			return nil, err
+		}
+		if end-start >= MaxListSize-uint64(len(numbers)) {
+			return nil, errors.Wrapf(ErrInvalidList, "more than %d numbers", MaxListSize)
+		}
+		for n := start; ; n++ {
+			if _, ok := parsed[n]; ok {
+				return nil, errors.Wrapf(ErrInvalidList, "%d is duplicated", n)
+			}
+			parsed[n] = struct{}{}
+			numbers = append(numbers, n)
+			if n == end {
+				break
+			}
+		}
+	}
+
+	return numbers, nil
+}
+
+// parseItem returns the start and the end of a list item that is either a number or a range
+func parseItem(item string) (uint64, uint64, error) {
+	bounds := strings.Split(item, RangeSeparator)
+	if len(bounds) > 2 {
+		return 0, 0, errors.Wrapf(ErrInvalidList, "%s: invalid range", item)
+	}
+
+	start, err := strconv.ParseUint(strings.TrimSpace(bounds[0]), 10, 64)
+	if err != nil {
+		return 0, 0, errors.Wrapf(ErrInvalidList, "%s: %s", item, err.Error())
+	}
+	if len(bounds) == 1 {
+		return start, start, nil
+	}
+
+	end, err := strconv.ParseUint(strings.TrimSpace(bounds[1]), 10, 64)
+	if err != nil {
+		return 0, 0, errors.Wrapf(ErrInvalidList, "%s: %s", item, err.Error())
+	}
+	if start > end {
+		return 0, 0, errors.Wrapf(ErrInvalidList, "%s: range start is greater than range end", item)
+	}
+
+	return start, end, nil
+}
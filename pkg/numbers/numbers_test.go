@@ -0,0 +1,124 @@
+package numbers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/pkg/numbers"
+)
+
+func TestParseList(t *testing.T) {
+	expectedMaxList := make([]uint64, numbers.MaxListSize)
+	for i := range expectedMaxList {
+		expectedMaxList[i] = uint64(i + 1)
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		list     string
+		expected []uint64
+		valid    bool
+	}{
+		{
+			desc:     "single number",
+			list:     "42",
+			expected: []uint64{42},
+			valid:    true,
+		},
+		{
+			desc:     "list of numbers",
+			list:     "3,1,2",
+			expected: []uint64{3, 1, 2},
+			valid:    true,
+		},
+		{
+			desc:     "range",
+			list:     "1-5",
+			expected: []uint64{1, 2, 3, 4, 5},
+			valid:    true,
+		},
+		{
+			desc:     "range of a single number",
+			list:     "7-7",
+			expected: []uint64{7},
+			valid:    true,
+		},
+		{
+			desc:     "maximum list size",
+			list:     "1-9999,10000",
+			expected: expectedMaxList,
+			valid:    true,
+		},
+		{
+			desc:     "numbers and ranges with spaces",
+			list:     "0-2, 5 ,10 - 11",
+			expected: []uint64{0, 1, 2, 5, 10, 11},
+			valid:    true,
+		},
+		{
+			desc:  "empty",
+			list:  "",
+			valid: false,
+		},
+		{
+			desc:  "empty item",
+			list:  "1,,2",
+			valid: false,
+		},
+		{
+			desc:  "invalid number",
+			list:  "1,foo",
+			valid: false,
+		},
+		{
+			desc:  "negative number",
+			list:  "-1",
+			valid: false,
+		},
+		{
+			desc:  "invalid range",
+			list:  "1-2-3",
+			valid: false,
+		},
+		{
+			desc:  "range with missing end",
+			list:  "1-",
+			valid: false,
+		},
+		{
+			desc:  "range start greater than end",
+			list:  "5-1",
+			valid: false,
+		},
+		{
+			desc:  "too many numbers",
+			list:  "1-10000,10001",
+			valid: false,
+		},
+		{
+			desc:  "range too large",
+			list:  "0-18446744073709551615",
+			valid: false,
+		},
+		{
+			desc:  "duplicated number",
+			list:  "1,2,1",
+			valid: false,
+		},
+		{
+			desc:  "overlapping ranges",
+			list:  "1-5,5-10",
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			parsed, err := numbers.ParseList(tc.list)
+			if !tc.valid {
+				require.ErrorIs(t, err, numbers.ErrInvalidList)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, parsed)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Package events provides helpers to check the typed events emitted in tests
+package events
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// RequireLastTypedEvent checks the last event emitted in the context with the type of the provided typed event
+// is equal to the provided typed event
+func RequireLastTypedEvent(t *testing.T, ctx sdk.Context, expected proto.Message) {
+	t.Helper()
+
+	expectedEvent, err := sdk.TypedEventToEvent(expected)
+	require.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == expectedEvent.Type {
+			require.Equal(t, attributeMap(expectedEvent.Attributes), attributeMap(events[i].Attributes))
+			return
+		}
+	}
+	require.Failf(t, "event not emitted", "no event of type %s", expectedEvent.Type)
+}
+
+// RequireTypedEvent checks the provided typed event has been emitted in the context
+func RequireTypedEvent(t *testing.T, ctx sdk.Context, expected proto.Message) {
+	t.Helper()
+
+	expectedEvent, err := sdk.TypedEventToEvent(expected)
+	require.NoError(t, err)
+	expectedAttributes := attributeMap(expectedEvent.Attributes)
+
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != expectedEvent.Type || len(event.Attributes) != len(expectedAttributes) {
+			continue
+		}
+		attributes := attributeMap(event.Attributes)
+		equal := true
+		for key, value := range expectedAttributes {
+			if attributes[key] != value {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return
+		}
+	}
+	require.Failf(t, "event not emitted", "no event %s with attributes %v", expectedEvent.Type, expectedAttributes)
+}
+
+// attributeMap returns the attributes of an event indexed by key
+func attributeMap(attributes []abci.EventAttribute) map[string]string {
+	m := make(map[string]string, len(attributes))
+	for _, attribute := range attributes {
+		m[string(attribute.Key)] = string(attribute.Value)
+	}
+	return m
+}
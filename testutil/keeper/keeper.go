@@ -12,9 +12,15 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/spn/testutil/sample"
@@ -34,10 +40,14 @@ var (
 	ExampleTimestamp = time.Date(2020, time.January, 1, 12, 0, 0, 0, time.UTC)
 
 	moduleAccountPerms = map[string][]string{
-		authtypes.FeeCollectorName:  nil,
-		minttypes.ModuleName:        {authtypes.Minter},
-		ibctransfertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
-		campaigntypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:     nil,
+		distrtypes.ModuleName:          nil,
+		minttypes.ModuleName:           {authtypes.Minter},
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		campaigntypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+		launchtypes.ModuleName:         {authtypes.Burner},
 	}
 )
 
@@ -50,10 +60,17 @@ func AllKeepers(t testing.TB) (*campaignkeeper.Keeper, *launchkeeper.Keeper, *pr
 	paramKeeper := initParam(cdc, db, stateStore)
 	authKeeper := initAuth(cdc, db, stateStore, paramKeeper)
 	bankKeeper := initBank(cdc, db, stateStore, paramKeeper, authKeeper)
+	feegrantKeeper := initFeegrant(cdc, db, stateStore, authKeeper)
+	stakingKeeper := initStaking(cdc, db, stateStore, paramKeeper, authKeeper, bankKeeper)
+	distrKeeper := initDistribution(cdc, db, stateStore, paramKeeper, authKeeper, bankKeeper, stakingKeeper)
 	profileKeeper := initProfile(cdc, db, stateStore)
-	launchKeeper := initLaunch(cdc, db, stateStore, profileKeeper, paramKeeper)
-	campaignKeeper := initCampaign(cdc, db, stateStore, launchKeeper, profileKeeper, bankKeeper)
+	launchKeeper := initLaunch(cdc, db, stateStore, profileKeeper, bankKeeper, feegrantKeeper, distrKeeper, paramKeeper)
+	campaignKeeper := initCampaign(cdc, db, stateStore, paramKeeper, launchKeeper, profileKeeper, bankKeeper, distrKeeper)
 	launchKeeper.SetCampaignKeeper(campaignKeeper)
+	profileKeeper.SetHooks(profiletypes.NewMultiProfileHooks(
+		launchKeeper.ProfileHooks(),
+		campaignKeeper.ProfileHooks(),
+	))
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	// Create a context using a custom timestamp
@@ -61,8 +78,12 @@ func AllKeepers(t testing.TB) (*campaignkeeper.Keeper, *launchkeeper.Keeper, *pr
 		Time: ExampleTimestamp,
 	}, false, log.NewNopLogger())
 
+	// Initialize community pool
+	distrKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+
 	// Initialize params
 	launchKeeper.SetParams(ctx, launchtypes.DefaultParams())
+	campaignKeeper.SetParams(ctx, campaigntypes.DefaultParams())
 
 	return campaignKeeper, launchKeeper, profileKeeper, bankKeeper, ctx
 }
@@ -86,8 +107,13 @@ func Launch(t testing.TB) (*launchkeeper.Keeper, sdk.Context) {
 	stateStore := store.NewCommitMultiStore(db)
 
 	paramKeeper := initParam(cdc, db, stateStore)
+	authKeeper := initAuth(cdc, db, stateStore, paramKeeper)
+	bankKeeper := initBank(cdc, db, stateStore, paramKeeper, authKeeper)
+	feegrantKeeper := initFeegrant(cdc, db, stateStore, authKeeper)
+	stakingKeeper := initStaking(cdc, db, stateStore, paramKeeper, authKeeper, bankKeeper)
+	distrKeeper := initDistribution(cdc, db, stateStore, paramKeeper, authKeeper, bankKeeper, stakingKeeper)
 	profileKeeper := initProfile(cdc, db, stateStore)
-	launchKeeper := initLaunch(cdc, db, stateStore, profileKeeper, paramKeeper)
+	launchKeeper := initLaunch(cdc, db, stateStore, profileKeeper, bankKeeper, feegrantKeeper, distrKeeper, paramKeeper)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	// Create a context using a custom timestamp
@@ -95,6 +121,9 @@ func Launch(t testing.TB) (*launchkeeper.Keeper, sdk.Context) {
 		Time: ExampleTimestamp,
 	}, false, log.NewNopLogger())
 
+	// Initialize community pool
+	distrKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+
 	// Initialize params
 	launchKeeper.SetParams(ctx, launchtypes.DefaultParams())
 
@@ -156,6 +185,65 @@ func initBank(
 	return bankkeeper.NewBaseKeeper(cdc, storeKey, authKeeper, bankSubspace, modAccAddrs)
 }
 
+func initFeegrant(
+	cdc codec.Codec,
+	db *tmdb.MemDB,
+	stateStore store.CommitMultiStore,
+	authKeeper authkeeper.AccountKeeper,
+) feegrantkeeper.Keeper {
+	storeKey := sdk.NewKVStoreKey(feegrant.StoreKey)
+
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+
+	return feegrantkeeper.NewKeeper(cdc, storeKey, authKeeper)
+}
+
+func initStaking(
+	cdc codec.Codec,
+	db *tmdb.MemDB,
+	stateStore store.CommitMultiStore,
+	paramKeeper paramskeeper.Keeper,
+	authKeeper authkeeper.AccountKeeper,
+	bankKeeper bankkeeper.Keeper,
+) stakingkeeper.Keeper {
+	storeKey := sdk.NewKVStoreKey(stakingtypes.StoreKey)
+
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+
+	paramKeeper.Subspace(stakingtypes.ModuleName)
+	stakingSubspace, _ := paramKeeper.GetSubspace(stakingtypes.ModuleName)
+
+	return stakingkeeper.NewKeeper(cdc, storeKey, authKeeper, bankKeeper, stakingSubspace)
+}
+
+func initDistribution(
+	cdc codec.Codec,
+	db *tmdb.MemDB,
+	stateStore store.CommitMultiStore,
+	paramKeeper paramskeeper.Keeper,
+	authKeeper authkeeper.AccountKeeper,
+	bankKeeper bankkeeper.Keeper,
+	stakingKeeper stakingkeeper.Keeper,
+) distrkeeper.Keeper {
+	storeKey := sdk.NewKVStoreKey(distrtypes.StoreKey)
+
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+
+	paramKeeper.Subspace(distrtypes.ModuleName)
+	distrSubspace, _ := paramKeeper.GetSubspace(distrtypes.ModuleName)
+
+	return distrkeeper.NewKeeper(
+		cdc,
+		storeKey,
+		distrSubspace,
+		authKeeper,
+		bankKeeper,
+		stakingKeeper,
+		authtypes.FeeCollectorName,
+		map[string]bool{},
+	)
+}
+
 func initProfile(cdc codec.Codec, db *tmdb.MemDB, stateStore store.CommitMultiStore) *profilekeeper.Keeper {
 	storeKey := sdk.NewKVStoreKey(profiletypes.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(profiletypes.MemStoreKey)
@@ -171,6 +259,9 @@ func initLaunch(
 	db *tmdb.MemDB,
 	stateStore store.CommitMultiStore,
 	profileKeeper *profilekeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	distrKeeper distrkeeper.Keeper,
 	paramKeeper paramskeeper.Keeper,
 ) *launchkeeper.Keeper {
 	storeKey := sdk.NewKVStoreKey(launchtypes.StoreKey)
@@ -182,16 +273,28 @@ func initLaunch(
 	paramKeeper.Subspace(launchtypes.ModuleName)
 	launchSubspace, _ := paramKeeper.GetSubspace(launchtypes.ModuleName)
 
-	return launchkeeper.NewKeeper(cdc, storeKey, memStoreKey, launchSubspace, profileKeeper)
+	return launchkeeper.NewKeeper(
+		cdc,
+		storeKey,
+		memStoreKey,
+		launchSubspace,
+		profileKeeper,
+		bankKeeper,
+		feegrantKeeper,
+		distrKeeper,
+		sample.TxConfig(),
+	)
 }
 
 func initCampaign(
 	cdc codec.Codec,
 	db *tmdb.MemDB,
 	stateStore store.CommitMultiStore,
+	paramKeeper paramskeeper.Keeper,
 	launchKeeper *launchkeeper.Keeper,
 	profileKeeper *profilekeeper.Keeper,
 	bankKeeper bankkeeper.Keeper,
+	distrKeeper distrkeeper.Keeper,
 ) *campaignkeeper.Keeper {
 	storeKey := sdk.NewKVStoreKey(campaigntypes.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(campaigntypes.MemStoreKey)
@@ -199,5 +302,17 @@ func initCampaign(
 	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
 
-	return campaignkeeper.NewKeeper(cdc, storeKey, memStoreKey, launchKeeper, bankKeeper, profileKeeper)
+	paramKeeper.Subspace(campaigntypes.ModuleName)
+	campaignSubspace, _ := paramKeeper.GetSubspace(campaigntypes.ModuleName)
+
+	return campaignkeeper.NewKeeper(
+		cdc,
+		storeKey,
+		memStoreKey,
+		campaignSubspace,
+		launchKeeper,
+		bankKeeper,
+		distrKeeper,
+		profileKeeper,
+	)
 }
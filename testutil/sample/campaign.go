@@ -18,6 +18,24 @@ func ShareVestingOptions() campaign.ShareVestingOptions {
 	return *campaign.NewShareDelayedVesting(Shares(), time.Now().Unix())
 }
 
+// ShareContinuousVestingOptions returns a sample continuous ShareVestingOptions
+func ShareContinuousVestingOptions() campaign.ShareVestingOptions {
+	startTime := time.Now().Unix()
+	return *campaign.NewShareContinuousVesting(Shares(), startTime, startTime+int64(rand.Intn(10000)+1))
+}
+
+// SharePeriodicVestingOptions returns a sample periodic ShareVestingOptions
+func SharePeriodicVestingOptions() campaign.ShareVestingOptions {
+	periods := make([]campaign.ShareVestingPeriod, rand.Intn(5)+1)
+	for i := range periods {
+		periods[i] = campaign.ShareVestingPeriod{
+			Length: int64(rand.Intn(10000) + 1),
+			Amount: Shares(),
+		}
+	}
+	return *campaign.NewSharePeriodicVesting(time.Now().Unix(), periods)
+}
+
 // Voucher returns a sample voucher structure
 func Voucher(campaignID uint64) sdk.Coin {
 	denom := campaign.VoucherDenom(campaignID, AlphaString(5))
@@ -83,9 +101,50 @@ func MsgCreateCampaign(coordAddr string) campaign.MsgCreateCampaign {
 	}
 }
 
+// Sale returns a sample fixed-price sale of vouchers of a campaign
+func Sale(id, campaignID uint64) campaign.Sale {
+	startTime := time.Now().Unix()
+	return campaign.NewSale(
+		id,
+		campaignID,
+		Voucher(campaignID),
+		sdk.NewCoin(AlphaString(5), sdk.NewInt(int64(rand.Intn(100)+1))),
+		startTime,
+		startTime+int64(rand.Intn(10000)+1),
+		sdk.NewInt(int64(rand.Intn(1000))),
+	)
+}
+
+// Auction returns a sample batch auction of vouchers of a campaign
+func Auction(id, campaignID uint64) campaign.Auction {
+	startTime := time.Now().Unix()
+	return campaign.NewAuction(
+		id,
+		campaignID,
+		Voucher(campaignID),
+		sdk.NewCoin(AlphaString(5), sdk.NewInt(int64(rand.Intn(100)+1))),
+		startTime,
+		startTime+int64(rand.Intn(10000)+1),
+	)
+}
+
+// Bid returns a sample bid for an auction
+func Bid(auction campaign.Auction, id uint64) campaign.Bid {
+	return campaign.NewBid(
+		auction.Id,
+		id,
+		Address(),
+		auction.MinPrice.AddAmount(sdk.NewInt(int64(rand.Intn(100)))),
+		sdk.NewInt(int64(rand.Intn(1000)+1)),
+	)
+}
+
 // CampaignGenesisState returns a sample genesis state for the campaign module
 func CampaignGenesisState() campaign.GenesisState {
 	campaign1, campaign2 := Campaign(0), Campaign(1)
+	auction1, auction2 := Auction(0, 0), Auction(1, 1)
+	auction1.BidCounter = 2
+	auction1.BidCount = 2
 
 	return campaign.GenesisState{
 		CampaignList: []campaign.Campaign{
@@ -104,5 +163,34 @@ func CampaignGenesisState() campaign.GenesisState {
 			MainnetVestingAccount(0, Address()),
 			MainnetVestingAccount(1, Address()),
 		},
+		SaleList: []campaign.Sale{
+			Sale(0, 0),
+			Sale(1, 1),
+		},
+		SaleCounter: 2,
+		SalePurchaseList: []campaign.SalePurchase{
+			{
+				SaleID:  0,
+				Address: Address(),
+				Amount:  sdk.OneInt(),
+			},
+		},
+		AuctionList: []campaign.Auction{
+			auction1,
+			auction2,
+		},
+		AuctionCounter: 2,
+		BidList: []campaign.Bid{
+			Bid(auction1, 0),
+			Bid(auction1, 1),
+		},
+		Params: CampaignParams(),
+	}
+}
+
+// CampaignParams returns a sample of params for the campaign module
+func CampaignParams() campaign.Params {
+	return campaign.Params{
+		CampaignCreationFee: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(int64(rand.Intn(10)+1)))),
 	}
 }
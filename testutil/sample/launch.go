@@ -3,11 +3,20 @@ package sample
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"math/rand"
 	"time"
 
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/tendermint/spn/pkg/chainid"
 	launch "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/tendermint/p2p"
 )
 
 // GenesisChainID returns a sample chain id
@@ -31,6 +40,31 @@ func Chain(id uint64, coordinatorID uint64) launch.Chain {
 	}
 }
 
+// ChainLimits returns a sample ChainLimits
+func ChainLimits() launch.ChainLimits {
+	minSelfDelegation := sdk.NewCoin("stake", sdk.NewInt(int64(rand.Intn(1000)+1)))
+	maxSelfDelegation := minSelfDelegation.Add(sdk.NewCoin("stake", sdk.NewInt(int64(rand.Intn(1000)))))
+	return launch.ChainLimits{
+		MaxValidators:      uint64(rand.Intn(100) + 1),
+		MaxGenesisAccounts: uint64(rand.Intn(100) + 1),
+		MaxAccountCoins:    Coins(),
+		MinSelfDelegation:  &minSelfDelegation,
+		MaxSelfDelegation:  &maxSelfDelegation,
+	}
+}
+
+// AutoApprovePolicy returns a sample AutoApprovePolicy
+func AutoApprovePolicy() launch.AutoApprovePolicy {
+	minSelfDelegation := sdk.NewCoin("stake", sdk.NewInt(int64(rand.Intn(1000)+1)))
+	maxSelfDelegation := minSelfDelegation.Add(sdk.NewCoin("stake", sdk.NewInt(int64(rand.Intn(1000)))))
+	return launch.AutoApprovePolicy{
+		MaxAccountCoins:   Coins(),
+		MinSelfDelegation: &minSelfDelegation,
+		MaxSelfDelegation: &maxSelfDelegation,
+		AllowedAddresses:  []string{Address(), Address()},
+	}
+}
+
 // GenesisAccount returns a sample GenesisAccount
 func GenesisAccount(launchID uint64, address string) launch.GenesisAccount {
 	return launch.GenesisAccount{
@@ -45,6 +79,24 @@ func VestingOptions() launch.VestingOptions {
 	return *launch.NewDelayedVesting(Coins(), time.Now().Unix())
 }
 
+// ContinuousVestingOptions returns a sample continuous VestingOptions
+func ContinuousVestingOptions() launch.VestingOptions {
+	startTime := time.Now().Unix()
+	return *launch.NewContinuousVesting(Coins(), startTime, startTime+int64(rand.Intn(10000)+1))
+}
+
+// PeriodicVestingOptions returns a sample periodic VestingOptions
+func PeriodicVestingOptions() launch.VestingOptions {
+	periods := make([]launch.VestingPeriod, rand.Intn(5)+1)
+	for i := range periods {
+		periods[i] = launch.VestingPeriod{
+			Length: int64(rand.Intn(10000) + 1),
+			Amount: Coins(),
+		}
+	}
+	return *launch.NewPeriodicVesting(time.Now().Unix(), periods)
+}
+
 // VestingAccount returns a sample VestingAccount
 func VestingAccount(launchID uint64, address string) launch.VestingAccount {
 	return launch.VestingAccount{
@@ -70,10 +122,20 @@ func GenesisValidator(launchID uint64, address string) launch.GenesisValidator {
 		GenTx:          Bytes(200),
 		ConsPubKey:     Bytes(10),
 		SelfDelegation: Coin(),
-		Peer:           String(10),
+		Peer:           Peer(),
 	}
 }
 
+// Peer returns a sample peer reachable through TCP
+func Peer() launch.Peer {
+	return launch.NewPeerConn(NodeID(), fmt.Sprintf("%s.com:%d", AlphaString(10), rand.Intn(65535)+1))
+}
+
+// NodeID returns a sample node ID
+func NodeID() string {
+	return hex.EncodeToString(Bytes(p2p.IDByteLength))
+}
+
 // ValidatorRemoval returns a sample ValidatorRemoval
 func ValidatorRemoval(address string) launch.ValidatorRemoval {
 	return launch.ValidatorRemoval{
@@ -98,7 +160,7 @@ func AllRequestContents(launchID uint64, genesis, vesting, validator string) []l
 		launch.NewAccountRemoval(genesis),
 		launch.NewVestingAccount(launchID, vesting, Coins(), VestingOptions()),
 		launch.NewAccountRemoval(vesting),
-		launch.NewGenesisValidator(launchID, validator, Bytes(300), Bytes(30), Coin(), String(30)),
+		launch.NewGenesisValidator(launchID, validator, Bytes(300), Bytes(30), Coin(), Peer()),
 		launch.NewValidatorRemoval(validator),
 	}
 }
@@ -130,6 +192,7 @@ func MsgCreateChain(coordAddress, genesisURL string, hasCampaign bool, campaignI
 		genesisHash,
 		hasCampaign,
 		campaignID,
+		launch.ChainLimits{},
 	)
 }
 
@@ -168,6 +231,8 @@ func MsgEditChain(
 		sourceURL,
 		sourceHash,
 		initialGenesis,
+		nil,
+		nil,
 	)
 }
 
@@ -208,18 +273,72 @@ func MsgRequestRemoveValidator(creator, validatorAddr string, launchID uint64) l
 	)
 }
 
-// MsgRequestAddValidator returns a sample MsgRequestAddValidator
-func MsgRequestAddValidator(address string, launchID uint64) launch.MsgRequestAddValidator {
+// MsgRequestAddValidator returns a sample MsgRequestAddValidator with a gentx signed by the private key for the chain ID
+func MsgRequestAddValidator(privKey cryptotypes.PrivKey, launchID uint64, chainID string) launch.MsgRequestAddValidator {
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	selfDelegation := Coin()
 	return *launch.NewMsgRequestAddValidator(
-		address,
+		sdk.AccAddress(privKey.PubKey().Address()).String(),
 		launchID,
-		Bytes(500),
-		Bytes(30),
-		Coin(),
-		String(30),
+		GenTx(privKey, consPubKey, selfDelegation, chainID),
+		consPubKey.Bytes(),
+		selfDelegation,
+		Peer(),
 	)
 }
 
+// GenTx returns a sample JSON encoded gentx creating a validator for the account of the private key
+// The gentx is signed for the chain ID with an account number and a sequence of 0
+func GenTx(privKey cryptotypes.PrivKey, consPubKey cryptotypes.PubKey, selfDelegation sdk.Coin, chainID string) []byte {
+	txConfig := TxConfig()
+	valAddress := sdk.ValAddress(privKey.PubKey().Address())
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddress,
+		consPubKey,
+		selfDelegation,
+		stakingtypes.NewDescription(AlphaString(10), "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+		sdk.OneInt(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	txBuilder := txConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msg); err != nil {
+		panic(err)
+	}
+
+	// The signer info must be set before computing the bytes to sign
+	signMode := txConfig.SignModeHandler().DefaultMode()
+	if err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: privKey.PubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signMode},
+	}); err != nil {
+		panic(err)
+	}
+	sig, err := clienttx.SignWithPrivKey(
+		signMode,
+		authsigning.SignerData{ChainID: chainID},
+		txBuilder,
+		privKey,
+		txConfig,
+		0,
+	)
+	if err != nil {
+		panic(err)
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		panic(err)
+	}
+
+	genTx, err := txConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		panic(err)
+	}
+	return genTx
+}
+
 // MsgRevertLaunch returns a sample MsgRevertLaunch
 func MsgRevertLaunch(coordinator string, launchID uint64) launch.MsgRevertLaunch {
 	return *launch.NewMsgRevertLaunch(
@@ -246,9 +365,29 @@ func MsgSettleRequest(coordinator string, launchID, requestID uint64, approve bo
 		launchID,
 		requestID,
 		approve,
+		"",
 	)
 }
 
+// RewardPool returns a sample RewardPool
+func RewardPool(launchID uint64) launch.RewardPool {
+	return launch.NewRewardPool(launchID, Address(), Coins(), int64(rand.Intn(10000)+1))
+}
+
+// ParticipantList returns a sample ParticipantList
+func ParticipantList(launchID uint64) launch.ParticipantList {
+	listType := launch.ParticipantList_ALLOWLIST
+	if rand.Intn(2) == 0 {
+		listType = launch.ParticipantList_DENYLIST
+	}
+	return launch.NewParticipantList(launchID, listType, []string{Address(), Address()})
+}
+
+// RequestAllowanceGrant returns a sample RequestAllowanceGrant
+func RequestAllowanceGrant(launchID uint64) launch.RequestAllowanceGrant {
+	return launch.NewRequestAllowanceGrant(launchID, Address(), Address())
+}
+
 // GenesisHash returns a sample sha256 hash of custom genesis for GenesisURL
 func GenesisHash() string {
 	hash := sha256.Sum256([]byte(String(50)))
@@ -260,8 +399,10 @@ func LaunchParams() launch.Params {
 	maxLaunchTime := launch.DefaultMaxLaunchTime - uint64(rand.Intn(10))
 	minLaunchTime := uint64(rand.Intn(10)) + launch.DefaultMinLaunchTime
 	return launch.Params{
-		MinLaunchTime: minLaunchTime,
-		MaxLaunchTime: maxLaunchTime,
+		MinLaunchTime:    minLaunchTime,
+		MaxLaunchTime:    maxLaunchTime,
+		RequestDeposit:   sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(int64(rand.Intn(10)+1)))),
+		ChainCreationFee: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(int64(rand.Intn(10)+1)))),
 	}
 }
 
@@ -305,6 +446,14 @@ func LaunchGenesisState(addresses ...string) launch.GenesisState {
 				Counter:  2,
 			},
 		},
+		ParticipantListList: []launch.ParticipantList{
+			ParticipantList(0),
+			ParticipantList(1),
+		},
+		RequestAllowanceGrantList: []launch.RequestAllowanceGrant{
+			RequestAllowanceGrant(0),
+			RequestAllowanceGrant(1),
+		},
 		Params: LaunchParams(),
 	}
 }
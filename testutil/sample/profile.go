@@ -1,6 +1,8 @@
 package sample
 
 import (
+	"math/rand"
+
 	profile "github.com/tendermint/spn/x/profile/types"
 )
 
@@ -42,6 +44,22 @@ func CoordinatorDescription() profile.CoordinatorDescription {
 	}
 }
 
+// OperatorPermissions returns a sample non-empty list of distinct operator permissions
+func OperatorPermissions() []profile.CoordinatorOperator_Permission {
+	var permissions []profile.CoordinatorOperator_Permission
+	// the unspecified permission is skipped
+	n := len(profile.CoordinatorOperator_Permission_name) - 1
+	for _, i := range rand.Perm(n)[:rand.Intn(n)+1] {
+		permissions = append(permissions, profile.CoordinatorOperator_Permission(i+1))
+	}
+	return permissions
+}
+
+// CoordinatorOperator returns a sample CoordinatorOperator
+func CoordinatorOperator(coordinatorID uint64) profile.CoordinatorOperator {
+	return profile.NewCoordinatorOperator(coordinatorID, Address(), OperatorPermissions())
+}
+
 // ProfileGenesisState returns a sample genesis state for the profile module
 func ProfileGenesisState(addresses ...string) profile.GenesisState {
 	for len(addresses) < 7 {
@@ -98,6 +116,10 @@ func ProfileGenesisState(addresses ...string) profile.GenesisState {
 			},
 		},
 		CoordinatorCounter: 5,
+		CoordinatorOperatorList: []profile.CoordinatorOperator{
+			CoordinatorOperator(0),
+			CoordinatorOperator(1),
+		},
 		ValidatorList: []profile.Validator{
 			{
 				Address:     addresses[5],
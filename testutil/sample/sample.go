@@ -3,13 +3,18 @@ package sample
 import (
 	"math/rand"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	launch "github.com/tendermint/spn/x/launch/types"
 	profile "github.com/tendermint/spn/x/profile/types"
@@ -17,12 +22,22 @@ import (
 
 // Codec returns a codec with preregistered interfaces
 func Codec() codec.Codec {
+	return protoCodec()
+}
+
+// TxConfig returns a transaction config using a codec with preregistered interfaces
+func TxConfig() client.TxConfig {
+	return authtx.NewTxConfig(protoCodec(), authtx.DefaultSignModes)
+}
+
+func protoCodec() *codec.ProtoCodec {
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
 
 	cryptocodec.RegisterInterfaces(interfaceRegistry)
 	authtypes.RegisterInterfaces(interfaceRegistry)
 	stakingtypes.RegisterInterfaces(interfaceRegistry)
 	banktypes.RegisterInterfaces(interfaceRegistry)
+	feegrant.RegisterInterfaces(interfaceRegistry)
 	launch.RegisterInterfaces(interfaceRegistry)
 	profile.RegisterInterfaces(interfaceRegistry)
 
@@ -67,6 +82,11 @@ func AlphaString(n int) string {
 	return string(randomString)
 }
 
+// PrivKey returns a sample account private key
+func PrivKey() cryptotypes.PrivKey {
+	return secp256k1.GenPrivKey()
+}
+
 // AccAddress returns a sample account address
 func AccAddress() sdk.AccAddress {
 	pk := ed25519.GenPrivKey().PubKey()
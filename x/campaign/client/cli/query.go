@@ -24,8 +24,16 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowCampaignChains())
 	cmd.AddCommand(CmdListMainnetAccount())
 	cmd.AddCommand(CmdShowMainnetAccount())
+	cmd.AddCommand(CmdListMainnetAccountBalance())
+	cmd.AddCommand(CmdShowMainnetAccountBalance())
 	cmd.AddCommand(CmdListMainnetVestingAccount())
 	cmd.AddCommand(CmdShowMainnetVestingAccount())
+	cmd.AddCommand(CmdListSale())
+	cmd.AddCommand(CmdShowSale())
+	cmd.AddCommand(CmdListAuction())
+	cmd.AddCommand(CmdShowAuction())
+	cmd.AddCommand(CmdListBid())
+	cmd.AddCommand(CmdQueryParams())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -23,8 +23,9 @@ func networkWithCampaignChainsObjects(t *testing.T, n int) (*network.Network, []
 
 	for i := 0; i < n; i++ {
 		state.CampaignChainsList = append(state.CampaignChainsList, types.CampaignChains{
-			CampaignID: uint64(i),
-			Chains:     []uint64{uint64(i)},
+			CampaignID:     uint64(i),
+			Chains:         []uint64{uint64(i)},
+			ReservedShares: []types.ChainShares{},
 		})
 	}
 	buf, err := cfg.Codec.MarshalJSON(&state)
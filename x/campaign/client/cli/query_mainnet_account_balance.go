@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func CmdListMainnetAccountBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-mainnet-account-balance [campaign-id]",
+		Short: "list the balances of the mainnet accounts of a campaign computed from their shares",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllMainnetAccountBalanceRequest{
+				CampaignID: campaignID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.MainnetAccountBalanceAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowMainnetAccountBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-mainnet-account-balance [campaign-id] [address]",
+		Short: "shows the balance of a mainnet account computed from its shares",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argsCampaignID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argsAddress := args[1]
+
+			params := &types.QueryGetMainnetAccountBalanceRequest{
+				CampaignID: argsCampaignID,
+				Address:    argsAddress,
+			}
+
+			res, err := queryClient.MainnetAccountBalance(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -25,10 +25,19 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdInitializeMainnet())
 	cmd.AddCommand(CmdAddShares())
 	cmd.AddCommand(CmdAddVestingOptions())
+	cmd.AddCommand(CmdAddPeriodicVestingOptions())
 	cmd.AddCommand(CmdMintVouchers())
 	cmd.AddCommand(CmdBurnVouchers())
 	cmd.AddCommand(CmdUnredeemVouchers())
 	cmd.AddCommand(CmdRedeemVouchers())
+	cmd.AddCommand(CmdCreateSale())
+	cmd.AddCommand(CmdBuyVouchers())
+	cmd.AddCommand(CmdWithdrawSale())
+	cmd.AddCommand(CmdCreateAuction())
+	cmd.AddCommand(CmdPlaceBid())
+	cmd.AddCommand(CmdWithdrawAuction())
+	cmd.AddCommand(CmdReserveChainShares())
+	cmd.AddCommand(CmdDistributeChainShares())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// periodicVestingFile is the content of the file describing the periods of a periodic vesting
+type periodicVestingFile struct {
+	StartTime int64 `json:"start_time"`
+	Periods   []struct {
+		Shares string `json:"shares"`
+		Length int64  `json:"length_seconds"`
+	} `json:"periods"`
+}
+
+func CmdAddPeriodicVestingOptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-periodic-vesting-options [campaign-id] [address] [starting-shares] [periods-file]",
+		Short: "Add a mainnet vesting account with a periodic vesting",
+		Long: `Add a mainnet vesting account with a periodic vesting.
+The periods file is a JSON file describing the consecutive vesting periods, e.g.
+{
+  "start_time": 1625204910,
+  "periods": [
+    {"shares": "10stake", "length_seconds": 2592000},
+    {"shares": "10stake", "length_seconds": 2592000}
+  ]
+}`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			campaignID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			address := args[1]
+
+			startingShares, err := types.NewShares(args[2])
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[3])
+			if err != nil {
+				return err
+			}
+			var file periodicVestingFile
+			if err := json.Unmarshal(bz, &file); err != nil {
+				return fmt.Errorf("failed to parse periods file: %w", err)
+			}
+
+			periods := make([]types.ShareVestingPeriod, len(file.Periods))
+			for i, period := range file.Periods {
+				amount, err := types.NewShares(period.Shares)
+				if err != nil {
+					return fmt.Errorf("failed to parse shares of period %d: %w", i, err)
+				}
+				periods[i] = types.ShareVestingPeriod{
+					Length: period.Length,
+					Amount: amount,
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddVestingOptions(
+				campaignID,
+				clientCtx.GetFromAddress().String(),
+				address,
+				startingShares,
+				*types.NewSharePeriodicVesting(file.StartTime, periods),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
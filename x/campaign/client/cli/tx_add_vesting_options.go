@@ -11,11 +11,16 @@ import (
 	"github.com/tendermint/spn/x/campaign/types"
 )
 
+const flagVestingStartTime = "vesting-start-time"
+
 func CmdAddVestingOptions() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-vesting-options [campaign-id] [address] [starting-shares] [vesting-shares] [vesting-end-time]",
 		Short: "Add a mainnet vesting account",
-		Args:  cobra.ExactArgs(5),
+		Long: `Add a mainnet vesting account.
+The vesting shares are vested at the end time, or linearly between the start time and the end time
+if --vesting-start-time is provided`,
+		Args: cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			campaignID, err := cast.ToUint64E(args[0])
 			if err != nil {
@@ -34,7 +39,11 @@ func CmdAddVestingOptions() *cobra.Command {
 				return err
 			}
 			endTime, _ := strconv.ParseUint(args[4], 10, 64)
-			delayedVesting := *types.NewShareDelayedVesting(vestingShares, int64(endTime))
+			vestingOptions := *types.NewShareDelayedVesting(vestingShares, int64(endTime))
+			startTime, _ := cmd.Flags().GetInt64(flagVestingStartTime)
+			if startTime != 0 {
+				vestingOptions = *types.NewShareContinuousVesting(vestingShares, startTime, int64(endTime))
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -46,7 +55,7 @@ func CmdAddVestingOptions() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				address,
 				startingShares,
-				delayedVesting,
+				vestingOptions,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -55,6 +64,7 @@ func CmdAddVestingOptions() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Int64(flagVestingStartTime, 0, "Start time of a continuous vesting")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func CmdCreateAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-auction [campaign-id] [vouchers] [min-price] [start-time] [end-time]",
+		Short: "Open a batch auction of campaign vouchers cleared at a uniform price, the min price is for each voucher unit and times are unix timestamps",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			campaignID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			vouchers, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			minPrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := cast.ToInt64E(args[3])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := cast.ToInt64E(args[4])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateAuction(
+				clientCtx.GetFromAddress().String(),
+				campaignID,
+				vouchers,
+				minPrice,
+				startTime,
+				endTime,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/tendermint/spn/x/campaign/types"
 )
 
-const flagDynamicShares = "dynamic-shares"
+const (
+	flagDynamicShares          = "dynamic-shares"
+	flagDisableVoucherTransfer = "disable-voucher-transfer"
+)
 
 func CmdCreateCampaign() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,7 +21,8 @@ func CmdCreateCampaign() *cobra.Command {
 		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var (
-				dynamicShares, _ = cmd.Flags().GetBool(flagDynamicShares)
+				dynamicShares, _           = cmd.Flags().GetBool(flagDynamicShares)
+				voucherTransferDisabled, _ = cmd.Flags().GetBool(flagDisableVoucherTransfer)
 			)
 
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -39,6 +43,7 @@ func CmdCreateCampaign() *cobra.Command {
 				args[0],
 				totalSupply,
 				dynamicShares,
+				voucherTransferDisabled,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -48,6 +53,7 @@ func CmdCreateCampaign() *cobra.Command {
 	}
 
 	cmd.Flags().Bool(flagDynamicShares, false, "Allows to update the shares supply for the mainnet coins supply")
+	cmd.Flags().Bool(flagDisableVoucherTransfer, false, "Prevents the vouchers of the campaign from being transferred over IBC until the mainnet is initialized")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
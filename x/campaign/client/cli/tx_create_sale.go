@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+const flagMaxPerAddress = "max-per-address"
+
+func CmdCreateSale() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-sale [campaign-id] [vouchers] [price] [start-time] [end-time]",
+		Short: "Open a fixed-price sale of campaign vouchers, the price is paid for each voucher unit and times are unix timestamps",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			campaignID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			vouchers, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := cast.ToInt64E(args[3])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := cast.ToInt64E(args[4])
+			if err != nil {
+				return err
+			}
+
+			maxPerAddress, err := cmd.Flags().GetUint64(flagMaxPerAddress)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSale(
+				clientCtx.GetFromAddress().String(),
+				campaignID,
+				vouchers,
+				price,
+				startTime,
+				endTime,
+				sdk.NewIntFromUint64(maxPerAddress),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxPerAddress, 0, "Maximum amount of vouchers an address can buy, no limit if zero")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
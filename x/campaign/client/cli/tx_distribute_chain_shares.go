@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func CmdDistributeChainShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute-chain-shares [campaign-id] [launch-id] [address=shares]...",
+		Short: "Distribute the shares reserved for a launched chain to its participants",
+		Long: `Distribute the shares reserved for a launched chain to its participants.
+Each distribution is an address and the comma-separated shares it receives, e.g.
+spnd tx campaign distribute-chain-shares 0 1 spn1...=100foo,20bar spn1...=50foo`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			campaignID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			launchID, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			distributions := make([]types.ShareDistribution, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				address, sharesStr, ok := parseDistribution(arg)
+				if !ok {
+					return fmt.Errorf("invalid distribution %s, expected address=shares", arg)
+				}
+				shares, err := types.NewShares(sharesStr)
+				if err != nil {
+					return err
+				}
+				distributions = append(distributions, types.ShareDistribution{
+					Address: address,
+					Shares:  shares,
+				})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDistributeChainShares(
+				clientCtx.GetFromAddress().String(),
+				campaignID,
+				launchID,
+				distributions,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseDistribution splits a distribution argument formatted as address=shares
+func parseDistribution(arg string) (address, shares string, ok bool) {
+	parts := strings.SplitN(arg, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func CmdPlaceBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-bid [auction-id] [price] [quantity]",
+		Short: "Place a bid in an auction, the price is the maximum paid for each voucher unit",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			auctionID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			quantity, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid quantity: %s", args[2])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBid(
+				clientCtx.GetFromAddress().String(),
+				auctionID,
+				price,
+				quantity,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
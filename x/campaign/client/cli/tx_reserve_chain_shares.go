@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func CmdReserveChainShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-chain-shares [campaign-id] [launch-id] [shares]",
+		Short: "Reserve shares of a campaign for the participants of a chain of the campaign",
+		Long: `Reserve shares of a campaign for the participants of a chain of the campaign.
+The reserved shares that are not distributed when the mainnet is initialized are released`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			campaignID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			launchID, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			shares, err := types.NewShares(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReserveChainShares(
+				clientCtx.GetFromAddress().String(),
+				campaignID,
+				launchID,
+				shares,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
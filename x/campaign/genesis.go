@@ -32,7 +32,45 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetMainnetVestingAccount(ctx, elem)
 	}
 
+	// Set all the sale
+	for _, elem := range genState.SaleList {
+		k.SetSale(ctx, elem)
+
+		// Sales that are not settled yet are added back to the sale queue
+		if !elem.Settled {
+			k.EnqueueSale(ctx, elem.EndTime, elem.Id)
+		}
+	}
+
+	// Set sale counter
+	k.SetSaleCounter(ctx, genState.SaleCounter)
+
+	// Set all the salePurchase
+	for _, elem := range genState.SalePurchaseList {
+		k.SetSalePurchase(ctx, elem)
+	}
+
+	// Set all the auction
+	for _, elem := range genState.AuctionList {
+		k.SetAuction(ctx, elem)
+
+		// Auctions that are not settled yet are added back to the auction queue
+		if !elem.Settled {
+			k.EnqueueAuction(ctx, elem.EndTime, elem.Id)
+		}
+	}
+
+	// Set auction counter
+	k.SetAuctionCounter(ctx, genState.AuctionCounter)
+
+	// Set all the bid
+	for _, elem := range genState.BidList {
+		k.SetBid(ctx, elem)
+	}
+
 	// this line is used by starport scaffolding # genesis/module/init
+
+	k.SetParams(ctx, genState.Params)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -44,6 +82,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.CampaignChainsList = k.GetAllCampaignChains(ctx)
 	genesis.MainnetAccountList = k.GetAllMainnetAccount(ctx)
 	genesis.MainnetVestingAccountList = k.GetAllMainnetVestingAccount(ctx)
+	genesis.SaleList = k.GetAllSale(ctx)
+	genesis.SaleCounter = k.GetSaleCounter(ctx)
+	genesis.SalePurchaseList = k.GetAllSalePurchase(ctx)
+	genesis.AuctionList = k.GetAllAuction(ctx)
+	genesis.AuctionCounter = k.GetAuctionCounter(ctx)
+	genesis.BidList = k.GetAllBid(ctx)
+	genesis.Params = k.GetParams(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -29,5 +29,25 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.MainnetAccountList, got.MainnetAccountList)
 	require.ElementsMatch(t, genesisState.MainnetVestingAccountList, got.MainnetVestingAccountList)
 
+	require.ElementsMatch(t, genesisState.SaleList, got.SaleList)
+	require.Equal(t, genesisState.SaleCounter, got.SaleCounter)
+	require.ElementsMatch(t, genesisState.SalePurchaseList, got.SalePurchaseList)
+
+	// sales that are not settled are added back to the sale queue
+	for _, sale := range genesisState.SaleList {
+		require.Contains(t, keeper.GetSaleQueue(ctx, sale.EndTime), sale.Id)
+	}
+
+	require.ElementsMatch(t, genesisState.AuctionList, got.AuctionList)
+	require.Equal(t, genesisState.AuctionCounter, got.AuctionCounter)
+	require.ElementsMatch(t, genesisState.BidList, got.BidList)
+
+	// auctions that are not settled are added back to the auction queue
+	for _, auction := range genesisState.AuctionList {
+		require.Contains(t, keeper.GetAuctionQueue(ctx, auction.EndTime), auction.Id)
+	}
+
+	require.Equal(t, genesisState.Params, got.Params)
+
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// GetAuctionCounter get the counter for auction
+func (k Keeper) GetAuctionCounter(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.KeyPrefix(types.AuctionCounterKey))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetAuctionCounter set the counter for auction
+func (k Keeper) SetAuctionCounter(ctx sdk.Context, counter uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, counter)
+	store.Set(types.KeyPrefix(types.AuctionCounterKey), bz)
+}
+
+// AppendAuction appends an auction in the store with a new id and update the counter
+func (k Keeper) AppendAuction(ctx sdk.Context, auction types.Auction) uint64 {
+	counter := k.GetAuctionCounter(ctx)
+	auction.Id = counter
+	k.SetAuction(ctx, auction)
+	k.SetAuctionCounter(ctx, counter+1)
+	return counter
+}
+
+// SetAuction set a specific auction in the store
+// The auction is indexed by its campaign, the campaign of an auction never changes
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKeyPrefix))
+	b := k.cdc.MustMarshal(&auction)
+	store.Set(types.AuctionKey(auction.Id), b)
+
+	byCampaignStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionByCampaignKeyPrefix))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, auction.Id)
+	byCampaignStore.Set(types.AuctionByCampaignKey(auction.CampaignID, auction.Id), bz)
+}
+
+// GetAuction returns an auction from its id
+func (k Keeper) GetAuction(ctx sdk.Context, id uint64) (val types.Auction, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKeyPrefix))
+	b := store.Get(types.AuctionKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllAuctionByCampaignID returns all the auctions of a campaign
+func (k Keeper) GetAllAuctionByCampaignID(ctx sdk.Context, campaignID uint64) (list []types.Auction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionByCampaignKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.AuctionByCampaignAllKey(campaignID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		auction, found := k.GetAuction(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if found {
+			list = append(list, auction)
+		}
+	}
+
+	return
+}
+
+// GetAllAuction returns all auction
+func (k Keeper) GetAllAuction(ctx sdk.Context) (list []types.Auction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Auction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// EnqueueAuction adds an auction in the auction queue to be cleared once its end time is passed
+func (k Keeper) EnqueueAuction(ctx sdk.Context, endTime int64, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionQueueKeyPrefix))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, auctionID)
+	store.Set(types.AuctionQueueKey(endTime, auctionID), bz)
+}
+
+// DequeueAuction removes an auction from the auction queue
+func (k Keeper) DequeueAuction(ctx sdk.Context, endTime int64, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionQueueKeyPrefix))
+	store.Delete(types.AuctionQueueKey(endTime, auctionID))
+}
+
+// GetAuctionQueue returns the IDs of the auctions in the auction queue
+// whose end time is lower or equal to the provided timestamp
+func (k Keeper) GetAuctionQueue(ctx sdk.Context, timestamp int64) (list []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionQueueKeyPrefix))
+	iterator := store.Iterator(nil, types.AuctionQueueEndTimeKey(timestamp+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, binary.BigEndian.Uint64(iterator.Value()))
+	}
+
+	return
+}
+
+// SettleAuctions clears all the auctions of the auction queue whose end time is passed
+// The vouchers are allocated to the winning bids and the excess payments are refunded to the bidders
+// Once settled, the proceeds and the unsold vouchers can be withdrawn by the coordinator
+func (k Keeper) SettleAuctions(ctx sdk.Context) {
+	for _, auctionID := range k.GetAuctionQueue(ctx, ctx.BlockTime().Unix()) {
+		// The auction is settled in a cached context to not write partial transfers on failure
+		// An auction that can't be settled remains in the queue
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.SettleAuction(cacheCtx, auctionID); err != nil {
+			k.Logger(ctx).Error("auction can't be settled", "auctionID", auctionID, "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// SettleAuction clears an auction from the auction queue and distributes the vouchers and the refunds to the bidders
+func (k Keeper) SettleAuction(ctx sdk.Context, auctionID uint64) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return spnerrors.Criticalf("auction %d in auction queue not found", auctionID)
+	}
+	k.DequeueAuction(ctx, auction.EndTime, auctionID)
+
+	auction, bids := types.ClearAuction(auction, k.GetAuctionBids(ctx, auctionID))
+	for _, bid := range bids {
+		bidder, err := sdk.AccAddressFromBech32(bid.Bidder)
+		if err != nil {
+			return spnerrors.Criticalf("can't parse bidder address %s", err.Error())
+		}
+		distributed := sdk.NewCoins(
+			sdk.NewCoin(auction.Vouchers.Denom, bid.Allocated),
+			bid.Refund(auction.ClearingPrice),
+		)
+		if !distributed.Empty() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, distributed); err != nil {
+				return spnerrors.Criticalf("can't distribute bid %d %s", bid.Id, err.Error())
+			}
+		}
+		k.SetBid(ctx, bid)
+	}
+	k.SetAuction(ctx, auction)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAuctionSettled{
+		AuctionID:     auctionID,
+		ClearingPrice: auction.ClearingPrice,
+		Sold:          auction.Sold,
+	})
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	campaignkeeper "github.com/tendermint/spn/x/campaign/keeper"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func createNAuction(keeper *campaignkeeper.Keeper, ctx sdk.Context, n int) []types.Auction {
+	items := make([]types.Auction, n)
+	for i := range items {
+		items[i] = sample.Auction(0, 0)
+		items[i].Id = keeper.AppendAuction(ctx, items[i])
+	}
+	return items
+}
+
+func TestAuctionGet(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNAuction(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetAuction(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t, item, got)
+	}
+	_, found := keeper.GetAuction(ctx, uint64(len(items)))
+	require.False(t, found)
+}
+
+func TestAuctionGetAll(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNAuction(keeper, ctx, 10)
+	require.ElementsMatch(t, items, keeper.GetAllAuction(ctx))
+}
+
+func TestAuctionGetAllByCampaignID(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := make([]types.Auction, 10)
+	for i := range items {
+		items[i] = sample.Auction(0, uint64(i%2))
+		items[i].Id = keeper.AppendAuction(ctx, items[i])
+	}
+
+	require.ElementsMatch(t, []types.Auction{items[1], items[3], items[5], items[7], items[9]},
+		keeper.GetAllAuctionByCampaignID(ctx, 1))
+	require.Empty(t, keeper.GetAllAuctionByCampaignID(ctx, 2))
+}
+
+func TestAuctionCount(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNAuction(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetAuctionCounter(ctx))
+}
+
+func TestAuctionQueue(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+
+	keeper.EnqueueAuction(ctx, 1000, 0)
+	keeper.EnqueueAuction(ctx, 1000, 1)
+	keeper.EnqueueAuction(ctx, 2000, 2)
+	keeper.EnqueueAuction(ctx, 3000, 3)
+
+	require.Empty(t, keeper.GetAuctionQueue(ctx, 999))
+	require.Equal(t, []uint64{0, 1}, keeper.GetAuctionQueue(ctx, 1000))
+	require.Equal(t, []uint64{0, 1, 2}, keeper.GetAuctionQueue(ctx, 2999))
+	require.Equal(t, []uint64{0, 1, 2, 3}, keeper.GetAuctionQueue(ctx, 3000))
+
+	keeper.DequeueAuction(ctx, 1000, 1)
+	keeper.DequeueAuction(ctx, 3000, 3)
+	require.Equal(t, []uint64{0, 2}, keeper.GetAuctionQueue(ctx, 3000))
+}
+
+func TestSettleAuctions(t *testing.T) {
+	var (
+		campaignKeeper, _, _, bankKeeper, _, _, ctx = setupMsgServer(t)
+
+		now      = ctx.BlockTime().Unix()
+		vouchers = sdk.NewCoin(types.VoucherDenom(0, "foo"), sdk.NewInt(100))
+		minPrice = sdk.NewCoin("uatom", sdk.NewInt(2))
+		bidders  = []sdk.AccAddress{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	)
+
+	// appendAuction creates an auction with its vouchers and the payments of its bids escrowed in the module account
+	appendAuction := func(endTime int64, bids ...types.Bid) types.Auction {
+		auction := types.NewAuction(0, 0, vouchers, minPrice, now-1000, endTime)
+		auction.Id = campaignKeeper.AppendAuction(ctx, auction)
+		require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(vouchers)))
+		for i, bid := range bids {
+			bid.AuctionID = auction.Id
+			bid.Id = uint64(i)
+			campaignKeeper.SetBid(ctx, bid)
+			require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(bid.Escrow())))
+		}
+		auction.BidCounter = uint64(len(bids))
+		auction.BidCount = uint64(len(bids))
+		campaignKeeper.SetAuction(ctx, auction)
+		campaignKeeper.EnqueueAuction(ctx, auction.EndTime, auction.Id)
+		return auction
+	}
+	newBid := func(bidder sdk.AccAddress, price, quantity int64) types.Bid {
+		return types.NewBid(0, 0, bidder.String(), sdk.NewCoin(minPrice.Denom, sdk.NewInt(price)), sdk.NewInt(quantity))
+	}
+
+	// auction with more demand than vouchers
+	oversubscribed := appendAuction(
+		now-1,
+		newBid(bidders[0], 10, 60),
+		newBid(bidders[1], 5, 60),
+		newBid(bidders[2], 3, 10),
+	)
+
+	// auction with no bid
+	noBid := appendAuction(now)
+
+	// auction not ended
+	ongoing := appendAuction(now+1, newBid(bidders[0], 10, 10))
+
+	balances := make([]sdk.Coins, len(bidders))
+	for i, bidder := range bidders {
+		balances[i] = bankKeeper.GetAllBalances(ctx, bidder)
+	}
+
+	campaignKeeper.SettleAuctions(ctx)
+
+	// the auction is cleared at the price of the lowest winning bid
+	auction, found := campaignKeeper.GetAuction(ctx, oversubscribed.Id)
+	require.True(t, found)
+	require.True(t, auction.Settled)
+	require.True(t, auction.ClearingPrice.Equal(sdk.NewInt(5)))
+	require.True(t, auction.Sold.Equal(vouchers.Amount))
+
+	expectedAllocated := []int64{60, 40, 0}
+	expectedBalances := []sdk.Coins{
+		// 60 vouchers paid 5 instead of 10
+		sdk.NewCoins(sdk.NewCoin(vouchers.Denom, sdk.NewInt(60)), sdk.NewCoin(minPrice.Denom, sdk.NewInt(300))),
+		// 40 vouchers paid 5 and 20 unfilled
+		sdk.NewCoins(sdk.NewCoin(vouchers.Denom, sdk.NewInt(40)), sdk.NewCoin(minPrice.Denom, sdk.NewInt(100))),
+		// bid fully refunded
+		sdk.NewCoins(sdk.NewCoin(minPrice.Denom, sdk.NewInt(30))),
+	}
+	for i, bidder := range bidders {
+		bid, found := campaignKeeper.GetBid(ctx, oversubscribed.Id, uint64(i))
+		require.True(t, found)
+		require.True(t, bid.Allocated.Equal(sdk.NewInt(expectedAllocated[i])))
+		require.True(t, balances[i].Add(expectedBalances[i]...).IsEqual(bankKeeper.GetAllBalances(ctx, bidder)))
+	}
+
+	auction, found = campaignKeeper.GetAuction(ctx, noBid.Id)
+	require.True(t, found)
+	require.True(t, auction.Settled)
+	require.True(t, auction.Sold.IsZero())
+	require.True(t, auction.ClearingPrice.IsZero())
+
+	auction, found = campaignKeeper.GetAuction(ctx, ongoing.Id)
+	require.True(t, found)
+	require.False(t, auction.Settled)
+
+	// settled auctions are removed from the queue
+	require.Equal(t, []uint64{ongoing.Id}, campaignKeeper.GetAuctionQueue(ctx, now+1))
+
+	// events are emitted for the settled auctions
+	settledEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "tendermint.spn.campaign.EventAuctionSettled" {
+			settledEvents++
+		}
+	}
+	require.Equal(t, 2, settledEvents)
+
+	// the proceeds and the unsold vouchers remain in the module account for the coordinator
+	moduleBalance := bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+	require.True(t, moduleBalance.IsEqual(sdk.NewCoins(
+		sdk.NewCoin(vouchers.Denom, sdk.NewInt(200)),
+		sdk.NewCoin(minPrice.Denom, sdk.NewInt(600)),
+	)))
+}
+
+func TestSettleAuctionsFailure(t *testing.T) {
+	var (
+		campaignKeeper, _, _, _, _, _, ctx = setupMsgServer(t)
+
+		now    = ctx.BlockTime().Unix()
+		bidder = sample.Address()
+	)
+
+	// the payment of the bid is not escrowed in the module account
+	auction := sample.Auction(0, 0)
+	auction.StartTime = now - 1000
+	auction.EndTime = now
+	auction.BidCounter = 1
+	auction.BidCount = 1
+	auction.Id = campaignKeeper.AppendAuction(ctx, auction)
+	campaignKeeper.EnqueueAuction(ctx, auction.EndTime, auction.Id)
+	campaignKeeper.SetBid(ctx, types.NewBid(auction.Id, 0, bidder, auction.MinPrice, sdk.OneInt()))
+
+	campaignKeeper.SettleAuctions(ctx)
+
+	// the auction remains in the queue and is not modified
+	got, found := campaignKeeper.GetAuction(ctx, auction.Id)
+	require.True(t, found)
+	require.Equal(t, auction, got)
+	require.Equal(t, []uint64{auction.Id}, campaignKeeper.GetAuctionQueue(ctx, now))
+	bid, found := campaignKeeper.GetBid(ctx, auction.Id, 0)
+	require.True(t, found)
+	require.True(t, bid.Allocated.IsZero())
+}
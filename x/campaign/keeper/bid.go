@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// SetBid set a specific bid in the store from its index
+func (k Keeper) SetBid(ctx sdk.Context, bid types.Bid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BidKeyPrefix))
+	b := k.cdc.MustMarshal(&bid)
+	store.Set(types.BidKey(bid.AuctionID, bid.Id), b)
+}
+
+// GetBid returns a bid from its index
+func (k Keeper) GetBid(
+	ctx sdk.Context,
+	auctionID,
+	bidID uint64,
+) (val types.Bid, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BidKeyPrefix))
+
+	b := store.Get(types.BidKey(auctionID, bidID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveBid removes a bid from the store
+func (k Keeper) RemoveBid(ctx sdk.Context, auctionID, bidID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BidKeyPrefix))
+	store.Delete(types.BidKey(auctionID, bidID))
+}
+
+// GetAuctionBids returns all the bids of an auction
+func (k Keeper) GetAuctionBids(ctx sdk.Context, auctionID uint64) (list []types.Bid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BidKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.BidAllKey(auctionID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Bid
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllBid returns all bid
+func (k Keeper) GetAllBid(ctx sdk.Context) (list []types.Bid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BidKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Bid
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	campaignkeeper "github.com/tendermint/spn/x/campaign/keeper"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func createNBid(keeper *campaignkeeper.Keeper, ctx sdk.Context, auction types.Auction, n int) []types.Bid {
+	items := make([]types.Bid, n)
+	for i := range items {
+		items[i] = sample.Bid(auction, uint64(i))
+		keeper.SetBid(ctx, items[i])
+	}
+	return items
+}
+
+func TestBidGet(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNBid(keeper, ctx, sample.Auction(0, 0), 10)
+	for _, item := range items {
+		got, found := keeper.GetBid(ctx, item.AuctionID, item.Id)
+		require.True(t, found)
+		require.Equal(t, item, got)
+	}
+	_, found := keeper.GetBid(ctx, 0, uint64(len(items)))
+	require.False(t, found)
+}
+
+func TestBidGetAll(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNBid(keeper, ctx, sample.Auction(0, 0), 10)
+	require.ElementsMatch(t, items, keeper.GetAllBid(ctx))
+}
+
+func TestGetAuctionBids(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNBid(keeper, ctx, sample.Auction(0, 0), 5)
+	otherItems := createNBid(keeper, ctx, sample.Auction(1, 0), 5)
+	require.ElementsMatch(t, items, keeper.GetAuctionBids(ctx, 0))
+	require.ElementsMatch(t, otherItems, keeper.GetAuctionBids(ctx, 1))
+	require.Empty(t, keeper.GetAuctionBids(ctx, 2))
+}
@@ -43,6 +43,7 @@ func (k Keeper) AppendCampaign(ctx sdk.Context, campaign types.Campaign) uint64
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CampaignKey))
 	appendedValue := k.cdc.MustMarshal(&campaign)
 	store.Set(GetCampaignIDBytes(campaign.Id), appendedValue)
+	k.setCampaignByCoordinator(ctx, campaign.CoordinatorID, campaign.Id)
 
 	// Update campaign count
 	k.SetCampaignCounter(ctx, counter+1)
@@ -52,9 +53,15 @@ func (k Keeper) AppendCampaign(ctx sdk.Context, campaign types.Campaign) uint64
 
 // SetCampaign set a specific campaign in the store
 func (k Keeper) SetCampaign(ctx sdk.Context, campaign types.Campaign) {
+	// The campaign is no longer indexed by its previous coordinator if it changed
+	if previous, found := k.GetCampaign(ctx, campaign.Id); found && previous.CoordinatorID != campaign.CoordinatorID {
+		k.removeCampaignByCoordinator(ctx, previous.CoordinatorID, campaign.Id)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CampaignKey))
 	b := k.cdc.MustMarshal(&campaign)
 	store.Set(GetCampaignIDBytes(campaign.Id), b)
+	k.setCampaignByCoordinator(ctx, campaign.CoordinatorID, campaign.Id)
 }
 
 // GetCampaign returns a campaign from its id
@@ -70,6 +77,10 @@ func (k Keeper) GetCampaign(ctx sdk.Context, id uint64) (val types.Campaign, fou
 
 // RemoveCampaign removes a campaign from the store
 func (k Keeper) RemoveCampaign(ctx sdk.Context, id uint64) {
+	if campaign, found := k.GetCampaign(ctx, id); found {
+		k.removeCampaignByCoordinator(ctx, campaign.CoordinatorID, id)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CampaignKey))
 	store.Delete(GetCampaignIDBytes(id))
 }
@@ -90,6 +101,35 @@ func (k Keeper) GetAllCampaign(ctx sdk.Context) (list []types.Campaign) {
 	return
 }
 
+// GetAllCampaignByCoordinatorID returns all the campaigns of a coordinator
+func (k Keeper) GetAllCampaignByCoordinatorID(ctx sdk.Context, coordinatorID uint64) (list []types.Campaign) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CampaignByCoordinatorKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.CampaignByCoordinatorAllKey(coordinatorID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		campaign, found := k.GetCampaign(ctx, GetCampaignIDFromBytes(iterator.Value()))
+		if found {
+			list = append(list, campaign)
+		}
+	}
+
+	return
+}
+
+// setCampaignByCoordinator indexes a campaign by its coordinator
+func (k Keeper) setCampaignByCoordinator(ctx sdk.Context, coordinatorID, campaignID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CampaignByCoordinatorKeyPrefix))
+	store.Set(types.CampaignByCoordinatorKey(coordinatorID, campaignID), GetCampaignIDBytes(campaignID))
+}
+
+// removeCampaignByCoordinator removes a campaign from the index of the campaigns of its coordinator
+func (k Keeper) removeCampaignByCoordinator(ctx sdk.Context, coordinatorID, campaignID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CampaignByCoordinatorKeyPrefix))
+	store.Delete(types.CampaignByCoordinatorKey(coordinatorID, campaignID))
+}
+
 // GetCampaignIDBytes returns the byte representation of the ID
 func GetCampaignIDBytes(id uint64) []byte {
 	bz := make([]byte, 8)
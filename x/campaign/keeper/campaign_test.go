@@ -46,6 +46,28 @@ func TestCampaignGetAll(t *testing.T) {
 	require.ElementsMatch(t, items, keeper.GetAllCampaign(ctx))
 }
 
+func TestCampaignGetAllByCoordinatorID(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := make([]types.Campaign, 10)
+	for i := range items {
+		items[i] = sample.Campaign(0)
+		items[i].CoordinatorID = uint64(i % 2)
+		items[i].Id = keeper.AppendCampaign(ctx, items[i])
+	}
+
+	require.ElementsMatch(t, []types.Campaign{items[0], items[2], items[4], items[6], items[8]},
+		keeper.GetAllCampaignByCoordinatorID(ctx, 0))
+	require.Empty(t, keeper.GetAllCampaignByCoordinatorID(ctx, 2))
+
+	// the index follows the coordinator of the campaign and its removal
+	items[0].CoordinatorID = 2
+	keeper.SetCampaign(ctx, items[0])
+	keeper.RemoveCampaign(ctx, items[2].Id)
+	require.ElementsMatch(t, []types.Campaign{items[4], items[6], items[8]},
+		keeper.GetAllCampaignByCoordinatorID(ctx, 0))
+	require.ElementsMatch(t, []types.Campaign{items[0]}, keeper.GetAllCampaignByCoordinatorID(ctx, 2))
+}
+
 func TestCampaignCount(t *testing.T) {
 	keeper, ctx := testkeeper.Campaign(t)
 	items := createNCampaign(keeper, ctx, 10)
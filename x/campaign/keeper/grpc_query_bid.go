@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/spn/x/campaign/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BidAll(c context.Context, req *types.QueryAllBidRequest) (*types.QueryAllBidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var bids []types.Bid
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	bidStore := prefix.NewStore(store, types.KeyPrefix(types.BidKeyPrefix))
+	auctionBidStore := prefix.NewStore(bidStore, types.BidAllKey(req.AuctionID))
+
+	pageRes, err := query.Paginate(auctionBidStore, req.Pagination, func(key []byte, value []byte) error {
+		var bid types.Bid
+		if err := k.cdc.Unmarshal(value, &bid); err != nil {
+			return err
+		}
+
+		bids = append(bids, bid)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllBidResponse{Bid: bids, Pagination: pageRes}, nil
+}
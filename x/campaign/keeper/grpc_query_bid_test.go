@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBidQueryPaginated(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	auctionID := uint64(0)
+	msgs := createNBid(keeper, ctx, sample.Auction(auctionID, 0), 5)
+
+	// bids of another auction are not returned
+	createNBid(keeper, ctx, sample.Auction(auctionID+1, 0), 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllBidRequest {
+		return &types.QueryAllBidRequest{
+			AuctionID: auctionID,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.BidAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Bid), step)
+			require.Subset(t, msgs, resp.Bid)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.BidAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Bid), step)
+			require.Subset(t, msgs, resp.Bid)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.BidAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t, msgs, resp.Bid)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.BidAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
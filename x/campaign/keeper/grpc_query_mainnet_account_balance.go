@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/spn/x/campaign/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MainnetAccountBalanceAll(c context.Context, req *types.QueryAllMainnetAccountBalanceRequest) (*types.QueryAllMainnetAccountBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the balances are computed for all the accounts since the remainders are distributed among them
+	balances, err := k.GetMainnetAccountBalances(ctx, req.CampaignID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	balanceByAddress := make(map[string]types.MainnetAccountBalance)
+	for _, balance := range balances {
+		balanceByAddress[balance.Address] = balance
+	}
+
+	var mainnetAccountBalances []types.MainnetAccountBalance
+	store := ctx.KVStore(k.storeKey)
+	mainnetAccountStore := prefix.NewStore(store, types.MainnetAccountAllKey(req.CampaignID))
+
+	pageRes, err := query.Paginate(mainnetAccountStore, req.Pagination, func(key []byte, value []byte) error {
+		var mainnetAccount types.MainnetAccount
+		if err := k.cdc.Unmarshal(value, &mainnetAccount); err != nil {
+			return err
+		}
+
+		mainnetAccountBalances = append(mainnetAccountBalances, balanceByAddress[mainnetAccount.Address])
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMainnetAccountBalanceResponse{MainnetAccountBalance: mainnetAccountBalances, Pagination: pageRes}, nil
+}
+
+// MainnetAccountBalance computes the balances of all the mainnet accounts of the campaign since the rounding
+// remainders are distributed among them: the cost of the query grows with the number of mainnet accounts
+func (k Keeper) MainnetAccountBalance(c context.Context, req *types.QueryGetMainnetAccountBalanceRequest) (*types.QueryGetMainnetAccountBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetMainnetAccount(ctx, req.CampaignID, req.Address); !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	balances, err := k.GetMainnetAccountBalances(ctx, req.CampaignID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for _, balance := range balances {
+		if balance.Address == req.Address {
+			return &types.QueryGetMainnetAccountBalanceResponse{MainnetAccountBalance: balance}, nil
+		}
+	}
+
+	return nil, status.Error(codes.InvalidArgument, "not found")
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/keeper"
+	"github.com/tendermint/spn/x/campaign/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createNMainnetAccountBalance creates a campaign with n mainnet accounts and returns their expected balances
+func createNMainnetAccountBalance(
+	keeper *keeper.Keeper,
+	ctx sdk.Context,
+	n int,
+) (uint64, []types.MainnetAccountBalance) {
+	campaign := sample.Campaign(0)
+	campaign.TotalSupply = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+	campaign.Id = keeper.AppendCampaign(ctx, campaign)
+
+	items := make([]types.MainnetAccountBalance, n)
+	for i := range items {
+		address := strconv.Itoa(i)
+		keeper.SetMainnetAccount(ctx, types.MainnetAccount{
+			CampaignID: campaign.Id,
+			Address:    address,
+			Shares:     types.NewSharesFromCoins(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10000)))),
+		})
+		items[i] = types.MainnetAccountBalance{
+			CampaignID: campaign.Id,
+			Address:    address,
+			Coins:      sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100))),
+		}
+	}
+	return campaign.Id, items
+}
+
+func TestMainnetAccountBalanceQuerySingle(t *testing.T) {
+	var (
+		k, ctx  = keepertest.Campaign(t)
+		wctx    = sdk.WrapSDKContext(ctx)
+		_, msgs = createNMainnetAccountBalance(k, ctx, 2)
+	)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetMainnetAccountBalanceRequest
+		response *types.QueryGetMainnetAccountBalanceResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetMainnetAccountBalanceRequest{
+				CampaignID: msgs[0].CampaignID,
+				Address:    msgs[0].Address,
+			},
+			response: &types.QueryGetMainnetAccountBalanceResponse{MainnetAccountBalance: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetMainnetAccountBalanceRequest{
+				CampaignID: msgs[1].CampaignID,
+				Address:    msgs[1].Address,
+			},
+			response: &types.QueryGetMainnetAccountBalanceResponse{MainnetAccountBalance: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetMainnetAccountBalanceRequest{
+				CampaignID: msgs[0].CampaignID,
+				Address:    strconv.Itoa(100000),
+			},
+			err: status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc:    "InvalidRequest",
+			request: nil,
+			err:     status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := k.MainnetAccountBalance(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestMainnetAccountBalanceQueryPaginated(t *testing.T) {
+	var (
+		k, ctx           = keepertest.Campaign(t)
+		wctx             = sdk.WrapSDKContext(ctx)
+		campaignID, msgs = createNMainnetAccountBalance(k, ctx, 5)
+	)
+	request := func(campaignID uint64, next []byte, offset, limit uint64, total bool) *types.QueryAllMainnetAccountBalanceRequest {
+		return &types.QueryAllMainnetAccountBalanceRequest{
+			CampaignID: campaignID,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := k.MainnetAccountBalanceAll(wctx, request(campaignID, nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.MainnetAccountBalance), step)
+			require.Subset(t, msgs, resp.MainnetAccountBalance)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := k.MainnetAccountBalanceAll(wctx, request(campaignID, next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.MainnetAccountBalance), step)
+			require.Subset(t, msgs, resp.MainnetAccountBalance)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := k.MainnetAccountBalanceAll(wctx, request(campaignID, nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t, msgs, resp.MainnetAccountBalance)
+	})
+	t.Run("CampaignNotFound", func(t *testing.T) {
+		_, err := k.MainnetAccountBalanceAll(wctx, request(1000, nil, 0, 0, true))
+		require.Error(t, err)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := k.MainnetAccountBalanceAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
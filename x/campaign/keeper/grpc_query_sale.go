@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/spn/x/campaign/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SaleAll(c context.Context, req *types.QueryAllSaleRequest) (*types.QueryAllSaleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var sales []types.Sale
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	saleStore := prefix.NewStore(store, types.KeyPrefix(types.SaleKeyPrefix))
+
+	pageRes, err := query.Paginate(saleStore, req.Pagination, func(key []byte, value []byte) error {
+		var sale types.Sale
+		if err := k.cdc.Unmarshal(value, &sale); err != nil {
+			return err
+		}
+
+		sales = append(sales, sale)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSaleResponse{Sale: sales, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Sale(c context.Context, req *types.QueryGetSaleRequest) (*types.QueryGetSaleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sale, found := k.GetSale(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetSaleResponse{Sale: sale}, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+// ProfileHooks implements the hooks called by the profile module
+type ProfileHooks struct {
+	k Keeper
+}
+
+var _ profiletypes.ProfileHooks = ProfileHooks{}
+
+// ProfileHooks returns the profile hooks of the campaign module
+func (k Keeper) ProfileHooks() ProfileHooks {
+	return ProfileHooks{k}
+}
+
+// BeforeCoordinatorDeleted prevents the deletion of a coordinator that still manages a campaign
+// with a mainnet not initialized, reserved shares not distributed, or a sale or auction not withdrawn
+func (h ProfileHooks) BeforeCoordinatorDeleted(ctx sdk.Context, coordinatorID uint64) error {
+	for _, campaign := range h.k.GetAllCampaignByCoordinatorID(ctx, coordinatorID) {
+		if !campaign.MainnetInitialized {
+			return sdkerrors.Wrapf(
+				types.ErrCoordinatorActiveCampaign,
+				"mainnet of campaign %d is not initialized",
+				campaign.Id,
+			)
+		}
+		campaignChains, found := h.k.GetCampaignChains(ctx, campaign.Id)
+		if found && len(campaignChains.ReservedShares) > 0 {
+			return sdkerrors.Wrapf(
+				types.ErrCoordinatorActiveCampaign,
+				"campaign %d has reserved shares not distributed",
+				campaign.Id,
+			)
+		}
+		for _, sale := range h.k.GetAllSaleByCampaignID(ctx, campaign.Id) {
+			if !sale.Withdrawn {
+				return sdkerrors.Wrapf(types.ErrCoordinatorActiveCampaign, "sale %d is not withdrawn", sale.Id)
+			}
+		}
+		for _, auction := range h.k.GetAllAuctionByCampaignID(ctx, campaign.Id) {
+			if !auction.Withdrawn {
+				return sdkerrors.Wrapf(types.ErrCoordinatorActiveCampaign, "auction %d is not withdrawn", auction.Id)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestProfileHooksBeforeCoordinatorDeleted(t *testing.T) {
+	k, ctx := testkeeper.Campaign(t)
+	hooks := k.ProfileHooks()
+	coordID := uint64(1)
+
+	// An open campaign of another coordinator
+	otherCampaign := sample.Campaign(0)
+	otherCampaign.CoordinatorID = coordID + 1
+	otherCampaign.MainnetInitialized = false
+	otherCampaign.Id = k.AppendCampaign(ctx, otherCampaign)
+	k.AppendSale(ctx, sample.Sale(0, otherCampaign.Id))
+	require.NoError(t, hooks.BeforeCoordinatorDeleted(ctx, coordID))
+
+	// An open campaign of the coordinator
+	campaign := sample.Campaign(0)
+	campaign.CoordinatorID = coordID
+	campaign.MainnetInitialized = false
+	campaign.Id = k.AppendCampaign(ctx, campaign)
+	err := hooks.BeforeCoordinatorDeleted(ctx, coordID)
+	require.ErrorIs(t, err, types.ErrCoordinatorActiveCampaign)
+
+	campaign.MainnetInitialized = true
+	k.SetCampaign(ctx, campaign)
+	require.NoError(t, hooks.BeforeCoordinatorDeleted(ctx, coordID))
+
+	// Shares reserved for a chain of the campaign
+	campaignChains := types.CampaignChains{
+		CampaignID: campaign.Id,
+		Chains:     []uint64{0},
+	}
+	campaignChains.SetChainReservedShares(0, sample.Shares())
+	k.SetCampaignChains(ctx, campaignChains)
+	err = hooks.BeforeCoordinatorDeleted(ctx, coordID)
+	require.ErrorIs(t, err, types.ErrCoordinatorActiveCampaign)
+
+	campaignChains.SetChainReservedShares(0, types.EmptyShares())
+	k.SetCampaignChains(ctx, campaignChains)
+	require.NoError(t, hooks.BeforeCoordinatorDeleted(ctx, coordID))
+
+	// A sale not withdrawn
+	sale := sample.Sale(0, campaign.Id)
+	sale.Id = k.AppendSale(ctx, sale)
+	err = hooks.BeforeCoordinatorDeleted(ctx, coordID)
+	require.ErrorIs(t, err, types.ErrCoordinatorActiveCampaign)
+
+	sale.Settled = true
+	sale.Withdrawn = true
+	k.SetSale(ctx, sale)
+	require.NoError(t, hooks.BeforeCoordinatorDeleted(ctx, coordID))
+
+	// An auction not withdrawn
+	auction := sample.Auction(0, campaign.Id)
+	auction.Id = k.AppendAuction(ctx, auction)
+	err = hooks.BeforeCoordinatorDeleted(ctx, coordID)
+	require.ErrorIs(t, err, types.ErrCoordinatorActiveCampaign)
+
+	auction.Settled = true
+	auction.Withdrawn = true
+	k.SetAuction(ctx, auction)
+	require.NoError(t, hooks.BeforeCoordinatorDeleted(ctx, coordID))
+}
@@ -94,6 +94,7 @@ func VestingAccountWithoutCampaignInvariant(k Keeper) sdk.Invariant {
 
 // CampaignSharesInvariant invariant that checks if
 // the `MainnetVestingAccount` and `MainnetAccount` shares
+// and the shares reserved for the chains
 // sum is equal to existing campaign shares.
 func CampaignSharesInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
@@ -133,6 +134,21 @@ func CampaignSharesInvariant(k Keeper) sdk.Invariant {
 			)
 		}
 
+		// get all the shares reserved for the chains of the campaigns
+		for _, campaignChains := range k.GetAllCampaignChains(ctx) {
+			reserved := campaignChains.TotalReservedShares()
+			if len(reserved) == 0 {
+				continue
+			}
+			if _, ok := shares[campaignChains.CampaignID]; !ok {
+				shares[campaignChains.CampaignID] = types.EmptyShares()
+			}
+			shares[campaignChains.CampaignID] = types.IncreaseShares(
+				shares[campaignChains.CampaignID],
+				reserved,
+			)
+		}
+
 		for campaignID, campaignShares := range shares {
 			campaign, found := k.GetCampaign(ctx, campaignID)
 			if !found {
@@ -59,3 +59,36 @@ func TestCampaignChainsWithoutCampaignInvariant(t *testing.T) {
 		require.Equal(t, true, isValid)
 	})
 }
+
+func TestCampaignSharesInvariant(t *testing.T) {
+	k, _, _, _, _, _, ctx := setupMsgServer(t) //nolint
+	accountShares, err := types.NewShares("10foo")
+	require.NoError(t, err)
+	reservedShares, err := types.NewShares("20foo,5bar")
+	require.NoError(t, err)
+
+	campaign := sample.Campaign(0)
+	campaign.AllocatedShares = types.IncreaseShares(accountShares, reservedShares)
+	campaign.Id = k.AppendCampaign(ctx, campaign)
+	k.SetMainnetAccount(ctx, types.MainnetAccount{
+		CampaignID: campaign.Id,
+		Address:    sample.Address(),
+		Shares:     accountShares,
+	})
+	campaignChains := types.CampaignChains{CampaignID: campaign.Id, Chains: []uint64{0}}
+	campaignChains.SetChainReservedShares(0, reservedShares)
+	k.SetCampaignChains(ctx, campaignChains)
+
+	t.Run("valid case", func(t *testing.T) {
+		_, isValid := keeper.CampaignSharesInvariant(*k)(ctx)
+		require.Equal(t, false, isValid)
+	})
+
+	t.Run("invalid case", func(t *testing.T) {
+		campaignChains.Chains = append(campaignChains.Chains, 1)
+		campaignChains.SetChainReservedShares(1, reservedShares)
+		k.SetCampaignChains(ctx, campaignChains)
+		_, isValid := keeper.CampaignSharesInvariant(*k)(ctx)
+		require.Equal(t, true, isValid)
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/spn/x/campaign/types"
 )
 
@@ -15,8 +16,10 @@ type (
 		cdc           codec.BinaryCodec
 		storeKey      sdk.StoreKey
 		memKey        sdk.StoreKey
+		paramstore    paramtypes.Subspace
 		launchKeeper  types.LaunchKeeper
 		bankKeeper    types.BankKeeper
+		distrKeeper   types.DistributionKeeper
 		profileKeeper types.ProfileKeeper
 	}
 )
@@ -25,16 +28,25 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey,
 	memKey sdk.StoreKey,
+	ps paramtypes.Subspace,
 	launchKeeper types.LaunchKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 	profileKeeper types.ProfileKeeper,
 ) *Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
 		memKey:        memKey,
+		paramstore:    ps,
 		launchKeeper:  launchKeeper,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 		profileKeeper: profileKeeper,
 	}
 }
@@ -42,3 +54,8 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// GetLaunchKeeper get the launch keeper interface of the module
+func (k Keeper) GetLaunchKeeper() types.LaunchKeeper {
+	return k.launchKeeper
+}
@@ -46,6 +46,22 @@ func (k Keeper) RemoveMainnetAccount(
 	))
 }
 
+// GetAllMainnetAccountByCampaignID returns all mainnetAccount for a campaign ID
+func (k Keeper) GetAllMainnetAccountByCampaignID(ctx sdk.Context, campaignID uint64) (list []types.MainnetAccount) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MainnetAccountAllKey(campaignID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MainnetAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
 // GetAllMainnetAccount returns all mainnetAccount
 func (k Keeper) GetAllMainnetAccount(ctx sdk.Context) (list []types.MainnetAccount) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MainnetAccountKeyPrefix))
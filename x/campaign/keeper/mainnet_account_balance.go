@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+// GetMainnetAccountBalances returns the balances of the mainnet accounts of a campaign computed from their shares
+func (k Keeper) GetMainnetAccountBalances(ctx sdk.Context, campaignID uint64) ([]types.MainnetAccountBalance, error) {
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", campaignID)
+	}
+
+	distribution, err := k.mainnetDistribution(ctx, campaign)
+	if err != nil {
+		return nil, err
+	}
+
+	accountBalances := make([]types.MainnetAccountBalance, len(distribution.accounts))
+	for i, account := range distribution.accounts {
+		accountBalances[i] = types.MainnetAccountBalance{
+			CampaignID: campaignID,
+			Address:    account.Address,
+			Coins:      distribution.balances[i],
+		}
+	}
+	return accountBalances, nil
+}
+
+// MainnetGenesisAccounts returns the genesis accounts and the vesting accounts of the mainnet of a campaign
+// computed from the shares of the mainnet accounts and the mainnet vesting accounts
+// The mainnet account of an address having a mainnet vesting account is merged into its vesting account
+func (k Keeper) MainnetGenesisAccounts(
+	ctx sdk.Context,
+	campaignID uint64,
+) ([]launchtypes.GenesisAccount, []launchtypes.VestingAccount, error) {
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return nil, nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", campaignID)
+	}
+	if !campaign.MainnetInitialized {
+		return nil, nil, sdkerrors.Wrapf(types.ErrMainnetNotInitialized, "%d", campaignID)
+	}
+
+	distribution, err := k.mainnetDistribution(ctx, campaign)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	liquidBalances := make(map[string]sdk.Coins)
+	for i, account := range distribution.accounts {
+		liquidBalances[account.Address] = distribution.balances[i]
+	}
+
+	var (
+		genesisAccounts []launchtypes.GenesisAccount
+		vesting         []launchtypes.VestingAccount
+	)
+	for i, account := range distribution.vestingAccounts {
+		startingBalance := distribution.startingBalances[i].Add(liquidBalances[account.Address]...)
+		delete(liquidBalances, account.Address)
+
+		options := distribution.vestingOptions[i]
+		vestingCoins, err := options.TotalVesting()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// the shares of the account may represent no vesting coin
+		if vestingCoins.Empty() {
+			liquidBalances[account.Address] = startingBalance
+			continue
+		}
+		vesting = append(vesting, launchtypes.VestingAccount{
+			LaunchID:        campaign.MainnetID,
+			Address:         account.Address,
+			StartingBalance: startingBalance,
+			VestingOptions:  options,
+		})
+	}
+
+	for address, coins := range liquidBalances {
+		if coins.Empty() {
+			continue
+		}
+		genesisAccounts = append(genesisAccounts, launchtypes.GenesisAccount{
+			LaunchID: campaign.MainnetID,
+			Address:  address,
+			Coins:    coins,
+		})
+	}
+
+	// the genesis accounts are sorted to get a deterministic genesis
+	sort.Slice(genesisAccounts, func(i, j int) bool {
+		return genesisAccounts[i].Address < genesisAccounts[j].Address
+	})
+
+	return genesisAccounts, vesting, nil
+}
+
+// mainnetDistribution is the distribution of the total supply of a campaign among its mainnet accounts
+// and mainnet vesting accounts
+type mainnetDistribution struct {
+	accounts         []types.MainnetAccount
+	balances         []sdk.Coins
+	vestingAccounts  []types.MainnetVestingAccount
+	startingBalances []sdk.Coins
+	vestingOptions   []launchtypes.VestingOptions
+}
+
+// mainnetDistribution computes the balances of the mainnet accounts, the starting balances and the vesting options
+// of the mainnet vesting accounts of a campaign from their shares
+// All these shares are distributed in a single pass so the remainders of the rounding are distributed among
+// the balances and the vesting coins alike
+// All the mainnet accounts of the campaign are loaded: the cost grows with the number of accounts
+func (k Keeper) mainnetDistribution(ctx sdk.Context, campaign types.Campaign) (d mainnetDistribution, err error) {
+	d.accounts = k.GetAllMainnetAccountByCampaignID(ctx, campaign.Id)
+	d.vestingAccounts = k.GetAllMainnetVestingAccountByCampaignID(ctx, campaign.Id)
+
+	shares := make([]types.Shares, 0, len(d.accounts)+len(d.vestingAccounts))
+	for _, account := range d.accounts {
+		shares = append(shares, account.Shares)
+	}
+	for _, account := range d.vestingAccounts {
+		shares = append(shares, account.StartingShares)
+	}
+
+	// the vesting shares of each vesting account are appended after the starting shares
+	vestingShareCounts := make([]int, len(d.vestingAccounts))
+	for i, account := range d.vestingAccounts {
+		vestingShares, err := shareVestingAmounts(account.VestingOptions)
+		if err != nil {
+			return d, err
+		}
+		vestingShareCounts[i] = len(vestingShares)
+		shares = append(shares, vestingShares...)
+	}
+
+	distributed, err := types.DistributeTotalSupply(campaign.TotalSupply, campaign.TotalShares, shares)
+	if err != nil {
+		return d, err
+	}
+
+	d.balances = distributed[:len(d.accounts)]
+	distributed = distributed[len(d.accounts):]
+	d.startingBalances = distributed[:len(d.vestingAccounts)]
+	distributed = distributed[len(d.vestingAccounts):]
+
+	d.vestingOptions = make([]launchtypes.VestingOptions, len(d.vestingAccounts))
+	for i, account := range d.vestingAccounts {
+		d.vestingOptions[i], err = vestingOptionsFromCoins(account.VestingOptions, distributed[:vestingShareCounts[i]])
+		if err != nil {
+			return d, err
+		}
+		distributed = distributed[vestingShareCounts[i]:]
+	}
+
+	return d, nil
+}
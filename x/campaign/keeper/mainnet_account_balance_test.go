@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+func TestKeeper_GetMainnetAccountBalances(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+
+	campaign := sample.Campaign(0)
+	campaign.TotalSupply = sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(1000)),
+		sdk.NewCoin("bar", sdk.NewInt(1000)),
+	)
+	campaign.TotalShares = types.Shares(sdk.NewCoins(sdk.NewCoin(types.SharePrefix+"bar", sdk.NewInt(3))))
+	campaign.Id = keeper.AppendCampaign(ctx, campaign)
+
+	addr1, addr2, addr3 := sample.Address(), sample.Address(), sample.Address()
+	shares1, err := types.NewShares("33333foo,1bar")
+	require.NoError(t, err)
+	shares2, err := types.NewShares("33333foo,1bar")
+	require.NoError(t, err)
+	shares3, err := types.NewShares("33334foo,2bar")
+	require.NoError(t, err)
+	keeper.SetMainnetAccount(ctx, types.MainnetAccount{CampaignID: campaign.Id, Address: addr1, Shares: shares1})
+	keeper.SetMainnetAccount(ctx, types.MainnetAccount{CampaignID: campaign.Id, Address: addr2, Shares: shares2})
+	keeper.SetMainnetVestingAccount(ctx, sample.MainnetVestingAccountWithShares(campaign.Id, addr3, shares3))
+
+	// accounts of another campaign
+	otherCampaign := sample.Campaign(0)
+	otherCampaign.Id = keeper.AppendCampaign(ctx, otherCampaign)
+	keeper.SetMainnetAccount(ctx, sample.MainnetAccount(otherCampaign.Id, sample.Address()))
+
+	t.Run("should compute the balances of the mainnet accounts", func(t *testing.T) {
+		balances, err := keeper.GetMainnetAccountBalances(ctx, campaign.Id)
+		require.NoError(t, err)
+		require.Len(t, balances, 2)
+
+		expected := sdk.NewCoins(
+			sdk.NewCoin("foo", sdk.NewInt(333)),
+			sdk.NewCoin("bar", sdk.NewInt(333)),
+		)
+		addresses := make([]string, len(balances))
+		for i, balance := range balances {
+			require.EqualValues(t, campaign.Id, balance.CampaignID)
+			require.True(t, balance.Coins.IsEqual(expected), "%s != %s", balance.Coins, expected)
+			addresses[i] = balance.Address
+		}
+		require.ElementsMatch(t, []string{addr1, addr2}, addresses)
+	})
+
+	t.Run("should return an error for a non existing campaign", func(t *testing.T) {
+		_, err := keeper.GetMainnetAccountBalances(ctx, 1000)
+		require.ErrorIs(t, err, types.ErrCampaignNotFound)
+	})
+}
+
+func TestKeeper_MainnetGenesisAccounts(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	now := time.Now().Unix()
+
+	campaign := sample.Campaign(0)
+	campaign.TotalSupply = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+	campaign.MainnetInitialized = true
+	campaign.MainnetID = 10
+	campaign.Id = keeper.AppendCampaign(ctx, campaign)
+
+	notInitialized := sample.Campaign(0)
+	notInitialized.MainnetInitialized = false
+	notInitialized.Id = keeper.AppendCampaign(ctx, notInitialized)
+
+	newShares := func(str string) types.Shares {
+		shares, err := types.NewShares(str)
+		require.NoError(t, err)
+		return shares
+	}
+
+	// account with a mainnet account only
+	addrAccount := sample.Address()
+	keeper.SetMainnetAccount(ctx, types.MainnetAccount{
+		CampaignID: campaign.Id,
+		Address:    addrAccount,
+		Shares:     newShares("33333foo"),
+	})
+
+	// account with a mainnet account and a mainnet vesting account
+	addrBoth := sample.Address()
+	keeper.SetMainnetAccount(ctx, types.MainnetAccount{
+		CampaignID: campaign.Id,
+		Address:    addrBoth,
+		Shares:     newShares("33333foo"),
+	})
+	keeper.SetMainnetVestingAccount(ctx, types.MainnetVestingAccount{
+		CampaignID:     campaign.Id,
+		Address:        addrBoth,
+		StartingShares: newShares("33334foo"),
+		VestingOptions: *types.NewShareDelayedVesting(newShares("10000foo"), now),
+	})
+
+	// account with a mainnet vesting account representing no coin
+	keeper.SetMainnetVestingAccount(ctx, types.MainnetVestingAccount{
+		CampaignID:     campaign.Id,
+		Address:        sample.Address(),
+		StartingShares: types.EmptyShares(),
+		VestingOptions: *types.NewShareDelayedVesting(newShares("1foo"), now),
+	})
+
+	// account with a mainnet vesting account without starting shares
+	addrVesting := sample.Address()
+	keeper.SetMainnetVestingAccount(ctx, types.MainnetVestingAccount{
+		CampaignID:     campaign.Id,
+		Address:        addrVesting,
+		StartingShares: types.EmptyShares(),
+		VestingOptions: *types.NewShareContinuousVesting(newShares("20000foo"), now, now+1000),
+	})
+
+	t.Run("should compute the genesis accounts of the mainnet", func(t *testing.T) {
+		genesisAccounts, vestingAccounts, err := keeper.MainnetGenesisAccounts(ctx, campaign.Id)
+		require.NoError(t, err)
+
+		require.Len(t, genesisAccounts, 1)
+		require.EqualValues(t, campaign.MainnetID, genesisAccounts[0].LaunchID)
+		require.EqualValues(t, addrAccount, genesisAccounts[0].Address)
+		require.True(t, genesisAccounts[0].Coins.IsEqual(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(333)))))
+
+		require.Len(t, vestingAccounts, 2)
+		vestingByAddress := make(map[string]launchtypes.VestingAccount)
+		for _, acc := range vestingAccounts {
+			require.EqualValues(t, campaign.MainnetID, acc.LaunchID)
+			vestingByAddress[acc.Address] = acc
+		}
+
+		both, ok := vestingByAddress[addrBoth]
+		require.True(t, ok)
+		require.True(t, both.StartingBalance.IsEqual(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(667)))))
+		require.Equal(t, *launchtypes.NewDelayedVesting(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100))), now), both.VestingOptions)
+
+		vesting, ok := vestingByAddress[addrVesting]
+		require.True(t, ok)
+		require.True(t, vesting.StartingBalance.Empty())
+		require.Equal(t, *launchtypes.NewContinuousVesting(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(200))), now, now+1000), vesting.VestingOptions)
+	})
+
+	t.Run("should distribute the remainders among the balances and the vesting coins", func(t *testing.T) {
+		remainderCampaign := sample.Campaign(0)
+		remainderCampaign.TotalSupply = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10)))
+		remainderCampaign.MainnetInitialized = true
+		remainderCampaign.Id = keeper.AppendCampaign(ctx, remainderCampaign)
+
+		keeper.SetMainnetAccount(ctx, types.MainnetAccount{
+			CampaignID: remainderCampaign.Id,
+			Address:    sample.Address(),
+			Shares:     newShares("33333foo"),
+		})
+		keeper.SetMainnetVestingAccount(ctx, types.MainnetVestingAccount{
+			CampaignID:     remainderCampaign.Id,
+			Address:        sample.Address(),
+			StartingShares: types.EmptyShares(),
+			VestingOptions: *types.NewShareDelayedVesting(newShares("66667foo"), now),
+		})
+
+		genesisAccounts, vestingAccounts, err := keeper.MainnetGenesisAccounts(ctx, remainderCampaign.Id)
+		require.NoError(t, err)
+		require.Len(t, genesisAccounts, 1)
+		require.True(t, genesisAccounts[0].Coins.IsEqual(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(3)))))
+		require.Len(t, vestingAccounts, 1)
+		require.Equal(t, *launchtypes.NewDelayedVesting(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(7))), now), vestingAccounts[0].VestingOptions)
+	})
+
+	t.Run("should return an error for a non existing campaign", func(t *testing.T) {
+		_, _, err := keeper.MainnetGenesisAccounts(ctx, 1000)
+		require.ErrorIs(t, err, types.ErrCampaignNotFound)
+	})
+
+	t.Run("should return an error for a campaign with no initialized mainnet", func(t *testing.T) {
+		_, _, err := keeper.MainnetGenesisAccounts(ctx, notInitialized.Id)
+		require.ErrorIs(t, err, types.ErrMainnetNotInitialized)
+	})
+}
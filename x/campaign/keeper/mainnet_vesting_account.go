@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"errors"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
 )
 
 // SetMainnetVestingAccount set a specific mainnetVestingAccount in the store from its index
@@ -43,6 +46,22 @@ func (k Keeper) RemoveMainnetVestingAccount(
 	store.Delete(types.MainnetVestingAccountKey(campaignID, address))
 }
 
+// GetAllMainnetVestingAccountByCampaignID returns all mainnetVestingAccount for a campaign ID
+func (k Keeper) GetAllMainnetVestingAccountByCampaignID(ctx sdk.Context, campaignID uint64) (list []types.MainnetVestingAccount) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MainnetVestingAccountAllKey(campaignID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MainnetVestingAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
 // GetAllMainnetVestingAccount returns all mainnetVestingAccount
 func (k Keeper) GetAllMainnetVestingAccount(ctx sdk.Context) (list []types.MainnetVestingAccount) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MainnetVestingAccountKeyPrefix))
@@ -58,3 +77,89 @@ func (k Keeper) GetAllMainnetVestingAccount(ctx sdk.Context) (list []types.Mainn
 
 	return
 }
+
+// VestingOptionsFromShares converts share vesting options into launch vesting options
+// The vesting shares are converted into coins from the total supply and total shares of the campaign
+// Periods of a periodic vesting representing no coin are merged into the next period to preserve the vesting schedule
+func VestingOptionsFromShares(
+	options types.ShareVestingOptions,
+	totalSupply sdk.Coins,
+	totalShares types.Shares,
+) (launchtypes.VestingOptions, error) {
+	vestingShares, err := shareVestingAmounts(options)
+	if err != nil {
+		return launchtypes.VestingOptions{}, err
+	}
+	vestingCoins := make([]sdk.Coins, len(vestingShares))
+	for i, shares := range vestingShares {
+		vestingCoins[i], err = shares.CoinsFromTotalSupply(totalSupply, totalShares)
+		if err != nil {
+			return launchtypes.VestingOptions{}, err
+		}
+	}
+	return vestingOptionsFromCoins(options, vestingCoins)
+}
+
+// shareVestingAmounts returns the vesting shares of share vesting options
+// A periodic vesting has an entry for each of its periods
+func shareVestingAmounts(options types.ShareVestingOptions) ([]types.Shares, error) {
+	switch vestingOptions := options.Options.(type) {
+	case *types.ShareVestingOptions_DelayedVesting:
+		return []types.Shares{vestingOptions.DelayedVesting.Vesting}, nil
+	case *types.ShareVestingOptions_ContinuousVesting:
+		return []types.Shares{vestingOptions.ContinuousVesting.Vesting}, nil
+	case *types.ShareVestingOptions_PeriodicVesting:
+		periods := vestingOptions.PeriodicVesting.Periods
+		amounts := make([]types.Shares, len(periods))
+		for i, period := range periods {
+			amounts[i] = period.Amount
+		}
+		return amounts, nil
+	default:
+		return nil, errors.New("unrecognized vesting options")
+	}
+}
+
+// vestingOptionsFromCoins converts share vesting options into launch vesting options from the coins
+// represented by the vesting shares returned by shareVestingAmounts
+func vestingOptionsFromCoins(
+	options types.ShareVestingOptions,
+	vestingCoins []sdk.Coins,
+) (launchtypes.VestingOptions, error) {
+	switch vestingOptions := options.Options.(type) {
+	case *types.ShareVestingOptions_DelayedVesting:
+		if len(vestingCoins) != 1 {
+			return launchtypes.VestingOptions{}, errors.New("invalid delayed vesting coins")
+		}
+		return *launchtypes.NewDelayedVesting(vestingCoins[0], vestingOptions.DelayedVesting.EndTime), nil
+	case *types.ShareVestingOptions_ContinuousVesting:
+		cv := vestingOptions.ContinuousVesting
+		if len(vestingCoins) != 1 {
+			return launchtypes.VestingOptions{}, errors.New("invalid continuous vesting coins")
+		}
+		return *launchtypes.NewContinuousVesting(vestingCoins[0], cv.StartTime, cv.EndTime), nil
+	case *types.ShareVestingOptions_PeriodicVesting:
+		pv := vestingOptions.PeriodicVesting
+		if len(vestingCoins) != len(pv.Periods) {
+			return launchtypes.VestingOptions{}, errors.New("invalid periodic vesting coins")
+		}
+		var (
+			periods      []launchtypes.VestingPeriod
+			mergedLength int64
+		)
+		for i, period := range pv.Periods {
+			mergedLength += period.Length
+			if vestingCoins[i].Empty() {
+				continue
+			}
+			periods = append(periods, launchtypes.VestingPeriod{
+				Length: mergedLength,
+				Amount: vestingCoins[i],
+			})
+			mergedLength = 0
+		}
+		return *launchtypes.NewPeriodicVesting(pv.StartTime, periods), nil
+	default:
+		return launchtypes.VestingOptions{}, errors.New("unrecognized vesting options")
+	}
+}
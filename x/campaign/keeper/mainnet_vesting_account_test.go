@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -9,6 +10,7 @@ import (
 	"github.com/tendermint/spn/testutil/sample"
 	campaignkeeper "github.com/tendermint/spn/x/campaign/keeper"
 	"github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
 )
 
 func createNMainnetVestingAccount(keeper *campaignkeeper.Keeper, ctx sdk.Context, n int) []types.MainnetVestingAccount {
@@ -53,3 +55,83 @@ func TestMainnetVestingAccountGetAll(t *testing.T) {
 	items := createNMainnetVestingAccount(keeper, ctx, 10)
 	require.ElementsMatch(t, items, keeper.GetAllMainnetVestingAccount(ctx))
 }
+
+func TestVestingOptionsFromShares(t *testing.T) {
+	now := time.Now().Unix()
+	totalSupply := sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(1000)),
+		sdk.NewCoin("bar", sdk.NewInt(500)),
+	)
+	totalShares, err := types.NewShares("100foo")
+	require.NoError(t, err)
+	shares, err := types.NewShares("10foo,50000bar")
+	require.NoError(t, err)
+	coins := sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(100)),
+		sdk.NewCoin("bar", sdk.NewInt(250)),
+	)
+	smallShares, err := types.NewShares("1bar")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		options types.ShareVestingOptions
+		want    launchtypes.VestingOptions
+		err     bool
+	}{
+		{
+			desc:    "delayed vesting",
+			options: *types.NewShareDelayedVesting(shares, now),
+			want:    *launchtypes.NewDelayedVesting(coins, now),
+		},
+		{
+			desc:    "continuous vesting",
+			options: *types.NewShareContinuousVesting(shares, now, now+1000),
+			want:    *launchtypes.NewContinuousVesting(coins, now, now+1000),
+		},
+		{
+			desc: "periodic vesting",
+			options: *types.NewSharePeriodicVesting(now, []types.ShareVestingPeriod{
+				{Length: 1000, Amount: shares},
+				{Length: 2000, Amount: shares},
+			}),
+			want: *launchtypes.NewPeriodicVesting(now, []launchtypes.VestingPeriod{
+				{Length: 1000, Amount: coins},
+				{Length: 2000, Amount: coins},
+			}),
+		},
+		{
+			desc: "periodic vesting with periods representing no coin",
+			options: *types.NewSharePeriodicVesting(now, []types.ShareVestingPeriod{
+				{Length: 1000, Amount: smallShares},
+				{Length: 2000, Amount: shares},
+				{Length: 3000, Amount: smallShares},
+				{Length: 4000, Amount: shares},
+			}),
+			want: *launchtypes.NewPeriodicVesting(now, []launchtypes.VestingPeriod{
+				{Length: 3000, Amount: coins},
+				{Length: 7000, Amount: coins},
+			}),
+		},
+		{
+			desc:    "invalid shares",
+			options: *types.NewShareDelayedVesting(types.Shares(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10)))), now),
+			err:     true,
+		},
+		{
+			desc:    "no vesting options",
+			options: types.ShareVestingOptions{},
+			err:     true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := campaignkeeper.VestingOptionsFromShares(tc.options, totalSupply, totalShares)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the voucher denoms from the v/<campaignID>/<denom> format to the v-<campaignID>-<denom> format
+// ICS-20 only allows slashes in the denoms of IBC vouchers, the vouchers of the version 1 can't be transferred over IBC
+// The balances are migrated by burning the legacy vouchers and minting the new vouchers to their holders,
+// the supply and the shares of the campaigns and mainnet accounts denominated in legacy vouchers are renamed
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.migrateVoucherBalances(ctx); err != nil {
+		return err
+	}
+	m.keeper.migrateVoucherShares(ctx)
+	return nil
+}
+
+func (k Keeper) migrateVoucherBalances(ctx sdk.Context) error {
+	type holding struct {
+		address sdk.AccAddress
+		coin    sdk.Coin
+	}
+	var holdings []holding
+	k.bankKeeper.IterateAllBalances(ctx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		if _, _, err := types.LegacyVoucherDenom(coin.Denom); err == nil {
+			holdings = append(holdings, holding{address: address, coin: coin})
+		}
+		return false
+	})
+
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	for _, h := range holdings {
+		legacy := sdk.NewCoins(h.coin)
+		vouchers := sdk.NewCoins(migrateVoucherCoin(h.coin))
+
+		// the holder may be the module itself when the vouchers are escrowed in a sale or an auction
+		if !h.address.Equals(moduleAddress) {
+			if err := k.bankKeeper.SendCoins(ctx, h.address, moduleAddress, legacy); err != nil {
+				return spnerrors.Criticalf("can't escrow legacy vouchers of %s %s", h.address.String(), err.Error())
+			}
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, legacy); err != nil {
+			return spnerrors.Criticalf("can't burn legacy vouchers %s", err.Error())
+		}
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
+			return spnerrors.Criticalf("can't mint vouchers %s", err.Error())
+		}
+		if !h.address.Equals(moduleAddress) {
+			if err := k.bankKeeper.SendCoins(ctx, moduleAddress, h.address, vouchers); err != nil {
+				return spnerrors.Criticalf("can't send vouchers to %s %s", h.address.String(), err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) migrateVoucherShares(ctx sdk.Context) {
+	for _, campaign := range k.GetAllCampaign(ctx) {
+		campaign.TotalSupply = migrateVoucherCoins(campaign.TotalSupply)
+		campaign.AllocatedShares = types.Shares(migrateVoucherCoins(sdk.Coins(campaign.AllocatedShares)))
+		campaign.TotalShares = types.Shares(migrateVoucherCoins(sdk.Coins(campaign.TotalShares)))
+		k.SetCampaign(ctx, campaign)
+	}
+	for _, acc := range k.GetAllMainnetAccount(ctx) {
+		acc.Shares = types.Shares(migrateVoucherCoins(sdk.Coins(acc.Shares)))
+		k.SetMainnetAccount(ctx, acc)
+	}
+}
+
+// migrateVoucherCoins returns the coins with the legacy vouchers and the shares of legacy vouchers migrated
+func migrateVoucherCoins(coins sdk.Coins) sdk.Coins {
+	if len(coins) == 0 {
+		return coins
+	}
+	migrated := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if strings.HasPrefix(coin.Denom, types.SharePrefix) {
+			coin.Denom = strings.TrimPrefix(coin.Denom, types.SharePrefix)
+			coin = migrateVoucherCoin(coin)
+			coin.Denom = types.SharePrefix + coin.Denom
+		} else {
+			coin = migrateVoucherCoin(coin)
+		}
+		migrated[i] = coin
+	}
+	return migrated.Sort()
+}
+
+// migrateVoucherCoin returns the coin with the voucher denom format of the version 2 if it is a legacy voucher
+func migrateVoucherCoin(coin sdk.Coin) sdk.Coin {
+	campaignID, denom, err := types.LegacyVoucherDenom(coin.Denom)
+	if err != nil {
+		return coin
+	}
+	coin.Denom = types.VoucherDenom(campaignID, denom)
+	return coin
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/keeper"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMigrator_Migrate1to2(t *testing.T) {
+	campaignKeeper, _, _, bankKeeper, _, _, ctx := setupMsgServer(t)
+
+	var (
+		holder        = sample.AccAddress()
+		moduleAddress = authtypes.NewModuleAddress(types.ModuleName)
+		legacyFoo     = types.LegacyVoucherPrefix + "0" + types.LegacyVoucherSeparator + "foo"
+		legacyBar     = types.LegacyVoucherPrefix + "1" + types.LegacyVoucherSeparator + "bar/baz"
+		notVoucher    = sdk.NewCoin("foo", sdk.NewInt(50))
+	)
+
+	// legacy vouchers held by an account and escrowed in the module
+	held := sdk.NewCoins(sdk.NewCoin(legacyFoo, sdk.NewInt(100)), sdk.NewCoin(legacyBar, sdk.NewInt(200)), notVoucher)
+	escrowed := sdk.NewCoins(sdk.NewCoin(legacyFoo, sdk.NewInt(300)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, held.Add(escrowed...)))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, held))
+
+	// campaign and mainnet account shares of a legacy voucher
+	campaign := sample.Campaign(0)
+	campaign.TotalSupply = sdk.NewCoins(sdk.NewCoin(legacyFoo, sdk.NewInt(1000)), notVoucher)
+	campaign.AllocatedShares = types.NewSharesFromCoins(sdk.NewCoins(sdk.NewCoin(legacyFoo, sdk.NewInt(10))))
+	campaign.TotalShares = types.Shares(nil)
+	campaignKeeper.SetCampaign(ctx, campaign)
+	mainnetAccount := sample.MainnetAccount(campaign.Id, sample.Address())
+	mainnetAccount.Shares = types.NewSharesFromCoins(sdk.NewCoins(sdk.NewCoin(legacyFoo, sdk.NewInt(10)), notVoucher))
+	campaignKeeper.SetMainnetAccount(ctx, mainnetAccount)
+
+	require.NoError(t, keeper.NewMigrator(*campaignKeeper).Migrate1to2(ctx))
+
+	fooVoucher := types.VoucherDenom(0, "foo")
+	barVoucher := types.VoucherDenom(1, "bar/baz")
+	require.True(t, bankKeeper.GetAllBalances(ctx, holder).IsEqual(sdk.NewCoins(
+		sdk.NewCoin(fooVoucher, sdk.NewInt(100)),
+		sdk.NewCoin(barVoucher, sdk.NewInt(200)),
+		notVoucher,
+	)))
+	require.True(t, bankKeeper.GetAllBalances(ctx, moduleAddress).IsEqual(sdk.NewCoins(
+		sdk.NewCoin(fooVoucher, sdk.NewInt(300)),
+	)))
+	require.True(t, bankKeeper.GetSupply(ctx, legacyFoo).IsZero())
+	require.True(t, bankKeeper.GetSupply(ctx, legacyBar).IsZero())
+	require.EqualValues(t, 400, bankKeeper.GetSupply(ctx, fooVoucher).Amount.Int64())
+
+	migratedCampaign, found := campaignKeeper.GetCampaign(ctx, campaign.Id)
+	require.True(t, found)
+	require.True(t, migratedCampaign.TotalSupply.IsEqual(sdk.NewCoins(sdk.NewCoin(fooVoucher, sdk.NewInt(1000)), notVoucher)))
+	require.True(t, types.IsEqualShares(
+		migratedCampaign.AllocatedShares,
+		types.NewSharesFromCoins(sdk.NewCoins(sdk.NewCoin(fooVoucher, sdk.NewInt(10)))),
+	))
+	require.Empty(t, migratedCampaign.TotalShares)
+	migratedAccount, found := campaignKeeper.GetMainnetAccount(ctx, campaign.Id, mainnetAccount.Address)
+	require.True(t, found)
+	require.True(t, types.IsEqualShares(
+		migratedAccount.Shares,
+		types.NewSharesFromCoins(sdk.NewCoins(sdk.NewCoin(fooVoucher, sdk.NewInt(10)), notVoucher)),
+	))
+}
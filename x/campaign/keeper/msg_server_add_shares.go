@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,16 +17,14 @@ func (k msgServer) AddShares(goCtx context.Context, msg *types.MsgAddShares) (*t
 		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
 	}
 
-	// Get the coordinator ID
-	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
-	if !found {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
-	}
-	if campaign.CoordinatorID != coordinatorID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the campaign is %d",
-			campaign.CoordinatorID,
-		))
+	// Check sender is the coordinator of the campaign or an authorized operator
+	if err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		campaign.CoordinatorID,
+		msg.Coordinator,
+		profiletypes.CoordinatorOperator_MANAGE_SHARES,
+	); err != nil {
+		return nil, err
 	}
 
 	// check if the account already exists
@@ -53,5 +50,9 @@ func (k msgServer) AddShares(goCtx context.Context, msg *types.MsgAddShares) (*t
 	k.SetCampaign(ctx, campaign)
 	k.SetMainnetAccount(ctx, account)
 
-	return &types.MsgAddSharesResponse{}, nil
+	return &types.MsgAddSharesResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventSharesAdded{
+		CampaignID: campaign.Id,
+		Address:    msg.Address,
+		Shares:     msg.Shares,
+	})
 }
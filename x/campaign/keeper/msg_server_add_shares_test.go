@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -182,6 +183,12 @@ func TestMsgAddShares(t *testing.T) {
 				equal := types.IsEqualShares(previousCampaign.AllocatedShares, tmpShare)
 				require.True(t, equal)
 			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventSharesAdded{
+				CampaignID: tc.msg.CampaignID,
+				Address:    tc.msg.Address,
+				Shares:     tc.msg.Shares,
+			})
 		})
 	}
 }
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -19,16 +18,14 @@ func (k msgServer) AddVestingOptions(goCtx context.Context, msg *types.MsgAddVes
 		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
 	}
 
-	// Get the coordinator ID
-	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
-	if !found {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
-	}
-	if campaign.CoordinatorID != coordinatorID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the campaign is %d",
-			campaign.CoordinatorID,
-		))
+	// Check sender is the coordinator of the campaign or an authorized operator
+	if err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		campaign.CoordinatorID,
+		msg.Coordinator,
+		profiletypes.CoordinatorOperator_MANAGE_SHARES,
+	); err != nil {
+		return nil, err
 	}
 
 	// check if the account already exists
@@ -70,5 +67,10 @@ func (k msgServer) AddVestingOptions(goCtx context.Context, msg *types.MsgAddVes
 	k.SetCampaign(ctx, campaign)
 	k.SetMainnetVestingAccount(ctx, account)
 
-	return &types.MsgAddVestingOptionsResponse{}, nil
+	return &types.MsgAddVestingOptionsResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventVestingOptionsAdded{
+		CampaignID:     campaign.Id,
+		Address:        msg.Address,
+		StartingShares: msg.StartingShares,
+		VestingOptions: msg.VestingOptions,
+	})
 }
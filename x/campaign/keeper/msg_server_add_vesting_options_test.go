@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -139,6 +140,26 @@ func TestMsgAddVestingOptions(t *testing.T) {
 				VestingOptions: sample.ShareVestingOptions(),
 			},
 		},
+		{
+			name: "create new account with continuous vesting",
+			msg: types.MsgAddVestingOptions{
+				Coordinator:    coordAddr1,
+				CampaignID:     campaign.Id,
+				Address:        sample.Address(),
+				StartingShares: lowShare,
+				VestingOptions: sample.ShareContinuousVestingOptions(),
+			},
+		},
+		{
+			name: "create new account with periodic vesting",
+			msg: types.MsgAddVestingOptions{
+				Coordinator:    coordAddr1,
+				CampaignID:     campaign.Id,
+				Address:        sample.Address(),
+				StartingShares: lowShare,
+				VestingOptions: sample.SharePeriodicVestingOptions(),
+			},
+		},
 		{
 			name: "update existing account shares",
 			msg: types.MsgAddVestingOptions{
@@ -194,6 +215,13 @@ func TestMsgAddVestingOptions(t *testing.T) {
 			require.Equal(t, tc.msg.VestingOptions, account.VestingOptions)
 			equal := types.IsEqualShares(campaign.AllocatedShares, tmpShare)
 			require.True(t, equal)
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventVestingOptionsAdded{
+				CampaignID:     tc.msg.CampaignID,
+				Address:        tc.msg.Address,
+				StartingShares: tc.msg.StartingShares,
+				VestingOptions: tc.msg.VestingOptions,
+			})
 		})
 	}
 }
@@ -42,5 +42,9 @@ func (k msgServer) BurnVouchers(goCtx context.Context, msg *types.MsgBurnVoucher
 	}
 	k.SetCampaign(ctx, campaign)
 
-	return &types.MsgBurnVouchersResponse{}, nil
+	return &types.MsgBurnVouchersResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventVouchersBurned{
+		CampaignID: msg.CampaignID,
+		Address:    msg.Sender,
+		Vouchers:   msg.Vouchers,
+	})
 }
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 )
@@ -18,7 +19,7 @@ func TestMsgBurnVouchers(t *testing.T) {
 		campaign       = sample.Campaign(0)
 		addr           = sample.AccAddress()
 		vouchersTooBig = sdk.NewCoins(
-			sdk.NewCoin("v/0/foo", sdk.NewInt(types.DefaultTotalShareNumber+1)),
+			sdk.NewCoin("v-0-foo", sdk.NewInt(types.DefaultTotalShareNumber+1)),
 		)
 	)
 
@@ -163,6 +164,12 @@ func TestMsgBurnVouchers(t *testing.T) {
 			balance := bankKeeper.GetAllBalances(sdkCtx, creatorAddr)
 			expectedBalance := previousBalance.Sub(tc.msg.Vouchers)
 			require.True(t, balance.IsEqual(expectedBalance))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventVouchersBurned{
+				CampaignID: tc.msg.CampaignID,
+				Address:    tc.msg.Sender,
+				Vouchers:   tc.msg.Vouchers,
+			})
 		})
 	}
 }
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func (k msgServer) BuyVouchers(goCtx context.Context, msg *types.MsgBuyVouchers) (*types.MsgBuyVouchersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sale, found := k.GetSale(ctx, msg.SaleID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrSaleNotFound, "%d", msg.SaleID)
+	}
+	if !sale.IsActive(ctx.BlockTime().Unix()) {
+		return nil, sdkerrors.Wrapf(types.ErrSaleNotActive, "%d", msg.SaleID)
+	}
+	if msg.Vouchers.Denom != sale.Vouchers.Denom {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidVouchers,
+			"sale %d offers %s vouchers",
+			msg.SaleID,
+			sale.Vouchers.Denom,
+		)
+	}
+	if msg.Vouchers.Amount.GT(sale.RemainingVouchers()) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInsufficientSaleVouchers,
+			"%s remaining vouchers",
+			sale.RemainingVouchers(),
+		)
+	}
+
+	// Check the max amount of vouchers the buyer can get from the sale
+	purchase, found := k.GetSalePurchase(ctx, msg.SaleID, msg.Buyer)
+	if !found {
+		purchase = types.SalePurchase{
+			SaleID:  msg.SaleID,
+			Address: msg.Buyer,
+			Amount:  sdk.ZeroInt(),
+		}
+	}
+	purchase.Amount = purchase.Amount.Add(msg.Vouchers.Amount)
+	if sale.MaxPerAddress.IsPositive() && purchase.Amount.GT(sale.MaxPerAddress) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrSaleMaxPerAddress,
+			"an address can buy at most %s vouchers",
+			sale.MaxPerAddress,
+		)
+	}
+
+	// Pay the vouchers and receive them from the module account
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return nil, spnerrors.Criticalf("can't parse buyer address %s", err.Error())
+	}
+	payment := sale.Cost(msg.Vouchers.Amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, sdk.NewCoins(payment)); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, sdk.NewCoins(msg.Vouchers)); err != nil {
+		return nil, spnerrors.Criticalf("can't send sale vouchers %s", err.Error())
+	}
+
+	sale.Sold = sale.Sold.Add(msg.Vouchers.Amount)
+	k.SetSale(ctx, sale)
+	k.SetSalePurchase(ctx, purchase)
+
+	return &types.MsgBuyVouchersResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventVouchersPurchased{
+		SaleID:   msg.SaleID,
+		Buyer:    msg.Buyer,
+		Vouchers: msg.Vouchers,
+		Payment:  payment,
+	})
+}
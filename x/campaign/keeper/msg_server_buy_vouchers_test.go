@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgBuyVouchers(t *testing.T) {
+	var (
+		campaignKeeper, _, _, bankKeeper, campaignSrv, _, sdkCtx = setupMsgServer(t)
+		ctx                                                      = sdk.WrapSDKContext(sdkCtx)
+
+		buyer      = sample.AccAddress()
+		otherBuyer = sample.AccAddress()
+		poorBuyer  = sample.AccAddress()
+		now        = sdkCtx.BlockTime().Unix()
+		price      = sdk.NewCoin("uatom", sdk.NewInt(10))
+		funds      = sdk.NewCoins(sdk.NewCoin(price.Denom, sdk.NewInt(10000)))
+	)
+
+	campaign := sample.Campaign(0)
+	campaign.Id = campaignKeeper.AppendCampaign(sdkCtx, campaign)
+
+	// appendSale creates a sale with its vouchers escrowed in the module account
+	appendSale := func(startTime, endTime int64, maxPerAddress int64) types.Sale {
+		vouchers := sdk.NewCoin(types.VoucherDenom(campaign.Id, "foo"), sdk.NewInt(100))
+		require.NoError(t, bankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(vouchers)))
+		sale := types.NewSale(0, campaign.Id, vouchers, price, startTime, endTime, sdk.NewInt(maxPerAddress))
+		sale.Id = campaignKeeper.AppendSale(sdkCtx, sale)
+		return sale
+	}
+	sale := appendSale(now, now+1000, 0)
+	cappedSale := appendSale(now, now+1000, 30)
+	notStartedSale := appendSale(now+1, now+1000, 0)
+	endedSale := appendSale(now-1000, now, 0)
+	settledSale := appendSale(now-1000, now+1000, 0)
+	settledSale.Settled = true
+	campaignKeeper.SetSale(sdkCtx, settledSale)
+
+	// Fund the buyers
+	for _, addr := range []sdk.AccAddress{buyer, otherBuyer} {
+		require.NoError(t, bankKeeper.MintCoins(sdkCtx, types.ModuleName, funds))
+		require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, addr, funds))
+	}
+
+	for _, tc := range []struct {
+		name         string
+		msg          types.MsgBuyVouchers
+		expectedSold sdk.Int
+		err          error
+	}{
+		{
+			name:         "buy vouchers",
+			msg:          *types.NewMsgBuyVouchers(buyer.String(), sale.Id, sdk.NewCoin(sale.Vouchers.Denom, sdk.NewInt(50))),
+			expectedSold: sdk.NewInt(50),
+		},
+		{
+			name:         "buy vouchers again",
+			msg:          *types.NewMsgBuyVouchers(buyer.String(), sale.Id, sdk.NewCoin(sale.Vouchers.Denom, sdk.NewInt(20))),
+			expectedSold: sdk.NewInt(70),
+		},
+		{
+			name: "buy more vouchers than remaining",
+			msg:  *types.NewMsgBuyVouchers(otherBuyer.String(), sale.Id, sdk.NewCoin(sale.Vouchers.Denom, sdk.NewInt(31))),
+			err:  types.ErrInsufficientSaleVouchers,
+		},
+		{
+			name:         "buy all the remaining vouchers",
+			msg:          *types.NewMsgBuyVouchers(otherBuyer.String(), sale.Id, sdk.NewCoin(sale.Vouchers.Denom, sdk.NewInt(30))),
+			expectedSold: sdk.NewInt(100),
+		},
+		{
+			name:         "buy vouchers up to the max per address",
+			msg:          *types.NewMsgBuyVouchers(buyer.String(), cappedSale.Id, sdk.NewCoin(cappedSale.Vouchers.Denom, sdk.NewInt(30))),
+			expectedSold: sdk.NewInt(30),
+		},
+		{
+			name: "buy vouchers over the max per address",
+			msg:  *types.NewMsgBuyVouchers(buyer.String(), cappedSale.Id, sdk.NewCoin(cappedSale.Vouchers.Denom, sdk.OneInt())),
+			err:  types.ErrSaleMaxPerAddress,
+		},
+		{
+			name:         "max per address applies for each address",
+			msg:          *types.NewMsgBuyVouchers(otherBuyer.String(), cappedSale.Id, sdk.NewCoin(cappedSale.Vouchers.Denom, sdk.NewInt(10))),
+			expectedSold: sdk.NewInt(40),
+		},
+		{
+			name: "non existing sale",
+			msg:  *types.NewMsgBuyVouchers(buyer.String(), 1000, sdk.NewCoin(sale.Vouchers.Denom, sdk.OneInt())),
+			err:  types.ErrSaleNotFound,
+		},
+		{
+			name: "sale not started",
+			msg:  *types.NewMsgBuyVouchers(buyer.String(), notStartedSale.Id, sdk.NewCoin(sale.Vouchers.Denom, sdk.OneInt())),
+			err:  types.ErrSaleNotActive,
+		},
+		{
+			name: "sale ended",
+			msg:  *types.NewMsgBuyVouchers(buyer.String(), endedSale.Id, sdk.NewCoin(sale.Vouchers.Denom, sdk.OneInt())),
+			err:  types.ErrSaleNotActive,
+		},
+		{
+			name: "sale settled",
+			msg:  *types.NewMsgBuyVouchers(buyer.String(), settledSale.Id, sdk.NewCoin(sale.Vouchers.Denom, sdk.OneInt())),
+			err:  types.ErrSaleNotActive,
+		},
+		{
+			name: "vouchers not offered by the sale",
+			msg:  *types.NewMsgBuyVouchers(buyer.String(), cappedSale.Id, sdk.NewCoin(types.VoucherDenom(campaign.Id, "bar"), sdk.OneInt())),
+			err:  types.ErrInvalidVouchers,
+		},
+		{
+			name: "insufficient funds",
+			msg:  *types.NewMsgBuyVouchers(poorBuyer.String(), cappedSale.Id, sdk.NewCoin(cappedSale.Vouchers.Denom, sdk.OneInt())),
+			err:  sdkerrors.ErrInsufficientFunds,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			buyerAddr, err := sdk.AccAddressFromBech32(tc.msg.Buyer)
+			require.NoError(t, err)
+			balance := bankKeeper.GetAllBalances(sdkCtx, buyerAddr)
+			purchased := sdk.ZeroInt()
+			if purchase, found := campaignKeeper.GetSalePurchase(sdkCtx, tc.msg.SaleID, tc.msg.Buyer); found {
+				purchased = purchase.Amount
+			}
+
+			_, err = campaignSrv.BuyVouchers(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			sale, found := campaignKeeper.GetSale(sdkCtx, tc.msg.SaleID)
+			require.True(t, found)
+			require.True(t, tc.expectedSold.Equal(sale.Sold))
+
+			purchase, found := campaignKeeper.GetSalePurchase(sdkCtx, tc.msg.SaleID, tc.msg.Buyer)
+			require.True(t, found)
+			require.True(t, purchased.Add(tc.msg.Vouchers.Amount).Equal(purchase.Amount))
+
+			// the buyer paid the vouchers
+			payment := sale.Cost(tc.msg.Vouchers.Amount)
+			expectedBalance := balance.Add(tc.msg.Vouchers).Sub(sdk.NewCoins(payment))
+			require.True(t, expectedBalance.IsEqual(bankKeeper.GetAllBalances(sdkCtx, buyerAddr)))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventVouchersPurchased{
+				SaleID:   tc.msg.SaleID,
+				Buyer:    tc.msg.Buyer,
+				Vouchers: tc.msg.Vouchers,
+				Payment:  payment,
+			})
+		})
+	}
+}
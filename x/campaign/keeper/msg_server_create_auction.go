@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) CreateAuction(goCtx context.Context, msg *types.MsgCreateAuction) (*types.MsgCreateAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
+	}
+
+	// Get the coordinator ID
+	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	if !found {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
+	}
+	if campaign.CoordinatorID != coordinatorID {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
+			"coordinator of the campaign is %d",
+			campaign.CoordinatorID,
+		))
+	}
+
+	// The auction must not be already ended
+	if msg.EndTime <= ctx.BlockTime().Unix() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuction, "end time %d is already passed", msg.EndTime)
+	}
+
+	// Escrow the auctioned vouchers in the module account
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return nil, spnerrors.Criticalf("can't parse coordinator address %s", err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		coordinator,
+		types.ModuleName,
+		sdk.NewCoins(msg.Vouchers),
+	); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientVouchers, "%s", err.Error())
+	}
+
+	auction := types.NewAuction(
+		0,
+		msg.CampaignID,
+		msg.Vouchers,
+		msg.MinPrice,
+		msg.StartTime,
+		msg.EndTime,
+	)
+	auction.Id = k.AppendAuction(ctx, auction)
+	k.EnqueueAuction(ctx, auction.EndTime, auction.Id)
+
+	return &types.MsgCreateAuctionResponse{AuctionID: auction.Id}, ctx.EventManager().EmitTypedEvent(&types.EventAuctionCreated{
+		AuctionID:  auction.Id,
+		CampaignID: auction.CampaignID,
+		Vouchers:   auction.Vouchers,
+		MinPrice:   auction.MinPrice,
+	})
+}
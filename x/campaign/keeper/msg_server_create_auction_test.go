@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgCreateAuction(t *testing.T) {
+	var (
+		campaignKeeper, _, _, bankKeeper, campaignSrv, profileSrv, sdkCtx = setupMsgServer(t)
+		ctx                                                               = sdk.WrapSDKContext(sdkCtx)
+
+		coord           = sample.Address()
+		coordNoCampaign = sample.Address()
+		now             = sdkCtx.BlockTime().Unix()
+	)
+
+	// Create coordinators
+	res, err := profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coord,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+	coordID := res.CoordinatorId
+	_, err = profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordNoCampaign,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+
+	// Set campaign
+	campaign := sample.Campaign(0)
+	campaign.CoordinatorID = coordID
+	campaign.Id = campaignKeeper.AppendCampaign(sdkCtx, campaign)
+
+	// Mint vouchers for the coordinator
+	vouchers := sdk.NewCoin(types.VoucherDenom(campaign.Id, "foo"), sdk.NewInt(1000))
+	coordAddr, err := sdk.AccAddressFromBech32(coord)
+	require.NoError(t, err)
+	require.NoError(t, bankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(vouchers)))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, coordAddr, sdk.NewCoins(vouchers)))
+
+	auctionVouchers := sdk.NewCoin(vouchers.Denom, sdk.NewInt(600))
+	minPrice := sdk.NewCoin("uatom", sdk.NewInt(10))
+
+	for _, tc := range []struct {
+		name       string
+		msg        types.MsgCreateAuction
+		expectedID uint64
+		err        error
+	}{
+		{
+			name:       "create an auction",
+			msg:        *types.NewMsgCreateAuction(coord, campaign.Id, auctionVouchers, minPrice, now, now+1000),
+			expectedID: 0,
+		},
+		{
+			name:       "create an auction starting in the future",
+			msg:        *types.NewMsgCreateAuction(coord, campaign.Id, sdk.NewCoin(vouchers.Denom, sdk.NewInt(400)), minPrice, now+100, now+1000),
+			expectedID: 1,
+		},
+		{
+			name: "non existing campaign",
+			msg:  *types.NewMsgCreateAuction(coord, 1000, auctionVouchers, minPrice, now, now+1000),
+			err:  types.ErrCampaignNotFound,
+		},
+		{
+			name: "non existing coordinator",
+			msg:  *types.NewMsgCreateAuction(sample.Address(), campaign.Id, auctionVouchers, minPrice, now, now+1000),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "not the coordinator of the campaign",
+			msg:  *types.NewMsgCreateAuction(coordNoCampaign, campaign.Id, auctionVouchers, minPrice, now, now+1000),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "end time passed",
+			msg:  *types.NewMsgCreateAuction(coord, campaign.Id, auctionVouchers, minPrice, now-1000, now),
+			err:  types.ErrInvalidAuction,
+		},
+		{
+			name: "insufficient vouchers",
+			msg:  *types.NewMsgCreateAuction(coord, campaign.Id, sdk.NewCoin(vouchers.Denom, sdk.OneInt()), minPrice, now, now+1000),
+			err:  types.ErrInsufficientVouchers,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			balance := bankKeeper.GetBalance(sdkCtx, coordAddr, tc.msg.Vouchers.Denom)
+
+			got, err := campaignSrv.CreateAuction(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedID, got.AuctionID)
+
+			auction, found := campaignKeeper.GetAuction(sdkCtx, got.AuctionID)
+			require.True(t, found)
+			require.Equal(t, tc.msg.CampaignID, auction.CampaignID)
+			require.Equal(t, tc.msg.Vouchers, auction.Vouchers)
+			require.Equal(t, tc.msg.MinPrice, auction.MinPrice)
+			require.Equal(t, tc.msg.StartTime, auction.StartTime)
+			require.Equal(t, tc.msg.EndTime, auction.EndTime)
+			require.Zero(t, auction.BidCounter)
+			require.True(t, auction.ClearingPrice.IsZero())
+			require.True(t, auction.Sold.IsZero())
+			require.False(t, auction.Settled)
+			require.False(t, auction.Withdrawn)
+
+			// the auction is cleared at its end time
+			require.Contains(t, campaignKeeper.GetAuctionQueue(sdkCtx, auction.EndTime), auction.Id)
+
+			// the vouchers are escrowed
+			require.True(t, balance.Sub(tc.msg.Vouchers).IsEqual(
+				bankKeeper.GetBalance(sdkCtx, coordAddr, tc.msg.Vouchers.Denom),
+			))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventAuctionCreated{
+				AuctionID:  got.AuctionID,
+				CampaignID: tc.msg.CampaignID,
+				Vouchers:   tc.msg.Vouchers,
+				MinPrice:   tc.msg.MinPrice,
+			})
+		})
+	}
+}
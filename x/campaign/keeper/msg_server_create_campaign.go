@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
 	"github.com/tendermint/spn/x/campaign/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
 )
@@ -18,8 +19,20 @@ func (k msgServer) CreateCampaign(goCtx context.Context, msg *types.MsgCreateCam
 		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
 	}
 
+	// The creation fee is paid to the community pool by the coordinator
+	if fee := k.CampaignCreationFee(ctx); !fee.Empty() {
+		coordAddr, err := sdk.AccAddressFromBech32(msg.Coordinator)
+		if err != nil {
+			return nil, spnerrors.Criticalf("can't parse coordinator address %s", err.Error())
+		}
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, coordAddr); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "can't pay the campaign creation fee %s", err.Error())
+		}
+	}
+
 	// Append the new campaign
 	campaign := types.NewCampaign(0, msg.CampaignName, coordinatorID, msg.TotalSupply, msg.DynamicShares)
+	campaign.VoucherTransferDisabled = msg.VoucherTransferDisabled
 	campaignID := k.AppendCampaign(ctx, campaign)
 
 	// Initialize the list of campaign chains
@@ -28,5 +41,9 @@ func (k msgServer) CreateCampaign(goCtx context.Context, msg *types.MsgCreateCam
 		Chains:     []uint64{},
 	})
 
-	return &types.MsgCreateCampaignResponse{CampaignID: campaignID}, nil
+	return &types.MsgCreateCampaignResponse{CampaignID: campaignID}, ctx.EventManager().EmitTypedEvent(&types.EventCampaignCreated{
+		CampaignID:         campaignID,
+		CoordinatorAddress: msg.Coordinator,
+		CoordinatorID:      coordinatorID,
+	})
 }
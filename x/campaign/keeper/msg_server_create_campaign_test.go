@@ -4,7 +4,11 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -69,6 +73,17 @@ func TestMsgCreateCampaign(t *testing.T) {
 			},
 			expectedID: uint64(2),
 		},
+		{
+			name: "create a campaign 4 with disabled voucher transfer",
+			msg: types.MsgCreateCampaign{
+				CampaignName:            sample.CampaignName(),
+				Coordinator:             coordAddr1,
+				TotalSupply:             sample.Coins(),
+				DynamicShares:           false,
+				VoucherTransferDisabled: true,
+			},
+			expectedID: uint64(3),
+		},
 		{
 			name: "create a campaign from a non existing coordinator",
 			msg: types.MsgCreateCampaign{
@@ -96,6 +111,7 @@ func TestMsgCreateCampaign(t *testing.T) {
 			require.False(t, campaign.MainnetInitialized)
 			require.True(t, tc.msg.TotalSupply.IsEqual(campaign.TotalSupply))
 			require.EqualValues(t, tc.msg.DynamicShares, campaign.DynamicShares)
+			require.EqualValues(t, tc.msg.VoucherTransferDisabled, campaign.VoucherTransferDisabled)
 			require.EqualValues(t, types.EmptyShares(), campaign.AllocatedShares)
 			require.EqualValues(t, types.EmptyShares(), campaign.TotalShares)
 
@@ -104,6 +120,50 @@ func TestMsgCreateCampaign(t *testing.T) {
 			require.True(t, found)
 			require.EqualValues(t, got.CampaignID, campaignChains.CampaignID)
 			require.Empty(t, campaignChains.Chains)
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventCampaignCreated{
+				CampaignID:         got.CampaignID,
+				CoordinatorAddress: tc.msg.Coordinator,
+				CoordinatorID:      coordMap[tc.msg.Coordinator],
+			})
 		})
 	}
 }
+
+func TestMsgCreateCampaignFee(t *testing.T) {
+	var (
+		coordAddr                                                         = sample.AccAddress()
+		campaignKeeper, _, _, bankKeeper, campaignSrv, profileSrv, sdkCtx = setupMsgServer(t)
+		ctx                                                               = sdk.WrapSDKContext(sdkCtx)
+		fee                                                               = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)))
+		communityPoolAddr                                                 = authtypes.NewModuleAddress(distrtypes.ModuleName)
+	)
+
+	msgCreateCoordinator := sample.MsgCreateCoordinator(coordAddr.String())
+	_, err := profileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.CampaignCreationFee = fee
+	campaignKeeper.SetParams(sdkCtx, params)
+
+	// the coordinator can pay the fee of a single campaign
+	require.NoError(t, bankKeeper.MintCoins(sdkCtx, types.ModuleName, fee))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, coordAddr, fee))
+
+	t.Run("should send the campaign creation fee to the community pool", func(t *testing.T) {
+		msg := sample.MsgCreateCampaign(coordAddr.String())
+		_, err := campaignSrv.CreateCampaign(ctx, &msg)
+		require.NoError(t, err)
+		require.True(t, bankKeeper.GetAllBalances(sdkCtx, coordAddr).IsZero())
+		require.True(t, fee.IsEqual(bankKeeper.GetAllBalances(sdkCtx, communityPoolAddr)))
+	})
+
+	t.Run("should prevent creating a campaign without balance for the fee", func(t *testing.T) {
+		counter := campaignKeeper.GetCampaignCounter(sdkCtx)
+		msg := sample.MsgCreateCampaign(coordAddr.String())
+		_, err := campaignSrv.CreateCampaign(ctx, &msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+		require.Equal(t, counter, campaignKeeper.GetCampaignCounter(sdkCtx))
+	})
+}
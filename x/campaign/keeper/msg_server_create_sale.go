@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) CreateSale(goCtx context.Context, msg *types.MsgCreateSale) (*types.MsgCreateSaleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
+	}
+
+	// Get the coordinator ID
+	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	if !found {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
+	}
+	if campaign.CoordinatorID != coordinatorID {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
+			"coordinator of the campaign is %d",
+			campaign.CoordinatorID,
+		))
+	}
+
+	// The sale must not be already ended
+	if msg.EndTime <= ctx.BlockTime().Unix() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSale, "end time %d is already passed", msg.EndTime)
+	}
+
+	// Escrow the sold vouchers in the module account
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return nil, spnerrors.Criticalf("can't parse coordinator address %s", err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		coordinator,
+		types.ModuleName,
+		sdk.NewCoins(msg.Vouchers),
+	); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientVouchers, "%s", err.Error())
+	}
+
+	sale := types.NewSale(
+		0,
+		msg.CampaignID,
+		msg.Vouchers,
+		msg.Price,
+		msg.StartTime,
+		msg.EndTime,
+		msg.MaxPerAddress,
+	)
+	sale.Id = k.AppendSale(ctx, sale)
+	k.EnqueueSale(ctx, sale.EndTime, sale.Id)
+
+	return &types.MsgCreateSaleResponse{SaleID: sale.Id}, ctx.EventManager().EmitTypedEvent(&types.EventSaleCreated{
+		SaleID:     sale.Id,
+		CampaignID: sale.CampaignID,
+		Vouchers:   sale.Vouchers,
+		Price:      sale.Price,
+	})
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgCreateSale(t *testing.T) {
+	var (
+		campaignKeeper, _, _, bankKeeper, campaignSrv, profileSrv, sdkCtx = setupMsgServer(t)
+		ctx                                                               = sdk.WrapSDKContext(sdkCtx)
+
+		coord           = sample.Address()
+		coordNoCampaign = sample.Address()
+		now             = sdkCtx.BlockTime().Unix()
+	)
+
+	// Create coordinators
+	res, err := profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coord,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+	coordID := res.CoordinatorId
+	_, err = profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordNoCampaign,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+
+	// Set campaign
+	campaign := sample.Campaign(0)
+	campaign.CoordinatorID = coordID
+	campaign.Id = campaignKeeper.AppendCampaign(sdkCtx, campaign)
+
+	// Mint vouchers for the coordinator
+	vouchers := sdk.NewCoin(types.VoucherDenom(campaign.Id, "foo"), sdk.NewInt(1000))
+	coordAddr, err := sdk.AccAddressFromBech32(coord)
+	require.NoError(t, err)
+	require.NoError(t, bankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(vouchers)))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, coordAddr, sdk.NewCoins(vouchers)))
+
+	saleVouchers := sdk.NewCoin(vouchers.Denom, sdk.NewInt(600))
+	price := sdk.NewCoin("uatom", sdk.NewInt(10))
+
+	for _, tc := range []struct {
+		name       string
+		msg        types.MsgCreateSale
+		expectedID uint64
+		err        error
+	}{
+		{
+			name:       "create a sale",
+			msg:        *types.NewMsgCreateSale(coord, campaign.Id, saleVouchers, price, now, now+1000, sdk.ZeroInt()),
+			expectedID: 0,
+		},
+		{
+			name:       "create a sale starting in the future with max per address",
+			msg:        *types.NewMsgCreateSale(coord, campaign.Id, sdk.NewCoin(vouchers.Denom, sdk.NewInt(400)), price, now+100, now+1000, sdk.NewInt(10)),
+			expectedID: 1,
+		},
+		{
+			name: "non existing campaign",
+			msg:  *types.NewMsgCreateSale(coord, 1000, saleVouchers, price, now, now+1000, sdk.ZeroInt()),
+			err:  types.ErrCampaignNotFound,
+		},
+		{
+			name: "non existing coordinator",
+			msg:  *types.NewMsgCreateSale(sample.Address(), campaign.Id, saleVouchers, price, now, now+1000, sdk.ZeroInt()),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "not the coordinator of the campaign",
+			msg:  *types.NewMsgCreateSale(coordNoCampaign, campaign.Id, saleVouchers, price, now, now+1000, sdk.ZeroInt()),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "end time passed",
+			msg:  *types.NewMsgCreateSale(coord, campaign.Id, saleVouchers, price, now-1000, now, sdk.ZeroInt()),
+			err:  types.ErrInvalidSale,
+		},
+		{
+			name: "insufficient vouchers",
+			msg:  *types.NewMsgCreateSale(coord, campaign.Id, sdk.NewCoin(vouchers.Denom, sdk.OneInt()), price, now, now+1000, sdk.ZeroInt()),
+			err:  types.ErrInsufficientVouchers,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			balance := bankKeeper.GetBalance(sdkCtx, coordAddr, tc.msg.Vouchers.Denom)
+
+			got, err := campaignSrv.CreateSale(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedID, got.SaleID)
+
+			sale, found := campaignKeeper.GetSale(sdkCtx, got.SaleID)
+			require.True(t, found)
+			require.Equal(t, tc.msg.CampaignID, sale.CampaignID)
+			require.Equal(t, tc.msg.Vouchers, sale.Vouchers)
+			require.Equal(t, tc.msg.Price, sale.Price)
+			require.Equal(t, tc.msg.StartTime, sale.StartTime)
+			require.Equal(t, tc.msg.EndTime, sale.EndTime)
+			require.True(t, tc.msg.MaxPerAddress.Equal(sale.MaxPerAddress))
+			require.True(t, sale.Sold.IsZero())
+			require.False(t, sale.Settled)
+			require.False(t, sale.Withdrawn)
+
+			// the sale is settled at its end time
+			require.Contains(t, campaignKeeper.GetSaleQueue(sdkCtx, sale.EndTime), sale.Id)
+
+			// the vouchers are escrowed
+			require.True(t, balance.Sub(tc.msg.Vouchers).IsEqual(
+				bankKeeper.GetBalance(sdkCtx, coordAddr, tc.msg.Vouchers.Denom),
+			))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventSaleCreated{
+				SaleID:     got.SaleID,
+				CampaignID: tc.msg.CampaignID,
+				Vouchers:   tc.msg.Vouchers,
+				Price:      tc.msg.Price,
+			})
+		})
+	}
+}
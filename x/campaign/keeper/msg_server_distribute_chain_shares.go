@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) DistributeChainShares(goCtx context.Context, msg *types.MsgDistributeChainShares) (*types.MsgDistributeChainSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
+	}
+
+	if campaign.MainnetInitialized {
+		return nil, sdkerrors.Wrapf(types.ErrMainnetInitialized, "%d", msg.CampaignID)
+	}
+
+	// Check sender is the coordinator of the campaign or an authorized operator
+	if err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		campaign.CoordinatorID,
+		msg.Coordinator,
+		profiletypes.CoordinatorOperator_MANAGE_SHARES,
+	); err != nil {
+		return nil, err
+	}
+
+	campaignChains, found := k.GetCampaignChains(ctx, msg.CampaignID)
+	if !found || !campaignChains.HasChain(msg.LaunchID) {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotInCampaign, "chain %d not in campaign %d", msg.LaunchID, msg.CampaignID)
+	}
+
+	// The reserved shares can be distributed once the chain has been launched
+	launched, found := k.launchKeeper.IsChainLaunched(ctx, msg.LaunchID)
+	if !found {
+		return nil, spnerrors.Criticalf("chain %d of campaign %d not found", msg.LaunchID, msg.CampaignID)
+	}
+	if !launched {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotLaunched, "%d", msg.LaunchID)
+	}
+
+	reserved := campaignChains.ChainReservedShares(msg.LaunchID)
+	remaining, err := types.DecreaseShares(reserved, msg.TotalShares())
+	if err != nil {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInsufficientReservedShares,
+			"%s reserved for chain %d",
+			sdk.Coins(reserved).String(),
+			msg.LaunchID,
+		)
+	}
+
+	// The distributed shares are already allocated shares of the campaign
+	for _, distribution := range msg.Distributions {
+		account, found := k.GetMainnetAccount(ctx, campaign.Id, distribution.Address)
+		if !found {
+			account = types.MainnetAccount{
+				CampaignID: campaign.Id,
+				Address:    distribution.Address,
+				Shares:     types.EmptyShares(),
+			}
+		}
+		account.Shares = types.IncreaseShares(account.Shares, distribution.Shares)
+		k.SetMainnetAccount(ctx, account)
+	}
+
+	campaignChains.SetChainReservedShares(msg.LaunchID, remaining)
+	k.SetCampaignChains(ctx, campaignChains)
+
+	return &types.MsgDistributeChainSharesResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventChainSharesDistributed{
+		CampaignID:    msg.CampaignID,
+		LaunchID:      msg.LaunchID,
+		Distributions: msg.Distributions,
+	})
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgDistributeChainShares(t *testing.T) {
+	var (
+		coordAddr      = sample.Address()
+		otherCoordAddr = sample.Address()
+		addr1          = sample.Address()
+		addr2          = sample.Address()
+		campaign       = sample.Campaign(0)
+
+		campaignKeeper, _, launchKeeper, _, campaignSrv, profileSrv, sdkCtx = setupMsgServer(t)
+		ctx                                                                 = sdk.WrapSDKContext(sdkCtx)
+	)
+
+	reservedShares, err := types.NewShares("90token")
+	require.NoError(t, err)
+	shares, err := types.NewShares("30token")
+	require.NoError(t, err)
+	highShares, err := types.NewShares("50token")
+	require.NoError(t, err)
+
+	res, err := profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordAddr,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+	campaign.CoordinatorID = res.CoordinatorId
+	campaign.AllocatedShares = reservedShares
+	campaign.Id = campaignKeeper.AppendCampaign(sdkCtx, campaign)
+	campaignMainnetInitialized := sample.Campaign(1)
+	campaignMainnetInitialized.CoordinatorID = res.CoordinatorId
+	campaignMainnetInitialized.MainnetInitialized = true
+	campaignMainnetInitialized.Id = campaignKeeper.AppendCampaign(sdkCtx, campaignMainnetInitialized)
+
+	_, err = profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     otherCoordAddr,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+
+	launchedChain := sample.Chain(0, campaign.CoordinatorID)
+	launchedChain.Launched = true
+	launchedLaunchID := launchKeeper.AppendChain(sdkCtx, launchedChain)
+	notLaunchedChain := sample.Chain(1, campaign.CoordinatorID)
+	notLaunchedChain.Launched = false
+	notLaunchedLaunchID := launchKeeper.AppendChain(sdkCtx, notLaunchedChain)
+	nonExistingLaunchID := uint64(1000)
+	otherLaunchID := launchKeeper.AppendChain(sdkCtx, sample.Chain(2, campaign.CoordinatorID))
+
+	campaignChains := types.CampaignChains{
+		CampaignID: campaign.Id,
+		Chains:     []uint64{launchedLaunchID, notLaunchedLaunchID, nonExistingLaunchID},
+	}
+	campaignChains.SetChainReservedShares(launchedLaunchID, reservedShares)
+	campaignKeeper.SetCampaignChains(sdkCtx, campaignChains)
+
+	// addr2 already has shares in the campaign
+	campaignKeeper.SetMainnetAccount(sdkCtx, types.MainnetAccount{
+		CampaignID: campaign.Id,
+		Address:    addr2,
+		Shares:     shares,
+	})
+
+	for _, tc := range []struct {
+		name string
+		msg  types.MsgDistributeChainShares
+		err  error
+	}{
+		{
+			name: "non existing campaign",
+			msg: *types.NewMsgDistributeChainShares(coordAddr, 1000, launchedLaunchID, []types.ShareDistribution{
+				{Address: addr1, Shares: shares},
+			}),
+			err: types.ErrCampaignNotFound,
+		},
+		{
+			name: "campaign with initialized mainnet",
+			msg: *types.NewMsgDistributeChainShares(coordAddr, campaignMainnetInitialized.Id, launchedLaunchID, []types.ShareDistribution{
+				{Address: addr1, Shares: shares},
+			}),
+			err: types.ErrMainnetInitialized,
+		},
+		{
+			name: "coordinator address not found",
+			msg: *types.NewMsgDistributeChainShares(sample.Address(), campaign.Id, launchedLaunchID, []types.ShareDistribution{
+				{Address: addr1, Shares: shares},
+			}),
+			err: profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "invalid coordinator",
+			msg: *types.NewMsgDistributeChainShares(otherCoordAddr, campaign.Id, launchedLaunchID, []types.ShareDistribution{
+				{Address: addr1, Shares: shares},
+			}),
+			err: profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "chain not in campaign",
+			msg: *types.NewMsgDistributeChainShares(coordAddr, campaign.Id, otherLaunchID, []types.ShareDistribution{
+				{Address: addr1, Shares: shares},
+			}),
+			err: types.ErrChainNotInCampaign,
+		},
+		{
+			name: "non existing chain",
+			msg: *types.NewMsgDistributeChainShares(coordAddr, campaign.Id, nonExistingLaunchID, []types.ShareDistribution{
+				{Address: addr1, Shares: shares},
+			}),
+			err: spnerrors.ErrCritical,
+		},
+		{
+			name: "chain not launched",
+			msg: *types.NewMsgDistributeChainShares(coordAddr, campaign.Id, notLaunchedLaunchID, []types.ShareDistribution{
+				{Address: addr1, Shares: shares},
+			}),
+			err: types.ErrChainNotLaunched,
+		},
+		{
+			name: "distribute shares to new and existing accounts",
+			msg: *types.NewMsgDistributeChainShares(coordAddr, campaign.Id, launchedLaunchID, []types.ShareDistribution{
+				{Address: addr1, Shares: shares},
+				{Address: addr2, Shares: shares},
+			}),
+		},
+		{
+			name: "insufficient reserved shares",
+			msg: *types.NewMsgDistributeChainShares(coordAddr, campaign.Id, launchedLaunchID, []types.ShareDistribution{
+				{Address: addr1, Shares: highShares},
+			}),
+			err: types.ErrInsufficientReservedShares,
+		},
+		{
+			name: "distribute the remaining reserved shares",
+			msg: *types.NewMsgDistributeChainShares(coordAddr, campaign.Id, launchedLaunchID, []types.ShareDistribution{
+				{Address: addr1, Shares: shares},
+			}),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				previousCampaign types.Campaign
+				previousChains   types.CampaignChains
+				previousAccounts = make(map[string]types.Shares)
+			)
+			if tc.err == nil {
+				var found bool
+				previousCampaign, found = campaignKeeper.GetCampaign(sdkCtx, tc.msg.CampaignID)
+				require.True(t, found)
+				previousChains, found = campaignKeeper.GetCampaignChains(sdkCtx, tc.msg.CampaignID)
+				require.True(t, found)
+				for _, distribution := range tc.msg.Distributions {
+					previousAccounts[distribution.Address] = types.EmptyShares()
+					account, found := campaignKeeper.GetMainnetAccount(sdkCtx, tc.msg.CampaignID, distribution.Address)
+					if found {
+						previousAccounts[distribution.Address] = account.Shares
+					}
+				}
+			}
+
+			_, err := campaignSrv.DistributeChainShares(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// allocated shares of the campaign remain unchanged
+			campaign, found := campaignKeeper.GetCampaign(sdkCtx, tc.msg.CampaignID)
+			require.True(t, found)
+			require.True(t, types.IsEqualShares(previousCampaign.AllocatedShares, campaign.AllocatedShares))
+
+			campaignChains, found := campaignKeeper.GetCampaignChains(sdkCtx, tc.msg.CampaignID)
+			require.True(t, found)
+			remaining, err := types.DecreaseShares(
+				previousChains.ChainReservedShares(tc.msg.LaunchID),
+				tc.msg.TotalShares(),
+			)
+			require.NoError(t, err)
+			require.True(t, types.IsEqualShares(remaining, campaignChains.ChainReservedShares(tc.msg.LaunchID)))
+
+			for _, distribution := range tc.msg.Distributions {
+				account, found := campaignKeeper.GetMainnetAccount(sdkCtx, tc.msg.CampaignID, distribution.Address)
+				require.True(t, found)
+				require.True(t, types.IsEqualShares(
+					types.IncreaseShares(previousAccounts[distribution.Address], distribution.Shares),
+					account.Shares,
+				))
+			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventChainSharesDistributed{
+				CampaignID:    tc.msg.CampaignID,
+				LaunchID:      tc.msg.LaunchID,
+				Distributions: tc.msg.Distributions,
+			})
+		})
+	}
+
+	// all the reserved shares have been distributed
+	campaignChains, found := campaignKeeper.GetCampaignChains(sdkCtx, campaign.Id)
+	require.True(t, found)
+	require.Empty(t, campaignChains.ReservedShares)
+}
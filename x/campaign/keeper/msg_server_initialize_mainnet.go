@@ -40,22 +40,33 @@ func (k msgServer) InitializeMainnet(goCtx context.Context, msg *types.MsgInitia
 	}
 
 	// Create the mainnet chain for launch
-	mainnetID, err := k.launchKeeper.CreateNewChain(
+	mainnetID, err := k.launchKeeper.CreateNewMainnet(
 		ctx,
 		coordinatorID,
 		msg.MainnetChainID,
 		msg.SourceURL,
 		msg.SourceHash,
-		"",
-		"",
-		true,
 		msg.CampaignID,
-		true,
 	)
 	if err != nil {
 		return nil, spnerrors.Criticalf("cannot create the mainnet: %s", err.Error())
 	}
 
+	// Reserved shares can no longer be distributed once the mainnet is initialized,
+	// the undistributed ones are released from the allocated shares
+	campaignChains, found := k.GetCampaignChains(ctx, msg.CampaignID)
+	if found && len(campaignChains.ReservedShares) > 0 {
+		campaign.AllocatedShares, err = types.DecreaseShares(
+			campaign.AllocatedShares,
+			campaignChains.TotalReservedShares(),
+		)
+		if err != nil {
+			return nil, spnerrors.Criticalf("campaign allocated shares lower than reserved shares: %s", err.Error())
+		}
+		campaignChains.ReservedShares = nil
+		k.SetCampaignChains(ctx, campaignChains)
+	}
+
 	// Set mainnet as initialized and save the change
 	campaign.MainnetID = mainnetID
 	campaign.MainnetInitialized = true
@@ -63,5 +74,8 @@ func (k msgServer) InitializeMainnet(goCtx context.Context, msg *types.MsgInitia
 
 	return &types.MsgInitializeMainnetResponse{
 		MainnetID: mainnetID,
-	}, nil
+	}, ctx.EventManager().EmitTypedEvent(&types.EventMainnetInitialized{
+		CampaignID: msg.CampaignID,
+		MainnetID:  mainnetID,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -16,6 +17,7 @@ func TestMsgInitializeMainnet(t *testing.T) {
 		campaignMainnetInitializedID uint64 = 1
 		campaignIncorrectCoordID     uint64 = 2
 		campaignEmptySupplyID        uint64 = 3
+		campaignReservedSharesID     uint64 = 4
 		coordAddr                           = sample.Address()
 		coordAddrNoCampaign                 = sample.Address()
 
@@ -41,6 +43,22 @@ func TestMsgInitializeMainnet(t *testing.T) {
 	campaign.CoordinatorID = coordID
 	campaignKeeper.SetCampaign(sdkCtx, campaign)
 
+	// Campaign with shares reserved for the participants of a chain
+	allocatedShares, err := types.NewShares("1000foo,500bar")
+	require.NoError(t, err)
+	reservedShares, err := types.NewShares("300foo,500bar")
+	require.NoError(t, err)
+	campaignReservedShares := sample.Campaign(campaignReservedSharesID)
+	campaignReservedShares.CoordinatorID = coordID
+	campaignReservedShares.AllocatedShares = types.IncreaseShares(allocatedShares, reservedShares)
+	campaignKeeper.SetCampaign(sdkCtx, campaignReservedShares)
+	campaignChains := types.CampaignChains{
+		CampaignID: campaignReservedSharesID,
+		Chains:     []uint64{10},
+	}
+	campaignChains.SetChainReservedShares(10, reservedShares)
+	campaignKeeper.SetCampaignChains(sdkCtx, campaignChains)
+
 	campaignMainnetInitialized := sample.Campaign(campaignMainnetInitializedID)
 	campaignMainnetInitialized.CoordinatorID = coordID
 	campaignMainnetInitialized.MainnetInitialized = true
@@ -56,9 +74,10 @@ func TestMsgInitializeMainnet(t *testing.T) {
 	campaignKeeper.SetCampaign(sdkCtx, campaignIncorrectCoord)
 
 	for _, tc := range []struct {
-		name string
-		msg  types.MsgInitializeMainnet
-		err  error
+		name            string
+		msg             types.MsgInitializeMainnet
+		allocatedShares types.Shares
+		err             error
 	}{
 		{
 			name: "initialize mainnet",
@@ -69,6 +88,18 @@ func TestMsgInitializeMainnet(t *testing.T) {
 				SourceURL:      sample.String(20),
 				MainnetChainID: sample.GenesisChainID(),
 			},
+			allocatedShares: campaign.AllocatedShares,
+		},
+		{
+			name: "initialize mainnet releases reserved shares",
+			msg: types.MsgInitializeMainnet{
+				CampaignID:     campaignReservedSharesID,
+				Coordinator:    coordAddr,
+				SourceHash:     sample.String(30),
+				SourceURL:      sample.String(20),
+				MainnetChainID: sample.GenesisChainID(),
+			},
+			allocatedShares: allocatedShares,
 		},
 		{
 			name: "campaign not found",
@@ -136,6 +167,7 @@ func TestMsgInitializeMainnet(t *testing.T) {
 		require.True(t, found)
 		require.True(t, campaign.MainnetInitialized)
 		require.EqualValues(t, res.MainnetID, campaign.MainnetID)
+		require.True(t, types.IsEqualShares(tc.allocatedShares, campaign.AllocatedShares))
 
 		// Chain is in launch module
 		chain, found := launchKeeper.GetChain(sdkCtx, campaign.MainnetID)
@@ -148,5 +180,11 @@ func TestMsgInitializeMainnet(t *testing.T) {
 		campaignChains, found := campaignKeeper.GetCampaignChains(sdkCtx, tc.msg.CampaignID)
 		require.True(t, found)
 		require.Contains(t, campaignChains.Chains, campaign.MainnetID)
+		require.Empty(t, campaignChains.ReservedShares)
+
+		events.RequireLastTypedEvent(t, sdkCtx, &types.EventMainnetInitialized{
+			CampaignID: tc.msg.CampaignID,
+			MainnetID:  res.MainnetID,
+		})
 	}
 }
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -19,16 +18,21 @@ func (k msgServer) MintVouchers(goCtx context.Context, msg *types.MsgMintVoucher
 		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
 	}
 
-	// Get the coordinator ID
-	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
-	if !found {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
+	// Check sender is the coordinator of the campaign or an authorized operator
+	if err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		campaign.CoordinatorID,
+		msg.Coordinator,
+		profiletypes.CoordinatorOperator_MINT_VOUCHERS,
+	); err != nil {
+		return nil, err
 	}
-	if campaign.CoordinatorID != coordinatorID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the campaign is %d",
-			campaign.CoordinatorID,
-		))
+
+	// The vouchers are minted to the coordinator even if the message is sent by an operator
+	coordAddress, found := k.profileKeeper.GetCoordinatorAddressFromID(ctx, campaign.CoordinatorID)
+	if !found {
+		return nil, sdkerrors.Wrapf(profiletypes.ErrCoordAddressNotFound,
+			"the campaign %d coordinator has been deleted", campaign.Id)
 	}
 
 	// Increase the campaign shares
@@ -46,7 +50,7 @@ func (k msgServer) MintVouchers(goCtx context.Context, msg *types.MsgMintVoucher
 		return nil, sdkerrors.Wrap(types.ErrVouchersMinting, err.Error())
 	}
 
-	receiver, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	receiver, err := sdk.AccAddressFromBech32(coordAddress)
 	if err != nil {
 		return nil, spnerrors.Criticalf("can't parse coordinator address %s", err.Error())
 	}
@@ -57,5 +61,9 @@ func (k msgServer) MintVouchers(goCtx context.Context, msg *types.MsgMintVoucher
 
 	k.SetCampaign(ctx, campaign)
 
-	return &types.MsgMintVouchersResponse{}, nil
+	return &types.MsgMintVouchersResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventVouchersMinted{
+		CampaignID: msg.CampaignID,
+		Address:    coordAddress,
+		Vouchers:   vouchers,
+	})
 }
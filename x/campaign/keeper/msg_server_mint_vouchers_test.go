@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -17,6 +18,8 @@ func TestMsgMintVouchers(t *testing.T) {
 
 		coord           = sample.Address()
 		coordNoCampaign = sample.Address()
+		operator        = sample.Address()
+		operatorNoPerm  = sample.Address()
 
 		shares, _    = types.NewShares("1000foo,500bar,300foobar")
 		sharesTooBig = types.NewSharesFromCoins(sdk.NewCoins(
@@ -37,6 +40,20 @@ func TestMsgMintVouchers(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// Set operators of the coordinator
+	_, err = profileSrv.SetOperator(ctx, profiletypes.NewMsgSetOperator(
+		coord,
+		operator,
+		[]profiletypes.CoordinatorOperator_Permission{profiletypes.CoordinatorOperator_MINT_VOUCHERS},
+	))
+	require.NoError(t, err)
+	_, err = profileSrv.SetOperator(ctx, profiletypes.NewMsgSetOperator(
+		coord,
+		operatorNoPerm,
+		[]profiletypes.CoordinatorOperator_Permission{profiletypes.CoordinatorOperator_MANAGE_SHARES},
+	))
+	require.NoError(t, err)
+
 	// Set campaign
 	campaign := sample.Campaign(0)
 	campaign.CoordinatorID = coordID
@@ -71,6 +88,23 @@ func TestMsgMintVouchers(t *testing.T) {
 				Shares:      sample.Shares(),
 			},
 		},
+		{
+			name: "mint vouchers from an operator",
+			msg: types.MsgMintVouchers{
+				Coordinator: operator,
+				CampaignID:  0,
+				Shares:      shares,
+			},
+		},
+		{
+			name: "operator without permission",
+			msg: types.MsgMintVouchers{
+				Coordinator: operatorNoPerm,
+				CampaignID:  0,
+				Shares:      shares,
+			},
+			err: profiletypes.ErrOperatorUnauthorized,
+		},
 		{
 			name: "should not mint more than total shares",
 			msg: types.MsgMintVouchers{
@@ -112,7 +146,8 @@ func TestMsgMintVouchers(t *testing.T) {
 			var previousCampaign types.Campaign
 			var previousBalance sdk.Coins
 
-			coordAddr, err := sdk.AccAddressFromBech32(tc.msg.Coordinator)
+			// The vouchers are minted to the coordinator of the campaign
+			coordAddr, err := sdk.AccAddressFromBech32(coord)
 			require.NoError(t, err)
 
 			// Get values before message execution
@@ -144,6 +179,17 @@ func TestMsgMintVouchers(t *testing.T) {
 			require.NoError(t, err)
 			balance := bankKeeper.GetAllBalances(sdkCtx, coordAddr)
 			require.True(t, balance.IsEqual(previousBalance.Add(minted...)))
+			if tc.msg.Coordinator != coord {
+				senderAddr, err := sdk.AccAddressFromBech32(tc.msg.Coordinator)
+				require.NoError(t, err)
+				require.True(t, bankKeeper.GetAllBalances(sdkCtx, senderAddr).Empty())
+			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventVouchersMinted{
+				CampaignID: tc.msg.CampaignID,
+				Address:    coord,
+				Vouchers:   minted,
+			})
 		})
 	}
 }
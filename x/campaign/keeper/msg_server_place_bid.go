@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func (k msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*types.MsgPlaceBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetAuction(ctx, msg.AuctionID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", msg.AuctionID)
+	}
+	if !auction.IsActive(ctx.BlockTime().Unix()) {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNotActive, "%d", msg.AuctionID)
+	}
+	if msg.Price.Denom != auction.MinPrice.Denom {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidBid,
+			"auction %d is paid in %s",
+			msg.AuctionID,
+			auction.MinPrice.Denom,
+		)
+	}
+	if msg.Price.IsLT(auction.MinPrice) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidBid,
+			"bid price lower than the auction min price %s",
+			auction.MinPrice.String(),
+		)
+	}
+	if msg.Quantity.GT(auction.Vouchers.Amount) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidBid,
+			"bid quantity greater than the auctioned vouchers %s",
+			auction.Vouchers.Amount,
+		)
+	}
+
+	// Escrow the maximum payment of the bid, the excess is refunded when the auction is settled
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, spnerrors.Criticalf("can't parse bidder address %s", err.Error())
+	}
+	bid := types.NewBid(msg.AuctionID, auction.BidCounter, msg.Bidder, msg.Price, msg.Quantity)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(bid.Escrow())); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	// Once the auction is full, the bid evicts the lowest bid
+	if auction.BidCount >= types.AuctionMaxBids {
+		if err := k.evictLowestBid(ctx, auction, bid); err != nil {
+			return nil, err
+		}
+	} else {
+		auction.BidCount++
+	}
+
+	auction.BidCounter++
+	k.SetAuction(ctx, auction)
+	k.SetBid(ctx, bid)
+
+	return &types.MsgPlaceBidResponse{BidID: bid.Id}, ctx.EventManager().EmitTypedEvent(&types.EventBidPlaced{
+		AuctionID: bid.AuctionID,
+		BidID:     bid.Id,
+		Bidder:    bid.Bidder,
+		Price:     bid.Price,
+		Quantity:  bid.Quantity,
+	})
+}
+
+// evictLowestBid removes the lowest bid of a full auction and refunds its escrowed payment
+// An error is returned if the new bid doesn't outrank the lowest bid
+func (k msgServer) evictLowestBid(ctx sdk.Context, auction types.Auction, bid types.Bid) error {
+	lowest, found := types.LowestBid(k.GetAuctionBids(ctx, auction.Id))
+	if !found {
+		return spnerrors.Criticalf("full auction %d has no bid", auction.Id)
+	}
+	if bid.Price.Amount.LTE(lowest.Price.Amount) {
+		return sdkerrors.Wrapf(
+			types.ErrAuctionMaxBids,
+			"bid price must be greater than the lowest bid price %s of the auction %d",
+			lowest.Price.String(),
+			auction.Id,
+		)
+	}
+
+	lowestBidder, err := sdk.AccAddressFromBech32(lowest.Bidder)
+	if err != nil {
+		return spnerrors.Criticalf("can't parse bidder address %s", err.Error())
+	}
+	refund := lowest.Escrow()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lowestBidder, sdk.NewCoins(refund)); err != nil {
+		return spnerrors.Criticalf("can't refund evicted bid %s", err.Error())
+	}
+	k.RemoveBid(ctx, auction.Id, lowest.Id)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBidEvicted{
+		AuctionID: auction.Id,
+		BidID:     lowest.Id,
+		Bidder:    lowest.Bidder,
+		Refund:    refund,
+	})
+}
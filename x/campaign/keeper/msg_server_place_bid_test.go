@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgPlaceBid(t *testing.T) {
+	var (
+		campaignKeeper, _, _, bankKeeper, campaignSrv, _, sdkCtx = setupMsgServer(t)
+		ctx                                                      = sdk.WrapSDKContext(sdkCtx)
+
+		bidder     = sample.AccAddress()
+		poorBidder = sample.AccAddress()
+		now        = sdkCtx.BlockTime().Unix()
+		minPrice   = sdk.NewCoin("uatom", sdk.NewInt(10))
+		funds      = sdk.NewCoins(sdk.NewCoin(minPrice.Denom, sdk.NewInt(10000)))
+	)
+
+	// appendAuction creates an auction with its vouchers escrowed in the module account
+	appendAuction := func(startTime, endTime int64) types.Auction {
+		vouchers := sdk.NewCoin(types.VoucherDenom(0, "foo"), sdk.NewInt(100))
+		require.NoError(t, bankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(vouchers)))
+		auction := types.NewAuction(0, 0, vouchers, minPrice, startTime, endTime)
+		auction.Id = campaignKeeper.AppendAuction(sdkCtx, auction)
+		return auction
+	}
+	auction := appendAuction(now, now+1000)
+	notStartedAuction := appendAuction(now+1, now+1000)
+	endedAuction := appendAuction(now-1000, now)
+	settledAuction := appendAuction(now-1000, now+1000)
+	settledAuction.Settled = true
+	campaignKeeper.SetAuction(sdkCtx, settledAuction)
+	fullAuction := appendAuction(now, now+1000)
+	fullAuction.BidCounter = types.AuctionMaxBids
+	fullAuction.BidCount = types.AuctionMaxBids
+
+	// the full auction holds a higher bid and a lowest bid whose payments are escrowed
+	evictedBidder := sample.AccAddress()
+	higherBid := types.NewBid(fullAuction.Id, 0, sample.Address(), minPrice.AddAmount(sdk.NewInt(5)), sdk.OneInt())
+	lowestBid := types.NewBid(fullAuction.Id, 1, evictedBidder.String(), minPrice, sdk.NewInt(2))
+	for _, bid := range []types.Bid{higherBid, lowestBid} {
+		require.NoError(t, bankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(bid.Escrow())))
+		campaignKeeper.SetBid(sdkCtx, bid)
+	}
+	campaignKeeper.SetAuction(sdkCtx, fullAuction)
+
+	// Fund the bidder
+	require.NoError(t, bankKeeper.MintCoins(sdkCtx, types.ModuleName, funds))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, bidder, funds))
+
+	for _, tc := range []struct {
+		name       string
+		msg        types.MsgPlaceBid
+		expectedID uint64
+		evicted    *types.Bid
+		err        error
+	}{
+		{
+			name:       "place a bid",
+			msg:        *types.NewMsgPlaceBid(bidder.String(), auction.Id, minPrice, sdk.NewInt(50)),
+			expectedID: 0,
+		},
+		{
+			name:       "place a bid with a higher price",
+			msg:        *types.NewMsgPlaceBid(bidder.String(), auction.Id, minPrice.AddAmount(sdk.NewInt(5)), sdk.NewInt(100)),
+			expectedID: 1,
+		},
+		{
+			name: "non existing auction",
+			msg:  *types.NewMsgPlaceBid(bidder.String(), 1000, minPrice, sdk.OneInt()),
+			err:  types.ErrAuctionNotFound,
+		},
+		{
+			name: "auction not started",
+			msg:  *types.NewMsgPlaceBid(bidder.String(), notStartedAuction.Id, minPrice, sdk.OneInt()),
+			err:  types.ErrAuctionNotActive,
+		},
+		{
+			name: "auction ended",
+			msg:  *types.NewMsgPlaceBid(bidder.String(), endedAuction.Id, minPrice, sdk.OneInt()),
+			err:  types.ErrAuctionNotActive,
+		},
+		{
+			name: "auction settled",
+			msg:  *types.NewMsgPlaceBid(bidder.String(), settledAuction.Id, minPrice, sdk.OneInt()),
+			err:  types.ErrAuctionNotActive,
+		},
+		{
+			name: "max bids reached with a price not greater than the lowest bid",
+			msg:  *types.NewMsgPlaceBid(bidder.String(), fullAuction.Id, minPrice, sdk.OneInt()),
+			err:  types.ErrAuctionMaxBids,
+		},
+		{
+			name:       "max bids reached with a price greater than the lowest bid",
+			msg:        *types.NewMsgPlaceBid(bidder.String(), fullAuction.Id, minPrice.AddAmount(sdk.OneInt()), sdk.OneInt()),
+			expectedID: types.AuctionMaxBids,
+			evicted:    &lowestBid,
+		},
+		{
+			name: "invalid price denom",
+			msg:  *types.NewMsgPlaceBid(bidder.String(), auction.Id, sdk.NewCoin("foo", minPrice.Amount), sdk.OneInt()),
+			err:  types.ErrInvalidBid,
+		},
+		{
+			name: "price lower than min price",
+			msg:  *types.NewMsgPlaceBid(bidder.String(), auction.Id, minPrice.SubAmount(sdk.OneInt()), sdk.OneInt()),
+			err:  types.ErrInvalidBid,
+		},
+		{
+			name: "quantity greater than auctioned vouchers",
+			msg:  *types.NewMsgPlaceBid(bidder.String(), auction.Id, minPrice, sdk.NewInt(101)),
+			err:  types.ErrInvalidBid,
+		},
+		{
+			name: "insufficient funds",
+			msg:  *types.NewMsgPlaceBid(poorBidder.String(), auction.Id, minPrice, sdk.OneInt()),
+			err:  sdkerrors.ErrInsufficientFunds,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bidderAddr, err := sdk.AccAddressFromBech32(tc.msg.Bidder)
+			require.NoError(t, err)
+			balance := bankKeeper.GetAllBalances(sdkCtx, bidderAddr)
+			previous, _ := campaignKeeper.GetAuction(sdkCtx, tc.msg.AuctionID)
+
+			got, err := campaignSrv.PlaceBid(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedID, got.BidID)
+
+			auction, found := campaignKeeper.GetAuction(sdkCtx, tc.msg.AuctionID)
+			require.True(t, found)
+			require.Equal(t, tc.expectedID+1, auction.BidCounter)
+			if tc.evicted != nil {
+				require.Equal(t, previous.BidCount, auction.BidCount)
+			} else {
+				require.Equal(t, previous.BidCount+1, auction.BidCount)
+			}
+
+			bid, found := campaignKeeper.GetBid(sdkCtx, tc.msg.AuctionID, got.BidID)
+			require.True(t, found)
+			require.Equal(t, tc.msg.Bidder, bid.Bidder)
+			require.Equal(t, tc.msg.Price, bid.Price)
+			require.True(t, tc.msg.Quantity.Equal(bid.Quantity))
+			require.True(t, bid.Allocated.IsZero())
+
+			// the payment of the bid is escrowed
+			expectedBalance := balance.Sub(sdk.NewCoins(bid.Escrow()))
+			require.True(t, expectedBalance.IsEqual(bankKeeper.GetAllBalances(sdkCtx, bidderAddr)))
+
+			// the lowest bid is removed and its payment refunded
+			if tc.evicted != nil {
+				_, found := campaignKeeper.GetBid(sdkCtx, tc.msg.AuctionID, tc.evicted.Id)
+				require.False(t, found)
+				evictedAddr, err := sdk.AccAddressFromBech32(tc.evicted.Bidder)
+				require.NoError(t, err)
+				require.True(t, sdk.NewCoins(tc.evicted.Escrow()).IsEqual(bankKeeper.GetAllBalances(sdkCtx, evictedAddr)))
+				events.RequireTypedEvent(t, sdkCtx, &types.EventBidEvicted{
+					AuctionID: tc.msg.AuctionID,
+					BidID:     tc.evicted.Id,
+					Bidder:    tc.evicted.Bidder,
+					Refund:    tc.evicted.Escrow(),
+				})
+			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventBidPlaced{
+				AuctionID: tc.msg.AuctionID,
+				BidID:     got.BidID,
+				Bidder:    tc.msg.Bidder,
+				Price:     tc.msg.Price,
+				Quantity:  tc.msg.Quantity,
+			})
+		})
+	}
+}
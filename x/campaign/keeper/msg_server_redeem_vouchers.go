@@ -49,5 +49,10 @@ func (k msgServer) RedeemVouchers(goCtx context.Context, msg *types.MsgRedeemVou
 	account.Shares = types.IncreaseShares(account.Shares, shares)
 	k.SetMainnetAccount(ctx, account)
 
-	return &types.MsgRedeemVouchersResponse{}, nil
+	return &types.MsgRedeemVouchersResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventVouchersRedeemed{
+		CampaignID: msg.CampaignID,
+		Sender:     msg.Sender,
+		Account:    msg.Account,
+		Vouchers:   msg.Vouchers,
+	})
 }
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 )
@@ -19,7 +20,7 @@ func TestMsgRedeemVouchers(t *testing.T) {
 		existAddr      = sample.AccAddress()
 		campaign       = sample.Campaign(0)
 		vouchersTooBig = sdk.NewCoins(
-			sdk.NewCoin("v/0/foo", sdk.NewInt(types.DefaultTotalShareNumber+1)),
+			sdk.NewCoin("v-0-foo", sdk.NewInt(types.DefaultTotalShareNumber+1)),
 		)
 	)
 
@@ -200,6 +201,13 @@ func TestMsgRedeemVouchers(t *testing.T) {
 			}
 			balance := bankKeeper.GetAllBalances(sdkCtx, accountAddr)
 			require.True(t, expectedVouchers.IsEqual(balance))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventVouchersRedeemed{
+				CampaignID: tc.msg.CampaignID,
+				Sender:     tc.msg.Sender,
+				Account:    tc.msg.Account,
+				Vouchers:   tc.msg.Vouchers,
+			})
 		})
 	}
 }
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) ReserveChainShares(goCtx context.Context, msg *types.MsgReserveChainShares) (*types.MsgReserveChainSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
+	}
+
+	if campaign.MainnetInitialized {
+		return nil, sdkerrors.Wrapf(types.ErrMainnetInitialized, "%d", msg.CampaignID)
+	}
+
+	// Check sender is the coordinator of the campaign or an authorized operator
+	if err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		campaign.CoordinatorID,
+		msg.Coordinator,
+		profiletypes.CoordinatorOperator_MANAGE_SHARES,
+	); err != nil {
+		return nil, err
+	}
+
+	campaignChains, found := k.GetCampaignChains(ctx, msg.CampaignID)
+	if !found || !campaignChains.HasChain(msg.LaunchID) {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotInCampaign, "chain %d not in campaign %d", msg.LaunchID, msg.CampaignID)
+	}
+
+	// reserved shares are allocated shares of the campaign
+	campaign.AllocatedShares = types.IncreaseShares(campaign.AllocatedShares, msg.Shares)
+	if types.IsTotalSharesReached(campaign.AllocatedShares, campaign.TotalShares) {
+		return nil, sdkerrors.Wrapf(types.ErrTotalSharesLimit, "%d", msg.CampaignID)
+	}
+
+	campaignChains.SetChainReservedShares(
+		msg.LaunchID,
+		types.IncreaseShares(campaignChains.ChainReservedShares(msg.LaunchID), msg.Shares),
+	)
+
+	k.SetCampaign(ctx, campaign)
+	k.SetCampaignChains(ctx, campaignChains)
+
+	return &types.MsgReserveChainSharesResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventChainSharesReserved{
+		CampaignID: msg.CampaignID,
+		LaunchID:   msg.LaunchID,
+		Shares:     msg.Shares,
+	})
+}
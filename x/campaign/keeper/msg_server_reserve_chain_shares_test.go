@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgReserveChainShares(t *testing.T) {
+	var (
+		coordAddr                   = sample.Address()
+		coordAddrMainnetInitialized = sample.Address()
+		campaign                    = sample.Campaign(0)
+		campaignMainnetInitialized  = sample.Campaign(1)
+
+		campaignKeeper, _, launchKeeper, _, campaignSrv, profileSrv, sdkCtx = setupMsgServer(t)
+		ctx                                                                 = sdk.WrapSDKContext(sdkCtx)
+	)
+
+	allocatedShares, err := types.NewShares("91token")
+	require.NoError(t, err)
+	totalShares, err := types.NewShares("100token")
+	require.NoError(t, err)
+	lowShares, err := types.NewShares("4token")
+	require.NoError(t, err)
+	highShares, err := types.NewShares("10token")
+	require.NoError(t, err)
+
+	res, err := profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordAddr,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+	campaign.CoordinatorID = res.CoordinatorId
+	campaign.AllocatedShares = allocatedShares
+	campaign.TotalShares = totalShares
+	campaign.Id = campaignKeeper.AppendCampaign(sdkCtx, campaign)
+
+	res, err = profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordAddrMainnetInitialized,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+	campaignMainnetInitialized.CoordinatorID = res.CoordinatorId
+	campaignMainnetInitialized.MainnetInitialized = true
+	campaignMainnetInitialized.Id = campaignKeeper.AppendCampaign(sdkCtx, campaignMainnetInitialized)
+
+	launchID := launchKeeper.AppendChain(sdkCtx, sample.Chain(0, campaign.CoordinatorID))
+	otherLaunchID := launchKeeper.AppendChain(sdkCtx, sample.Chain(1, campaign.CoordinatorID))
+	campaignKeeper.SetCampaignChains(sdkCtx, types.CampaignChains{
+		CampaignID: campaign.Id,
+		Chains:     []uint64{launchID},
+	})
+
+	for _, tc := range []struct {
+		name string
+		msg  types.MsgReserveChainShares
+		err  error
+	}{
+		{
+			name: "non existing campaign",
+			msg:  *types.NewMsgReserveChainShares(coordAddr, 1000, launchID, lowShares),
+			err:  types.ErrCampaignNotFound,
+		},
+		{
+			name: "campaign with initialized mainnet",
+			msg: *types.NewMsgReserveChainShares(
+				coordAddrMainnetInitialized,
+				campaignMainnetInitialized.Id,
+				launchID,
+				lowShares,
+			),
+			err: types.ErrMainnetInitialized,
+		},
+		{
+			name: "coordinator address not found",
+			msg:  *types.NewMsgReserveChainShares(sample.Address(), campaign.Id, launchID, lowShares),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "invalid coordinator",
+			msg:  *types.NewMsgReserveChainShares(coordAddrMainnetInitialized, campaign.Id, launchID, lowShares),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "chain not in campaign",
+			msg:  *types.NewMsgReserveChainShares(coordAddr, campaign.Id, otherLaunchID, lowShares),
+			err:  types.ErrChainNotInCampaign,
+		},
+		{
+			name: "reserve shares for a chain",
+			msg:  *types.NewMsgReserveChainShares(coordAddr, campaign.Id, launchID, lowShares),
+		},
+		{
+			name: "reserve additional shares for a chain",
+			msg:  *types.NewMsgReserveChainShares(coordAddr, campaign.Id, launchID, lowShares),
+		},
+		{
+			name: "allocated shares greater than total shares",
+			msg:  *types.NewMsgReserveChainShares(coordAddr, campaign.Id, launchID, highShares),
+			err:  types.ErrTotalSharesLimit,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				previousCampaign types.Campaign
+				previousChains   types.CampaignChains
+			)
+			if tc.err == nil {
+				var found bool
+				previousCampaign, found = campaignKeeper.GetCampaign(sdkCtx, tc.msg.CampaignID)
+				require.True(t, found)
+				previousChains, found = campaignKeeper.GetCampaignChains(sdkCtx, tc.msg.CampaignID)
+				require.True(t, found)
+			}
+
+			_, err := campaignSrv.ReserveChainShares(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// reserved shares are added to the allocated shares
+			campaign, found := campaignKeeper.GetCampaign(sdkCtx, tc.msg.CampaignID)
+			require.True(t, found)
+			require.True(t, types.IsEqualShares(
+				types.IncreaseShares(previousCampaign.AllocatedShares, tc.msg.Shares),
+				campaign.AllocatedShares,
+			))
+
+			campaignChains, found := campaignKeeper.GetCampaignChains(sdkCtx, tc.msg.CampaignID)
+			require.True(t, found)
+			require.True(t, types.IsEqualShares(
+				types.IncreaseShares(previousChains.ChainReservedShares(tc.msg.LaunchID), tc.msg.Shares),
+				campaignChains.ChainReservedShares(tc.msg.LaunchID),
+			))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventChainSharesReserved{
+				CampaignID: tc.msg.CampaignID,
+				LaunchID:   tc.msg.LaunchID,
+				Shares:     tc.msg.Shares,
+			})
+		})
+	}
+}
@@ -54,5 +54,9 @@ func (k msgServer) UnredeemVouchers(goCtx context.Context, msg *types.MsgUnredee
 		return nil, spnerrors.Criticalf("can't send minted coins %s", err.Error())
 	}
 
-	return &types.MsgUnredeemVouchersResponse{}, nil
+	return &types.MsgUnredeemVouchersResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventVouchersUnredeemed{
+		CampaignID: msg.CampaignID,
+		Address:    msg.Sender,
+		Shares:     msg.Shares,
+	})
 }
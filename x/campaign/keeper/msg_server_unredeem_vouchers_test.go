@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 )
@@ -141,6 +142,12 @@ func TestMsgUnredeemVouchers(t *testing.T) {
 			require.NoError(t, err)
 			balance := bankKeeper.GetAllBalances(sdkCtx, accountAddr)
 			require.True(t, balance.IsEqual(previousBalance.Add(unredeemed...)))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventVouchersUnredeemed{
+				CampaignID: tc.msg.CampaignID,
+				Address:    tc.msg.Sender,
+				Shares:     tc.msg.Shares,
+			})
 		})
 	}
 }
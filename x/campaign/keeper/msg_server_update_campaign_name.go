@@ -32,5 +32,8 @@ func (k msgServer) UpdateCampaignName(goCtx context.Context, msg *types.MsgUpdat
 	campaign.CampaignName = msg.Name
 	k.SetCampaign(ctx, campaign)
 
-	return &types.MsgUpdateCampaignNameResponse{}, nil
+	return &types.MsgUpdateCampaignNameResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventCampaignNameUpdated{
+		CampaignID: msg.CampaignID,
+		Name:       msg.Name,
+	})
 }
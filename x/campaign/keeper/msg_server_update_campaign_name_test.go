@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -86,6 +87,11 @@ func TestMsgUpdateCampaignName(t *testing.T) {
 			campaign, found := campaignKeeper.GetCampaign(sdkCtx, tc.msg.CampaignID)
 			require.True(t, found)
 			require.Equal(t, tc.msg.Name, campaign.CampaignName)
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventCampaignNameUpdated{
+				CampaignID: tc.msg.CampaignID,
+				Name:       tc.msg.Name,
+			})
 		})
 	}
 }
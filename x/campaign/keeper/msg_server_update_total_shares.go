@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -22,16 +21,14 @@ func (k msgServer) UpdateTotalShares(goCtx context.Context, msg *types.MsgUpdate
 		return nil, sdkerrors.Wrap(types.ErrNoDynamicShares, "campaign doesn't has dynamic shares option set")
 	}
 
-	// Get the coordinator ID
-	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
-	if !found {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
-	}
-	if campaign.CoordinatorID != coordinatorID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the campaign is %d",
-			campaign.CoordinatorID,
-		))
+	// Check sender is the coordinator of the campaign or an authorized operator
+	if err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		campaign.CoordinatorID,
+		msg.Coordinator,
+		profiletypes.CoordinatorOperator_MANAGE_SHARES,
+	); err != nil {
+		return nil, err
 	}
 
 	if campaign.MainnetInitialized {
@@ -45,5 +42,8 @@ func (k msgServer) UpdateTotalShares(goCtx context.Context, msg *types.MsgUpdate
 	campaign.TotalShares = msg.TotalShares
 	k.SetCampaign(ctx, campaign)
 
-	return &types.MsgUpdateTotalSharesResponse{}, nil
+	return &types.MsgUpdateTotalSharesResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventCampaignTotalSharesUpdated{
+		CampaignID:  msg.CampaignID,
+		TotalShares: msg.TotalShares,
+	})
 }
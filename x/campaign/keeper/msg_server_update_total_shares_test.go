@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -141,6 +142,11 @@ func TestMsgUpdateTotalShares(t *testing.T) {
 			campaign, found := campaignKeeper.GetCampaign(sdkCtx, tc.msg.CampaignID)
 			require.True(t, found)
 			require.True(t, sdk.Coins(tc.msg.TotalShares).IsEqual(sdk.Coins(campaign.TotalShares)))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventCampaignTotalSharesUpdated{
+				CampaignID:  tc.msg.CampaignID,
+				TotalShares: tc.msg.TotalShares,
+			})
 		})
 	}
 }
@@ -37,5 +37,8 @@ func (k msgServer) UpdateTotalSupply(goCtx context.Context, msg *types.MsgUpdate
 	campaign.TotalSupply = types.UpdateTotalSupply(campaign.TotalSupply, msg.TotalSupplyUpdate)
 	k.SetCampaign(ctx, campaign)
 
-	return &types.MsgUpdateTotalSupplyResponse{}, nil
+	return &types.MsgUpdateTotalSupplyResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventCampaignTotalSupplyUpdated{
+		CampaignID:  msg.CampaignID,
+		TotalSupply: campaign.TotalSupply,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -118,6 +119,11 @@ func TestMsgUpdateTotalSupply(t *testing.T) {
 			require.True(t, campaign.TotalSupply.IsEqual(
 				types.UpdateTotalSupply(previousTotalSupply, tc.msg.TotalSupplyUpdate),
 			))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventCampaignTotalSupplyUpdated{
+				CampaignID:  tc.msg.CampaignID,
+				TotalSupply: campaign.TotalSupply,
+			})
 		})
 	}
 }
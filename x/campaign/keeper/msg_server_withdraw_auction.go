@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) WithdrawAuction(goCtx context.Context, msg *types.MsgWithdrawAuction) (*types.MsgWithdrawAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetAuction(ctx, msg.AuctionID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", msg.AuctionID)
+	}
+
+	campaign, found := k.GetCampaign(ctx, auction.CampaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", auction.CampaignID)
+	}
+
+	// Get the coordinator ID
+	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	if !found {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
+	}
+	if campaign.CoordinatorID != coordinatorID {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
+			"coordinator of the campaign is %d",
+			campaign.CoordinatorID,
+		))
+	}
+
+	if !auction.Settled {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNotSettled, "%d", msg.AuctionID)
+	}
+	if auction.Withdrawn {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionWithdrawn, "%d", msg.AuctionID)
+	}
+
+	// Send the proceeds and the unsold vouchers to the coordinator
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return nil, spnerrors.Criticalf("can't parse coordinator address %s", err.Error())
+	}
+	proceeds := auction.Proceeds()
+	unsoldVouchers := sdk.NewCoin(auction.Vouchers.Denom, auction.UnsoldVouchers())
+	withdrawn := sdk.NewCoins(proceeds, unsoldVouchers)
+	if !withdrawn.Empty() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, coordinator, withdrawn); err != nil {
+			return nil, spnerrors.Criticalf("can't send auction proceeds %s", err.Error())
+		}
+	}
+
+	auction.Withdrawn = true
+	k.SetAuction(ctx, auction)
+
+	return &types.MsgWithdrawAuctionResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventAuctionWithdrawn{
+		AuctionID:      msg.AuctionID,
+		Address:        msg.Coordinator,
+		Proceeds:       proceeds,
+		UnsoldVouchers: unsoldVouchers,
+	})
+}
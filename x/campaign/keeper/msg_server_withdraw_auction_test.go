@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgWithdrawAuction(t *testing.T) {
+	var (
+		campaignKeeper, _, _, bankKeeper, campaignSrv, profileSrv, sdkCtx = setupMsgServer(t)
+		ctx                                                               = sdk.WrapSDKContext(sdkCtx)
+
+		coord           = sample.Address()
+		coordNoCampaign = sample.Address()
+		now             = sdkCtx.BlockTime().Unix()
+		minPrice        = sdk.NewCoin("uatom", sdk.NewInt(10))
+	)
+
+	// Create coordinators
+	res, err := profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coord,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+	coordID := res.CoordinatorId
+	_, err = profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordNoCampaign,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+
+	// Set campaign
+	campaign := sample.Campaign(0)
+	campaign.CoordinatorID = coordID
+	campaign.Id = campaignKeeper.AppendCampaign(sdkCtx, campaign)
+
+	// appendAuction creates an auction whose unsold vouchers and proceeds are in the module account
+	appendAuction := func(sold, clearingPrice int64, settled, withdrawn bool) types.Auction {
+		vouchers := sdk.NewCoin(types.VoucherDenom(campaign.Id, "foo"), sdk.NewInt(100))
+		auction := types.NewAuction(0, campaign.Id, vouchers, minPrice, now-1000, now)
+		auction.Sold = sdk.NewInt(sold)
+		auction.ClearingPrice = sdk.NewInt(clearingPrice)
+		auction.Settled = settled
+		auction.Withdrawn = withdrawn
+		escrow := sdk.NewCoins(sdk.NewCoin(vouchers.Denom, auction.UnsoldVouchers()), auction.Proceeds())
+		require.NoError(t, bankKeeper.MintCoins(sdkCtx, types.ModuleName, escrow))
+		auction.Id = campaignKeeper.AppendAuction(sdkCtx, auction)
+		return auction
+	}
+	partiallySoldAuction := appendAuction(40, 12, true, false)
+	soldOutAuction := appendAuction(100, 15, true, false)
+	unsoldAuction := appendAuction(0, 0, true, false)
+	notSettledAuction := appendAuction(0, 0, false, false)
+	withdrawnAuction := appendAuction(0, 0, true, true)
+
+	for _, tc := range []struct {
+		name string
+		msg  types.MsgWithdrawAuction
+		err  error
+	}{
+		{
+			name: "withdraw a partially sold auction",
+			msg:  *types.NewMsgWithdrawAuction(coord, partiallySoldAuction.Id),
+		},
+		{
+			name: "withdraw a sold out auction",
+			msg:  *types.NewMsgWithdrawAuction(coord, soldOutAuction.Id),
+		},
+		{
+			name: "withdraw an unsold auction",
+			msg:  *types.NewMsgWithdrawAuction(coord, unsoldAuction.Id),
+		},
+		{
+			name: "auction already withdrawn",
+			msg:  *types.NewMsgWithdrawAuction(coord, partiallySoldAuction.Id),
+			err:  types.ErrAuctionWithdrawn,
+		},
+		{
+			name: "auction withdrawn at genesis",
+			msg:  *types.NewMsgWithdrawAuction(coord, withdrawnAuction.Id),
+			err:  types.ErrAuctionWithdrawn,
+		},
+		{
+			name: "auction not settled",
+			msg:  *types.NewMsgWithdrawAuction(coord, notSettledAuction.Id),
+			err:  types.ErrAuctionNotSettled,
+		},
+		{
+			name: "non existing auction",
+			msg:  *types.NewMsgWithdrawAuction(coord, 1000),
+			err:  types.ErrAuctionNotFound,
+		},
+		{
+			name: "non existing coordinator",
+			msg:  *types.NewMsgWithdrawAuction(sample.Address(), unsoldAuction.Id),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "not the coordinator of the campaign",
+			msg:  *types.NewMsgWithdrawAuction(coordNoCampaign, unsoldAuction.Id),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			coordAddr, err := sdk.AccAddressFromBech32(tc.msg.Coordinator)
+			require.NoError(t, err)
+			balance := bankKeeper.GetAllBalances(sdkCtx, coordAddr)
+
+			_, err = campaignSrv.WithdrawAuction(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			auction, found := campaignKeeper.GetAuction(sdkCtx, tc.msg.AuctionID)
+			require.True(t, found)
+			require.True(t, auction.Withdrawn)
+
+			// the coordinator received the proceeds and the unsold vouchers
+			unsoldVouchers := sdk.NewCoin(auction.Vouchers.Denom, auction.UnsoldVouchers())
+			expectedBalance := balance.Add(auction.Proceeds(), unsoldVouchers)
+			require.True(t, expectedBalance.IsEqual(bankKeeper.GetAllBalances(sdkCtx, coordAddr)))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventAuctionWithdrawn{
+				AuctionID:      tc.msg.AuctionID,
+				Address:        tc.msg.Coordinator,
+				Proceeds:       auction.Proceeds(),
+				UnsoldVouchers: unsoldVouchers,
+			})
+		})
+	}
+}
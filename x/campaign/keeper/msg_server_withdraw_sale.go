@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) WithdrawSale(goCtx context.Context, msg *types.MsgWithdrawSale) (*types.MsgWithdrawSaleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sale, found := k.GetSale(ctx, msg.SaleID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrSaleNotFound, "%d", msg.SaleID)
+	}
+
+	campaign, found := k.GetCampaign(ctx, sale.CampaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", sale.CampaignID)
+	}
+
+	// Get the coordinator ID
+	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	if !found {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
+	}
+	if campaign.CoordinatorID != coordinatorID {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
+			"coordinator of the campaign is %d",
+			campaign.CoordinatorID,
+		))
+	}
+
+	if !sale.Settled {
+		return nil, sdkerrors.Wrapf(types.ErrSaleNotSettled, "%d", msg.SaleID)
+	}
+	if sale.Withdrawn {
+		return nil, sdkerrors.Wrapf(types.ErrSaleWithdrawn, "%d", msg.SaleID)
+	}
+
+	// Send the proceeds and the unsold vouchers to the coordinator
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return nil, spnerrors.Criticalf("can't parse coordinator address %s", err.Error())
+	}
+	proceeds := sale.Proceeds()
+	unsoldVouchers := sdk.NewCoin(sale.Vouchers.Denom, sale.RemainingVouchers())
+	withdrawn := sdk.NewCoins(proceeds, unsoldVouchers)
+	if !withdrawn.Empty() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, coordinator, withdrawn); err != nil {
+			return nil, spnerrors.Criticalf("can't send sale proceeds %s", err.Error())
+		}
+	}
+
+	sale.Withdrawn = true
+	k.SetSale(ctx, sale)
+
+	return &types.MsgWithdrawSaleResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventSaleWithdrawn{
+		SaleID:         msg.SaleID,
+		Address:        msg.Coordinator,
+		Proceeds:       proceeds,
+		UnsoldVouchers: unsoldVouchers,
+	})
+}
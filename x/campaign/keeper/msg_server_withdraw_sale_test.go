@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgWithdrawSale(t *testing.T) {
+	var (
+		campaignKeeper, _, _, bankKeeper, campaignSrv, profileSrv, sdkCtx = setupMsgServer(t)
+		ctx                                                               = sdk.WrapSDKContext(sdkCtx)
+
+		coord           = sample.Address()
+		coordNoCampaign = sample.Address()
+		now             = sdkCtx.BlockTime().Unix()
+		price           = sdk.NewCoin("uatom", sdk.NewInt(10))
+	)
+
+	// Create coordinators
+	res, err := profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coord,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+	coordID := res.CoordinatorId
+	_, err = profileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordNoCampaign,
+		Description: sample.CoordinatorDescription(),
+	})
+	require.NoError(t, err)
+
+	// Set campaign
+	campaign := sample.Campaign(0)
+	campaign.CoordinatorID = coordID
+	campaign.Id = campaignKeeper.AppendCampaign(sdkCtx, campaign)
+
+	// appendSale creates a sale whose unsold vouchers and proceeds are in the module account
+	appendSale := func(sold int64, settled, withdrawn bool) types.Sale {
+		vouchers := sdk.NewCoin(types.VoucherDenom(campaign.Id, "foo"), sdk.NewInt(100))
+		sale := types.NewSale(0, campaign.Id, vouchers, price, now-1000, now, sdk.ZeroInt())
+		sale.Sold = sdk.NewInt(sold)
+		sale.Settled = settled
+		sale.Withdrawn = withdrawn
+		escrow := sdk.NewCoins(sdk.NewCoin(vouchers.Denom, sale.RemainingVouchers()), sale.Proceeds())
+		require.NoError(t, bankKeeper.MintCoins(sdkCtx, types.ModuleName, escrow))
+		sale.Id = campaignKeeper.AppendSale(sdkCtx, sale)
+		return sale
+	}
+	partiallySoldSale := appendSale(40, true, false)
+	soldOutSale := appendSale(100, true, false)
+	unsoldSale := appendSale(0, true, false)
+	notSettledSale := appendSale(0, false, false)
+	withdrawnSale := appendSale(0, true, true)
+
+	for _, tc := range []struct {
+		name string
+		msg  types.MsgWithdrawSale
+		err  error
+	}{
+		{
+			name: "withdraw a partially sold sale",
+			msg:  *types.NewMsgWithdrawSale(coord, partiallySoldSale.Id),
+		},
+		{
+			name: "withdraw a sold out sale",
+			msg:  *types.NewMsgWithdrawSale(coord, soldOutSale.Id),
+		},
+		{
+			name: "withdraw an unsold sale",
+			msg:  *types.NewMsgWithdrawSale(coord, unsoldSale.Id),
+		},
+		{
+			name: "sale already withdrawn",
+			msg:  *types.NewMsgWithdrawSale(coord, partiallySoldSale.Id),
+			err:  types.ErrSaleWithdrawn,
+		},
+		{
+			name: "sale withdrawn at genesis",
+			msg:  *types.NewMsgWithdrawSale(coord, withdrawnSale.Id),
+			err:  types.ErrSaleWithdrawn,
+		},
+		{
+			name: "sale not settled",
+			msg:  *types.NewMsgWithdrawSale(coord, notSettledSale.Id),
+			err:  types.ErrSaleNotSettled,
+		},
+		{
+			name: "non existing sale",
+			msg:  *types.NewMsgWithdrawSale(coord, 1000),
+			err:  types.ErrSaleNotFound,
+		},
+		{
+			name: "non existing coordinator",
+			msg:  *types.NewMsgWithdrawSale(sample.Address(), unsoldSale.Id),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "not the coordinator of the campaign",
+			msg:  *types.NewMsgWithdrawSale(coordNoCampaign, unsoldSale.Id),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			coordAddr, err := sdk.AccAddressFromBech32(tc.msg.Coordinator)
+			require.NoError(t, err)
+			balance := bankKeeper.GetAllBalances(sdkCtx, coordAddr)
+
+			_, err = campaignSrv.WithdrawSale(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			sale, found := campaignKeeper.GetSale(sdkCtx, tc.msg.SaleID)
+			require.True(t, found)
+			require.True(t, sale.Withdrawn)
+
+			// the coordinator received the proceeds and the unsold vouchers
+			unsoldVouchers := sdk.NewCoin(sale.Vouchers.Denom, sale.RemainingVouchers())
+			expectedBalance := balance.Add(sale.Proceeds(), unsoldVouchers)
+			require.True(t, expectedBalance.IsEqual(bankKeeper.GetAllBalances(sdkCtx, coordAddr)))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventSaleWithdrawn{
+				SaleID:         tc.msg.SaleID,
+				Address:        tc.msg.Coordinator,
+				Proceeds:       sale.Proceeds(),
+				UnsoldVouchers: unsoldVouchers,
+			})
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// CampaignCreationFee returns the campaign creation fee param
+func (k Keeper) CampaignCreationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramstore.Get(ctx, types.KeyCampaignCreationFee, &res)
+	return
+}
+
+// GetParams get all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		k.CampaignCreationFee(ctx),
+	)
+}
+
+// SetParams set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// GetSaleCounter get the counter for sale
+func (k Keeper) GetSaleCounter(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.KeyPrefix(types.SaleCounterKey))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetSaleCounter set the counter for sale
+func (k Keeper) SetSaleCounter(ctx sdk.Context, counter uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, counter)
+	store.Set(types.KeyPrefix(types.SaleCounterKey), bz)
+}
+
+// AppendSale appends a sale in the store with a new id and update the counter
+func (k Keeper) AppendSale(ctx sdk.Context, sale types.Sale) uint64 {
+	counter := k.GetSaleCounter(ctx)
+	sale.Id = counter
+	k.SetSale(ctx, sale)
+	k.SetSaleCounter(ctx, counter+1)
+	return counter
+}
+
+// SetSale set a specific sale in the store
+// The sale is indexed by its campaign, the campaign of a sale never changes
+func (k Keeper) SetSale(ctx sdk.Context, sale types.Sale) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SaleKeyPrefix))
+	b := k.cdc.MustMarshal(&sale)
+	store.Set(types.SaleKey(sale.Id), b)
+
+	byCampaignStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SaleByCampaignKeyPrefix))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sale.Id)
+	byCampaignStore.Set(types.SaleByCampaignKey(sale.CampaignID, sale.Id), bz)
+}
+
+// GetSale returns a sale from its id
+func (k Keeper) GetSale(ctx sdk.Context, id uint64) (val types.Sale, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SaleKeyPrefix))
+	b := store.Get(types.SaleKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSaleByCampaignID returns all the sales of a campaign
+func (k Keeper) GetAllSaleByCampaignID(ctx sdk.Context, campaignID uint64) (list []types.Sale) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SaleByCampaignKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.SaleByCampaignAllKey(campaignID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		sale, found := k.GetSale(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if found {
+			list = append(list, sale)
+		}
+	}
+
+	return
+}
+
+// GetAllSale returns all sale
+func (k Keeper) GetAllSale(ctx sdk.Context) (list []types.Sale) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SaleKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Sale
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// EnqueueSale adds a sale in the sale queue to be settled once its end time is passed
+func (k Keeper) EnqueueSale(ctx sdk.Context, endTime int64, saleID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SaleQueueKeyPrefix))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, saleID)
+	store.Set(types.SaleQueueKey(endTime, saleID), bz)
+}
+
+// DequeueSale removes a sale from the sale queue
+func (k Keeper) DequeueSale(ctx sdk.Context, endTime int64, saleID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SaleQueueKeyPrefix))
+	store.Delete(types.SaleQueueKey(endTime, saleID))
+}
+
+// GetSaleQueue returns the IDs of the sales in the sale queue
+// whose end time is lower or equal to the provided timestamp
+func (k Keeper) GetSaleQueue(ctx sdk.Context, timestamp int64) (list []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SaleQueueKeyPrefix))
+	iterator := store.Iterator(nil, types.SaleQueueEndTimeKey(timestamp+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, binary.BigEndian.Uint64(iterator.Value()))
+	}
+
+	return
+}
+
+// SettleSales settles all the sales of the sale queue whose end time is passed
+// Once settled, no more vouchers can be bought and the sale can be withdrawn by the coordinator
+func (k Keeper) SettleSales(ctx sdk.Context) {
+	for _, saleID := range k.GetSaleQueue(ctx, ctx.BlockTime().Unix()) {
+		sale, found := k.GetSale(ctx, saleID)
+		if !found {
+			k.Logger(ctx).Error("sale in sale queue not found", "saleID", saleID)
+			continue
+		}
+		k.DequeueSale(ctx, sale.EndTime, saleID)
+
+		sale.Settled = true
+		k.SetSale(ctx, sale)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventSaleSettled{
+			SaleID: saleID,
+			Sold:   sale.Sold,
+		}); err != nil {
+			k.Logger(ctx).Error("event emission failed", "saleID", saleID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// SetSalePurchase set a specific salePurchase in the store from its index
+func (k Keeper) SetSalePurchase(ctx sdk.Context, salePurchase types.SalePurchase) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SalePurchaseKeyPrefix))
+	b := k.cdc.MustMarshal(&salePurchase)
+	store.Set(types.SalePurchaseKey(salePurchase.SaleID, salePurchase.Address), b)
+}
+
+// GetSalePurchase returns a salePurchase from its index
+func (k Keeper) GetSalePurchase(
+	ctx sdk.Context,
+	saleID uint64,
+	address string,
+) (val types.SalePurchase, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SalePurchaseKeyPrefix))
+
+	b := store.Get(types.SalePurchaseKey(saleID, address))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSalePurchase returns all salePurchase
+func (k Keeper) GetAllSalePurchase(ctx sdk.Context) (list []types.SalePurchase) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SalePurchaseKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SalePurchase
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	campaignkeeper "github.com/tendermint/spn/x/campaign/keeper"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func createNSalePurchase(keeper *campaignkeeper.Keeper, ctx sdk.Context, n int) []types.SalePurchase {
+	items := make([]types.SalePurchase, n)
+	for i := range items {
+		items[i] = types.SalePurchase{
+			SaleID:  uint64(i % 2),
+			Address: sample.Address(),
+			Amount:  sdk.NewInt(int64(i + 1)),
+		}
+		keeper.SetSalePurchase(ctx, items[i])
+	}
+	return items
+}
+
+func TestSalePurchaseGet(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNSalePurchase(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetSalePurchase(ctx, item.SaleID, item.Address)
+		require.True(t, found)
+		require.Equal(t, item, got)
+	}
+	_, found := keeper.GetSalePurchase(ctx, 0, sample.Address())
+	require.False(t, found)
+}
+
+func TestSalePurchaseGetAll(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNSalePurchase(keeper, ctx, 10)
+	require.ElementsMatch(t, items, keeper.GetAllSalePurchase(ctx))
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	campaignkeeper "github.com/tendermint/spn/x/campaign/keeper"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func createNSale(keeper *campaignkeeper.Keeper, ctx sdk.Context, n int) []types.Sale {
+	items := make([]types.Sale, n)
+	for i := range items {
+		items[i] = sample.Sale(0, 0)
+		items[i].Id = keeper.AppendSale(ctx, items[i])
+	}
+	return items
+}
+
+func TestSaleGet(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNSale(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetSale(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t, item, got)
+	}
+	_, found := keeper.GetSale(ctx, uint64(len(items)))
+	require.False(t, found)
+}
+
+func TestSaleGetAll(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNSale(keeper, ctx, 10)
+	require.ElementsMatch(t, items, keeper.GetAllSale(ctx))
+}
+
+func TestSaleGetAllByCampaignID(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := make([]types.Sale, 10)
+	for i := range items {
+		items[i] = sample.Sale(0, uint64(i%2))
+		items[i].Id = keeper.AppendSale(ctx, items[i])
+	}
+
+	require.ElementsMatch(t, []types.Sale{items[1], items[3], items[5], items[7], items[9]},
+		keeper.GetAllSaleByCampaignID(ctx, 1))
+	require.Empty(t, keeper.GetAllSaleByCampaignID(ctx, 2))
+}
+
+func TestSaleCount(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	items := createNSale(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetSaleCounter(ctx))
+}
+
+func TestSaleQueue(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+
+	keeper.EnqueueSale(ctx, 1000, 0)
+	keeper.EnqueueSale(ctx, 1000, 1)
+	keeper.EnqueueSale(ctx, 2000, 2)
+	keeper.EnqueueSale(ctx, 3000, 3)
+
+	require.Empty(t, keeper.GetSaleQueue(ctx, 999))
+	require.Equal(t, []uint64{0, 1}, keeper.GetSaleQueue(ctx, 1000))
+	require.Equal(t, []uint64{0, 1, 2}, keeper.GetSaleQueue(ctx, 2999))
+	require.Equal(t, []uint64{0, 1, 2, 3}, keeper.GetSaleQueue(ctx, 3000))
+
+	keeper.DequeueSale(ctx, 1000, 1)
+	keeper.DequeueSale(ctx, 3000, 3)
+	require.Equal(t, []uint64{0, 2}, keeper.GetSaleQueue(ctx, 3000))
+}
+
+func TestSettleSales(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+	now := ctx.BlockTime().Unix()
+
+	appendSale := func(endTime int64) types.Sale {
+		sale := sample.Sale(0, 0)
+		sale.StartTime = now - 1000
+		sale.EndTime = endTime
+		sale.Id = keeper.AppendSale(ctx, sale)
+		keeper.EnqueueSale(ctx, sale.EndTime, sale.Id)
+		return sale
+	}
+
+	// end time passed
+	ended := appendSale(now - 1)
+
+	// end time reached
+	reached := appendSale(now)
+
+	// end time not reached
+	ongoing := appendSale(now + 1)
+
+	keeper.SettleSales(ctx)
+
+	for _, saleID := range []uint64{ended.Id, reached.Id} {
+		sale, found := keeper.GetSale(ctx, saleID)
+		require.True(t, found)
+		require.True(t, sale.Settled)
+	}
+	sale, found := keeper.GetSale(ctx, ongoing.Id)
+	require.True(t, found)
+	require.False(t, sale.Settled)
+
+	// settled sales are removed from the queue
+	require.Equal(t, []uint64{ongoing.Id}, keeper.GetSaleQueue(ctx, now+1))
+
+	// events are emitted for the settled sales
+	settledEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "tendermint.spn.campaign.EventSaleSettled" {
+			settledEvents++
+		}
+	}
+	require.Equal(t, 2, settledEvents)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// CheckVoucherTransfer returns an error if the denom is a voucher of a campaign whose vouchers can't be transferred
+// The vouchers of a campaign with disabled voucher transfer can be transferred once the mainnet is initialized
+// A denom that is not the voucher of an existing campaign is not restricted
+func (k Keeper) CheckVoucherTransfer(ctx sdk.Context, denom string) error {
+	campaignID, err := types.VoucherCampaign(denom)
+	if err != nil {
+		// not a voucher
+		return nil
+	}
+
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return nil
+	}
+	if campaign.VoucherTransferDisabled && !campaign.MainnetInitialized {
+		return sdkerrors.Wrapf(types.ErrVoucherTransferDisabled, "vouchers of the campaign %d can't be transferred", campaignID)
+	}
+	return nil
+}
+
+type voucherTransferMsgServer struct {
+	ibctransfertypes.MsgServer
+	keeper Keeper
+}
+
+// NewVoucherTransferMsgServer returns an IBC transfer message server preventing the transfer
+// of the vouchers of campaigns with disabled voucher transfer
+func NewVoucherTransferMsgServer(keeper Keeper, transferServer ibctransfertypes.MsgServer) ibctransfertypes.MsgServer {
+	return &voucherTransferMsgServer{
+		MsgServer: transferServer,
+		keeper:    keeper,
+	}
+}
+
+func (s voucherTransferMsgServer) Transfer(goCtx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.keeper.CheckVoucherTransfer(ctx, msg.Token.Denom); err != nil {
+		return nil, err
+	}
+
+	return s.MsgServer.Transfer(goCtx, msg)
+}
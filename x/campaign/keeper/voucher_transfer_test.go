@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestKeeper_CheckVoucherTransfer(t *testing.T) {
+	keeper, ctx := testkeeper.Campaign(t)
+
+	enabled := sample.Campaign(0)
+	enabled.VoucherTransferDisabled = false
+	enabled.Id = keeper.AppendCampaign(ctx, enabled)
+
+	disabled := sample.Campaign(0)
+	disabled.VoucherTransferDisabled = true
+	disabled.MainnetInitialized = false
+	disabled.Id = keeper.AppendCampaign(ctx, disabled)
+
+	initialized := sample.Campaign(0)
+	initialized.VoucherTransferDisabled = true
+	initialized.MainnetInitialized = true
+	initialized.Id = keeper.AppendCampaign(ctx, initialized)
+
+	for _, tc := range []struct {
+		name  string
+		denom string
+		err   error
+	}{
+		{
+			name:  "a denom that is not a voucher can be transferred",
+			denom: "foo",
+		},
+		{
+			name:  "vouchers of a campaign with enabled voucher transfer can be transferred",
+			denom: types.VoucherDenom(enabled.Id, "foo"),
+		},
+		{
+			name:  "vouchers of a campaign with disabled voucher transfer and initialized mainnet can be transferred",
+			denom: types.VoucherDenom(initialized.Id, "foo"),
+		},
+		{
+			name:  "vouchers of a campaign with disabled voucher transfer can't be transferred",
+			denom: types.VoucherDenom(disabled.Id, "foo"),
+			err:   types.ErrVoucherTransferDisabled,
+		},
+		{
+			name:  "a denom with the voucher prefix and an invalid campaign ID can be transferred",
+			denom: types.VoucherPrefix + "foo" + types.VoucherSeparator + "foo",
+		},
+		{
+			name:  "a denom with the voucher prefix and no actual denom can be transferred",
+			denom: types.VoucherDenom(disabled.Id, ""),
+		},
+		{
+			name:  "a denom with the voucher prefix of a non existing campaign can be transferred",
+			denom: types.VoucherDenom(1000, "foo"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := keeper.CheckVoucherTransfer(ctx, tc.denom)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,40 +1,58 @@
 package campaign
 
 import (
+	"encoding/json"
 	"math/rand"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/tendermint/spn/testutil/sample"
 	campaignsim "github.com/tendermint/spn/x/campaign/simulation"
 	"github.com/tendermint/spn/x/campaign/types"
 )
 
 const (
-	defaultWeightMsgCreateCampaign    = 25
-	defaultWeightMsgUpdateTotalSupply = 20
-	defaultWeightMsgUpdateTotalShares = 20
-	defaultWeightMsgInitializeMainnet = 5
-	defaultWeightMsgAddShares         = 20
-	defaultWeightMsgAddVestingOptions = 20
-	defaultWeightMsgMintVouchers      = 20
-	defaultWeightMsgBurnVouchers      = 20
-	defaultWeightMsgRedeemVouchers    = 20
-	defaultWeightMsgUnredeemVouchers  = 20
-	defaultWeightMsgSendVouchers      = 20
+	defaultWeightMsgCreateCampaign        = 25
+	defaultWeightMsgUpdateTotalSupply     = 20
+	defaultWeightMsgUpdateTotalShares     = 20
+	defaultWeightMsgInitializeMainnet     = 5
+	defaultWeightMsgAddShares             = 20
+	defaultWeightMsgAddVestingOptions     = 20
+	defaultWeightMsgMintVouchers          = 20
+	defaultWeightMsgBurnVouchers          = 20
+	defaultWeightMsgRedeemVouchers        = 20
+	defaultWeightMsgUnredeemVouchers      = 20
+	defaultWeightMsgSendVouchers          = 20
+	defaultWeightMsgCreateSale            = 10
+	defaultWeightMsgBuyVouchers           = 20
+	defaultWeightMsgWithdrawSale          = 10
+	defaultWeightMsgCreateAuction         = 10
+	defaultWeightMsgPlaceBid              = 20
+	defaultWeightMsgWithdrawAuction       = 10
+	defaultWeightMsgReserveChainShares    = 10
+	defaultWeightMsgDistributeChainShares = 10
 
-	opWeightMsgCreateCampaign    = "op_weight_msg_create_campaign"
-	opWeightMsgUpdateTotalSupply = "op_weight_msg_update_total_supply"
-	opWeightMsgUpdateTotalShares = "op_weight_msg_update_total_share"
-	opWeightMsgInitializeMainnet = "op_weight_msg_initialize_mainnet"
-	opWeightMsgAddShares         = "op_weight_msg_add_shares"
-	opWeightMsgAddVestingOptions = "op_weight_msg_add_vesting_options"
-	opWeightMsgMintVouchers      = "op_weight_msg_mint_vouchers"
-	opWeightMsgBurnVouchers      = "op_weight_msg_burn_vouchers"
-	opWeightMsgRedeemVouchers    = "op_weight_msg_redeem_vouchers"
-	opWeightMsgUnredeemVouchers  = "op_weight_msg_unredeem_vouchers"
-	opWeightMsgSendVouchers      = "op_weight_msg_send_vouchers"
+	opWeightMsgCreateCampaign        = "op_weight_msg_create_campaign"
+	opWeightMsgUpdateTotalSupply     = "op_weight_msg_update_total_supply"
+	opWeightMsgUpdateTotalShares     = "op_weight_msg_update_total_share"
+	opWeightMsgInitializeMainnet     = "op_weight_msg_initialize_mainnet"
+	opWeightMsgAddShares             = "op_weight_msg_add_shares"
+	opWeightMsgAddVestingOptions     = "op_weight_msg_add_vesting_options"
+	opWeightMsgMintVouchers          = "op_weight_msg_mint_vouchers"
+	opWeightMsgBurnVouchers          = "op_weight_msg_burn_vouchers"
+	opWeightMsgRedeemVouchers        = "op_weight_msg_redeem_vouchers"
+	opWeightMsgUnredeemVouchers      = "op_weight_msg_unredeem_vouchers"
+	opWeightMsgSendVouchers          = "op_weight_msg_send_vouchers"
+	opWeightMsgCreateSale            = "op_weight_msg_create_sale"
+	opWeightMsgBuyVouchers           = "op_weight_msg_buy_vouchers"
+	opWeightMsgWithdrawSale          = "op_weight_msg_withdraw_sale"
+	opWeightMsgCreateAuction         = "op_weight_msg_create_auction"
+	opWeightMsgPlaceBid              = "op_weight_msg_place_bid"
+	opWeightMsgWithdrawAuction       = "op_weight_msg_withdraw_auction"
+	opWeightMsgReserveChainShares    = "op_weight_msg_reserve_chain_shares"
+	opWeightMsgDistributeChainShares = "op_weight_msg_distribute_chain_shares"
 )
 
 // GenerateGenesisState creates a randomized GenState of the module
@@ -48,8 +66,17 @@ func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedP
 }
 
 // RandomizedParams creates randomized  param changes for the simulator
-func (am AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
-	return []simtypes.ParamChange{}
+func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
+	campaignParams := sample.CampaignParams()
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyCampaignCreationFee), func(r *rand.Rand) string {
+			fee, err := json.Marshal(campaignParams.CampaignCreationFee)
+			if err != nil {
+				panic(err)
+			}
+			return string(fee)
+		}),
+	}
 }
 
 // RegisterStoreDecoder registers a decoder
@@ -58,17 +85,25 @@ func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	var (
-		weightMsgCreateCampaign    int
-		weightMsgUpdateTotalSupply int
-		weightMsgUpdateTotalShares int
-		weightMsgInitializeMainnet int
-		weightMsgAddShares         int
-		weightMsgAddVestingOptions int
-		weightMsgMintVouchers      int
-		weightMsgBurnVouchers      int
-		weightMsgRedeemVouchers    int
-		weightMsgUnredeemVouchers  int
-		weightMsgSendVouchers      int
+		weightMsgCreateCampaign        int
+		weightMsgUpdateTotalSupply     int
+		weightMsgUpdateTotalShares     int
+		weightMsgInitializeMainnet     int
+		weightMsgAddShares             int
+		weightMsgAddVestingOptions     int
+		weightMsgMintVouchers          int
+		weightMsgBurnVouchers          int
+		weightMsgRedeemVouchers        int
+		weightMsgUnredeemVouchers      int
+		weightMsgSendVouchers          int
+		weightMsgCreateSale            int
+		weightMsgBuyVouchers           int
+		weightMsgWithdrawSale          int
+		weightMsgCreateAuction         int
+		weightMsgPlaceBid              int
+		weightMsgWithdrawAuction       int
+		weightMsgReserveChainShares    int
+		weightMsgDistributeChainShares int
 	)
 
 	appParams := simState.AppParams
@@ -128,11 +163,51 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 			weightMsgSendVouchers = defaultWeightMsgSendVouchers
 		},
 	)
+	appParams.GetOrGenerate(cdc, opWeightMsgCreateSale, &weightMsgCreateSale, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateSale = defaultWeightMsgCreateSale
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgBuyVouchers, &weightMsgBuyVouchers, nil,
+		func(_ *rand.Rand) {
+			weightMsgBuyVouchers = defaultWeightMsgBuyVouchers
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgWithdrawSale, &weightMsgWithdrawSale, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawSale = defaultWeightMsgWithdrawSale
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgCreateAuction, &weightMsgCreateAuction, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateAuction = defaultWeightMsgCreateAuction
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgPlaceBid, &weightMsgPlaceBid, nil,
+		func(_ *rand.Rand) {
+			weightMsgPlaceBid = defaultWeightMsgPlaceBid
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgWithdrawAuction, &weightMsgWithdrawAuction, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawAuction = defaultWeightMsgWithdrawAuction
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgReserveChainShares, &weightMsgReserveChainShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgReserveChainShares = defaultWeightMsgReserveChainShares
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgDistributeChainShares, &weightMsgDistributeChainShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgDistributeChainShares = defaultWeightMsgDistributeChainShares
+		},
+	)
 
 	return []simtypes.WeightedOperation{
 		simulation.NewWeightedOperation(
 			weightMsgCreateCampaign,
-			campaignsim.SimulateMsgCreateCampaign(am.accountKeeper, am.bankKeeper, am.profileKeeper),
+			campaignsim.SimulateMsgCreateCampaign(am.accountKeeper, am.bankKeeper, am.profileKeeper, am.keeper),
 		),
 		simulation.NewWeightedOperation(
 			weightMsgUpdateTotalSupply,
@@ -174,5 +249,37 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 			weightMsgSendVouchers,
 			campaignsim.SimulateMsgSendVouchers(am.accountKeeper, am.bankKeeper),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateSale,
+			campaignsim.SimulateMsgCreateSale(am.accountKeeper, am.bankKeeper, am.profileKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBuyVouchers,
+			campaignsim.SimulateMsgBuyVouchers(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawSale,
+			campaignsim.SimulateMsgWithdrawSale(am.accountKeeper, am.bankKeeper, am.profileKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateAuction,
+			campaignsim.SimulateMsgCreateAuction(am.accountKeeper, am.bankKeeper, am.profileKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgPlaceBid,
+			campaignsim.SimulateMsgPlaceBid(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawAuction,
+			campaignsim.SimulateMsgWithdrawAuction(am.accountKeeper, am.bankKeeper, am.profileKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgReserveChainShares,
+			campaignsim.SimulateMsgReserveChainShares(am.accountKeeper, am.bankKeeper, am.profileKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDistributeChainShares,
+			campaignsim.SimulateMsgDistributeChainShares(am.accountKeeper, am.bankKeeper, am.profileKeeper, am.keeper),
+		),
 	}
 }
@@ -250,7 +250,7 @@ func GetAccountWithShares(
 }
 
 // SimulateMsgCreateCampaign simulates a MsgCreateCampaign message
-func SimulateMsgCreateCampaign(ak types.AccountKeeper, bk types.BankKeeper, pk types.ProfileKeeper) simtypes.Operation {
+func SimulateMsgCreateCampaign(ak types.AccountKeeper, bk types.BankKeeper, pk types.ProfileKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
@@ -260,14 +260,16 @@ func SimulateMsgCreateCampaign(ak types.AccountKeeper, bk types.BankKeeper, pk t
 		}
 
 		dynamicShares := r.Intn(100) > 80
+		voucherTransferDisabled := r.Intn(100) > 80
 
 		msg := types.NewMsgCreateCampaign(
 			simAccount.Address.String(),
 			sample.CampaignName(),
 			sample.Coins(),
 			dynamicShares,
+			voucherTransferDisabled,
 		)
-		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, k.CampaignCreationFee(ctx))
 	}
 }
 
@@ -391,12 +393,24 @@ func SimulateMsgAddVestingOptions(ak types.AccountKeeper, bk types.BankKeeper, p
 		// Select a random account to give vesting options
 		accountNb := r.Intn(len(accs))
 
+		// Select a random vesting schedule for the shares
+		now := time.Now().Unix()
+		vestingOptions := *types.NewShareDelayedVesting(shares, now)
+		switch r.Intn(3) {
+		case 1:
+			vestingOptions = *types.NewShareContinuousVesting(shares, now, now+r.Int63n(10000)+1)
+		case 2:
+			vestingOptions = *types.NewSharePeriodicVesting(now, []types.ShareVestingPeriod{
+				{Length: r.Int63n(10000) + 1, Amount: shares},
+			})
+		}
+
 		msg := types.NewMsgAddVestingOptions(
 			campID,
 			simAccount.Address.String(),
 			accs[accountNb].Address.String(),
 			types.EmptyShares(),
-			*types.NewShareDelayedVesting(shares, time.Now().Unix()),
+			vestingOptions,
 		)
 		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
 	}
@@ -517,3 +531,379 @@ func SimulateMsgSendVouchers(ak types.AccountKeeper, bk types.BankKeeper) simtyp
 		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, vouchers)
 	}
 }
+
+// GetCoordSimAccountWithVouchers finds the sim account of a campaign coordinator owning vouchers of the campaign
+func GetCoordSimAccountWithVouchers(
+	ctx sdk.Context,
+	bk types.BankKeeper,
+	pk types.ProfileKeeper,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+) (simtypes.Account, uint64, sdk.Coin, bool) {
+	for _, campaign := range k.GetAllCampaign(ctx) {
+		coordAddr, found := pk.GetCoordinatorAddressFromID(ctx, campaign.CoordinatorID)
+		if !found {
+			continue
+		}
+		for _, acc := range accs {
+			if acc.Address.String() != coordAddr {
+				continue
+			}
+
+			// Look for vouchers of the campaign in the coordinator balance
+			for _, coin := range bk.SpendableCoins(ctx, acc.Address) {
+				campID, err := types.VoucherCampaign(coin.Denom)
+				if err == nil && campID == campaign.Id && coin.Amount.Int64() > 1 {
+					coin.Amount = coin.Amount.Quo(sdk.NewInt(2))
+					return acc, campaign.Id, coin, true
+				}
+			}
+		}
+	}
+	return simtypes.Account{}, 0, sdk.Coin{}, false
+}
+
+// GetActiveSale returns a random sale where vouchers can be bought
+func GetActiveSale(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.Sale, bool) {
+	var activeSales []types.Sale
+	for _, sale := range k.GetAllSale(ctx) {
+		if sale.IsActive(ctx.BlockTime().Unix()) && sale.RemainingVouchers().IsPositive() {
+			activeSales = append(activeSales, sale)
+		}
+	}
+	if len(activeSales) == 0 {
+		return types.Sale{}, false
+	}
+	return activeSales[r.Intn(len(activeSales))], true
+}
+
+// GetCoordSimAccountWithWithdrawableSale finds the sim account of a campaign coordinator
+// with a settled sale that has not been withdrawn
+func GetCoordSimAccountWithWithdrawableSale(
+	ctx sdk.Context,
+	pk types.ProfileKeeper,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+) (simtypes.Account, uint64, bool) {
+	for _, sale := range k.GetAllSale(ctx) {
+		if !sale.Settled || sale.Withdrawn {
+			continue
+		}
+		campaign, found := k.GetCampaign(ctx, sale.CampaignID)
+		if !found {
+			continue
+		}
+		coordAddr, found := pk.GetCoordinatorAddressFromID(ctx, campaign.CoordinatorID)
+		if !found {
+			continue
+		}
+		for _, acc := range accs {
+			if acc.Address.String() == coordAddr {
+				return acc, sale.Id, true
+			}
+		}
+	}
+	return simtypes.Account{}, 0, false
+}
+
+// SimulateMsgCreateSale simulates a MsgCreateSale message
+func SimulateMsgCreateSale(ak types.AccountKeeper, bk types.BankKeeper, pk types.ProfileKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, campID, vouchers, found := GetCoordSimAccountWithVouchers(ctx, bk, pk, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateSale, "skip create sale"), nil, nil
+		}
+
+		startTime := ctx.BlockTime().Unix()
+		msg := types.NewMsgCreateSale(
+			simAccount.Address.String(),
+			campID,
+			vouchers,
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(r.Intn(10)+1))),
+			startTime,
+			startTime+int64(r.Intn(3600)+60),
+			sdk.NewInt(int64(r.Intn(100))),
+		)
+		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(vouchers))
+	}
+}
+
+// SimulateMsgBuyVouchers simulates a MsgBuyVouchers message
+func SimulateMsgBuyVouchers(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sale, found := GetActiveSale(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBuyVouchers, "skip buy vouchers"), nil, nil
+		}
+
+		// Buy a small amount of vouchers with a random account that can pay for them
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		amount := sdk.NewInt(int64(r.Intn(10) + 1))
+		if amount.GT(sale.RemainingVouchers()) {
+			amount = sale.RemainingVouchers()
+		}
+		if sale.MaxPerAddress.IsPositive() {
+			purchased := sdk.ZeroInt()
+			if purchase, found := k.GetSalePurchase(ctx, sale.Id, simAccount.Address.String()); found {
+				purchased = purchase.Amount
+			}
+			if purchased.Add(amount).GT(sale.MaxPerAddress) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBuyVouchers, "max vouchers per address reached"), nil, nil
+			}
+		}
+		payment := sale.Cost(amount)
+		if bk.SpendableCoins(ctx, simAccount.Address).AmountOf(payment.Denom).LTE(payment.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBuyVouchers, "skip buy vouchers"), nil, nil
+		}
+
+		msg := types.NewMsgBuyVouchers(
+			simAccount.Address.String(),
+			sale.Id,
+			sdk.NewCoin(sale.Vouchers.Denom, amount),
+		)
+		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(payment))
+	}
+}
+
+// SimulateMsgWithdrawSale simulates a MsgWithdrawSale message
+func SimulateMsgWithdrawSale(ak types.AccountKeeper, bk types.BankKeeper, pk types.ProfileKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, saleID, found := GetCoordSimAccountWithWithdrawableSale(ctx, pk, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawSale, "skip withdraw sale"), nil, nil
+		}
+
+		msg := types.NewMsgWithdrawSale(simAccount.Address.String(), saleID)
+		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+// GetActiveAuction returns a random auction where bids can be placed
+func GetActiveAuction(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.Auction, bool) {
+	var activeAuctions []types.Auction
+	for _, auction := range k.GetAllAuction(ctx) {
+		if auction.IsActive(ctx.BlockTime().Unix()) && auction.BidCount < types.AuctionMaxBids {
+			activeAuctions = append(activeAuctions, auction)
+		}
+	}
+	if len(activeAuctions) == 0 {
+		return types.Auction{}, false
+	}
+	return activeAuctions[r.Intn(len(activeAuctions))], true
+}
+
+// GetCoordSimAccountWithWithdrawableAuction finds the sim account of a campaign coordinator
+// with a settled auction that has not been withdrawn
+func GetCoordSimAccountWithWithdrawableAuction(
+	ctx sdk.Context,
+	pk types.ProfileKeeper,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+) (simtypes.Account, uint64, bool) {
+	for _, auction := range k.GetAllAuction(ctx) {
+		if !auction.Settled || auction.Withdrawn {
+			continue
+		}
+		campaign, found := k.GetCampaign(ctx, auction.CampaignID)
+		if !found {
+			continue
+		}
+		coordAddr, found := pk.GetCoordinatorAddressFromID(ctx, campaign.CoordinatorID)
+		if !found {
+			continue
+		}
+		for _, acc := range accs {
+			if acc.Address.String() == coordAddr {
+				return acc, auction.Id, true
+			}
+		}
+	}
+	return simtypes.Account{}, 0, false
+}
+
+// SimulateMsgCreateAuction simulates a MsgCreateAuction message
+func SimulateMsgCreateAuction(ak types.AccountKeeper, bk types.BankKeeper, pk types.ProfileKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, campID, vouchers, found := GetCoordSimAccountWithVouchers(ctx, bk, pk, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateAuction, "skip create auction"), nil, nil
+		}
+
+		startTime := ctx.BlockTime().Unix()
+		msg := types.NewMsgCreateAuction(
+			simAccount.Address.String(),
+			campID,
+			vouchers,
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(r.Intn(5)+1))),
+			startTime,
+			startTime+int64(r.Intn(3600)+60),
+		)
+		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(vouchers))
+	}
+}
+
+// SimulateMsgPlaceBid simulates a MsgPlaceBid message
+func SimulateMsgPlaceBid(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		auction, found := GetActiveAuction(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceBid, "skip place bid"), nil, nil
+		}
+
+		// Bid for a small amount of vouchers with a random account that can pay for them
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		quantity := sdk.NewInt(int64(r.Intn(10) + 1))
+		if quantity.GT(auction.Vouchers.Amount) {
+			quantity = auction.Vouchers.Amount
+		}
+		price := auction.MinPrice.AddAmount(sdk.NewInt(int64(r.Intn(5))))
+		bid := types.NewBid(auction.Id, auction.BidCounter, simAccount.Address.String(), price, quantity)
+		if bk.SpendableCoins(ctx, simAccount.Address).AmountOf(price.Denom).LTE(bid.Escrow().Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlaceBid, "skip place bid"), nil, nil
+		}
+
+		msg := types.NewMsgPlaceBid(
+			simAccount.Address.String(),
+			auction.Id,
+			price,
+			quantity,
+		)
+		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(bid.Escrow()))
+	}
+}
+
+// SimulateMsgWithdrawAuction simulates a MsgWithdrawAuction message
+func SimulateMsgWithdrawAuction(ak types.AccountKeeper, bk types.BankKeeper, pk types.ProfileKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, auctionID, found := GetCoordSimAccountWithWithdrawableAuction(ctx, pk, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawAuction, "skip withdraw auction"), nil, nil
+		}
+
+		msg := types.NewMsgWithdrawAuction(simAccount.Address.String(), auctionID)
+		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+// GetCoordSimAccountWithCampaignChain finds the sim account of a campaign coordinator
+// with a chain associated to the campaign and the mainnet not initialized
+func GetCoordSimAccountWithCampaignChain(
+	r *rand.Rand,
+	ctx sdk.Context,
+	pk types.ProfileKeeper,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+) (simtypes.Account, uint64, uint64, bool) {
+	for _, campaignChains := range k.GetAllCampaignChains(ctx) {
+		if len(campaignChains.Chains) == 0 {
+			continue
+		}
+		camp, found := k.GetCampaign(ctx, campaignChains.CampaignID)
+		if !found || camp.MainnetInitialized {
+			continue
+		}
+		coordAddr, found := pk.GetCoordinatorAddressFromID(ctx, camp.CoordinatorID)
+		if !found {
+			continue
+		}
+		for _, acc := range accs {
+			if acc.Address.String() == coordAddr {
+				launchID := campaignChains.Chains[r.Intn(len(campaignChains.Chains))]
+				return acc, camp.Id, launchID, true
+			}
+		}
+	}
+
+	return simtypes.Account{}, 0, 0, false
+}
+
+// SimulateMsgReserveChainShares simulates a MsgReserveChainShares message
+func SimulateMsgReserveChainShares(ak types.AccountKeeper, bk types.BankKeeper, pk types.ProfileKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, campID, launchID, found := GetCoordSimAccountWithCampaignChain(r, ctx, pk, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgReserveChainShares, "skip reserve chain shares"), nil, nil
+		}
+
+		shares, getShares := GetSharesFromCampaign(r, ctx, k, campID)
+		if !getShares {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgReserveChainShares, "skip reserve chain shares"), nil, nil
+		}
+
+		msg := types.NewMsgReserveChainShares(simAccount.Address.String(), campID, launchID, shares)
+		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
+
+// GetCoordSimAccountWithReservedChainShares finds the sim account of a campaign coordinator
+// with a launched chain holding reserved shares and the mainnet not initialized
+func GetCoordSimAccountWithReservedChainShares(
+	ctx sdk.Context,
+	pk types.ProfileKeeper,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+) (simtypes.Account, uint64, uint64, types.Shares, bool) {
+	for _, campaignChains := range k.GetAllCampaignChains(ctx) {
+		camp, found := k.GetCampaign(ctx, campaignChains.CampaignID)
+		if !found || camp.MainnetInitialized {
+			continue
+		}
+		coordAddr, found := pk.GetCoordinatorAddressFromID(ctx, camp.CoordinatorID)
+		if !found {
+			continue
+		}
+		for _, chainShares := range campaignChains.ReservedShares {
+			if sdk.Coins(chainShares.Shares).Empty() {
+				continue
+			}
+			launched, found := k.GetLaunchKeeper().IsChainLaunched(ctx, chainShares.LaunchID)
+			if !found || !launched {
+				continue
+			}
+			for _, acc := range accs {
+				if acc.Address.String() == coordAddr {
+					return acc, camp.Id, chainShares.LaunchID, chainShares.Shares, true
+				}
+			}
+		}
+	}
+
+	return simtypes.Account{}, 0, 0, types.EmptyShares(), false
+}
+
+// SimulateMsgDistributeChainShares simulates a MsgDistributeChainShares message
+func SimulateMsgDistributeChainShares(ak types.AccountKeeper, bk types.BankKeeper, pk types.ProfileKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, campID, launchID, reserved, found := GetCoordSimAccountWithReservedChainShares(ctx, pk, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDistributeChainShares, "skip distribute chain shares"), nil, nil
+		}
+
+		// distribute a random part of the reserved shares to a random account
+		var shares sdk.Coins
+		for _, share := range reserved {
+			shares = append(shares, sdk.NewCoin(share.Denom, sdk.NewInt(r.Int63n(share.Amount.Int64())+1)))
+		}
+		recipient, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgDistributeChainShares(simAccount.Address.String(), campID, launchID, []types.ShareDistribution{
+			{Address: recipient.Address.String(), Shares: types.Shares(shares)},
+		})
+		return deliverSimTx(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins())
+	}
+}
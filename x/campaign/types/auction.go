@@ -0,0 +1,176 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuctionMaxBids is the maximum number of bids an auction can contain
+// It bounds the computation performed when the auction is cleared at the end of the block
+// Once the auction is full, a new bid evicts the lowest bid if it has a higher price
+const AuctionMaxBids = 1000
+
+// NewAuction returns a new batch auction of vouchers
+func NewAuction(
+	id,
+	campaignID uint64,
+	vouchers,
+	minPrice sdk.Coin,
+	startTime,
+	endTime int64,
+) Auction {
+	return Auction{
+		Id:            id,
+		CampaignID:    campaignID,
+		Vouchers:      vouchers,
+		MinPrice:      minPrice,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		ClearingPrice: sdk.ZeroInt(),
+		Sold:          sdk.ZeroInt(),
+	}
+}
+
+// Validate checks the auction is valid
+func (m Auction) Validate() error {
+	if err := CheckSaleVouchers(m.Vouchers, m.CampaignID); err != nil {
+		return err
+	}
+	if err := CheckSalePrice(m.MinPrice); err != nil {
+		return err
+	}
+	if err := CheckPriceDenom(m.MinPrice, m.Vouchers); err != nil {
+		return err
+	}
+	if err := CheckSaleTimes(m.StartTime, m.EndTime); err != nil {
+		return err
+	}
+	if m.BidCount > AuctionMaxBids {
+		return fmt.Errorf("auction has more than %d bids", AuctionMaxBids)
+	}
+	if m.BidCount > m.BidCounter {
+		return fmt.Errorf("auction bid count %d greater than its bid counter %d", m.BidCount, m.BidCounter)
+	}
+	if m.ClearingPrice.IsNil() || m.ClearingPrice.IsNegative() {
+		return errors.New("clearing price can't be negative")
+	}
+	if m.Sold.IsNil() || m.Sold.IsNegative() {
+		return errors.New("sold vouchers can't be negative")
+	}
+	if m.Sold.GT(m.Vouchers.Amount) {
+		return fmt.Errorf("sold vouchers %s greater than auction vouchers %s", m.Sold, m.Vouchers.Amount)
+	}
+	if !m.Settled && (!m.Sold.IsZero() || !m.ClearingPrice.IsZero()) {
+		return errors.New("auction not settled with sold vouchers")
+	}
+	if m.Withdrawn && !m.Settled {
+		return errors.New("auction withdrawn before being settled")
+	}
+	return nil
+}
+
+// IsActive returns true if bids can be placed in the auction at the provided timestamp
+func (m Auction) IsActive(timestamp int64) bool {
+	return !m.Settled && timestamp >= m.StartTime && timestamp < m.EndTime
+}
+
+// UnsoldVouchers returns the amount of vouchers not allocated to any bid
+func (m Auction) UnsoldVouchers() sdk.Int {
+	return m.Vouchers.Amount.Sub(m.Sold)
+}
+
+// Proceeds returns the total payment received for the vouchers sold in the auction
+func (m Auction) Proceeds() sdk.Coin {
+	return sdk.NewCoin(m.MinPrice.Denom, m.ClearingPrice.Mul(m.Sold))
+}
+
+// NewBid returns a new bid for an auction
+func NewBid(auctionID, id uint64, bidder string, price sdk.Coin, quantity sdk.Int) Bid {
+	return Bid{
+		AuctionID: auctionID,
+		Id:        id,
+		Bidder:    bidder,
+		Price:     price,
+		Quantity:  quantity,
+		Allocated: sdk.ZeroInt(),
+	}
+}
+
+// Validate checks the bid is valid
+func (m Bid) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Bidder); err != nil {
+		return fmt.Errorf("invalid bidder address: %s", err.Error())
+	}
+	if err := CheckSalePrice(m.Price); err != nil {
+		return err
+	}
+	if m.Quantity.IsNil() || !m.Quantity.IsPositive() {
+		return errors.New("bid quantity must be positive")
+	}
+	if m.Allocated.IsNil() || m.Allocated.IsNegative() {
+		return errors.New("allocated vouchers can't be negative")
+	}
+	if m.Allocated.GT(m.Quantity) {
+		return fmt.Errorf("allocated vouchers %s greater than bid quantity %s", m.Allocated, m.Quantity)
+	}
+	return nil
+}
+
+// Escrow returns the payment escrowed when the bid is placed
+func (m Bid) Escrow() sdk.Coin {
+	return sdk.NewCoin(m.Price.Denom, m.Price.Amount.Mul(m.Quantity))
+}
+
+// Refund returns the part of the escrowed payment given back to the bidder
+// once the auction is cleared at the provided price
+func (m Bid) Refund(clearingPrice sdk.Int) sdk.Coin {
+	return m.Escrow().Sub(sdk.NewCoin(m.Price.Denom, clearingPrice.Mul(m.Allocated)))
+}
+
+// LowestBid returns the bid with the lowest priority in the clearing of the auction: the bid with the lowest price,
+// the last placed bid among the bids with the lowest price
+// A new bid outranks it only with a higher price
+func LowestBid(bids []Bid) (lowest Bid, found bool) {
+	for i, bid := range bids {
+		if i == 0 ||
+			bid.Price.Amount.LT(lowest.Price.Amount) ||
+			(bid.Price.Amount.Equal(lowest.Price.Amount) && bid.Id > lowest.Id) {
+			lowest = bid
+		}
+	}
+	return lowest, len(bids) > 0
+}
+
+// ClearAuction computes the uniform clearing price of the auction from its bids and allocates the vouchers
+// Bids are filled by decreasing price, bids with the same price are filled in the order they have been placed
+// The clearing price is the price of the lowest bid receiving vouchers and is paid by all the winning bids
+// The returned bids have their allocated amount set and are sorted by decreasing priority
+func ClearAuction(auction Auction, bids []Bid) (Auction, []Bid) {
+	cleared := make([]Bid, len(bids))
+	copy(cleared, bids)
+	sort.SliceStable(cleared, func(i, j int) bool {
+		if !cleared[i].Price.Amount.Equal(cleared[j].Price.Amount) {
+			return cleared[i].Price.Amount.GT(cleared[j].Price.Amount)
+		}
+		return cleared[i].Id < cleared[j].Id
+	})
+
+	remaining := auction.Vouchers.Amount
+	clearingPrice := sdk.ZeroInt()
+	for i, bid := range cleared {
+		allocated := sdk.MinInt(bid.Quantity, remaining)
+		cleared[i].Allocated = allocated
+		if allocated.IsPositive() {
+			clearingPrice = bid.Price.Amount
+		}
+		remaining = remaining.Sub(allocated)
+	}
+
+	auction.ClearingPrice = clearingPrice
+	auction.Sold = auction.Vouchers.Amount.Sub(remaining)
+	auction.Settled = true
+	return auction, cleared
+}
@@ -0,0 +1,326 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestAuction_Validate(t *testing.T) {
+	newAuction := func(update func(auction *types.Auction)) types.Auction {
+		auction := sample.Auction(0, 0)
+		update(&auction)
+		return auction
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		auction types.Auction
+		valid   bool
+	}{
+		{
+			desc:    "valid auction",
+			auction: sample.Auction(0, 0),
+			valid:   true,
+		},
+		{
+			desc: "valid settled auction",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.Settled = true
+				auction.ClearingPrice = auction.MinPrice.Amount
+				auction.Sold = auction.Vouchers.Amount
+			}),
+			valid: true,
+		},
+		{
+			desc: "valid settled and withdrawn auction",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.Settled = true
+				auction.Withdrawn = true
+			}),
+			valid: true,
+		},
+		{
+			desc: "vouchers of another campaign",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.Vouchers = sample.Voucher(1)
+			}),
+		},
+		{
+			desc: "zero min price",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.MinPrice.Amount = sdk.ZeroInt()
+			}),
+		},
+		{
+			desc: "min price in the sold vouchers",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.MinPrice.Denom = auction.Vouchers.Denom
+			}),
+		},
+		{
+			desc: "end time before start time",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.EndTime = auction.StartTime
+			}),
+		},
+		{
+			desc: "too many bids",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.BidCounter = types.AuctionMaxBids + 1
+				auction.BidCount = types.AuctionMaxBids + 1
+			}),
+		},
+		{
+			desc: "bid count greater than bid counter",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.BidCounter = 1
+				auction.BidCount = 2
+			}),
+		},
+		{
+			desc: "negative clearing price",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.Settled = true
+				auction.ClearingPrice = sdk.NewInt(-1)
+			}),
+		},
+		{
+			desc: "more sold vouchers than auction vouchers",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.Settled = true
+				auction.Sold = auction.Vouchers.Amount.AddRaw(1)
+			}),
+		},
+		{
+			desc: "sold vouchers in auction not settled",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.Sold = sdk.OneInt()
+			}),
+		},
+		{
+			desc: "withdrawn auction not settled",
+			auction: newAuction(func(auction *types.Auction) {
+				auction.Withdrawn = true
+			}),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.auction.Validate()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuction_IsActive(t *testing.T) {
+	auction := types.NewAuction(0, 0, sample.Voucher(0), sample.Coin(), 1000, 2000)
+
+	require.False(t, auction.IsActive(999))
+	require.True(t, auction.IsActive(1000))
+	require.True(t, auction.IsActive(1999))
+	require.False(t, auction.IsActive(2000))
+
+	auction.Settled = true
+	require.False(t, auction.IsActive(1500))
+}
+
+func TestBid_Validate(t *testing.T) {
+	auction := sample.Auction(0, 0)
+	newBid := func(update func(bid *types.Bid)) types.Bid {
+		bid := sample.Bid(auction, 0)
+		update(&bid)
+		return bid
+	}
+
+	for _, tc := range []struct {
+		desc  string
+		bid   types.Bid
+		valid bool
+	}{
+		{
+			desc:  "valid bid",
+			bid:   sample.Bid(auction, 0),
+			valid: true,
+		},
+		{
+			desc: "valid bid with allocated vouchers",
+			bid: newBid(func(bid *types.Bid) {
+				bid.Allocated = bid.Quantity
+			}),
+			valid: true,
+		},
+		{
+			desc: "invalid bidder address",
+			bid: newBid(func(bid *types.Bid) {
+				bid.Bidder = "invalid"
+			}),
+		},
+		{
+			desc: "zero price",
+			bid: newBid(func(bid *types.Bid) {
+				bid.Price.Amount = sdk.ZeroInt()
+			}),
+		},
+		{
+			desc: "zero quantity",
+			bid: newBid(func(bid *types.Bid) {
+				bid.Quantity = sdk.ZeroInt()
+			}),
+		},
+		{
+			desc: "negative allocated vouchers",
+			bid: newBid(func(bid *types.Bid) {
+				bid.Allocated = sdk.NewInt(-1)
+			}),
+		},
+		{
+			desc: "more allocated vouchers than quantity",
+			bid: newBid(func(bid *types.Bid) {
+				bid.Allocated = bid.Quantity.AddRaw(1)
+			}),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.bid.Validate()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBid_Refund(t *testing.T) {
+	bid := types.NewBid(0, 0, sample.Address(), sdk.NewCoin("foo", sdk.NewInt(10)), sdk.NewInt(100))
+	require.Equal(t, sdk.NewCoin("foo", sdk.NewInt(1000)), bid.Escrow())
+
+	// bid not filled
+	require.Equal(t, bid.Escrow(), bid.Refund(sdk.NewInt(8)))
+
+	// bid partially filled
+	bid.Allocated = sdk.NewInt(50)
+	require.Equal(t, sdk.NewCoin("foo", sdk.NewInt(600)), bid.Refund(sdk.NewInt(8)))
+
+	// bid filled at its price
+	bid.Allocated = bid.Quantity
+	require.True(t, bid.Refund(sdk.NewInt(10)).IsZero())
+}
+
+func TestLowestBid(t *testing.T) {
+	auction := sample.Auction(0, 0)
+	newBid := func(id uint64, price int64) types.Bid {
+		return types.NewBid(auction.Id, id, sample.Address(), sdk.NewCoin("foo", sdk.NewInt(price)), sdk.OneInt())
+	}
+
+	_, found := types.LowestBid(nil)
+	require.False(t, found)
+
+	bids := []types.Bid{newBid(0, 10), newBid(1, 5), newBid(2, 8), newBid(3, 5), newBid(4, 7)}
+	lowest, found := types.LowestBid(bids)
+	require.True(t, found)
+	require.Equal(t, bids[3], lowest, "the last bid with the lowest price must be returned")
+}
+
+func TestClearAuction(t *testing.T) {
+	var (
+		vouchers = sdk.NewCoin(types.VoucherDenom(0, "foo"), sdk.NewInt(100))
+		auction  = types.NewAuction(0, 0, vouchers, sdk.NewCoin("bar", sdk.NewInt(1)), 1000, 2000)
+		bidder   = sample.Address()
+	)
+	newBid := func(id uint64, price, quantity int64) types.Bid {
+		return types.NewBid(0, id, bidder, sdk.NewCoin("bar", sdk.NewInt(price)), sdk.NewInt(quantity))
+	}
+
+	for _, tc := range []struct {
+		desc              string
+		bids              []types.Bid
+		expectedPrice     sdk.Int
+		expectedSold      sdk.Int
+		expectedAllocated map[uint64]sdk.Int
+	}{
+		{
+			desc:              "no bid",
+			expectedPrice:     sdk.ZeroInt(),
+			expectedSold:      sdk.ZeroInt(),
+			expectedAllocated: map[uint64]sdk.Int{},
+		},
+		{
+			desc: "demand lower than supply",
+			bids: []types.Bid{
+				newBid(0, 5, 30),
+				newBid(1, 3, 20),
+			},
+			expectedPrice: sdk.NewInt(3),
+			expectedSold:  sdk.NewInt(50),
+			expectedAllocated: map[uint64]sdk.Int{
+				0: sdk.NewInt(30),
+				1: sdk.NewInt(20),
+			},
+		},
+		{
+			desc: "demand greater than supply",
+			bids: []types.Bid{
+				newBid(0, 2, 50),
+				newBid(1, 5, 60),
+				newBid(2, 4, 30),
+				newBid(3, 1, 10),
+			},
+			expectedPrice: sdk.NewInt(2),
+			expectedSold:  sdk.NewInt(100),
+			expectedAllocated: map[uint64]sdk.Int{
+				0: sdk.NewInt(10),
+				1: sdk.NewInt(60),
+				2: sdk.NewInt(30),
+				3: sdk.ZeroInt(),
+			},
+		},
+		{
+			desc: "bids with the same price are filled in order",
+			bids: []types.Bid{
+				newBid(2, 3, 60),
+				newBid(0, 3, 60),
+				newBid(1, 3, 60),
+			},
+			expectedPrice: sdk.NewInt(3),
+			expectedSold:  sdk.NewInt(100),
+			expectedAllocated: map[uint64]sdk.Int{
+				0: sdk.NewInt(60),
+				1: sdk.NewInt(40),
+				2: sdk.ZeroInt(),
+			},
+		},
+		{
+			desc: "supply exactly filled",
+			bids: []types.Bid{
+				newBid(0, 4, 100),
+				newBid(1, 2, 10),
+			},
+			expectedPrice: sdk.NewInt(4),
+			expectedSold:  sdk.NewInt(100),
+			expectedAllocated: map[uint64]sdk.Int{
+				0: sdk.NewInt(100),
+				1: sdk.ZeroInt(),
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cleared, bids := types.ClearAuction(auction, tc.bids)
+			require.True(t, cleared.Settled)
+			require.True(t, tc.expectedPrice.Equal(cleared.ClearingPrice), cleared.ClearingPrice.String())
+			require.True(t, tc.expectedSold.Equal(cleared.Sold), cleared.Sold.String())
+			require.Len(t, bids, len(tc.expectedAllocated))
+			for _, bid := range bids {
+				require.True(t, tc.expectedAllocated[bid.Id].Equal(bid.Allocated), "bid %d", bid.Id)
+			}
+			require.NoError(t, cleared.Validate())
+		})
+	}
+}
@@ -35,6 +35,8 @@ type Campaign struct {
 	AllocatedShares    Shares                                   `protobuf:"bytes,7,rep,name=AllocatedShares,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"AllocatedShares"`
 	DynamicShares      bool                                     `protobuf:"varint,8,opt,name=dynamicShares,proto3" json:"dynamicShares,omitempty"`
 	TotalShares        Shares                                   `protobuf:"bytes,9,rep,name=TotalShares,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"TotalShares"`
+	// voucherTransferDisabled prevents the vouchers of the campaign from being transferred over IBC until the mainnet is initialized
+	VoucherTransferDisabled bool `protobuf:"varint,10,opt,name=voucherTransferDisabled,proto3" json:"voucherTransferDisabled,omitempty"`
 }
 
 func (m *Campaign) Reset()         { *m = Campaign{} }
@@ -133,6 +135,13 @@ func (m *Campaign) GetTotalShares() Shares {
 	return nil
 }
 
+func (m *Campaign) GetVoucherTransferDisabled() bool {
+	if m != nil {
+		return m.VoucherTransferDisabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Campaign)(nil), "tendermint.spn.campaign.Campaign")
 }
@@ -140,33 +149,35 @@ func init() {
 func init() { proto.RegisterFile("campaign/campaign.proto", fileDescriptor_f6f0d6f3906b81bb) }
 
 var fileDescriptor_f6f0d6f3906b81bb = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xbd, 0xae, 0xd3, 0x30,
-	0x18, 0x8d, 0xdb, 0x52, 0x5a, 0x97, 0x1f, 0xc9, 0x42, 0x6a, 0xa8, 0x50, 0x1a, 0x55, 0x48, 0x44,
-	0x48, 0xd8, 0x2a, 0x4c, 0x8c, 0xb4, 0x5d, 0xba, 0x30, 0xa4, 0x4c, 0x30, 0x39, 0xb1, 0x95, 0x5a,
-	0x24, 0x76, 0x14, 0xbb, 0x15, 0x65, 0xe0, 0x19, 0x18, 0x79, 0x06, 0x9e, 0xa4, 0x63, 0x47, 0xa6,
-	0x82, 0xda, 0x91, 0x37, 0x60, 0x42, 0xcd, 0xcf, 0x6d, 0x7b, 0x75, 0xa5, 0x7b, 0xef, 0x70, 0xa7,
-	0x38, 0xc7, 0xe7, 0x3b, 0xe7, 0x7c, 0x5f, 0xbe, 0xc0, 0x6e, 0x48, 0x93, 0x94, 0x8a, 0x48, 0x92,
-	0xea, 0x80, 0xd3, 0x4c, 0x19, 0x85, 0xba, 0x86, 0x4b, 0xc6, 0xb3, 0x44, 0x48, 0x83, 0x75, 0x2a,
-	0x71, 0x75, 0xdd, 0x7b, 0x12, 0xa9, 0x48, 0xe5, 0x1c, 0x72, 0x38, 0x15, 0xf4, 0x9e, 0x13, 0x2a,
-	0x9d, 0x28, 0x4d, 0x02, 0xaa, 0x39, 0x59, 0x0e, 0x03, 0x6e, 0xe8, 0x90, 0x84, 0x4a, 0x94, 0x72,
-	0x83, 0xbf, 0x0d, 0xd8, 0x1a, 0x97, 0x12, 0xe8, 0x11, 0xac, 0x09, 0x66, 0x03, 0x17, 0x78, 0x0d,
-	0xbf, 0x26, 0x18, 0x1a, 0xc0, 0x07, 0x95, 0xfc, 0x7b, 0x9a, 0x70, 0xbb, 0xe6, 0x02, 0xaf, 0xed,
-	0x9f, 0x61, 0xe8, 0x39, 0x7c, 0x18, 0x2a, 0x95, 0x31, 0x21, 0xa9, 0x51, 0xd9, 0x74, 0x62, 0xd7,
-	0xf3, 0xf2, 0x73, 0x10, 0x3d, 0x83, 0xed, 0x84, 0x0a, 0x29, 0xb9, 0x99, 0x4e, 0xec, 0x46, 0xce,
-	0x38, 0x02, 0x08, 0x43, 0x54, 0xbd, 0x48, 0x61, 0x04, 0x8d, 0xc5, 0x57, 0xce, 0xec, 0x7b, 0x2e,
-	0xf0, 0x5a, 0xfe, 0x15, 0x37, 0xe8, 0x07, 0x80, 0x9d, 0x0f, 0xca, 0xd0, 0x78, 0xb6, 0x48, 0xd3,
-	0x78, 0x65, 0x37, 0xdd, 0xba, 0xd7, 0x79, 0xfd, 0x14, 0x17, 0xbd, 0xe2, 0x43, 0xaf, 0xb8, 0xec,
-	0x15, 0x8f, 0x95, 0x90, 0xa3, 0x4f, 0xeb, 0x6d, 0xdf, 0xfa, 0xb7, 0xed, 0xbf, 0x88, 0x84, 0x99,
-	0x2f, 0x02, 0x1c, 0xaa, 0x84, 0x94, 0x83, 0x29, 0x1e, 0xaf, 0x34, 0xfb, 0x4c, 0xcc, 0x2a, 0xe5,
-	0x3a, 0x2f, 0xf8, 0xf9, 0xbb, 0xef, 0xdd, 0x90, 0xaa, 0xfd, 0xd3, 0x28, 0xe8, 0x1b, 0x7c, 0xfc,
-	0x2e, 0x8e, 0x55, 0x48, 0x0d, 0x67, 0xb3, 0x39, 0xcd, 0xb8, 0xb6, 0xef, 0x5f, 0x97, 0xee, 0xed,
-	0xed, 0xd3, 0x35, 0x0b, 0x6d, 0xff, 0xb2, 0xd9, 0xe1, 0x73, 0xb0, 0x95, 0xa4, 0x89, 0x08, 0x4b,
-	0xf7, 0x56, 0x3e, 0xc5, 0x73, 0x10, 0x2d, 0xab, 0xf9, 0x15, 0x9c, 0xf6, 0x1d, 0x26, 0x3c, 0x35,
-	0x1a, 0x4d, 0xd6, 0x3b, 0x07, 0x6c, 0x76, 0x0e, 0xf8, 0xb3, 0x73, 0xc0, 0xf7, 0xbd, 0x63, 0x6d,
-	0xf6, 0x8e, 0xf5, 0x6b, 0xef, 0x58, 0x1f, 0x5f, 0x9e, 0x28, 0x1f, 0x37, 0x9c, 0xe8, 0x54, 0x92,
-	0x2f, 0x17, 0xbf, 0x40, 0xe1, 0x10, 0x34, 0xf3, 0xd5, 0x7d, 0xf3, 0x3f, 0x00, 0x00, 0xff, 0xff,
-	0x31, 0xa7, 0xfd, 0x44, 0x24, 0x03, 0x00, 0x00,
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xbd, 0x8e, 0xd3, 0x40,
+	0x10, 0xf6, 0xe6, 0xee, 0x42, 0xb2, 0xc7, 0x8f, 0xb4, 0x42, 0xca, 0x72, 0x42, 0x8e, 0x75, 0x42,
+	0xc2, 0x42, 0x62, 0x57, 0x07, 0x0d, 0x94, 0xdc, 0xb9, 0xb9, 0x86, 0xc2, 0x77, 0x15, 0x54, 0xeb,
+	0xdd, 0xc5, 0x59, 0x61, 0xef, 0x5a, 0xbb, 0x9b, 0x88, 0x50, 0xf0, 0x0c, 0x94, 0xbc, 0x00, 0x0d,
+	0x4f, 0x72, 0xe5, 0x95, 0x54, 0x07, 0x4a, 0xde, 0x82, 0x0a, 0xc5, 0x3f, 0x24, 0x41, 0x20, 0xa0,
+	0xa0, 0xf2, 0xf8, 0x9b, 0x6f, 0xbe, 0xf9, 0x66, 0xec, 0x81, 0x23, 0xce, 0xca, 0x8a, 0xa9, 0x5c,
+	0xd3, 0x2e, 0x20, 0x95, 0x35, 0xde, 0xa0, 0x91, 0x97, 0x5a, 0x48, 0x5b, 0x2a, 0xed, 0x89, 0xab,
+	0x34, 0xe9, 0xd2, 0x07, 0xb7, 0x73, 0x93, 0x9b, 0x9a, 0x43, 0x57, 0x51, 0x43, 0x3f, 0x08, 0xb9,
+	0x71, 0xa5, 0x71, 0x34, 0x63, 0x4e, 0xd2, 0xd9, 0x51, 0x26, 0x3d, 0x3b, 0xa2, 0xdc, 0xa8, 0x56,
+	0xee, 0xf0, 0xe3, 0x1e, 0x1c, 0x9c, 0xb4, 0x12, 0xe8, 0x26, 0xec, 0x29, 0x81, 0x41, 0x04, 0xe2,
+	0xdd, 0xb4, 0xa7, 0x04, 0x3a, 0x84, 0xd7, 0x3b, 0xf9, 0xe7, 0xac, 0x94, 0xb8, 0x17, 0x81, 0x78,
+	0x98, 0x6e, 0x61, 0xe8, 0x1e, 0xbc, 0xc1, 0x8d, 0xb1, 0x42, 0x69, 0xe6, 0x8d, 0x3d, 0x4d, 0xf0,
+	0x4e, 0x5d, 0xbe, 0x0d, 0xa2, 0xbb, 0x70, 0x58, 0x32, 0xa5, 0xb5, 0xf4, 0xa7, 0x09, 0xde, 0xad,
+	0x19, 0x6b, 0x00, 0x11, 0x88, 0xba, 0x17, 0xad, 0xbc, 0x62, 0x85, 0x7a, 0x2b, 0x05, 0xde, 0x8b,
+	0x40, 0x3c, 0x48, 0x7f, 0x91, 0x41, 0x1f, 0x00, 0xdc, 0x3f, 0x37, 0x9e, 0x15, 0x67, 0xd3, 0xaa,
+	0x2a, 0xe6, 0xb8, 0x1f, 0xed, 0xc4, 0xfb, 0x8f, 0xee, 0x90, 0x66, 0x56, 0xb2, 0x9a, 0x95, 0xb4,
+	0xb3, 0x92, 0x13, 0xa3, 0xf4, 0xf1, 0xcb, 0x8b, 0xab, 0x71, 0xf0, 0xed, 0x6a, 0x7c, 0x3f, 0x57,
+	0x7e, 0x32, 0xcd, 0x08, 0x37, 0x25, 0x6d, 0x17, 0xd3, 0x3c, 0x1e, 0x3a, 0xf1, 0x9a, 0xfa, 0x79,
+	0x25, 0x5d, 0x5d, 0xf0, 0xe9, 0xcb, 0x38, 0xfe, 0x4b, 0xaa, 0x4b, 0x37, 0xad, 0xa0, 0x77, 0xf0,
+	0xd6, 0xb3, 0xa2, 0x30, 0x9c, 0x79, 0x29, 0xce, 0x26, 0xcc, 0x4a, 0x87, 0xaf, 0xfd, 0xc9, 0xdd,
+	0xd3, 0x7f, 0x77, 0xd7, 0x6f, 0xb4, 0xd3, 0x9f, 0x9b, 0xad, 0x3e, 0x87, 0x98, 0x6b, 0x56, 0x2a,
+	0xde, 0x76, 0x1f, 0xd4, 0x5b, 0xdc, 0x06, 0xd1, 0xac, 0xdb, 0x5f, 0xc3, 0x19, 0xfe, 0x47, 0x87,
+	0x9b, 0x8d, 0xd0, 0x13, 0x38, 0x9a, 0x99, 0x29, 0x9f, 0x48, 0x7b, 0x6e, 0x99, 0x76, 0xaf, 0xa4,
+	0x4d, 0x94, 0x63, 0x59, 0x21, 0x05, 0x86, 0xb5, 0xcf, 0xdf, 0xa5, 0x8f, 0x93, 0x8b, 0x45, 0x08,
+	0x2e, 0x17, 0x21, 0xf8, 0xba, 0x08, 0xc1, 0xfb, 0x65, 0x18, 0x5c, 0x2e, 0xc3, 0xe0, 0xf3, 0x32,
+	0x0c, 0x5e, 0x3c, 0xd8, 0xf0, 0xb4, 0xbe, 0x0d, 0xea, 0x2a, 0x4d, 0xdf, 0xfc, 0x38, 0x9e, 0xc6,
+	0x5b, 0xd6, 0xaf, 0x7f, 0xfa, 0xc7, 0xdf, 0x07, 0x00, 0x43, 0x7b, 0xc4, 0x81, 0x5e, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Campaign) Marshal() (dAtA []byte, err error) {
@@ -189,6 +200,16 @@ func (m *Campaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VoucherTransferDisabled {
+		i--
+		if m.VoucherTransferDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.TotalShares) > 0 {
 		for iNdEx := len(m.TotalShares) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +351,9 @@ func (m *Campaign) Size() (n int) {
 			n += 1 + l + sovCampaign(uint64(l))
 		}
 	}
+	if m.VoucherTransferDisabled {
+		n += 2
+	}
 	return n
 }
 
@@ -599,6 +623,26 @@ func (m *Campaign) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherTransferDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VoucherTransferDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCampaign(dAtA[iNdEx:])
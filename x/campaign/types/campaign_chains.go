@@ -0,0 +1,79 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasChain returns true if the chain is associated to the campaign
+func (m CampaignChains) HasChain(launchID uint64) bool {
+	for _, chain := range m.Chains {
+		if chain == launchID {
+			return true
+		}
+	}
+	return false
+}
+
+// ChainReservedShares returns the shares reserved for the participants of a chain
+func (m CampaignChains) ChainReservedShares(launchID uint64) Shares {
+	for _, reserved := range m.ReservedShares {
+		if reserved.LaunchID == launchID {
+			return reserved.Shares
+		}
+	}
+	return EmptyShares()
+}
+
+// SetChainReservedShares sets the shares reserved for the participants of a chain
+// The reservation is removed if the shares are empty
+func (m *CampaignChains) SetChainReservedShares(launchID uint64, shares Shares) {
+	for i, reserved := range m.ReservedShares {
+		if reserved.LaunchID == launchID {
+			if len(shares) == 0 {
+				m.ReservedShares = append(m.ReservedShares[:i], m.ReservedShares[i+1:]...)
+			} else {
+				m.ReservedShares[i].Shares = shares
+			}
+			return
+		}
+	}
+	if len(shares) > 0 {
+		m.ReservedShares = append(m.ReservedShares, ChainShares{
+			LaunchID: launchID,
+			Shares:   shares,
+		})
+	}
+}
+
+// TotalReservedShares returns the sum of the shares reserved for all the chains of the campaign
+func (m CampaignChains) TotalReservedShares() Shares {
+	total := EmptyShares()
+	for _, reserved := range m.ReservedShares {
+		total = IncreaseShares(total, reserved.Shares)
+	}
+	return total
+}
+
+// ValidateReservedShares checks the shares are reserved for chains of the campaign
+func (m CampaignChains) ValidateReservedShares() error {
+	launchIDs := make(map[uint64]struct{})
+	for _, reserved := range m.ReservedShares {
+		if !m.HasChain(reserved.LaunchID) {
+			return fmt.Errorf("chain %d of reserved shares not associated to the campaign", reserved.LaunchID)
+		}
+		if _, ok := launchIDs[reserved.LaunchID]; ok {
+			return fmt.Errorf("duplicated reserved shares for chain %d", reserved.LaunchID)
+		}
+		if err := CheckShares(reserved.Shares); err != nil {
+			return err
+		}
+		if sdk.Coins(reserved.Shares).Empty() || !sdk.Coins(reserved.Shares).IsValid() {
+			return errors.New("invalid reserved shares")
+		}
+		launchIDs[reserved.LaunchID] = struct{}{}
+	}
+	return nil
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -25,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type CampaignChains struct {
 	CampaignID uint64   `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
 	Chains     []uint64 `protobuf:"varint,2,rep,packed,name=chains,proto3" json:"chains,omitempty"`
+	// reservedShares are the shares of the campaign reserved for the participants of the chains
+	ReservedShares []ChainShares `protobuf:"bytes,3,rep,name=reservedShares,proto3" json:"reservedShares"`
 }
 
 func (m *CampaignChains) Reset()         { *m = CampaignChains{} }
@@ -74,25 +79,152 @@ func (m *CampaignChains) GetChains() []uint64 {
 	return nil
 }
 
+func (m *CampaignChains) GetReservedShares() []ChainShares {
+	if m != nil {
+		return m.ReservedShares
+	}
+	return nil
+}
+
+// ChainShares are shares of a campaign reserved for the participants of a chain of the campaign
+type ChainShares struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Shares   Shares `protobuf:"bytes,2,rep,name=shares,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"shares"`
+}
+
+func (m *ChainShares) Reset()         { *m = ChainShares{} }
+func (m *ChainShares) String() string { return proto.CompactTextString(m) }
+func (*ChainShares) ProtoMessage()    {}
+func (*ChainShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2754f11458fa700, []int{1}
+}
+func (m *ChainShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainShares.Merge(m, src)
+}
+func (m *ChainShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainShares proto.InternalMessageInfo
+
+func (m *ChainShares) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *ChainShares) GetShares() Shares {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// ShareDistribution is an amount of shares distributed to an address
+type ShareDistribution struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Shares  Shares `protobuf:"bytes,2,rep,name=shares,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"shares"`
+}
+
+func (m *ShareDistribution) Reset()         { *m = ShareDistribution{} }
+func (m *ShareDistribution) String() string { return proto.CompactTextString(m) }
+func (*ShareDistribution) ProtoMessage()    {}
+func (*ShareDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f2754f11458fa700, []int{2}
+}
+func (m *ShareDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareDistribution.Merge(m, src)
+}
+func (m *ShareDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareDistribution proto.InternalMessageInfo
+
+func (m *ShareDistribution) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ShareDistribution) GetShares() Shares {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CampaignChains)(nil), "tendermint.spn.campaign.CampaignChains")
+	proto.RegisterType((*ChainShares)(nil), "tendermint.spn.campaign.ChainShares")
+	proto.RegisterType((*ShareDistribution)(nil), "tendermint.spn.campaign.ShareDistribution")
 }
 
 func init() { proto.RegisterFile("campaign/campaign_chains.proto", fileDescriptor_f2754f11458fa700) }
 
 var fileDescriptor_f2754f11458fa700 = []byte{
-	// 173 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcc, 0x2d,
-	0x48, 0xcc, 0x4c, 0xcf, 0xd3, 0x87, 0x31, 0xe2, 0x93, 0x33, 0x12, 0x33, 0xf3, 0x8a, 0xf5, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0x4b, 0x52, 0xf3, 0x52, 0x52, 0x8b, 0x72, 0x33, 0xf3, 0x4a,
-	0xf4, 0x8a, 0x0b, 0xf2, 0xf4, 0x60, 0xaa, 0x94, 0x3c, 0xb8, 0xf8, 0x9c, 0xa1, 0x6c, 0x67, 0xb0,
-	0x06, 0x21, 0x39, 0x2e, 0x2e, 0x98, 0xac, 0xa7, 0x8b, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10,
-	0x92, 0x88, 0x90, 0x18, 0x17, 0x1b, 0xc4, 0x68, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x96, 0x20, 0x28,
-	0xcf, 0xc9, 0xe5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd2, 0x33,
-	0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11, 0xee, 0xd0, 0x2f, 0x2e, 0xc8, 0xd3,
-	0xaf, 0x80, 0xbb, 0x57, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x5e, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0x83, 0xda, 0xb7, 0xd1, 0x00, 0x00, 0x00,
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0xbd, 0x4e, 0xeb, 0x30,
+	0x14, 0x8e, 0xdb, 0x2a, 0xf7, 0x5e, 0x57, 0xaa, 0x74, 0x23, 0x04, 0xa1, 0x83, 0x5b, 0x55, 0x48,
+	0x54, 0x48, 0xd8, 0x2a, 0x4c, 0xac, 0x6d, 0x17, 0xd6, 0xb0, 0xb1, 0x20, 0x27, 0xb1, 0x12, 0x8b,
+	0xc6, 0x8e, 0x62, 0xb7, 0x82, 0xb7, 0x60, 0x63, 0x80, 0x27, 0xe0, 0x49, 0x3a, 0x76, 0x64, 0x2a,
+	0xa8, 0x7d, 0x0b, 0x26, 0x54, 0x3b, 0x29, 0x15, 0x12, 0x2b, 0x53, 0xce, 0x39, 0xf9, 0xfc, 0xfd,
+	0xd8, 0x07, 0xa2, 0x88, 0x66, 0x39, 0xe5, 0x89, 0x20, 0x55, 0x71, 0x13, 0xa5, 0x94, 0x0b, 0x85,
+	0xf3, 0x42, 0x6a, 0xe9, 0x1d, 0x68, 0x26, 0x62, 0x56, 0x64, 0x5c, 0x68, 0xac, 0x72, 0x81, 0x2b,
+	0x54, 0x7b, 0x2f, 0x91, 0x89, 0x34, 0x18, 0xb2, 0xa9, 0x2c, 0xbc, 0x8d, 0x22, 0xa9, 0x32, 0xa9,
+	0x48, 0x48, 0x15, 0x23, 0xb3, 0x41, 0xc8, 0x34, 0x1d, 0x90, 0x48, 0x72, 0x61, 0xff, 0xf7, 0x9e,
+	0x01, 0x6c, 0x8d, 0x4a, 0x8a, 0x91, 0xd1, 0xf1, 0x10, 0x84, 0x15, 0xe9, 0xe5, 0xd8, 0x07, 0x5d,
+	0xd0, 0x6f, 0x04, 0x3b, 0x13, 0x6f, 0x1f, 0xba, 0xd6, 0x91, 0x5f, 0xeb, 0xd6, 0xfb, 0x8d, 0xa0,
+	0xec, 0xbc, 0x00, 0xb6, 0x0a, 0xa6, 0x58, 0x31, 0x63, 0xf1, 0x55, 0x4a, 0x0b, 0xa6, 0xfc, 0x7a,
+	0xb7, 0xde, 0x6f, 0x9e, 0x1d, 0xe1, 0x1f, 0x2c, 0x63, 0x23, 0x68, 0xb1, 0xc3, 0xc6, 0x7c, 0xd9,
+	0x71, 0x82, 0x6f, 0x0c, 0xbd, 0x47, 0x00, 0x9b, 0x3b, 0x28, 0xaf, 0x0d, 0xff, 0x4e, 0xe8, 0x54,
+	0x44, 0xe9, 0xd6, 0xd9, 0xb6, 0xf7, 0x26, 0xd0, 0x55, 0x56, 0xb7, 0x66, 0x74, 0x0f, 0xb1, 0xcd,
+	0x8e, 0x37, 0xd9, 0x71, 0x99, 0x1d, 0x8f, 0x24, 0x17, 0xc3, 0x8b, 0x8d, 0xd8, 0xc7, 0xb2, 0x73,
+	0x9c, 0x70, 0x9d, 0x4e, 0x43, 0x1c, 0xc9, 0x8c, 0x94, 0x17, 0x65, 0x3f, 0xa7, 0x2a, 0xbe, 0x25,
+	0xfa, 0x3e, 0x67, 0xca, 0x1c, 0x78, 0x79, 0xeb, 0xb8, 0xd6, 0x41, 0x50, 0x6a, 0xf4, 0x9e, 0x00,
+	0xfc, 0x6f, 0x46, 0x63, 0xae, 0x74, 0xc1, 0xc3, 0xa9, 0xe6, 0x52, 0x78, 0x3e, 0xfc, 0x43, 0xe3,
+	0xb8, 0x60, 0x4a, 0x19, 0x7b, 0xff, 0x82, 0xaa, 0xfd, 0x5d, 0x77, 0xc3, 0xf1, 0x7c, 0x85, 0xc0,
+	0x62, 0x85, 0xc0, 0xfb, 0x0a, 0x81, 0x87, 0x35, 0x72, 0x16, 0x6b, 0xe4, 0xbc, 0xae, 0x91, 0x73,
+	0x7d, 0xb2, 0x43, 0xfa, 0xf5, 0x2e, 0x44, 0xe5, 0x82, 0xdc, 0x6d, 0x57, 0xce, 0x92, 0x87, 0xae,
+	0xd9, 0x91, 0xf3, 0xcf, 0x01, 0x00, 0x64, 0xb1, 0xa4, 0x9a, 0x94, 0x02, 0x00, 0x00,
 }
 
 func (m *CampaignChains) Marshal() (dAtA []byte, err error) {
@@ -115,6 +247,20 @@ func (m *CampaignChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReservedShares) > 0 {
+		for iNdEx := len(m.ReservedShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCampaignChains(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Chains) > 0 {
 		dAtA2 := make([]byte, len(m.Chains)*10)
 		var j1 int
@@ -141,6 +287,92 @@ func (m *CampaignChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCampaignChains(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintCampaignChains(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShareDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCampaignChains(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCampaignChains(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCampaignChains(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCampaignChains(v)
 	base := offset
@@ -168,6 +400,49 @@ func (m *CampaignChains) Size() (n int) {
 		}
 		n += 1 + sovCampaignChains(uint64(l)) + l
 	}
+	if len(m.ReservedShares) > 0 {
+		for _, e := range m.ReservedShares {
+			l = e.Size()
+			n += 1 + l + sovCampaignChains(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovCampaignChains(uint64(m.LaunchID))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovCampaignChains(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShareDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCampaignChains(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovCampaignChains(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -301,6 +576,259 @@ func (m *CampaignChains) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignChains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaignChains
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaignChains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedShares = append(m.ReservedShares, ChainShares{})
+			if err := m.ReservedShares[len(m.ReservedShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaignChains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaignChains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaignChains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignChains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignChains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaignChains
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaignChains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaignChains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaignChains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaignChains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignChains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaignChains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaignChains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignChains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaignChains
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaignChains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCampaignChains(dAtA[iNdEx:])
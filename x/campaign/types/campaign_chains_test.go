@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	campaign "github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestCampaignChains_HasChain(t *testing.T) {
+	campaignChains := campaign.CampaignChains{
+		CampaignID: 0,
+		Chains:     []uint64{0, 1, 2},
+	}
+	require.True(t, campaignChains.HasChain(0))
+	require.True(t, campaignChains.HasChain(2))
+	require.False(t, campaignChains.HasChain(3))
+}
+
+func TestCampaignChains_SetChainReservedShares(t *testing.T) {
+	shares1, shares2 := sample.Shares(), sample.Shares()
+	campaignChains := campaign.CampaignChains{
+		CampaignID: 0,
+		Chains:     []uint64{0, 1, 2},
+	}
+	require.Empty(t, campaignChains.ChainReservedShares(0))
+
+	campaignChains.SetChainReservedShares(0, shares1)
+	campaignChains.SetChainReservedShares(1, shares2)
+	require.True(t, campaign.IsEqualShares(shares1, campaignChains.ChainReservedShares(0)))
+	require.True(t, campaign.IsEqualShares(shares2, campaignChains.ChainReservedShares(1)))
+	require.Empty(t, campaignChains.ChainReservedShares(2))
+	require.True(t, campaign.IsEqualShares(
+		campaign.IncreaseShares(shares1, shares2),
+		campaignChains.TotalReservedShares(),
+	))
+
+	// setting empty shares removes the reservation
+	campaignChains.SetChainReservedShares(0, campaign.EmptyShares())
+	require.Len(t, campaignChains.ReservedShares, 1)
+	require.Empty(t, campaignChains.ChainReservedShares(0))
+	require.True(t, campaign.IsEqualShares(shares2, campaignChains.TotalReservedShares()))
+}
+
+func TestCampaignChains_ValidateReservedShares(t *testing.T) {
+	for _, tc := range []struct {
+		desc           string
+		campaignChains campaign.CampaignChains
+		valid          bool
+	}{
+		{
+			desc: "no reserved shares",
+			campaignChains: campaign.CampaignChains{
+				Chains: []uint64{0, 1},
+			},
+			valid: true,
+		},
+		{
+			desc: "valid reserved shares",
+			campaignChains: campaign.CampaignChains{
+				Chains: []uint64{0, 1},
+				ReservedShares: []campaign.ChainShares{
+					{LaunchID: 0, Shares: sample.Shares()},
+					{LaunchID: 1, Shares: sample.Shares()},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "reserved shares for a chain not in the campaign",
+			campaignChains: campaign.CampaignChains{
+				Chains: []uint64{0, 1},
+				ReservedShares: []campaign.ChainShares{
+					{LaunchID: 2, Shares: sample.Shares()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated reserved shares",
+			campaignChains: campaign.CampaignChains{
+				Chains: []uint64{0, 1},
+				ReservedShares: []campaign.ChainShares{
+					{LaunchID: 0, Shares: sample.Shares()},
+					{LaunchID: 0, Shares: sample.Shares()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid reserved shares",
+			campaignChains: campaign.CampaignChains{
+				Chains: []uint64{0, 1},
+				ReservedShares: []campaign.ChainShares{
+					{LaunchID: 0, Shares: invalidShares},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty reserved shares",
+			campaignChains: campaign.CampaignChains{
+				Chains: []uint64{0, 1},
+				ReservedShares: []campaign.ChainShares{
+					{LaunchID: 0, Shares: campaign.EmptyShares()},
+				},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.campaignChains.ValidateReservedShares()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -19,6 +19,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurnVouchers{}, "campaign/BurnVouchers", nil)
 	cdc.RegisterConcrete(&MsgRedeemVouchers{}, "campaign/RedeemVouchers", nil)
 	cdc.RegisterConcrete(&MsgUnredeemVouchers{}, "campaign/UnredeemVouchers", nil)
+	cdc.RegisterConcrete(&MsgCreateSale{}, "campaign/CreateSale", nil)
+	cdc.RegisterConcrete(&MsgBuyVouchers{}, "campaign/BuyVouchers", nil)
+	cdc.RegisterConcrete(&MsgWithdrawSale{}, "campaign/WithdrawSale", nil)
+	cdc.RegisterConcrete(&MsgCreateAuction{}, "campaign/CreateAuction", nil)
+	cdc.RegisterConcrete(&MsgPlaceBid{}, "campaign/PlaceBid", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAuction{}, "campaign/WithdrawAuction", nil)
+	cdc.RegisterConcrete(&MsgReserveChainShares{}, "campaign/ReserveChainShares", nil)
+	cdc.RegisterConcrete(&MsgDistributeChainShares{}, "campaign/DistributeChainShares", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -36,6 +44,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgBurnVouchers{},
 		&MsgRedeemVouchers{},
 		&MsgUnredeemVouchers{},
+		&MsgCreateSale{},
+		&MsgBuyVouchers{},
+		&MsgWithdrawSale{},
+		&MsgCreateAuction{},
+		&MsgPlaceBid{},
+		&MsgWithdrawAuction{},
+		&MsgReserveChainShares{},
+		&MsgDistributeChainShares{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -8,17 +8,37 @@ import (
 
 // x/campaign module sentinel errors
 var (
-	ErrInvalidTotalSupply   = sdkerrors.Register(ModuleName, 2, "invalid total supply")
-	ErrCampaignNotFound     = sdkerrors.Register(ModuleName, 3, "campaign not found")
-	ErrMainnetInitialized   = sdkerrors.Register(ModuleName, 4, "mainnet initialized")
-	ErrInvalidShares        = sdkerrors.Register(ModuleName, 5, "invalid shares")
-	ErrNoDynamicShares      = sdkerrors.Register(ModuleName, 6, "no dynamic shares")
-	ErrTotalSharesLimit     = sdkerrors.Register(ModuleName, 7, "allocated shares greater than total shares")
-	ErrAccountNotFound      = sdkerrors.Register(ModuleName, 8, "account not found")
-	ErrSharesDecrease       = sdkerrors.Register(ModuleName, 9, "shares can't be decreased")
-	ErrVouchersMinting      = sdkerrors.Register(ModuleName, 10, "vouchers can't be minted")
-	ErrInvalidVouchers      = sdkerrors.Register(ModuleName, 11, "invalid vouchers")
-	ErrNoMatchVouchers      = sdkerrors.Register(ModuleName, 12, "vouchers don't match to campaign")
-	ErrInsufficientVouchers = sdkerrors.Register(ModuleName, 13, "account with insufficient vouchers")
-	ErrInvalidCampaignName  = sdkerrors.Register(ModuleName, 14, "invalid campaign name")
+	ErrInvalidTotalSupply         = sdkerrors.Register(ModuleName, 2, "invalid total supply")
+	ErrCampaignNotFound           = sdkerrors.Register(ModuleName, 3, "campaign not found")
+	ErrMainnetInitialized         = sdkerrors.Register(ModuleName, 4, "mainnet initialized")
+	ErrInvalidShares              = sdkerrors.Register(ModuleName, 5, "invalid shares")
+	ErrNoDynamicShares            = sdkerrors.Register(ModuleName, 6, "no dynamic shares")
+	ErrTotalSharesLimit           = sdkerrors.Register(ModuleName, 7, "allocated shares greater than total shares")
+	ErrAccountNotFound            = sdkerrors.Register(ModuleName, 8, "account not found")
+	ErrSharesDecrease             = sdkerrors.Register(ModuleName, 9, "shares can't be decreased")
+	ErrVouchersMinting            = sdkerrors.Register(ModuleName, 10, "vouchers can't be minted")
+	ErrInvalidVouchers            = sdkerrors.Register(ModuleName, 11, "invalid vouchers")
+	ErrNoMatchVouchers            = sdkerrors.Register(ModuleName, 12, "vouchers don't match to campaign")
+	ErrInsufficientVouchers       = sdkerrors.Register(ModuleName, 13, "account with insufficient vouchers")
+	ErrInvalidCampaignName        = sdkerrors.Register(ModuleName, 14, "invalid campaign name")
+	ErrMainnetNotInitialized      = sdkerrors.Register(ModuleName, 15, "mainnet not initialized")
+	ErrVoucherTransferDisabled    = sdkerrors.Register(ModuleName, 16, "voucher transfer disabled")
+	ErrSaleNotFound               = sdkerrors.Register(ModuleName, 17, "sale not found")
+	ErrInvalidSale                = sdkerrors.Register(ModuleName, 18, "invalid sale")
+	ErrSaleNotActive              = sdkerrors.Register(ModuleName, 19, "sale not active")
+	ErrInsufficientSaleVouchers   = sdkerrors.Register(ModuleName, 20, "insufficient vouchers in sale")
+	ErrSaleMaxPerAddress          = sdkerrors.Register(ModuleName, 21, "max vouchers per address reached")
+	ErrSaleNotSettled             = sdkerrors.Register(ModuleName, 22, "sale not settled")
+	ErrSaleWithdrawn              = sdkerrors.Register(ModuleName, 23, "sale already withdrawn")
+	ErrAuctionNotFound            = sdkerrors.Register(ModuleName, 24, "auction not found")
+	ErrInvalidAuction             = sdkerrors.Register(ModuleName, 25, "invalid auction")
+	ErrAuctionNotActive           = sdkerrors.Register(ModuleName, 26, "auction not active")
+	ErrInvalidBid                 = sdkerrors.Register(ModuleName, 27, "invalid bid")
+	ErrAuctionMaxBids             = sdkerrors.Register(ModuleName, 28, "max bids reached for auction")
+	ErrAuctionNotSettled          = sdkerrors.Register(ModuleName, 29, "auction not settled")
+	ErrAuctionWithdrawn           = sdkerrors.Register(ModuleName, 30, "auction already withdrawn")
+	ErrChainNotInCampaign         = sdkerrors.Register(ModuleName, 31, "chain not associated to campaign")
+	ErrChainNotLaunched           = sdkerrors.Register(ModuleName, 32, "chain not launched")
+	ErrInsufficientReservedShares = sdkerrors.Register(ModuleName, 33, "insufficient reserved shares")
+	ErrCoordinatorActiveCampaign  = sdkerrors.Register(ModuleName, 34, "the coordinator has an active campaign")
 )
@@ -7,18 +7,15 @@ import (
 )
 
 type LaunchKeeper interface {
-	CreateNewChain(
+	CreateNewMainnet(
 		ctx sdk.Context,
 		coordinatorID uint64,
-		genesisChainID,
+		mainnetChainID,
 		sourceURL,
-		sourceHash,
-		genesisURL,
-		genesisHash string,
-		hasCampaign bool,
+		sourceHash string,
 		campaignID uint64,
-		isMainnet bool,
 	) (uint64, error)
+	IsChainLaunched(ctx sdk.Context, launchID uint64) (launched bool, found bool)
 }
 
 type BankKeeper interface {
@@ -27,15 +24,26 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddress, sdk.Coin) bool)
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(sdk.Coin) bool)
 }
 
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 type ProfileKeeper interface {
 	GetAllCoordinator(ctx sdk.Context) []types.Coordinator
 	CoordinatorIDFromAddress(ctx sdk.Context, address string) (id uint64, found bool)
 	GetCoordinatorAddressFromID(ctx sdk.Context, id uint64) (string, bool)
+	CheckCoordinatorPermission(
+		ctx sdk.Context,
+		coordinatorID uint64,
+		address string,
+		permission types.CoordinatorOperator_Permission,
+	) error
 }
 
 type AccountKeeper interface {
@@ -12,6 +12,13 @@ func DefaultGenesis() *GenesisState {
 		CampaignChainsList:        []CampaignChains{},
 		MainnetAccountList:        []MainnetAccount{},
 		MainnetVestingAccountList: []MainnetVestingAccount{},
+		SaleList:                  []Sale{},
+		SaleCounter:               0,
+		SalePurchaseList:          []SalePurchase{},
+		AuctionList:               []Auction{},
+		AuctionCounter:            0,
+		BidList:                   []Bid{},
+		Params:                    DefaultParams(),
 		// this line is used by starport scaffolding # genesis/types/default
 	}
 }
@@ -45,6 +52,9 @@ func (gs GenesisState) Validate() error {
 		if _, ok := campaignChainsIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for campaignChains")
 		}
+		if err := elem.ValidateReservedShares(); err != nil {
+			return fmt.Errorf("invalid reserved shares for campaign %d: %s", elem.CampaignID, err.Error())
+		}
 		campaignChainsIndexMap[index] = struct{}{}
 	}
 
@@ -77,7 +87,89 @@ func (gs GenesisState) Validate() error {
 		mainnetVestingAccountIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated ID in sale
+	saleIDMap := make(map[uint64]struct{})
+	for _, elem := range gs.SaleList {
+		if _, ok := campaignIDMap[elem.CampaignID]; !ok {
+			return fmt.Errorf("campaign id %d doesn't exist for sale %d", elem.CampaignID, elem.Id)
+		}
+		if _, ok := saleIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for sale")
+		}
+		if elem.Id >= gs.SaleCounter {
+			return fmt.Errorf("sale id should be lower or equal than the last id")
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid sale %d: %s", elem.Id, err.Error())
+		}
+		saleIDMap[elem.Id] = struct{}{}
+	}
+
+	// Check for duplicated index in salePurchase
+	salePurchaseIndexMap := make(map[string]struct{})
+	for _, elem := range gs.SalePurchaseList {
+		if _, ok := saleIDMap[elem.SaleID]; !ok {
+			return fmt.Errorf("sale id %d doesn't exist for sale purchase %s", elem.SaleID, elem.Address)
+		}
+		index := string(SalePurchaseKey(elem.SaleID, elem.Address))
+		if _, ok := salePurchaseIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for salePurchase")
+		}
+		if elem.Amount.IsNil() || !elem.Amount.IsPositive() {
+			return fmt.Errorf("invalid amount for sale purchase %s of sale %d", elem.Address, elem.SaleID)
+		}
+		salePurchaseIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated ID in auction
+	auctionMap := make(map[uint64]Auction)
+	for _, elem := range gs.AuctionList {
+		if _, ok := campaignIDMap[elem.CampaignID]; !ok {
+			return fmt.Errorf("campaign id %d doesn't exist for auction %d", elem.CampaignID, elem.Id)
+		}
+		if _, ok := auctionMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for auction")
+		}
+		if elem.Id >= gs.AuctionCounter {
+			return fmt.Errorf("auction id should be lower or equal than the last id")
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid auction %d: %s", elem.Id, err.Error())
+		}
+		auctionMap[elem.Id] = elem
+	}
+
+	// Check for duplicated index in bid
+	bidIndexMap := make(map[string]struct{})
+	bidCounts := make(map[uint64]uint64)
+	for _, elem := range gs.BidList {
+		auction, ok := auctionMap[elem.AuctionID]
+		if !ok {
+			return fmt.Errorf("auction id %d doesn't exist for bid %d", elem.AuctionID, elem.Id)
+		}
+		index := string(BidKey(elem.AuctionID, elem.Id))
+		if _, ok := bidIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for bid")
+		}
+		if elem.Id >= auction.BidCounter {
+			return fmt.Errorf("bid id should be lower than the bid counter of auction %d", elem.AuctionID)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid bid %d of auction %d: %s", elem.Id, elem.AuctionID, err.Error())
+		}
+		if elem.Price.Denom != auction.MinPrice.Denom {
+			return fmt.Errorf("invalid price denom for bid %d of auction %d", elem.Id, elem.AuctionID)
+		}
+		bidIndexMap[index] = struct{}{}
+		bidCounts[elem.AuctionID]++
+	}
+	for _, auction := range gs.AuctionList {
+		if bidCounts[auction.Id] != auction.BidCount {
+			return fmt.Errorf("bid count of auction %d doesn't match its %d bids", auction.Id, bidCounts[auction.Id])
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
-	return nil
+	return gs.Params.Validate()
 }
@@ -30,6 +30,13 @@ type GenesisState struct {
 	CampaignChainsList        []CampaignChains        `protobuf:"bytes,3,rep,name=campaignChainsList,proto3" json:"campaignChainsList"`
 	MainnetAccountList        []MainnetAccount        `protobuf:"bytes,4,rep,name=mainnetAccountList,proto3" json:"mainnetAccountList"`
 	MainnetVestingAccountList []MainnetVestingAccount `protobuf:"bytes,5,rep,name=mainnetVestingAccountList,proto3" json:"mainnetVestingAccountList"`
+	SaleList                  []Sale                  `protobuf:"bytes,6,rep,name=saleList,proto3" json:"saleList"`
+	SaleCounter               uint64                  `protobuf:"varint,7,opt,name=saleCounter,proto3" json:"saleCounter,omitempty"`
+	SalePurchaseList          []SalePurchase          `protobuf:"bytes,8,rep,name=salePurchaseList,proto3" json:"salePurchaseList"`
+	AuctionList               []Auction               `protobuf:"bytes,9,rep,name=auctionList,proto3" json:"auctionList"`
+	AuctionCounter            uint64                  `protobuf:"varint,10,opt,name=auctionCounter,proto3" json:"auctionCounter,omitempty"`
+	BidList                   []Bid                   `protobuf:"bytes,11,rep,name=bidList,proto3" json:"bidList"`
+	Params                    Params                  `protobuf:"bytes,12,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +107,55 @@ func (m *GenesisState) GetMainnetVestingAccountList() []MainnetVestingAccount {
 	return nil
 }
 
+func (m *GenesisState) GetSaleList() []Sale {
+	if m != nil {
+		return m.SaleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSaleCounter() uint64 {
+	if m != nil {
+		return m.SaleCounter
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSalePurchaseList() []SalePurchase {
+	if m != nil {
+		return m.SalePurchaseList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionList() []Auction {
+	if m != nil {
+		return m.AuctionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionCounter() uint64 {
+	if m != nil {
+		return m.AuctionCounter
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBidList() []Bid {
+	if m != nil {
+		return m.BidList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "tendermint.spn.campaign.GenesisState")
 }
@@ -107,28 +163,38 @@ func init() {
 func init() { proto.RegisterFile("campaign/genesis.proto", fileDescriptor_34fad1c9ee281f6a) }
 
 var fileDescriptor_34fad1c9ee281f6a = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x4a, 0xc3, 0x30,
-	0x18, 0xc7, 0x5b, 0x37, 0x3d, 0xc4, 0x81, 0x10, 0xc4, 0xcd, 0x1d, 0xe2, 0xf4, 0xa0, 0xc3, 0x43,
-	0x02, 0xfa, 0x04, 0x6e, 0x82, 0x07, 0xf5, 0x32, 0xc1, 0x83, 0x20, 0x23, 0xab, 0x21, 0xcb, 0xa1,
-	0x49, 0x69, 0x32, 0xd1, 0xb7, 0xf0, 0x6d, 0x7c, 0x85, 0x1d, 0x77, 0xf4, 0x24, 0xb2, 0xbd, 0x88,
-	0x34, 0x4d, 0xaa, 0xb5, 0x8e, 0xde, 0xbe, 0x7e, 0xfd, 0xfe, 0xbf, 0x5f, 0xbf, 0x26, 0x60, 0x2f,
-	0xa2, 0x71, 0x42, 0x05, 0x97, 0x84, 0x33, 0xc9, 0xb4, 0xd0, 0x38, 0x49, 0x95, 0x51, 0xb0, 0x6d,
-	0x98, 0x7c, 0x62, 0x69, 0x2c, 0xa4, 0xc1, 0x3a, 0x91, 0xd8, 0x8f, 0x75, 0x77, 0xb9, 0xe2, 0xca,
-	0xce, 0x90, 0xac, 0xca, 0xc7, 0xbb, 0xa8, 0xc0, 0xf8, 0x62, 0x1c, 0x4d, 0xa9, 0x90, 0x0e, 0xd7,
-	0x3d, 0x2e, 0xde, 0xc7, 0x54, 0x48, 0xc9, 0xcc, 0xf8, 0x99, 0x69, 0x23, 0x24, 0x1f, 0xd3, 0x28,
-	0x52, 0x33, 0x69, 0xdc, 0x5c, 0xbb, 0xc2, 0xa9, 0x08, 0x3c, 0xa0, 0x14, 0x3c, 0x7a, 0x6f, 0x80,
-	0xd6, 0x55, 0xbe, 0xc1, 0x9d, 0xa1, 0x86, 0xc1, 0x6b, 0xd0, 0xf2, 0x91, 0x1b, 0xa1, 0x4d, 0x27,
-	0xec, 0x35, 0xfa, 0xdb, 0x67, 0x87, 0x78, 0xcd, 0x5e, 0x78, 0xe8, 0x8a, 0x41, 0x73, 0xfe, 0x79,
-	0x10, 0x8c, 0x4a, 0x61, 0xd8, 0x07, 0x3b, 0xfe, 0x79, 0x98, 0x49, 0x59, 0xda, 0xd9, 0xe8, 0x85,
-	0xfd, 0xe6, 0xe8, 0x6f, 0x1b, 0x3e, 0x02, 0x58, 0xb4, 0xec, 0x0f, 0xb0, 0xf2, 0x86, 0x95, 0x9f,
-	0xd4, 0xca, 0xf3, 0x88, 0xfb, 0x84, 0x7f, 0x40, 0x19, 0xde, 0xed, 0x7f, 0x91, 0xaf, 0x6f, 0xf1,
-	0xcd, 0x1a, 0xfc, 0x6d, 0x29, 0xe2, 0xf1, 0x55, 0x10, 0x4c, 0xc1, 0xbe, 0xeb, 0xde, 0xe7, 0xc7,
-	0xf3, 0xdb, 0xb2, 0x69, 0x2d, 0xb8, 0xce, 0x52, 0x4e, 0x3a, 0xd9, 0x7a, 0xec, 0xe0, 0x72, 0xbe,
-	0x44, 0xe1, 0x62, 0x89, 0xc2, 0xaf, 0x25, 0x0a, 0xdf, 0x56, 0x28, 0x58, 0xac, 0x50, 0xf0, 0xb1,
-	0x42, 0xc1, 0xc3, 0x29, 0x17, 0x66, 0x3a, 0x9b, 0xe0, 0x48, 0xc5, 0xe4, 0x47, 0x4a, 0x74, 0x22,
-	0xc9, 0x4b, 0x71, 0x3f, 0x88, 0x79, 0x4d, 0x98, 0x9e, 0x6c, 0xd9, 0x6b, 0x70, 0xfe, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0x34, 0xd7, 0xfa, 0xb4, 0xd0, 0x02, 0x00, 0x00,
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdf, 0x6a, 0xd4, 0x40,
+	0x14, 0x87, 0x37, 0x76, 0xdd, 0xd6, 0xc9, 0xa2, 0x32, 0xfe, 0xe9, 0xba, 0x68, 0x1a, 0x05, 0xeb,
+	0xe2, 0x45, 0x02, 0xf5, 0x56, 0x91, 0x6e, 0x05, 0x05, 0x15, 0x4a, 0x0b, 0x0a, 0x82, 0x2c, 0xb3,
+	0xd9, 0x21, 0x3b, 0xd0, 0x4c, 0x42, 0x66, 0x22, 0xfa, 0x16, 0x3e, 0x56, 0x2f, 0x7b, 0xe9, 0x95,
+	0x48, 0xf6, 0x45, 0x24, 0x67, 0xce, 0xa4, 0x9b, 0xc6, 0xb8, 0x77, 0x93, 0x93, 0xf3, 0xfb, 0xbe,
+	0x99, 0x33, 0x9b, 0x25, 0xf7, 0x23, 0x96, 0x64, 0x4c, 0xc4, 0x32, 0x8c, 0xb9, 0xe4, 0x4a, 0xa8,
+	0x20, 0xcb, 0x53, 0x9d, 0xd2, 0x5d, 0xcd, 0xe5, 0x82, 0xe7, 0x89, 0x90, 0x3a, 0x50, 0x99, 0x0c,
+	0x6c, 0xdb, 0xf8, 0x6e, 0x9c, 0xc6, 0x29, 0xf4, 0x84, 0xd5, 0xca, 0xb4, 0x8f, 0xbd, 0x1a, 0x63,
+	0x17, 0xb3, 0x68, 0xc9, 0x84, 0x44, 0xdc, 0x78, 0xbf, 0x7e, 0x9f, 0x30, 0x21, 0x25, 0xd7, 0xb3,
+	0x6f, 0x5c, 0x69, 0x21, 0xe3, 0x19, 0x8b, 0xa2, 0xb4, 0x90, 0x1a, 0xfb, 0x76, 0x5b, 0x9c, 0x96,
+	0xc0, 0x02, 0x9a, 0xc1, 0x3b, 0xf5, 0x7b, 0xc5, 0xce, 0x38, 0x16, 0x2f, 0x0f, 0xc7, 0x8a, 0x48,
+	0x8b, 0xd4, 0xc2, 0xee, 0xd5, 0xf5, 0x8c, 0xe5, 0x2c, 0xc1, 0x4d, 0x3e, 0x29, 0x07, 0x64, 0xf8,
+	0xd6, 0x4c, 0xe1, 0x54, 0x33, 0xcd, 0xe9, 0x7b, 0x32, 0xb4, 0x9d, 0x1f, 0x84, 0xd2, 0x23, 0xc7,
+	0xdf, 0x9a, 0xb8, 0x07, 0x8f, 0x83, 0x8e, 0xd9, 0x04, 0x47, 0xb8, 0x98, 0xf6, 0xcf, 0x7f, 0xef,
+	0xf5, 0x4e, 0x1a, 0x61, 0x3a, 0x21, 0xb7, 0xec, 0xf3, 0x51, 0xb5, 0x71, 0x9e, 0x8f, 0xae, 0xf9,
+	0xce, 0xa4, 0x7f, 0x72, 0xb5, 0x4c, 0xbf, 0x12, 0x5a, 0x97, 0x60, 0x88, 0x20, 0xdf, 0x02, 0xf9,
+	0xb3, 0x8d, 0x72, 0x13, 0xc1, 0x2d, 0xfc, 0x03, 0x54, 0xe1, 0x71, 0x86, 0x87, 0x66, 0x84, 0x80,
+	0xef, 0x6f, 0xc0, 0x7f, 0x6c, 0x44, 0x2c, 0xbe, 0x0d, 0xa2, 0x39, 0x79, 0x80, 0xd5, 0x4f, 0xe6,
+	0x8a, 0xd7, 0x2d, 0xd7, 0xc1, 0x12, 0x6c, 0xb2, 0x34, 0x93, 0x28, 0xeb, 0xc6, 0xd2, 0xd7, 0x64,
+	0xa7, 0xba, 0x76, 0x50, 0x0c, 0x40, 0xf1, 0xa8, 0x53, 0x71, 0xca, 0xce, 0x38, 0x12, 0xeb, 0x10,
+	0xf5, 0x89, 0x5b, 0xad, 0xed, 0xc5, 0x6c, 0xc3, 0xc5, 0xac, 0x97, 0xe8, 0x67, 0x72, 0xbb, 0x7a,
+	0x3c, 0x2e, 0xf2, 0x68, 0xc9, 0x94, 0x51, 0xed, 0x80, 0xea, 0xe9, 0x7f, 0x55, 0x36, 0x80, 0xca,
+	0x16, 0x84, 0xbe, 0x23, 0x2e, 0xfe, 0x3a, 0x81, 0x79, 0x03, 0x98, 0x7e, 0x27, 0xf3, 0xd0, 0xf4,
+	0x22, 0x6e, 0x3d, 0x4a, 0xf7, 0xc9, 0x4d, 0x7c, 0xb4, 0xe7, 0x20, 0x70, 0x8e, 0x2b, 0x55, 0xfa,
+	0x92, 0x6c, 0xcf, 0xc5, 0x02, 0x6c, 0x2e, 0xd8, 0x1e, 0x76, 0xda, 0xa6, 0x62, 0x81, 0x26, 0x1b,
+	0xa1, 0xaf, 0xc8, 0xc0, 0x7c, 0x35, 0xa3, 0xa1, 0xef, 0x4c, 0xdc, 0x83, 0xbd, 0xce, 0xf0, 0x31,
+	0xb4, 0x61, 0x1e, 0x43, 0xd3, 0x37, 0xe7, 0xa5, 0xe7, 0x5c, 0x94, 0x9e, 0xf3, 0xa7, 0xf4, 0x9c,
+	0x9f, 0x2b, 0xaf, 0x77, 0xb1, 0xf2, 0x7a, 0xbf, 0x56, 0x5e, 0xef, 0xcb, 0xf3, 0x58, 0xe8, 0x65,
+	0x31, 0x0f, 0xa2, 0x34, 0x09, 0x2f, 0x91, 0xa1, 0xca, 0x64, 0xf8, 0xbd, 0xfe, 0x3b, 0x08, 0xf5,
+	0x8f, 0x8c, 0xab, 0xf9, 0x00, 0xbe, 0xd8, 0x17, 0x7f, 0x07, 0x00, 0x1c, 0xf6, 0x5a, 0x7d, 0xbf,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +217,82 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.BidList) > 0 {
+		for iNdEx := len(m.BidList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.AuctionCounter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AuctionCounter))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AuctionList) > 0 {
+		for iNdEx := len(m.AuctionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SalePurchaseList) > 0 {
+		for iNdEx := len(m.SalePurchaseList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SalePurchaseList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.SaleCounter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SaleCounter))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SaleList) > 0 {
+		for iNdEx := len(m.SaleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SaleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.MainnetVestingAccountList) > 0 {
 		for iNdEx := len(m.MainnetVestingAccountList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -259,6 +401,38 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SaleList) > 0 {
+		for _, e := range m.SaleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SaleCounter != 0 {
+		n += 1 + sovGenesis(uint64(m.SaleCounter))
+	}
+	if len(m.SalePurchaseList) > 0 {
+		for _, e := range m.SalePurchaseList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionList) > 0 {
+		for _, e := range m.AuctionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AuctionCounter != 0 {
+		n += 1 + sovGenesis(uint64(m.AuctionCounter))
+	}
+	if len(m.BidList) > 0 {
+		for _, e := range m.BidList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -452,6 +626,213 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SaleList = append(m.SaleList, Sale{})
+			if err := m.SaleList[len(m.SaleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleCounter", wireType)
+			}
+			m.SaleCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SaleCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalePurchaseList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SalePurchaseList = append(m.SalePurchaseList, SalePurchase{})
+			if err := m.SalePurchaseList[len(m.SalePurchaseList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionList = append(m.AuctionList, Auction{})
+			if err := m.AuctionList[len(m.AuctionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionCounter", wireType)
+			}
+			m.AuctionCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidList = append(m.BidList, Bid{})
+			if err := m.BidList[len(m.BidList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
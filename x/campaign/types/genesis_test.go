@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/campaign/types"
@@ -13,6 +14,7 @@ func TestGenesisState_Validate(t *testing.T) {
 	var (
 		campaign1      = sample.Campaign(0)
 		campaign2      = sample.Campaign(1)
+		auction1       = sample.Auction(0, 0)
 		sharesVesting1 = sample.Shares()
 		sharesVesting2 = sample.Shares()
 		shares1        = sample.Shares()
@@ -85,6 +87,32 @@ func TestGenesisState_Validate(t *testing.T) {
 						VestingOptions: *types.NewShareDelayedVesting(sharesVesting2, time.Now().Unix()),
 					},
 				},
+				SaleList: []types.Sale{
+					sample.Sale(0, campaign1.Id),
+					sample.Sale(1, campaign2.Id),
+				},
+				SaleCounter: 2,
+				SalePurchaseList: []types.SalePurchase{
+					{
+						SaleID:  0,
+						Address: sample.Address(),
+						Amount:  sdk.OneInt(),
+					},
+				},
+				AuctionList: []types.Auction{
+					func() types.Auction {
+						auction := auction1
+						auction.BidCounter = 2
+						auction.BidCount = 2
+						return auction
+					}(),
+					sample.Auction(1, campaign2.Id),
+				},
+				AuctionCounter: 2,
+				BidList: []types.Bid{
+					sample.Bid(auction1, 0),
+					sample.Bid(auction1, 1),
+				},
 			},
 			valid: true,
 		},
@@ -163,6 +191,45 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "reserved shares for a chain not in the campaign",
+			genState: &types.GenesisState{
+				CampaignChainsList: []types.CampaignChains{
+					{
+						CampaignID: 0,
+						Chains:     []uint64{0},
+						ReservedShares: []types.ChainShares{
+							{LaunchID: 1, Shares: sample.Shares()},
+						},
+					},
+				},
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated reserved shares for a chain",
+			genState: &types.GenesisState{
+				CampaignChainsList: []types.CampaignChains{
+					{
+						CampaignID: 0,
+						Chains:     []uint64{0},
+						ReservedShares: []types.ChainShares{
+							{LaunchID: 0, Shares: sample.Shares()},
+							{LaunchID: 0, Shares: sample.Shares()},
+						},
+					},
+				},
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+			},
+			valid: false,
+		},
 		{
 			desc: "duplicated campaignChains",
 			genState: &types.GenesisState{
@@ -239,6 +306,294 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "non existing campaign for sale",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				SaleList: []types.Sale{
+					sample.Sale(0, 1),
+				},
+				SaleCounter: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated sale",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				SaleList: []types.Sale{
+					sample.Sale(0, 0),
+					sample.Sale(0, 0),
+				},
+				SaleCounter: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid sale count",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				SaleList: []types.Sale{
+					sample.Sale(1, 0),
+				},
+				SaleCounter: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid sale",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				SaleList: []types.Sale{
+					types.NewSale(0, 0, sample.Voucher(1), sample.Coin(), 1000, 2000, sdk.ZeroInt()),
+				},
+				SaleCounter: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "non existing sale for sale purchase",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				SaleList: []types.Sale{
+					sample.Sale(0, 0),
+				},
+				SaleCounter: 1,
+				SalePurchaseList: []types.SalePurchase{
+					{
+						SaleID:  1,
+						Address: sample.Address(),
+						Amount:  sdk.OneInt(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated salePurchase",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				SaleList: []types.Sale{
+					sample.Sale(0, 0),
+				},
+				SaleCounter: 1,
+				SalePurchaseList: []types.SalePurchase{
+					{
+						SaleID:  0,
+						Address: "0",
+						Amount:  sdk.OneInt(),
+					},
+					{
+						SaleID:  0,
+						Address: "0",
+						Amount:  sdk.OneInt(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid sale purchase amount",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				SaleList: []types.Sale{
+					sample.Sale(0, 0),
+				},
+				SaleCounter: 1,
+				SalePurchaseList: []types.SalePurchase{
+					{
+						SaleID:  0,
+						Address: sample.Address(),
+						Amount:  sdk.ZeroInt(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "non existing campaign for auction",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				AuctionList: []types.Auction{
+					sample.Auction(0, 1),
+				},
+				AuctionCounter: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auction",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				AuctionList: []types.Auction{
+					sample.Auction(0, 0),
+					sample.Auction(0, 0),
+				},
+				AuctionCounter: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auction count",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				AuctionList: []types.Auction{
+					sample.Auction(1, 0),
+				},
+				AuctionCounter: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auction",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				AuctionList: []types.Auction{
+					types.NewAuction(0, 0, sample.Voucher(1), sample.Coin(), 1000, 2000),
+				},
+				AuctionCounter: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "non existing auction for bid",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				AuctionList: []types.Auction{
+					auction1,
+				},
+				AuctionCounter: 1,
+				BidList: []types.Bid{
+					sample.Bid(sample.Auction(1, 0), 0),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated bid",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				AuctionList: []types.Auction{
+					func() types.Auction {
+						auction := auction1
+						auction.BidCounter = 1
+						return auction
+					}(),
+				},
+				AuctionCounter: 1,
+				BidList: []types.Bid{
+					sample.Bid(auction1, 0),
+					sample.Bid(auction1, 0),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "bid id greater than the auction bid counter",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				AuctionList: []types.Auction{
+					auction1,
+				},
+				AuctionCounter: 1,
+				BidList: []types.Bid{
+					sample.Bid(auction1, 0),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "auction bid count not matching its bids",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				AuctionList: []types.Auction{
+					func() types.Auction {
+						auction := auction1
+						auction.BidCounter = 2
+						auction.BidCount = 2
+						return auction
+					}(),
+				},
+				AuctionCounter: 1,
+				BidList: []types.Bid{
+					sample.Bid(auction1, 1),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid bid price denom",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(0),
+				},
+				CampaignCounter: 1,
+				AuctionList: []types.Auction{
+					func() types.Auction {
+						auction := auction1
+						auction.BidCounter = 1
+						return auction
+					}(),
+				},
+				AuctionCounter: 1,
+				BidList: []types.Bid{
+					types.NewBid(0, 0, sample.Address(), sample.Coin(), sdk.OneInt()),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid campaign creation fee",
+			genState: &types.GenesisState{
+				Params: types.NewParams(sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}}),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
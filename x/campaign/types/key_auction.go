@@ -0,0 +1,54 @@
+package types
+
+const (
+	// AuctionKeyPrefix is the prefix to retrieve all Auction
+	AuctionKeyPrefix = "Auction/value/"
+
+	// AuctionCounterKey is the prefix to store auction counter
+	AuctionCounterKey = "Auction/count/"
+
+	// AuctionByCampaignKeyPrefix is the prefix to retrieve the auctions of a campaign
+	AuctionByCampaignKeyPrefix = "Auction/campaign/"
+
+	// AuctionQueueKeyPrefix is the prefix to retrieve the auctions waiting for their end time to be cleared
+	AuctionQueueKeyPrefix = "AuctionQueue/value/"
+
+	// BidKeyPrefix is the prefix to retrieve all Bid
+	BidKeyPrefix = "Bid/value/"
+)
+
+// AuctionKey returns the store key to retrieve an Auction from the index fields
+func AuctionKey(auctionID uint64) []byte {
+	return append(uintBytes(auctionID), byte('/'))
+}
+
+// AuctionByCampaignKey returns the store key to retrieve an auction of a campaign
+func AuctionByCampaignKey(campaignID, auctionID uint64) []byte {
+	return append(AuctionByCampaignAllKey(campaignID), append(uintBytes(auctionID), byte('/'))...)
+}
+
+// AuctionByCampaignAllKey returns the store key prefix to retrieve all auctions of a campaign
+func AuctionByCampaignAllKey(campaignID uint64) []byte {
+	return append(uintBytes(campaignID), byte('/'))
+}
+
+// AuctionQueueKey returns the store key of an auction in the auction queue
+// Auctions are ordered by end time in the queue
+func AuctionQueueKey(endTime int64, auctionID uint64) []byte {
+	return append(AuctionQueueEndTimeKey(endTime), uintBytes(auctionID)...)
+}
+
+// AuctionQueueEndTimeKey returns the store key prefix of the auctions in the auction queue for an end time
+func AuctionQueueEndTimeKey(endTime int64) []byte {
+	return uintBytes(uint64(endTime))
+}
+
+// BidKey returns the store key to retrieve a Bid from the index fields
+func BidKey(auctionID, bidID uint64) []byte {
+	return append(BidAllKey(auctionID), append(uintBytes(bidID), byte('/'))...)
+}
+
+// BidAllKey returns the store key prefix to retrieve all bids of an auction
+func BidAllKey(auctionID uint64) []byte {
+	return append(uintBytes(auctionID), byte('/'))
+}
@@ -0,0 +1,51 @@
+package types
+
+const (
+	// SaleKeyPrefix is the prefix to retrieve all Sale
+	SaleKeyPrefix = "Sale/value/"
+
+	// SaleCounterKey is the prefix to store sale counter
+	SaleCounterKey = "Sale/count/"
+
+	// SaleByCampaignKeyPrefix is the prefix to retrieve the sales of a campaign
+	SaleByCampaignKeyPrefix = "Sale/campaign/"
+
+	// SaleQueueKeyPrefix is the prefix to retrieve the sales waiting for their end time to be settled
+	SaleQueueKeyPrefix = "SaleQueue/value/"
+
+	// SalePurchaseKeyPrefix is the prefix to retrieve all SalePurchase
+	SalePurchaseKeyPrefix = "SalePurchase/value/"
+)
+
+// SaleKey returns the store key to retrieve a Sale from the index fields
+func SaleKey(saleID uint64) []byte {
+	return append(uintBytes(saleID), byte('/'))
+}
+
+// SaleByCampaignKey returns the store key to retrieve a sale of a campaign
+func SaleByCampaignKey(campaignID, saleID uint64) []byte {
+	return append(SaleByCampaignAllKey(campaignID), append(uintBytes(saleID), byte('/'))...)
+}
+
+// SaleByCampaignAllKey returns the store key prefix to retrieve all sales of a campaign
+func SaleByCampaignAllKey(campaignID uint64) []byte {
+	return append(uintBytes(campaignID), byte('/'))
+}
+
+// SaleQueueKey returns the store key of a sale in the sale queue
+// Sales are ordered by end time in the queue
+func SaleQueueKey(endTime int64, saleID uint64) []byte {
+	return append(SaleQueueEndTimeKey(endTime), uintBytes(saleID)...)
+}
+
+// SaleQueueEndTimeKey returns the store key prefix of the sales in the sale queue for an end time
+func SaleQueueEndTimeKey(endTime int64) []byte {
+	return uintBytes(uint64(endTime))
+}
+
+// SalePurchaseKey returns the store key to retrieve a SalePurchase from the index fields
+func SalePurchaseKey(saleID uint64, address string) []byte {
+	saleIDBytes := append(uintBytes(saleID), byte('/'))
+	addressBytes := append([]byte(address), byte('/'))
+	return append(saleIDBytes, addressBytes...)
+}
@@ -23,12 +23,25 @@ const (
 
 	// CampaignCounterKey is the prefix to store campaign count
 	CampaignCounterKey = "Campaign-count-"
+
+	// CampaignByCoordinatorKeyPrefix is the prefix to retrieve the campaigns of a coordinator
+	CampaignByCoordinatorKeyPrefix = "Campaign-coordinator-"
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+// CampaignByCoordinatorKey returns the store key to retrieve a campaign of a coordinator
+func CampaignByCoordinatorKey(coordinatorID, campaignID uint64) []byte {
+	return append(CampaignByCoordinatorAllKey(coordinatorID), append(uintBytes(campaignID), byte('/'))...)
+}
+
+// CampaignByCoordinatorAllKey returns the store key prefix to retrieve all campaigns of a coordinator
+func CampaignByCoordinatorAllKey(coordinatorID uint64) []byte {
+	return append(uintBytes(coordinatorID), byte('/'))
+}
+
 func uintBytes(v uint64) []byte {
 	b := make([]byte, 8)
 	binary.BigEndian.PutUint64(b, v)
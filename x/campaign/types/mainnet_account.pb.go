@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -85,33 +85,97 @@ func (m *MainnetAccount) GetShares() Shares {
 	return nil
 }
 
+// MainnetAccountBalance is the balance of a mainnet account computed from its shares
+type MainnetAccountBalance struct {
+	CampaignID uint64                                   `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	Address    string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Coins      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MainnetAccountBalance) Reset()         { *m = MainnetAccountBalance{} }
+func (m *MainnetAccountBalance) String() string { return proto.CompactTextString(m) }
+func (*MainnetAccountBalance) ProtoMessage()    {}
+func (*MainnetAccountBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a87a85fe8b4c45d, []int{1}
+}
+func (m *MainnetAccountBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MainnetAccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MainnetAccountBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MainnetAccountBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MainnetAccountBalance.Merge(m, src)
+}
+func (m *MainnetAccountBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MainnetAccountBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MainnetAccountBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MainnetAccountBalance proto.InternalMessageInfo
+
+func (m *MainnetAccountBalance) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *MainnetAccountBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MainnetAccountBalance) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MainnetAccount)(nil), "tendermint.spn.campaign.MainnetAccount")
+	proto.RegisterType((*MainnetAccountBalance)(nil), "tendermint.spn.campaign.MainnetAccountBalance")
 }
 
 func init() { proto.RegisterFile("campaign/mainnet_account.proto", fileDescriptor_0a87a85fe8b4c45d) }
 
 var fileDescriptor_0a87a85fe8b4c45d = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x3d, 0x4e, 0xc3, 0x30,
-	0x18, 0x86, 0x63, 0x8a, 0x8a, 0x30, 0x12, 0x43, 0x84, 0x44, 0xe8, 0xe0, 0x44, 0x2c, 0x44, 0x48,
-	0xd8, 0x2a, 0x4c, 0x8c, 0x94, 0x2e, 0x0c, 0x2c, 0x61, 0x63, 0x41, 0x8e, 0x63, 0xa5, 0x16, 0xc4,
-	0x8e, 0xf2, 0xb9, 0x08, 0x6e, 0xc1, 0x39, 0x38, 0x01, 0x47, 0xe8, 0xd8, 0x91, 0xa9, 0xa0, 0xe4,
-	0x16, 0x4c, 0xa8, 0x71, 0x02, 0x9d, 0xfc, 0xf7, 0xfa, 0x79, 0x3e, 0xbd, 0x98, 0x08, 0x5e, 0x94,
-	0x5c, 0xe5, 0x9a, 0x15, 0x5c, 0x69, 0x2d, 0xed, 0x03, 0x17, 0xc2, 0xcc, 0xb5, 0xa5, 0x65, 0x65,
-	0xac, 0xf1, 0x0f, 0xad, 0xd4, 0x99, 0xac, 0x0a, 0xa5, 0x2d, 0x85, 0x52, 0xd3, 0x3e, 0x3e, 0x3a,
-	0xc8, 0x4d, 0x6e, 0xda, 0x0c, 0x5b, 0xef, 0x5c, 0x7c, 0x44, 0x84, 0x81, 0xc2, 0x00, 0x4b, 0x39,
-	0x48, 0xf6, 0x3c, 0x4e, 0xa5, 0xe5, 0x63, 0x26, 0x8c, 0xd2, 0xee, 0xfd, 0xf8, 0x03, 0xe1, 0xfd,
-	0x5b, 0x27, 0xba, 0x72, 0x1e, 0x9f, 0x60, 0xdc, 0x43, 0x6f, 0xa6, 0x01, 0x8a, 0x50, 0xbc, 0x9d,
-	0x6c, 0xdc, 0xf8, 0x01, 0xde, 0xe1, 0x59, 0x56, 0x49, 0x80, 0x60, 0x2b, 0x42, 0xf1, 0x6e, 0xd2,
-	0x1f, 0xfd, 0x27, 0x3c, 0x84, 0x19, 0xaf, 0x24, 0x04, 0x83, 0x68, 0x10, 0xef, 0x9d, 0x1f, 0x51,
-	0x67, 0xa7, 0x6b, 0x3b, 0xed, 0xec, 0xf4, 0xda, 0x28, 0x3d, 0xb9, 0x5c, 0xac, 0x42, 0xef, 0x67,
-	0x15, 0x9e, 0xe4, 0xca, 0xce, 0xe6, 0x29, 0x15, 0xa6, 0x60, 0xdd, 0xa8, 0x6e, 0x39, 0x83, 0xec,
-	0x91, 0xd9, 0xd7, 0x52, 0x42, 0xfb, 0xe1, 0xfd, 0x2b, 0x1c, 0xde, 0xb5, 0xec, 0xa4, 0x73, 0x4c,
-	0xa6, 0x8b, 0x9a, 0xa0, 0x65, 0x4d, 0xd0, 0x77, 0x4d, 0xd0, 0x5b, 0x43, 0xbc, 0x65, 0x43, 0xbc,
-	0xcf, 0x86, 0x78, 0xf7, 0xa7, 0x1b, 0xd0, 0xff, 0xba, 0x18, 0x94, 0x9a, 0xbd, 0xb0, 0xbf, 0x7e,
-	0x5b, 0x78, 0x3a, 0x6c, 0x7b, 0xb8, 0xf8, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x54, 0x80, 0xec,
-	0x78, 0x01, 0x00, 0x00,
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0x63, 0x0a, 0x45, 0x18, 0x89, 0x21, 0x02, 0x11, 0x3a, 0x38, 0x55, 0x17, 0x22, 0x24,
+	0x6c, 0x0a, 0x13, 0x23, 0xa5, 0x0b, 0x03, 0x4b, 0xd8, 0x58, 0x90, 0xe3, 0x58, 0x69, 0x44, 0x63,
+	0x47, 0xb9, 0x2e, 0x82, 0xb7, 0xe0, 0x39, 0x3a, 0x33, 0xf0, 0x08, 0x1d, 0x3b, 0x32, 0xb5, 0xa8,
+	0x7d, 0x0b, 0x26, 0x94, 0xb8, 0x85, 0xb2, 0x55, 0x62, 0xf2, 0xdf, 0xf1, 0xf9, 0xee, 0xf1, 0x35,
+	0x26, 0x82, 0x67, 0x39, 0x4f, 0x13, 0xc5, 0x32, 0x9e, 0x2a, 0x25, 0xcd, 0x03, 0x17, 0x42, 0x0f,
+	0x94, 0xa1, 0x79, 0xa1, 0x8d, 0x76, 0x0f, 0x8d, 0x54, 0xb1, 0x2c, 0xb2, 0x54, 0x19, 0x0a, 0xb9,
+	0xa2, 0x4b, 0x79, 0x63, 0x3f, 0xd1, 0x89, 0xae, 0x34, 0xac, 0x9c, 0x59, 0x79, 0x83, 0x08, 0x0d,
+	0x99, 0x06, 0x16, 0x71, 0x90, 0xec, 0xa9, 0x1d, 0x49, 0xc3, 0xdb, 0x4c, 0xe8, 0x54, 0xd9, 0xf3,
+	0xd6, 0x3b, 0xc2, 0x7b, 0xb7, 0x16, 0x74, 0x65, 0x39, 0x2e, 0xc1, 0x78, 0x69, 0x7a, 0xd3, 0xf5,
+	0x50, 0x13, 0x05, 0x9b, 0xe1, 0xca, 0x8e, 0xeb, 0xe1, 0x6d, 0x1e, 0xc7, 0x85, 0x04, 0xf0, 0x36,
+	0x9a, 0x28, 0xd8, 0x09, 0x97, 0x4b, 0xb7, 0x8f, 0xeb, 0xd0, 0xe3, 0x85, 0x04, 0xaf, 0xd6, 0xac,
+	0x05, 0xbb, 0xe7, 0x47, 0xd4, 0xd2, 0x69, 0x49, 0xa7, 0x0b, 0x3a, 0xbd, 0xd6, 0xa9, 0xea, 0x5c,
+	0x8e, 0x26, 0xbe, 0xf3, 0x35, 0xf1, 0x8f, 0x93, 0xd4, 0xf4, 0x06, 0x11, 0x15, 0x3a, 0x63, 0x8b,
+	0x52, 0xed, 0x70, 0x0a, 0xf1, 0x23, 0x33, 0x2f, 0xb9, 0x84, 0xea, 0xc2, 0x70, 0xea, 0xd7, 0xef,
+	0x2a, 0xef, 0x70, 0xc1, 0x68, 0xbd, 0x21, 0x7c, 0xf0, 0xb7, 0xf4, 0x0e, 0xef, 0x73, 0x25, 0xe4,
+	0x3f, 0x12, 0x70, 0xbc, 0x55, 0x3e, 0xce, 0x1a, 0x01, 0xce, 0xca, 0x00, 0xc3, 0xa9, 0x1f, 0xac,
+	0x19, 0x00, 0x42, 0xeb, 0xdc, 0xe9, 0x8e, 0x66, 0x04, 0x8d, 0x67, 0x04, 0x7d, 0xce, 0x08, 0x7a,
+	0x9d, 0x13, 0x67, 0x3c, 0x27, 0xce, 0xc7, 0x9c, 0x38, 0xf7, 0x27, 0x2b, 0x56, 0xbf, 0x5d, 0x66,
+	0x90, 0x2b, 0xf6, 0xcc, 0x7e, 0xbe, 0x45, 0x65, 0x19, 0xd5, 0xab, 0xf6, 0x5d, 0x7c, 0x0f, 0x00,
+	0x1e, 0x72, 0xc8, 0xe3, 0x2f, 0x02, 0x00, 0x00,
 }
 
 func (m *MainnetAccount) Marshal() (dAtA []byte, err error) {
@@ -163,6 +227,55 @@ func (m *MainnetAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MainnetAccountBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MainnetAccountBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MainnetAccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMainnetAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMainnetAccount(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintMainnetAccount(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMainnetAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMainnetAccount(v)
 	base := offset
@@ -196,6 +309,28 @@ func (m *MainnetAccount) Size() (n int) {
 	return n
 }
 
+func (m *MainnetAccountBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovMainnetAccount(uint64(m.CampaignID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMainnetAccount(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovMainnetAccount(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMainnetAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -337,6 +472,141 @@ func (m *MainnetAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MainnetAccountBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMainnetAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MainnetAccountBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MainnetAccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMainnetAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMainnetAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
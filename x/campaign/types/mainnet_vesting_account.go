@@ -1,8 +1,8 @@
 package types
 
-// GetTotalShares return total shares for account and delayed vesting options
+// GetTotalShares return total shares for account and vesting options
 func (m MainnetVestingAccount) GetTotalShares() (Shares, error) {
-	vestingShares, err := m.VestingOptions.GetDelayedVestingShare()
+	vestingShares, err := m.VestingOptions.GetTotalVestingShares()
 	if err != nil {
 		return nil, err
 	}
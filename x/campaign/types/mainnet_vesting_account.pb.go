@@ -96,6 +96,8 @@ func (m *MainnetVestingAccount) GetVestingOptions() ShareVestingOptions {
 type ShareVestingOptions struct {
 	// Types that are valid to be assigned to Options:
 	//	*ShareVestingOptions_DelayedVesting
+	//	*ShareVestingOptions_ContinuousVesting
+	//	*ShareVestingOptions_PeriodicVesting
 	Options isShareVestingOptions_Options `protobuf_oneof:"options"`
 }
 
@@ -141,8 +143,16 @@ type isShareVestingOptions_Options interface {
 type ShareVestingOptions_DelayedVesting struct {
 	DelayedVesting *ShareDelayedVesting `protobuf:"bytes,1,opt,name=delayedVesting,proto3,oneof" json:"delayedVesting,omitempty"`
 }
+type ShareVestingOptions_ContinuousVesting struct {
+	ContinuousVesting *ShareContinuousVesting `protobuf:"bytes,2,opt,name=continuousVesting,proto3,oneof" json:"continuousVesting,omitempty"`
+}
+type ShareVestingOptions_PeriodicVesting struct {
+	PeriodicVesting *SharePeriodicVesting `protobuf:"bytes,3,opt,name=periodicVesting,proto3,oneof" json:"periodicVesting,omitempty"`
+}
 
-func (*ShareVestingOptions_DelayedVesting) isShareVestingOptions_Options() {}
+func (*ShareVestingOptions_DelayedVesting) isShareVestingOptions_Options()    {}
+func (*ShareVestingOptions_ContinuousVesting) isShareVestingOptions_Options() {}
+func (*ShareVestingOptions_PeriodicVesting) isShareVestingOptions_Options()   {}
 
 func (m *ShareVestingOptions) GetOptions() isShareVestingOptions_Options {
 	if m != nil {
@@ -158,10 +168,26 @@ func (m *ShareVestingOptions) GetDelayedVesting() *ShareDelayedVesting {
 	return nil
 }
 
+func (m *ShareVestingOptions) GetContinuousVesting() *ShareContinuousVesting {
+	if x, ok := m.GetOptions().(*ShareVestingOptions_ContinuousVesting); ok {
+		return x.ContinuousVesting
+	}
+	return nil
+}
+
+func (m *ShareVestingOptions) GetPeriodicVesting() *SharePeriodicVesting {
+	if x, ok := m.GetOptions().(*ShareVestingOptions_PeriodicVesting); ok {
+		return x.PeriodicVesting
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ShareVestingOptions) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ShareVestingOptions_DelayedVesting)(nil),
+		(*ShareVestingOptions_ContinuousVesting)(nil),
+		(*ShareVestingOptions_PeriodicVesting)(nil),
 	}
 }
 
@@ -219,10 +245,184 @@ func (m *ShareDelayedVesting) GetEndTime() int64 {
 	return 0
 }
 
+// ShareContinuousVesting represents options for share continuous vesting
+// Continuous vesting is the type of vesting where vesting coins are vested linearly between start time and end time
+type ShareContinuousVesting struct {
+	Vesting   Shares `protobuf:"bytes,1,rep,name=vesting,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"vesting"`
+	StartTime int64  `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime   int64  `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (m *ShareContinuousVesting) Reset()         { *m = ShareContinuousVesting{} }
+func (m *ShareContinuousVesting) String() string { return proto.CompactTextString(m) }
+func (*ShareContinuousVesting) ProtoMessage()    {}
+func (*ShareContinuousVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90d9b25b318c41d6, []int{3}
+}
+func (m *ShareContinuousVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareContinuousVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareContinuousVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareContinuousVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareContinuousVesting.Merge(m, src)
+}
+func (m *ShareContinuousVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareContinuousVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareContinuousVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareContinuousVesting proto.InternalMessageInfo
+
+func (m *ShareContinuousVesting) GetVesting() Shares {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
+func (m *ShareContinuousVesting) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ShareContinuousVesting) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// SharePeriodicVesting represents options for share periodic vesting
+// Periodic vesting is the type of vesting where the coins of each period are vested once the period ends
+// The periods are consecutive and the first period starts at start time
+type SharePeriodicVesting struct {
+	StartTime int64                `protobuf:"varint,1,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Periods   []ShareVestingPeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *SharePeriodicVesting) Reset()         { *m = SharePeriodicVesting{} }
+func (m *SharePeriodicVesting) String() string { return proto.CompactTextString(m) }
+func (*SharePeriodicVesting) ProtoMessage()    {}
+func (*SharePeriodicVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90d9b25b318c41d6, []int{4}
+}
+func (m *SharePeriodicVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharePeriodicVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharePeriodicVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SharePeriodicVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharePeriodicVesting.Merge(m, src)
+}
+func (m *SharePeriodicVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharePeriodicVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharePeriodicVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharePeriodicVesting proto.InternalMessageInfo
+
+func (m *SharePeriodicVesting) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *SharePeriodicVesting) GetPeriods() []ShareVestingPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+// ShareVestingPeriod is a period of a share periodic vesting
+type ShareVestingPeriod struct {
+	// length is the duration of the period in seconds
+	Length int64  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Amount Shares `protobuf:"bytes,2,rep,name=amount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"amount"`
+}
+
+func (m *ShareVestingPeriod) Reset()         { *m = ShareVestingPeriod{} }
+func (m *ShareVestingPeriod) String() string { return proto.CompactTextString(m) }
+func (*ShareVestingPeriod) ProtoMessage()    {}
+func (*ShareVestingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90d9b25b318c41d6, []int{5}
+}
+func (m *ShareVestingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareVestingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareVestingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareVestingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareVestingPeriod.Merge(m, src)
+}
+func (m *ShareVestingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareVestingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareVestingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareVestingPeriod proto.InternalMessageInfo
+
+func (m *ShareVestingPeriod) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *ShareVestingPeriod) GetAmount() Shares {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MainnetVestingAccount)(nil), "tendermint.spn.campaign.MainnetVestingAccount")
 	proto.RegisterType((*ShareVestingOptions)(nil), "tendermint.spn.campaign.ShareVestingOptions")
 	proto.RegisterType((*ShareDelayedVesting)(nil), "tendermint.spn.campaign.ShareDelayedVesting")
+	proto.RegisterType((*ShareContinuousVesting)(nil), "tendermint.spn.campaign.ShareContinuousVesting")
+	proto.RegisterType((*SharePeriodicVesting)(nil), "tendermint.spn.campaign.SharePeriodicVesting")
+	proto.RegisterType((*ShareVestingPeriod)(nil), "tendermint.spn.campaign.ShareVestingPeriod")
 }
 
 func init() {
@@ -230,33 +430,42 @@ func init() {
 }
 
 var fileDescriptor_90d9b25b318c41d6 = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x41, 0xcf, 0xd2, 0x30,
-	0x1c, 0xc6, 0xd7, 0x17, 0x02, 0xa1, 0x24, 0x1c, 0xa6, 0xc6, 0xc9, 0xa1, 0x2c, 0x1c, 0x74, 0x31,
-	0xda, 0x06, 0x3c, 0x79, 0x74, 0x72, 0xd0, 0x83, 0x31, 0x99, 0x86, 0x03, 0x17, 0xd2, 0x6d, 0xcd,
-	0x68, 0x74, 0xed, 0xb2, 0x16, 0x02, 0x89, 0x1f, 0xc2, 0x6f, 0xe0, 0x5d, 0xbf, 0x08, 0x47, 0x8e,
-	0x9e, 0xd0, 0xc0, 0xb7, 0xf0, 0x64, 0xb6, 0x6e, 0x08, 0x44, 0xe3, 0xe9, 0x3d, 0x6d, 0x6d, 0xff,
-	0xcf, 0xf3, 0x7b, 0xf2, 0xb4, 0xf0, 0x61, 0x44, 0xd3, 0x8c, 0xf2, 0x44, 0x90, 0x94, 0x72, 0x21,
-	0x98, 0x9e, 0xaf, 0x98, 0xd2, 0x5c, 0x24, 0x73, 0x1a, 0x45, 0x72, 0x29, 0x34, 0xce, 0x72, 0xa9,
-	0xa5, 0x7d, 0x5f, 0x33, 0x11, 0xb3, 0x3c, 0xe5, 0x42, 0x63, 0x95, 0x09, 0x5c, 0xcb, 0xfa, 0x77,
-	0x13, 0x99, 0xc8, 0x72, 0x86, 0x14, 0x7f, 0x66, 0xbc, 0x8f, 0x22, 0xa9, 0x52, 0xa9, 0x48, 0x48,
-	0x15, 0x23, 0xab, 0x51, 0xc8, 0x34, 0x1d, 0x91, 0x48, 0x72, 0x61, 0xce, 0x87, 0xdf, 0x6e, 0xe0,
-	0xbd, 0x37, 0x06, 0x38, 0x35, 0xbc, 0x17, 0x06, 0x67, 0x23, 0x08, 0x6b, 0xef, 0xd7, 0x13, 0x07,
-	0xb8, 0xc0, 0x6b, 0x06, 0x67, 0x3b, 0xb6, 0x03, 0xdb, 0x34, 0x8e, 0x73, 0xa6, 0x94, 0x73, 0xe3,
-	0x02, 0xaf, 0x13, 0xd4, 0x4b, 0xfb, 0x13, 0xec, 0x29, 0x4d, 0xf3, 0xc2, 0xec, 0xdd, 0x82, 0xe6,
-	0x4c, 0x39, 0x0d, 0xb7, 0xe1, 0x75, 0xc7, 0x0f, 0xb0, 0x09, 0x83, 0x8b, 0x30, 0xb8, 0x0a, 0x83,
-	0x5f, 0x4a, 0x2e, 0xfc, 0xe7, 0xdb, 0xfd, 0xc0, 0xfa, 0xb5, 0x1f, 0x3c, 0x4a, 0xb8, 0x5e, 0x2c,
-	0x43, 0x1c, 0xc9, 0x94, 0x54, 0xc9, 0xcd, 0xe7, 0xa9, 0x8a, 0x3f, 0x10, 0xbd, 0xc9, 0x98, 0x2a,
-	0x05, 0x5f, 0x7f, 0x0c, 0x5a, 0xc6, 0x3b, 0xb8, 0x62, 0xd9, 0x33, 0xd8, 0xab, 0x9a, 0x7b, 0x9b,
-	0x69, 0x2e, 0x85, 0x72, 0x9a, 0x2e, 0xf0, 0xba, 0xe3, 0x27, 0xf8, 0x1f, 0xcd, 0xe1, 0x52, 0x38,
-	0xbd, 0xd0, 0xf8, 0xcd, 0x22, 0x50, 0x70, 0xe5, 0x34, 0x5c, 0xc3, 0x3b, 0x7f, 0x19, 0xb6, 0xa7,
-	0xb0, 0x17, 0xb3, 0x8f, 0x74, 0xc3, 0xe2, 0xea, 0xa0, 0xac, 0xeb, 0xbf, 0xc8, 0xc9, 0x85, 0xe6,
-	0x95, 0x15, 0x5c, 0xb9, 0xf8, 0x1d, 0xd8, 0x96, 0x15, 0xf9, 0x0b, 0xa8, 0xd0, 0x97, 0x22, 0x5b,
-	0xc0, 0xf6, 0xea, 0xc4, 0xbc, 0xbd, 0x92, 0x6b, 0x48, 0x71, 0xeb, 0x4c, 0xc4, 0xef, 0x79, 0xca,
-	0xca, 0x5b, 0x6f, 0x04, 0xf5, 0xd2, 0x9f, 0x6c, 0x0f, 0x08, 0xec, 0x0e, 0x08, 0xfc, 0x3c, 0x20,
-	0xf0, 0xf9, 0x88, 0xac, 0xdd, 0x11, 0x59, 0xdf, 0x8f, 0xc8, 0x9a, 0x3d, 0x3e, 0xe3, 0xfd, 0x29,
-	0x84, 0xa8, 0x4c, 0x90, 0x35, 0x39, 0x3d, 0xfb, 0x92, 0x1b, 0xb6, 0xca, 0x67, 0xf9, 0xec, 0x77,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xfe, 0x11, 0x58, 0x0f, 0x03, 0x00, 0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x49, 0x94, 0x28, 0xaf, 0x52, 0x10, 0xd7, 0x52, 0x4c, 0x85, 0x9c, 0x28, 0x03,
+	0x44, 0x40, 0x7d, 0x6a, 0x99, 0x18, 0x49, 0x33, 0x04, 0x21, 0x04, 0x32, 0x28, 0x12, 0x5d, 0xa2,
+	0x8b, 0x7d, 0x72, 0x4e, 0xc4, 0x77, 0x96, 0xef, 0x12, 0x51, 0x89, 0x85, 0xff, 0x80, 0x99, 0x85,
+	0x1d, 0x56, 0xfe, 0x00, 0xc6, 0x8e, 0x1d, 0x99, 0x0a, 0x4a, 0xfe, 0x0b, 0x26, 0xe4, 0x5f, 0x6d,
+	0xe2, 0x86, 0xaa, 0x4b, 0x99, 0xec, 0xbb, 0x7b, 0xef, 0xf3, 0x7d, 0xef, 0x7b, 0x3f, 0xe0, 0x9e,
+	0x4b, 0x83, 0x90, 0x72, 0x5f, 0x90, 0x80, 0x72, 0x21, 0x98, 0x1e, 0xce, 0x98, 0xd2, 0x5c, 0xf8,
+	0x43, 0xea, 0xba, 0x72, 0x2a, 0xb4, 0x1d, 0x46, 0x52, 0x4b, 0x7c, 0x5b, 0x33, 0xe1, 0xb1, 0x28,
+	0xe0, 0x42, 0xdb, 0x2a, 0x14, 0x76, 0x9e, 0xb6, 0xb3, 0xe5, 0x4b, 0x5f, 0x26, 0x31, 0x24, 0xfe,
+	0x4b, 0xc3, 0x77, 0x2c, 0x57, 0xaa, 0x40, 0x2a, 0x32, 0xa2, 0x8a, 0x91, 0xd9, 0xde, 0x88, 0x69,
+	0xba, 0x47, 0x5c, 0xc9, 0x45, 0xba, 0xde, 0xfe, 0x56, 0x82, 0x5b, 0x2f, 0x52, 0xc1, 0x41, 0xaa,
+	0xf7, 0x34, 0x95, 0xc3, 0x16, 0x40, 0xce, 0x7e, 0xd6, 0x33, 0x51, 0x0b, 0x75, 0x2a, 0xce, 0xd2,
+	0x0c, 0x36, 0xa1, 0x46, 0x3d, 0x2f, 0x62, 0x4a, 0x99, 0xa5, 0x16, 0xea, 0xd4, 0x9d, 0x7c, 0x88,
+	0x3f, 0x40, 0x43, 0x69, 0x1a, 0xc5, 0xb0, 0xd7, 0x63, 0x1a, 0x31, 0x65, 0x96, 0x5b, 0xe5, 0xce,
+	0xc6, 0xfe, 0x1d, 0x3b, 0x2d, 0xc6, 0x8e, 0x8b, 0xb1, 0xb3, 0x62, 0xec, 0x03, 0xc9, 0x45, 0xf7,
+	0xc9, 0xf1, 0x69, 0xd3, 0xf8, 0x73, 0xda, 0xbc, 0xef, 0x73, 0x3d, 0x9e, 0x8e, 0x6c, 0x57, 0x06,
+	0x24, 0xab, 0x3c, 0xfd, 0xec, 0x2a, 0xef, 0x1d, 0xd1, 0x47, 0x21, 0x53, 0x49, 0xc2, 0xd7, 0x5f,
+	0xcd, 0x6a, 0xca, 0x76, 0x0a, 0x5a, 0xf8, 0x10, 0x1a, 0x99, 0x73, 0x2f, 0x43, 0xcd, 0xa5, 0x50,
+	0x66, 0xa5, 0x85, 0x3a, 0x1b, 0xfb, 0x8f, 0xec, 0x7f, 0x38, 0x67, 0x27, 0x89, 0x83, 0x95, 0x9c,
+	0x6e, 0x25, 0x2e, 0xc8, 0x29, 0x90, 0xda, 0xdf, 0x4b, 0xb0, 0xb9, 0x26, 0x1a, 0x0f, 0xa0, 0xe1,
+	0xb1, 0x09, 0x3d, 0x62, 0x5e, 0xb6, 0x60, 0xa2, 0xab, 0x68, 0xf6, 0x56, 0x72, 0xfa, 0x86, 0x53,
+	0xa0, 0xe0, 0x21, 0xdc, 0x74, 0xa5, 0xd0, 0x5c, 0x4c, 0xe5, 0x54, 0xe5, 0xe8, 0x52, 0x82, 0x26,
+	0x97, 0xa3, 0x0f, 0x8a, 0x69, 0x7d, 0xc3, 0xb9, 0xc8, 0xc2, 0x6f, 0xe1, 0x46, 0xc8, 0x22, 0x2e,
+	0x3d, 0xee, 0xe6, 0xf8, 0x72, 0x82, 0xdf, 0xbd, 0x1c, 0xff, 0x6a, 0x35, 0xa9, 0x6f, 0x38, 0x45,
+	0x4e, 0xb7, 0x0e, 0x35, 0x99, 0xd9, 0xf6, 0x05, 0xc1, 0xe6, 0x9a, 0x86, 0xb1, 0x80, 0xda, 0xec,
+	0xcc, 0xaf, 0xeb, 0x3b, 0x21, 0xb9, 0x48, 0x7c, 0x64, 0x99, 0xf0, 0xde, 0xf0, 0x80, 0x25, 0x26,
+	0x96, 0x9d, 0x7c, 0xd8, 0xfe, 0x81, 0x60, 0x7b, 0xbd, 0x6f, 0xff, 0xbd, 0xc8, 0xbb, 0x50, 0x4f,
+	0x4e, 0xf4, 0x52, 0x99, 0xe7, 0x13, 0xcb, 0x2d, 0x94, 0x57, 0x5b, 0xf8, 0x88, 0x60, 0x6b, 0xdd,
+	0xde, 0xac, 0x02, 0x51, 0x11, 0xf8, 0x1c, 0x6a, 0xe9, 0xce, 0xc5, 0xd7, 0x38, 0x6e, 0xef, 0xe1,
+	0x95, 0xee, 0x49, 0x2a, 0x92, 0x5d, 0x93, 0x9c, 0xd0, 0xfe, 0x8c, 0x00, 0x5f, 0x8c, 0xc2, 0xdb,
+	0x50, 0x9d, 0x30, 0xe1, 0xeb, 0x71, 0x26, 0x9f, 0x8d, 0xf0, 0x04, 0xaa, 0x34, 0x88, 0x1f, 0x1b,
+	0xb3, 0x74, 0x8d, 0xce, 0x66, 0x1a, 0xdd, 0xde, 0xf1, 0xdc, 0x42, 0x27, 0x73, 0x0b, 0xfd, 0x9e,
+	0x5b, 0xe8, 0xd3, 0xc2, 0x32, 0x4e, 0x16, 0x96, 0xf1, 0x73, 0x61, 0x19, 0x87, 0x0f, 0x96, 0xa0,
+	0xe7, 0xcd, 0x13, 0x15, 0x0a, 0xf2, 0x9e, 0x9c, 0xbd, 0xcb, 0x09, 0x7c, 0x54, 0x4d, 0xde, 0xcd,
+	0xc7, 0x7f, 0x07, 0x00, 0x38, 0x43, 0xf2, 0xf0, 0xb0, 0x05, 0x00, 0x00,
 }
 
 func (m *MainnetVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -371,6 +580,48 @@ func (m *ShareVestingOptions_DelayedVesting) MarshalToSizedBuffer(dAtA []byte) (
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ShareVestingOptions_ContinuousVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareVestingOptions_ContinuousVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ContinuousVesting != nil {
+		{
+			size, err := m.ContinuousVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMainnetVestingAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShareVestingOptions_PeriodicVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareVestingOptions_PeriodicVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PeriodicVesting != nil {
+		{
+			size, err := m.PeriodicVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMainnetVestingAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ShareDelayedVesting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -413,6 +664,137 @@ func (m *ShareDelayedVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ShareContinuousVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareContinuousVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareContinuousVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintMainnetVestingAccount(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintMainnetVestingAccount(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Vesting) > 0 {
+		for iNdEx := len(m.Vesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMainnetVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SharePeriodicVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SharePeriodicVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharePeriodicVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMainnetVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintMainnetVestingAccount(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShareVestingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareVestingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareVestingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMainnetVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Length != 0 {
+		i = encodeVarintMainnetVestingAccount(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMainnetVestingAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMainnetVestingAccount(v)
 	base := offset
@@ -472,17 +854,41 @@ func (m *ShareVestingOptions_DelayedVesting) Size() (n int) {
 	}
 	return n
 }
-func (m *ShareDelayedVesting) Size() (n int) {
+func (m *ShareVestingOptions_ContinuousVesting) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Vesting) > 0 {
-		for _, e := range m.Vesting {
-			l = e.Size()
-			n += 1 + l + sovMainnetVestingAccount(uint64(l))
-		}
+	if m.ContinuousVesting != nil {
+		l = m.ContinuousVesting.Size()
+		n += 1 + l + sovMainnetVestingAccount(uint64(l))
+	}
+	return n
+}
+func (m *ShareVestingOptions_PeriodicVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodicVesting != nil {
+		l = m.PeriodicVesting.Size()
+		n += 1 + l + sovMainnetVestingAccount(uint64(l))
+	}
+	return n
+}
+func (m *ShareDelayedVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovMainnetVestingAccount(uint64(l))
+		}
 	}
 	if m.EndTime != 0 {
 		n += 1 + sovMainnetVestingAccount(uint64(m.EndTime))
@@ -490,6 +896,63 @@ func (m *ShareDelayedVesting) Size() (n int) {
 	return n
 }
 
+func (m *ShareContinuousVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovMainnetVestingAccount(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovMainnetVestingAccount(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovMainnetVestingAccount(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *SharePeriodicVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovMainnetVestingAccount(uint64(m.StartTime))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovMainnetVestingAccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShareVestingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovMainnetVestingAccount(uint64(m.Length))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMainnetVestingAccount(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMainnetVestingAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -728,6 +1191,76 @@ func (m *ShareVestingOptions) Unmarshal(dAtA []byte) error {
 			}
 			m.Options = &ShareVestingOptions_DelayedVesting{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ShareContinuousVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Options = &ShareVestingOptions_ContinuousVesting{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SharePeriodicVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Options = &ShareVestingOptions_PeriodicVesting{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMainnetVestingAccount(dAtA[iNdEx:])
@@ -852,6 +1385,334 @@ func (m *ShareDelayedVesting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ShareContinuousVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMainnetVestingAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareContinuousVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareContinuousVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vesting = append(m.Vesting, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMainnetVestingAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SharePeriodicVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMainnetVestingAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SharePeriodicVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SharePeriodicVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, ShareVestingPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMainnetVestingAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareVestingPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMainnetVestingAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareVestingPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareVestingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMainnetVestingAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMainnetVestingAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMainnetVestingAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
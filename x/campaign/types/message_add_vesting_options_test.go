@@ -24,6 +24,24 @@ func TestMsgAddVestingOptions_ValidateBasic(t *testing.T) {
 				VestingOptions: sample.ShareVestingOptions(),
 			},
 		},
+		{
+			name: "valid message with continuous vesting",
+			msg: types.MsgAddVestingOptions{
+				Coordinator:    sample.Address(),
+				CampaignID:     0,
+				StartingShares: sample.Shares(),
+				VestingOptions: sample.ShareContinuousVestingOptions(),
+			},
+		},
+		{
+			name: "valid message with periodic vesting",
+			msg: types.MsgAddVestingOptions{
+				Coordinator:    sample.Address(),
+				CampaignID:     0,
+				StartingShares: sample.Shares(),
+				VestingOptions: sample.SharePeriodicVestingOptions(),
+			},
+		},
 		{
 			name: "invalid address",
 			msg: types.MsgAddVestingOptions{
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBuyVouchers = "buy_vouchers"
+
+var _ sdk.Msg = &MsgBuyVouchers{}
+
+func NewMsgBuyVouchers(buyer string, saleID uint64, vouchers sdk.Coin) *MsgBuyVouchers {
+	return &MsgBuyVouchers{
+		Buyer:    buyer,
+		SaleID:   saleID,
+		Vouchers: vouchers,
+	}
+}
+
+func (msg *MsgBuyVouchers) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBuyVouchers) Type() string {
+	return TypeMsgBuyVouchers
+}
+
+func (msg *MsgBuyVouchers) GetSigners() []sdk.AccAddress {
+	buyer, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{buyer}
+}
+
+func (msg *MsgBuyVouchers) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBuyVouchers) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	if !msg.Vouchers.IsValid() || !msg.Vouchers.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidVouchers, msg.Vouchers.String())
+	}
+	return nil
+}
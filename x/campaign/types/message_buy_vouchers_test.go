@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgBuyVouchers_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgBuyVouchers
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgBuyVouchers{
+				Buyer:    sample.Address(),
+				SaleID:   0,
+				Vouchers: sample.Voucher(0),
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgBuyVouchers{
+				Buyer:    "invalid_address",
+				SaleID:   0,
+				Vouchers: sample.Voucher(0),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid vouchers",
+			msg: types.MsgBuyVouchers{
+				Buyer:    sample.Address(),
+				SaleID:   0,
+				Vouchers: sdk.Coin{Denom: "invalid denom", Amount: sdk.OneInt()},
+			},
+			err: types.ErrInvalidVouchers,
+		},
+		{
+			name: "zero vouchers",
+			msg: types.MsgBuyVouchers{
+				Buyer:    sample.Address(),
+				SaleID:   0,
+				Vouchers: sdk.NewCoin(types.VoucherDenom(0, "foo"), sdk.ZeroInt()),
+			},
+			err: types.ErrInvalidVouchers,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateAuction = "create_auction"
+
+var _ sdk.Msg = &MsgCreateAuction{}
+
+func NewMsgCreateAuction(
+	coordinator string,
+	campaignID uint64,
+	vouchers,
+	minPrice sdk.Coin,
+	startTime,
+	endTime int64,
+) *MsgCreateAuction {
+	return &MsgCreateAuction{
+		Coordinator: coordinator,
+		CampaignID:  campaignID,
+		Vouchers:    vouchers,
+		MinPrice:    minPrice,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+}
+
+func (msg *MsgCreateAuction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateAuction) Type() string {
+	return TypeMsgCreateAuction
+}
+
+func (msg *MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgCreateAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+
+	if err := CheckSaleVouchers(msg.Vouchers, msg.CampaignID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVouchers, err.Error())
+	}
+
+	if err := CheckSalePrice(msg.MinPrice); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAuction, err.Error())
+	}
+	if err := CheckPriceDenom(msg.MinPrice, msg.Vouchers); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAuction, err.Error())
+	}
+
+	if err := CheckSaleTimes(msg.StartTime, msg.EndTime); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAuction, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgCreateAuction_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgCreateAuction {
+		return *types.NewMsgCreateAuction(
+			sample.Address(),
+			0,
+			sample.Voucher(0),
+			sample.Coin(),
+			1000,
+			2000,
+		)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		update func(msg *types.MsgCreateAuction)
+		err    error
+	}{
+		{
+			name:   "valid message",
+			update: func(msg *types.MsgCreateAuction) {},
+		},
+		{
+			name: "invalid address",
+			update: func(msg *types.MsgCreateAuction) {
+				msg.Coordinator = "invalid_address"
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "vouchers of another campaign",
+			update: func(msg *types.MsgCreateAuction) {
+				msg.Vouchers = sample.Voucher(1)
+			},
+			err: types.ErrInvalidVouchers,
+		},
+		{
+			name: "zero vouchers",
+			update: func(msg *types.MsgCreateAuction) {
+				msg.Vouchers.Amount = sdk.ZeroInt()
+			},
+			err: types.ErrInvalidVouchers,
+		},
+		{
+			name: "zero min price",
+			update: func(msg *types.MsgCreateAuction) {
+				msg.MinPrice.Amount = sdk.ZeroInt()
+			},
+			err: types.ErrInvalidAuction,
+		},
+		{
+			name: "min price in the sold vouchers",
+			update: func(msg *types.MsgCreateAuction) {
+				msg.MinPrice.Denom = msg.Vouchers.Denom
+			},
+			err: types.ErrInvalidAuction,
+		},
+		{
+			name: "zero start time",
+			update: func(msg *types.MsgCreateAuction) {
+				msg.StartTime = 0
+			},
+			err: types.ErrInvalidAuction,
+		},
+		{
+			name: "end time before start time",
+			update: func(msg *types.MsgCreateAuction) {
+				msg.EndTime = 999
+			},
+			err: types.ErrInvalidAuction,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := validMsg()
+			tc.update(&msg)
+			err := msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
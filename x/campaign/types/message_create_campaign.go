@@ -14,12 +14,14 @@ func NewMsgCreateCampaign(
 	campaignName string,
 	totalSupply sdk.Coins,
 	dynamicShares bool,
+	voucherTransferDisabled bool,
 ) *MsgCreateCampaign {
 	return &MsgCreateCampaign{
-		Coordinator:   coordinator,
-		CampaignName:  campaignName,
-		TotalSupply:   totalSupply,
-		DynamicShares: dynamicShares,
+		Coordinator:             coordinator,
+		CampaignName:            campaignName,
+		TotalSupply:             totalSupply,
+		DynamicShares:           dynamicShares,
+		VoucherTransferDisabled: voucherTransferDisabled,
 	}
 }
 
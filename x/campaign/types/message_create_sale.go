@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateSale = "create_sale"
+
+var _ sdk.Msg = &MsgCreateSale{}
+
+func NewMsgCreateSale(
+	coordinator string,
+	campaignID uint64,
+	vouchers,
+	price sdk.Coin,
+	startTime,
+	endTime int64,
+	maxPerAddress sdk.Int,
+) *MsgCreateSale {
+	return &MsgCreateSale{
+		Coordinator:   coordinator,
+		CampaignID:    campaignID,
+		Vouchers:      vouchers,
+		Price:         price,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		MaxPerAddress: maxPerAddress,
+	}
+}
+
+func (msg *MsgCreateSale) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateSale) Type() string {
+	return TypeMsgCreateSale
+}
+
+func (msg *MsgCreateSale) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgCreateSale) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateSale) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+
+	if err := CheckSaleVouchers(msg.Vouchers, msg.CampaignID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVouchers, err.Error())
+	}
+
+	if err := CheckSalePrice(msg.Price); err != nil {
+		return sdkerrors.Wrap(ErrInvalidSale, err.Error())
+	}
+	if err := CheckPriceDenom(msg.Price, msg.Vouchers); err != nil {
+		return sdkerrors.Wrap(ErrInvalidSale, err.Error())
+	}
+
+	if err := CheckSaleTimes(msg.StartTime, msg.EndTime); err != nil {
+		return sdkerrors.Wrap(ErrInvalidSale, err.Error())
+	}
+
+	if msg.MaxPerAddress.IsNil() || msg.MaxPerAddress.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidSale, "max vouchers per address can't be negative")
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgCreateSale_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgCreateSale {
+		return *types.NewMsgCreateSale(
+			sample.Address(),
+			0,
+			sample.Voucher(0),
+			sample.Coin(),
+			1000,
+			2000,
+			sdk.ZeroInt(),
+		)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		update func(msg *types.MsgCreateSale)
+		err    error
+	}{
+		{
+			name:   "valid message",
+			update: func(msg *types.MsgCreateSale) {},
+		},
+		{
+			name: "valid message with max per address",
+			update: func(msg *types.MsgCreateSale) {
+				msg.MaxPerAddress = sdk.NewInt(100)
+			},
+		},
+		{
+			name: "invalid address",
+			update: func(msg *types.MsgCreateSale) {
+				msg.Coordinator = "invalid_address"
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "vouchers of another campaign",
+			update: func(msg *types.MsgCreateSale) {
+				msg.Vouchers = sample.Voucher(1)
+			},
+			err: types.ErrInvalidVouchers,
+		},
+		{
+			name: "zero vouchers",
+			update: func(msg *types.MsgCreateSale) {
+				msg.Vouchers.Amount = sdk.ZeroInt()
+			},
+			err: types.ErrInvalidVouchers,
+		},
+		{
+			name: "zero price",
+			update: func(msg *types.MsgCreateSale) {
+				msg.Price.Amount = sdk.ZeroInt()
+			},
+			err: types.ErrInvalidSale,
+		},
+		{
+			name: "price in the sold vouchers",
+			update: func(msg *types.MsgCreateSale) {
+				msg.Price.Denom = msg.Vouchers.Denom
+			},
+			err: types.ErrInvalidSale,
+		},
+		{
+			name: "zero start time",
+			update: func(msg *types.MsgCreateSale) {
+				msg.StartTime = 0
+			},
+			err: types.ErrInvalidSale,
+		},
+		{
+			name: "end time before start time",
+			update: func(msg *types.MsgCreateSale) {
+				msg.EndTime = 999
+			},
+			err: types.ErrInvalidSale,
+		},
+		{
+			name: "negative max per address",
+			update: func(msg *types.MsgCreateSale) {
+				msg.MaxPerAddress = sdk.NewInt(-1)
+			},
+			err: types.ErrInvalidSale,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := validMsg()
+			tc.update(&msg)
+			err := msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
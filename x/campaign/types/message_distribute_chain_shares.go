@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDistributeChainShares = "distribute_chain_shares"
+
+var _ sdk.Msg = &MsgDistributeChainShares{}
+
+func NewMsgDistributeChainShares(
+	coordinator string,
+	campaignID,
+	launchID uint64,
+	distributions []ShareDistribution,
+) *MsgDistributeChainShares {
+	return &MsgDistributeChainShares{
+		Coordinator:   coordinator,
+		CampaignID:    campaignID,
+		LaunchID:      launchID,
+		Distributions: distributions,
+	}
+}
+
+func (msg *MsgDistributeChainShares) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDistributeChainShares) Type() string {
+	return TypeMsgDistributeChainShares
+}
+
+func (msg *MsgDistributeChainShares) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgDistributeChainShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDistributeChainShares) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+
+	if len(msg.Distributions) == 0 {
+		return sdkerrors.Wrap(ErrInvalidShares, "no share distribution")
+	}
+
+	addresses := make(map[string]struct{})
+	for _, distribution := range msg.Distributions {
+		if _, err := sdk.AccAddressFromBech32(distribution.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid distribution address (%s)", err)
+		}
+		if _, ok := addresses[distribution.Address]; ok {
+			return sdkerrors.Wrapf(ErrInvalidShares, "duplicated distribution for %s", distribution.Address)
+		}
+		addresses[distribution.Address] = struct{}{}
+
+		if !sdk.Coins(distribution.Shares).IsValid() || sdk.Coins(distribution.Shares).Empty() {
+			return sdkerrors.Wrapf(ErrInvalidShares, "invalid shares for %s", distribution.Address)
+		}
+		if err := CheckShares(distribution.Shares); err != nil {
+			return sdkerrors.Wrap(ErrInvalidShares, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// TotalShares returns the sum of the distributed shares
+func (msg *MsgDistributeChainShares) TotalShares() Shares {
+	total := EmptyShares()
+	for _, distribution := range msg.Distributions {
+		total = IncreaseShares(total, distribution.Shares)
+	}
+	return total
+}
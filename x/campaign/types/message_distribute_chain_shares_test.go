@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgDistributeChainShares_ValidateBasic(t *testing.T) {
+	addr := sample.Address()
+
+	tests := []struct {
+		name string
+		msg  types.MsgDistributeChainShares
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgDistributeChainShares{
+				Coordinator: sample.Address(),
+				Distributions: []types.ShareDistribution{
+					{Address: sample.Address(), Shares: sample.Shares()},
+					{Address: sample.Address(), Shares: sample.Shares()},
+				},
+			},
+		},
+		{
+			name: "invalid coordinator address",
+			msg: types.MsgDistributeChainShares{
+				Coordinator: "invalid_address",
+				Distributions: []types.ShareDistribution{
+					{Address: sample.Address(), Shares: sample.Shares()},
+				},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no distribution",
+			msg: types.MsgDistributeChainShares{
+				Coordinator: sample.Address(),
+			},
+			err: types.ErrInvalidShares,
+		},
+		{
+			name: "invalid distribution address",
+			msg: types.MsgDistributeChainShares{
+				Coordinator: sample.Address(),
+				Distributions: []types.ShareDistribution{
+					{Address: "invalid_address", Shares: sample.Shares()},
+				},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "duplicated distribution address",
+			msg: types.MsgDistributeChainShares{
+				Coordinator: sample.Address(),
+				Distributions: []types.ShareDistribution{
+					{Address: addr, Shares: sample.Shares()},
+					{Address: addr, Shares: sample.Shares()},
+				},
+			},
+			err: types.ErrInvalidShares,
+		},
+		{
+			name: "invalid shares",
+			msg: types.MsgDistributeChainShares{
+				Coordinator: sample.Address(),
+				Distributions: []types.ShareDistribution{
+					{Address: sample.Address(), Shares: invalidShares},
+				},
+			},
+			err: types.ErrInvalidShares,
+		},
+		{
+			name: "empty shares",
+			msg: types.MsgDistributeChainShares{
+				Coordinator: sample.Address(),
+				Distributions: []types.ShareDistribution{
+					{Address: sample.Address(), Shares: types.Shares{}},
+				},
+			},
+			err: types.ErrInvalidShares,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPlaceBid = "place_bid"
+
+var _ sdk.Msg = &MsgPlaceBid{}
+
+func NewMsgPlaceBid(bidder string, auctionID uint64, price sdk.Coin, quantity sdk.Int) *MsgPlaceBid {
+	return &MsgPlaceBid{
+		Bidder:    bidder,
+		AuctionID: auctionID,
+		Price:     price,
+		Quantity:  quantity,
+	}
+}
+
+func (msg *MsgPlaceBid) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceBid) Type() string {
+	return TypeMsgPlaceBid
+}
+
+func (msg *MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{bidder}
+}
+
+func (msg *MsgPlaceBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlaceBid) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+
+	if err := CheckSalePrice(msg.Price); err != nil {
+		return sdkerrors.Wrap(ErrInvalidBid, err.Error())
+	}
+
+	if msg.Quantity.IsNil() || !msg.Quantity.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidBid, "bid quantity must be positive")
+	}
+	return nil
+}
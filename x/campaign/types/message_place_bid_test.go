@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgPlaceBid {
+		return *types.NewMsgPlaceBid(sample.Address(), 0, sample.Coin(), sdk.NewInt(10))
+	}
+
+	for _, tc := range []struct {
+		name   string
+		update func(msg *types.MsgPlaceBid)
+		err    error
+	}{
+		{
+			name:   "valid message",
+			update: func(msg *types.MsgPlaceBid) {},
+		},
+		{
+			name: "invalid address",
+			update: func(msg *types.MsgPlaceBid) {
+				msg.Bidder = "invalid_address"
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero price",
+			update: func(msg *types.MsgPlaceBid) {
+				msg.Price.Amount = sdk.ZeroInt()
+			},
+			err: types.ErrInvalidBid,
+		},
+		{
+			name: "invalid price denom",
+			update: func(msg *types.MsgPlaceBid) {
+				msg.Price.Denom = "invalid denom"
+			},
+			err: types.ErrInvalidBid,
+		},
+		{
+			name: "zero quantity",
+			update: func(msg *types.MsgPlaceBid) {
+				msg.Quantity = sdk.ZeroInt()
+			},
+			err: types.ErrInvalidBid,
+		},
+		{
+			name: "negative quantity",
+			update: func(msg *types.MsgPlaceBid) {
+				msg.Quantity = sdk.NewInt(-1)
+			},
+			err: types.ErrInvalidBid,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := validMsg()
+			tc.update(&msg)
+			err := msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgReserveChainShares = "reserve_chain_shares"
+
+var _ sdk.Msg = &MsgReserveChainShares{}
+
+func NewMsgReserveChainShares(coordinator string, campaignID, launchID uint64, shares Shares) *MsgReserveChainShares {
+	return &MsgReserveChainShares{
+		Coordinator: coordinator,
+		CampaignID:  campaignID,
+		LaunchID:    launchID,
+		Shares:      shares,
+	}
+}
+
+func (msg *MsgReserveChainShares) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReserveChainShares) Type() string {
+	return TypeMsgReserveChainShares
+}
+
+func (msg *MsgReserveChainShares) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgReserveChainShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReserveChainShares) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+
+	if !sdk.Coins(msg.Shares).IsValid() {
+		return sdkerrors.Wrap(ErrInvalidShares, sdk.Coins(msg.Shares).String())
+	}
+
+	if sdk.Coins(msg.Shares).Empty() {
+		return sdkerrors.Wrap(ErrInvalidShares, "shares is empty")
+	}
+
+	if err := CheckShares(msg.Shares); err != nil {
+		return sdkerrors.Wrap(ErrInvalidShares, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgWithdrawAuction = "withdraw_auction"
+
+var _ sdk.Msg = &MsgWithdrawAuction{}
+
+func NewMsgWithdrawAuction(coordinator string, auctionID uint64) *MsgWithdrawAuction {
+	return &MsgWithdrawAuction{
+		Coordinator: coordinator,
+		AuctionID:   auctionID,
+	}
+}
+
+func (msg *MsgWithdrawAuction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawAuction) Type() string {
+	return TypeMsgWithdrawAuction
+}
+
+func (msg *MsgWithdrawAuction) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgWithdrawAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWithdrawAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+	return nil
+}
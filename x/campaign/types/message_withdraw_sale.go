@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgWithdrawSale = "withdraw_sale"
+
+var _ sdk.Msg = &MsgWithdrawSale{}
+
+func NewMsgWithdrawSale(coordinator string, saleID uint64) *MsgWithdrawSale {
+	return &MsgWithdrawSale{
+		Coordinator: coordinator,
+		SaleID:      saleID,
+	}
+}
+
+func (msg *MsgWithdrawSale) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawSale) Type() string {
+	return TypeMsgWithdrawSale
+}
+
+func (msg *MsgWithdrawSale) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgWithdrawSale) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWithdrawSale) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+	return nil
+}
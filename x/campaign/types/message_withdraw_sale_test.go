@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgWithdrawSale_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgWithdrawSale
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgWithdrawSale{
+				Coordinator: sample.Address(),
+				SaleID:      0,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgWithdrawSale{
+				Coordinator: "invalid_address",
+				SaleID:      0,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
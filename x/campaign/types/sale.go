@@ -0,0 +1,114 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSale returns a new fixed-price sale of vouchers
+func NewSale(
+	id,
+	campaignID uint64,
+	vouchers,
+	price sdk.Coin,
+	startTime,
+	endTime int64,
+	maxPerAddress sdk.Int,
+) Sale {
+	return Sale{
+		Id:            id,
+		CampaignID:    campaignID,
+		Vouchers:      vouchers,
+		Price:         price,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		MaxPerAddress: maxPerAddress,
+		Sold:          sdk.ZeroInt(),
+	}
+}
+
+// Validate checks the sale is valid
+func (m Sale) Validate() error {
+	if err := CheckSaleVouchers(m.Vouchers, m.CampaignID); err != nil {
+		return err
+	}
+	if err := CheckSalePrice(m.Price); err != nil {
+		return err
+	}
+	if err := CheckPriceDenom(m.Price, m.Vouchers); err != nil {
+		return err
+	}
+	if err := CheckSaleTimes(m.StartTime, m.EndTime); err != nil {
+		return err
+	}
+	if m.MaxPerAddress.IsNil() || m.MaxPerAddress.IsNegative() {
+		return errors.New("max vouchers per address can't be negative")
+	}
+	if m.Sold.IsNil() || m.Sold.IsNegative() {
+		return errors.New("sold vouchers can't be negative")
+	}
+	if m.Sold.GT(m.Vouchers.Amount) {
+		return fmt.Errorf("sold vouchers %s greater than sale vouchers %s", m.Sold, m.Vouchers.Amount)
+	}
+	if m.Withdrawn && !m.Settled {
+		return errors.New("sale withdrawn before being settled")
+	}
+	return nil
+}
+
+// IsActive returns true if vouchers can be bought in the sale at the provided timestamp
+func (m Sale) IsActive(timestamp int64) bool {
+	return !m.Settled && timestamp >= m.StartTime && timestamp < m.EndTime
+}
+
+// RemainingVouchers returns the amount of vouchers that can still be bought in the sale
+func (m Sale) RemainingVouchers() sdk.Int {
+	return m.Vouchers.Amount.Sub(m.Sold)
+}
+
+// Cost returns the payment required to buy the provided amount of vouchers in the sale
+func (m Sale) Cost(amount sdk.Int) sdk.Coin {
+	return sdk.NewCoin(m.Price.Denom, m.Price.Amount.Mul(amount))
+}
+
+// Proceeds returns the total payment received for the vouchers sold in the sale
+func (m Sale) Proceeds() sdk.Coin {
+	return m.Cost(m.Sold)
+}
+
+// CheckSaleVouchers verifies the vouchers can be offered in a sale of the campaign
+func CheckSaleVouchers(vouchers sdk.Coin, campaignID uint64) error {
+	if !vouchers.IsValid() || !vouchers.IsPositive() {
+		return fmt.Errorf("invalid sale vouchers: %s", vouchers.String())
+	}
+	return CheckVouchers(sdk.NewCoins(vouchers), campaignID)
+}
+
+// CheckSalePrice verifies the price is valid for a sale
+func CheckSalePrice(price sdk.Coin) error {
+	if !price.IsValid() || !price.IsPositive() {
+		return fmt.Errorf("invalid sale price: %s", price.String())
+	}
+	return nil
+}
+
+// CheckPriceDenom verifies the vouchers offered in a sale or an auction are not paid with themselves
+func CheckPriceDenom(price, vouchers sdk.Coin) error {
+	if price.Denom == vouchers.Denom {
+		return fmt.Errorf("the price denom can't be the sold voucher denom %s", vouchers.Denom)
+	}
+	return nil
+}
+
+// CheckSaleTimes verifies the start and end times are valid for a sale
+func CheckSaleTimes(startTime, endTime int64) error {
+	if startTime <= 0 {
+		return errors.New("start time of the sale must be positive")
+	}
+	if endTime <= startTime {
+		return fmt.Errorf("end time of the sale must be after start time: %d <= %d", endTime, startTime)
+	}
+	return nil
+}
@@ -0,0 +1,964 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: campaign/sale.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Sale is a fixed-price sale of the vouchers of a campaign
+type Sale struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CampaignID uint64 `protobuf:"varint,2,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	// vouchers offered by the sale, they are escrowed in the module account until the sale is withdrawn
+	Vouchers types.Coin `protobuf:"bytes,3,opt,name=vouchers,proto3" json:"vouchers"`
+	// price of one unit of voucher, the denom of the price is the payment denom of the sale
+	Price     types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	StartTime int64      `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime   int64      `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// maxPerAddress is the maximum amount of vouchers an address can buy, there is no limit if zero
+	MaxPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=maxPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"maxPerAddress"`
+	// sold is the amount of vouchers bought during the sale
+	Sold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=sold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"sold"`
+	// settled is set once the end time of the sale is passed, the sale can then be withdrawn by the coordinator
+	Settled   bool `protobuf:"varint,9,opt,name=settled,proto3" json:"settled,omitempty"`
+	Withdrawn bool `protobuf:"varint,10,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+}
+
+func (m *Sale) Reset()         { *m = Sale{} }
+func (m *Sale) String() string { return proto.CompactTextString(m) }
+func (*Sale) ProtoMessage()    {}
+func (*Sale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef414c4153e0c61e, []int{0}
+}
+func (m *Sale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sale.Merge(m, src)
+}
+func (m *Sale) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sale) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sale proto.InternalMessageInfo
+
+func (m *Sale) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Sale) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *Sale) GetVouchers() types.Coin {
+	if m != nil {
+		return m.Vouchers
+	}
+	return types.Coin{}
+}
+
+func (m *Sale) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Sale) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *Sale) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *Sale) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
+func (m *Sale) GetWithdrawn() bool {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return false
+}
+
+// SalePurchase is the amount of vouchers bought by an address in a sale
+type SalePurchase struct {
+	SaleID  uint64                                 `protobuf:"varint,1,opt,name=saleID,proto3" json:"saleID,omitempty"`
+	Address string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *SalePurchase) Reset()         { *m = SalePurchase{} }
+func (m *SalePurchase) String() string { return proto.CompactTextString(m) }
+func (*SalePurchase) ProtoMessage()    {}
+func (*SalePurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef414c4153e0c61e, []int{1}
+}
+func (m *SalePurchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SalePurchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SalePurchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SalePurchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SalePurchase.Merge(m, src)
+}
+func (m *SalePurchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *SalePurchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_SalePurchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SalePurchase proto.InternalMessageInfo
+
+func (m *SalePurchase) GetSaleID() uint64 {
+	if m != nil {
+		return m.SaleID
+	}
+	return 0
+}
+
+func (m *SalePurchase) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Sale)(nil), "tendermint.spn.campaign.Sale")
+	proto.RegisterType((*SalePurchase)(nil), "tendermint.spn.campaign.SalePurchase")
+}
+
+func init() { proto.RegisterFile("campaign/sale.proto", fileDescriptor_ef414c4153e0c61e) }
+
+var fileDescriptor_ef414c4153e0c61e = []byte{
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xaf, 0xdb, 0xac, 0x6b, 0xcd, 0x9f, 0x83, 0x41, 0x60, 0x26, 0x94, 0x45, 0x3b, 0xa0, 0x08,
+	0x09, 0x5b, 0x03, 0x71, 0xe2, 0x44, 0xa9, 0x90, 0x7a, 0x9b, 0xc2, 0x4e, 0xdc, 0xdc, 0xf8, 0xa9,
+	0xb5, 0x68, 0xec, 0xc8, 0x76, 0xb7, 0xf1, 0x0d, 0x38, 0xf2, 0x61, 0xf8, 0x10, 0x3b, 0xee, 0x88,
+	0x38, 0x4c, 0xa8, 0xfd, 0x22, 0xc8, 0x4e, 0xb2, 0x8e, 0xdb, 0xb4, 0x53, 0xf2, 0xde, 0xfb, 0xfd,
+	0xc9, 0xcb, 0xfb, 0xe1, 0x27, 0xa5, 0xa8, 0x6a, 0xa1, 0x16, 0x9a, 0x3b, 0xb1, 0x02, 0x56, 0x5b,
+	0xe3, 0x0d, 0x79, 0xee, 0x41, 0x4b, 0xb0, 0x95, 0xd2, 0x9e, 0xb9, 0x5a, 0xb3, 0x0e, 0x73, 0xf0,
+	0x74, 0x61, 0x16, 0x26, 0x62, 0x78, 0x78, 0x6b, 0xe0, 0x07, 0x69, 0x69, 0x5c, 0x65, 0x1c, 0x9f,
+	0x0b, 0x07, 0xfc, 0xec, 0x78, 0x0e, 0x5e, 0x1c, 0xf3, 0xd2, 0x28, 0xdd, 0xcc, 0x8f, 0x7e, 0x0d,
+	0x70, 0xf2, 0x45, 0xac, 0x80, 0x3c, 0xc6, 0x7d, 0x25, 0x29, 0xca, 0x50, 0x9e, 0x14, 0x7d, 0x25,
+	0x49, 0x8a, 0x71, 0x27, 0x3d, 0x9b, 0xd2, 0x7e, 0xec, 0xdf, 0xea, 0x90, 0x0f, 0x78, 0x74, 0x66,
+	0xd6, 0xe5, 0x12, 0xac, 0xa3, 0x83, 0x0c, 0xe5, 0x0f, 0xde, 0xbe, 0x60, 0x8d, 0x17, 0x0b, 0x5e,
+	0xac, 0xf5, 0x62, 0x9f, 0x8c, 0xd2, 0x93, 0xe4, 0xf2, 0xfa, 0xb0, 0x57, 0xdc, 0x10, 0xc8, 0x7b,
+	0xbc, 0x57, 0x5b, 0x55, 0x02, 0x4d, 0xee, 0xc6, 0x6c, 0xd0, 0xe4, 0x25, 0x1e, 0x3b, 0x2f, 0xac,
+	0x3f, 0x55, 0x15, 0xd0, 0xbd, 0x0c, 0xe5, 0x83, 0x62, 0xd7, 0x20, 0x14, 0xef, 0x83, 0x96, 0x71,
+	0x36, 0x8c, 0xb3, 0xae, 0x24, 0xa7, 0xf8, 0x51, 0x25, 0x2e, 0x4e, 0xc0, 0x7e, 0x94, 0xd2, 0x82,
+	0x73, 0x74, 0x3f, 0x43, 0xf9, 0x78, 0xc2, 0x82, 0xf6, 0x9f, 0xeb, 0xc3, 0x57, 0x0b, 0xe5, 0x97,
+	0xeb, 0x39, 0x2b, 0x4d, 0xc5, 0xdb, 0xdf, 0xd5, 0x3c, 0xde, 0x38, 0xf9, 0x8d, 0xfb, 0xef, 0x35,
+	0x38, 0x36, 0xd3, 0xbe, 0xf8, 0x5f, 0x84, 0x4c, 0x70, 0xe2, 0xcc, 0x4a, 0xd2, 0xd1, 0xbd, 0xc4,
+	0x22, 0x37, 0x7c, 0xb3, 0x03, 0xef, 0x57, 0x20, 0xe9, 0x38, 0x43, 0xf9, 0xa8, 0xe8, 0xca, 0xb0,
+	0xeb, 0xb9, 0xf2, 0x4b, 0x69, 0xc5, 0xb9, 0xa6, 0x38, 0xce, 0x76, 0x8d, 0xa3, 0x1f, 0x08, 0x3f,
+	0x0c, 0x67, 0x3b, 0x59, 0xdb, 0x72, 0x29, 0x1c, 0x90, 0x67, 0x78, 0x18, 0x42, 0x32, 0x9b, 0xb6,
+	0x27, 0x6c, 0xab, 0x60, 0x20, 0xda, 0xa5, 0xc3, 0x0d, 0xc7, 0x45, 0x57, 0x92, 0xcf, 0x78, 0x28,
+	0x2a, 0xb3, 0xd6, 0x9e, 0x0e, 0xee, 0xb5, 0x40, 0xcb, 0x9e, 0x4c, 0x2f, 0x37, 0x29, 0xba, 0xda,
+	0xa4, 0xe8, 0xef, 0x26, 0x45, 0x3f, 0xb7, 0x69, 0xef, 0x6a, 0x9b, 0xf6, 0x7e, 0x6f, 0xd3, 0xde,
+	0xd7, 0xd7, 0xb7, 0x94, 0x76, 0xa9, 0xe5, 0xae, 0xd6, 0xfc, 0x82, 0xdf, 0x64, 0x3b, 0x2a, 0xce,
+	0x87, 0x31, 0x8e, 0xef, 0xfe, 0x0d, 0x00, 0x84, 0x76, 0x53, 0x28, 0xf4, 0x02, 0x00, 0x00,
+}
+
+func (m *Sale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Withdrawn {
+		i--
+		if m.Withdrawn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Settled {
+		i--
+		if m.Settled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.Sold.Size()
+		i -= size
+		if _, err := m.Sold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSale(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxPerAddress.Size()
+		i -= size
+		if _, err := m.MaxPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSale(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.EndTime != 0 {
+		i = encodeVarintSale(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintSale(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSale(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Vouchers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSale(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CampaignID != 0 {
+		i = encodeVarintSale(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintSale(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SalePurchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SalePurchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SalePurchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSale(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSale(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SaleID != 0 {
+		i = encodeVarintSale(dAtA, i, uint64(m.SaleID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSale(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSale(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Sale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSale(uint64(m.Id))
+	}
+	if m.CampaignID != 0 {
+		n += 1 + sovSale(uint64(m.CampaignID))
+	}
+	l = m.Vouchers.Size()
+	n += 1 + l + sovSale(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovSale(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovSale(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovSale(uint64(m.EndTime))
+	}
+	l = m.MaxPerAddress.Size()
+	n += 1 + l + sovSale(uint64(l))
+	l = m.Sold.Size()
+	n += 1 + l + sovSale(uint64(l))
+	if m.Settled {
+		n += 2
+	}
+	if m.Withdrawn {
+		n += 2
+	}
+	return n
+}
+
+func (m *SalePurchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SaleID != 0 {
+		n += 1 + sovSale(uint64(m.SaleID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSale(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSale(uint64(l))
+	return n
+}
+
+func sovSale(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSale(x uint64) (n int) {
+	return sovSale(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sale) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSale
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vouchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSale
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vouchers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSale
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Settled = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdrawn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSale(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSale
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SalePurchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSale
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SalePurchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SalePurchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleID", wireType)
+			}
+			m.SaleID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SaleID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSale
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSale
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSale(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSale
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSale(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSale
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSale
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSale
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSale
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSale
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSale        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSale          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSale = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,145 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestSale_Validate(t *testing.T) {
+	newSale := func(update func(sale *types.Sale)) types.Sale {
+		sale := sample.Sale(0, 0)
+		update(&sale)
+		return sale
+	}
+
+	for _, tc := range []struct {
+		desc  string
+		sale  types.Sale
+		valid bool
+	}{
+		{
+			desc:  "valid sale",
+			sale:  sample.Sale(0, 0),
+			valid: true,
+		},
+		{
+			desc: "valid sale with sold vouchers",
+			sale: newSale(func(sale *types.Sale) {
+				sale.Sold = sale.Vouchers.Amount
+			}),
+			valid: true,
+		},
+		{
+			desc: "valid settled and withdrawn sale",
+			sale: newSale(func(sale *types.Sale) {
+				sale.Settled = true
+				sale.Withdrawn = true
+			}),
+			valid: true,
+		},
+		{
+			desc: "vouchers of another campaign",
+			sale: newSale(func(sale *types.Sale) {
+				sale.Vouchers = sample.Voucher(1)
+			}),
+		},
+		{
+			desc: "zero vouchers",
+			sale: newSale(func(sale *types.Sale) {
+				sale.Vouchers.Amount = sdk.ZeroInt()
+			}),
+		},
+		{
+			desc: "zero price",
+			sale: newSale(func(sale *types.Sale) {
+				sale.Price.Amount = sdk.ZeroInt()
+			}),
+		},
+		{
+			desc: "invalid price denom",
+			sale: newSale(func(sale *types.Sale) {
+				sale.Price.Denom = "invalid denom"
+			}),
+		},
+		{
+			desc: "price in the sold vouchers",
+			sale: newSale(func(sale *types.Sale) {
+				sale.Price.Denom = sale.Vouchers.Denom
+			}),
+		},
+		{
+			desc: "zero start time",
+			sale: newSale(func(sale *types.Sale) {
+				sale.StartTime = 0
+			}),
+		},
+		{
+			desc: "end time before start time",
+			sale: newSale(func(sale *types.Sale) {
+				sale.EndTime = sale.StartTime
+			}),
+		},
+		{
+			desc: "negative max per address",
+			sale: newSale(func(sale *types.Sale) {
+				sale.MaxPerAddress = sdk.NewInt(-1)
+			}),
+		},
+		{
+			desc: "more sold vouchers than sale vouchers",
+			sale: newSale(func(sale *types.Sale) {
+				sale.Sold = sale.Vouchers.Amount.AddRaw(1)
+			}),
+		},
+		{
+			desc: "withdrawn sale not settled",
+			sale: newSale(func(sale *types.Sale) {
+				sale.Withdrawn = true
+			}),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.sale.Validate()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSale_IsActive(t *testing.T) {
+	sale := types.NewSale(0, 0, sample.Voucher(0), sample.Coin(), 1000, 2000, sdk.ZeroInt())
+
+	require.False(t, sale.IsActive(999))
+	require.True(t, sale.IsActive(1000))
+	require.True(t, sale.IsActive(1999))
+	require.False(t, sale.IsActive(2000))
+
+	sale.Settled = true
+	require.False(t, sale.IsActive(1500))
+}
+
+func TestSale_Proceeds(t *testing.T) {
+	sale := types.NewSale(
+		0,
+		0,
+		sdk.NewCoin(types.VoucherDenom(0, "foo"), sdk.NewInt(100)),
+		sdk.NewCoin("bar", sdk.NewInt(3)),
+		1000,
+		2000,
+		sdk.ZeroInt(),
+	)
+	require.Equal(t, sdk.NewCoin("bar", sdk.NewInt(30)), sale.Cost(sdk.NewInt(10)))
+	require.True(t, sale.Proceeds().IsZero())
+	require.True(t, sale.RemainingVouchers().Equal(sdk.NewInt(100)))
+
+	sale.Sold = sdk.NewInt(40)
+	require.Equal(t, sdk.NewCoin("bar", sdk.NewInt(120)), sale.Proceeds())
+	require.True(t, sale.RemainingVouchers().Equal(sdk.NewInt(60)))
+}
@@ -19,17 +19,50 @@ func NewShareDelayedVesting(vesting Shares, endTime int64) *ShareVestingOptions
 	}
 }
 
-// GetDelayedVestingShare return the vesting share for delayed vesting options
-func (m ShareVestingOptions) GetDelayedVestingShare() (Shares, error) {
+// NewShareContinuousVesting return the ShareVestingOptions for a continuous vesting
+func NewShareContinuousVesting(vesting Shares, startTime, endTime int64) *ShareVestingOptions {
+	return &ShareVestingOptions{
+		Options: &ShareVestingOptions_ContinuousVesting{
+			ContinuousVesting: &ShareContinuousVesting{
+				Vesting:   vesting,
+				StartTime: startTime,
+				EndTime:   endTime,
+			},
+		},
+	}
+}
+
+// NewSharePeriodicVesting return the ShareVestingOptions for a periodic vesting
+func NewSharePeriodicVesting(startTime int64, periods []ShareVestingPeriod) *ShareVestingOptions {
+	return &ShareVestingOptions{
+		Options: &ShareVestingOptions_PeriodicVesting{
+			PeriodicVesting: &SharePeriodicVesting{
+				StartTime: startTime,
+				Periods:   periods,
+			},
+		},
+	}
+}
+
+// GetTotalVestingShares return the total vesting shares of the vesting options
+func (m ShareVestingOptions) GetTotalVestingShares() (Shares, error) {
 	switch vestionOptions := m.Options.(type) {
 	case *ShareVestingOptions_DelayedVesting:
 		return vestionOptions.DelayedVesting.Vesting, nil
+	case *ShareVestingOptions_ContinuousVesting:
+		return vestionOptions.ContinuousVesting.Vesting, nil
+	case *ShareVestingOptions_PeriodicVesting:
+		total := EmptyShares()
+		for _, period := range vestionOptions.PeriodicVesting.Periods {
+			total = IncreaseShares(total, period.Amount)
+		}
+		return total, nil
 	default:
 		return nil, errors.New("invalid vesting options type")
 	}
 }
 
-// Validate check the ShareDelayedVesting object
+// Validate check the ShareVestingOptions object
 func (m ShareVestingOptions) Validate() error {
 	switch vestionOptions := m.Options.(type) {
 	case *ShareVestingOptions_DelayedVesting:
@@ -45,6 +78,50 @@ func (m ShareVestingOptions) Validate() error {
 		if vestionOptions.DelayedVesting.EndTime == 0 {
 			return errors.New("end time for DelayedVesting cannot be 0")
 		}
+	case *ShareVestingOptions_ContinuousVesting:
+		cv := vestionOptions.ContinuousVesting
+		if sdk.Coins(cv.Vesting).Empty() {
+			return errors.New("empty vesting shares for ShareContinuousVesting")
+		}
+		if !sdk.Coins(cv.Vesting).IsValid() {
+			return fmt.Errorf(
+				"invalid vesting shares for ShareContinuousVesting: %s",
+				sdk.Coins(cv.Vesting).String(),
+			)
+		}
+		if cv.StartTime == 0 {
+			return errors.New("start time for ShareContinuousVesting cannot be 0")
+		}
+		if cv.EndTime <= cv.StartTime {
+			return fmt.Errorf(
+				"end time for ShareContinuousVesting must be after start time: %d <= %d",
+				cv.EndTime,
+				cv.StartTime,
+			)
+		}
+	case *ShareVestingOptions_PeriodicVesting:
+		pv := vestionOptions.PeriodicVesting
+		if pv.StartTime == 0 {
+			return errors.New("start time for SharePeriodicVesting cannot be 0")
+		}
+		if len(pv.Periods) == 0 {
+			return errors.New("no period for SharePeriodicVesting")
+		}
+		for i, period := range pv.Periods {
+			if period.Length <= 0 {
+				return fmt.Errorf("length of the period %d for SharePeriodicVesting must be positive", i)
+			}
+			if sdk.Coins(period.Amount).Empty() {
+				return fmt.Errorf("empty vesting shares for the period %d of SharePeriodicVesting", i)
+			}
+			if !sdk.Coins(period.Amount).IsValid() {
+				return fmt.Errorf(
+					"invalid vesting shares for the period %d of SharePeriodicVesting: %s",
+					i,
+					sdk.Coins(period.Amount).String(),
+				)
+			}
+		}
 	default:
 		return errors.New("unrecognized vesting options")
 	}
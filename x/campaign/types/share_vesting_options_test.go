@@ -80,3 +80,199 @@ func TestDelayedVesting_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewShareContinuousVesting(t *testing.T) {
+	vesting := sample.Shares()
+	startTime := time.Now().Unix()
+	endTime := startTime + 1000
+
+	vestingOptions := types.NewShareContinuousVesting(vesting, startTime, endTime)
+
+	continuousVesting := vestingOptions.GetContinuousVesting()
+	require.NotNil(t, continuousVesting)
+	require.True(t, sdk.Coins(vesting).IsEqual(sdk.Coins(continuousVesting.Vesting)))
+	require.EqualValues(t, startTime, continuousVesting.StartTime)
+	require.EqualValues(t, endTime, continuousVesting.EndTime)
+}
+
+func TestNewSharePeriodicVesting(t *testing.T) {
+	startTime := time.Now().Unix()
+	periods := []types.ShareVestingPeriod{
+		{Length: 1000, Amount: sample.Shares()},
+		{Length: 2000, Amount: sample.Shares()},
+	}
+
+	vestingOptions := types.NewSharePeriodicVesting(startTime, periods)
+
+	periodicVesting := vestingOptions.GetPeriodicVesting()
+	require.NotNil(t, periodicVesting)
+	require.EqualValues(t, startTime, periodicVesting.StartTime)
+	require.Equal(t, periods, periodicVesting.Periods)
+}
+
+func TestShareVestingOptions_GetTotalVestingShares(t *testing.T) {
+	shares1, shares2 := sample.Shares(), sample.Shares()
+	now := time.Now().Unix()
+
+	for _, tc := range []struct {
+		desc   string
+		option types.ShareVestingOptions
+		total  types.Shares
+		err    bool
+	}{
+		{
+			desc:   "delayed vesting",
+			option: *types.NewShareDelayedVesting(shares1, now),
+			total:  shares1,
+		},
+		{
+			desc:   "continuous vesting",
+			option: *types.NewShareContinuousVesting(shares1, now, now+1000),
+			total:  shares1,
+		},
+		{
+			desc: "periodic vesting",
+			option: *types.NewSharePeriodicVesting(now, []types.ShareVestingPeriod{
+				{Length: 1000, Amount: shares1},
+				{Length: 1000, Amount: shares2},
+			}),
+			total: types.IncreaseShares(shares1, shares2),
+		},
+		{
+			desc:   "no vesting options",
+			option: types.ShareVestingOptions{},
+			err:    true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			total, err := tc.option.GetTotalVestingShares()
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, types.IsEqualShares(tc.total, total))
+		})
+	}
+}
+
+func TestShareContinuousVesting_Validate(t *testing.T) {
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name   string
+		option types.ShareVestingOptions
+		valid  bool
+	}{
+		{
+			name:   "vesting with empty shares",
+			option: *types.NewShareContinuousVesting(types.EmptyShares(), now, now+1000),
+			valid:  false,
+		},
+		{
+			name: "vesting with invalid coins",
+			option: *types.NewShareContinuousVesting(
+				types.NewSharesFromCoins(sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.NewInt(10)}}),
+				now,
+				now+1000,
+			),
+			valid: false,
+		},
+		{
+			name:   "vesting with no start time",
+			option: *types.NewShareContinuousVesting(sample.Shares(), 0, now),
+			valid:  false,
+		},
+		{
+			name:   "vesting with end time before start time",
+			option: *types.NewShareContinuousVesting(sample.Shares(), now, now-1000),
+			valid:  false,
+		},
+		{
+			name:   "vesting with end time equal to start time",
+			option: *types.NewShareContinuousVesting(sample.Shares(), now, now),
+			valid:  false,
+		},
+		{
+			name:   "valid continuous vesting",
+			option: *types.NewShareContinuousVesting(sample.Shares(), now, now+1000),
+			valid:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.option.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSharePeriodicVesting_Validate(t *testing.T) {
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name   string
+		option types.ShareVestingOptions
+		valid  bool
+	}{
+		{
+			name: "vesting with no start time",
+			option: *types.NewSharePeriodicVesting(0, []types.ShareVestingPeriod{
+				{Length: 1000, Amount: sample.Shares()},
+			}),
+			valid: false,
+		},
+		{
+			name:   "vesting with no period",
+			option: *types.NewSharePeriodicVesting(now, []types.ShareVestingPeriod{}),
+			valid:  false,
+		},
+		{
+			name: "vesting with a period with no length",
+			option: *types.NewSharePeriodicVesting(now, []types.ShareVestingPeriod{
+				{Length: 1000, Amount: sample.Shares()},
+				{Length: 0, Amount: sample.Shares()},
+			}),
+			valid: false,
+		},
+		{
+			name: "vesting with a period with empty shares",
+			option: *types.NewSharePeriodicVesting(now, []types.ShareVestingPeriod{
+				{Length: 1000, Amount: types.EmptyShares()},
+			}),
+			valid: false,
+		},
+		{
+			name: "vesting with a period with invalid coins",
+			option: *types.NewSharePeriodicVesting(now, []types.ShareVestingPeriod{
+				{
+					Length: 1000,
+					Amount: types.NewSharesFromCoins(sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.NewInt(10)}}),
+				},
+			}),
+			valid: false,
+		},
+		{
+			name: "valid periodic vesting",
+			option: *types.NewSharePeriodicVesting(now, []types.ShareVestingPeriod{
+				{Length: 1000, Amount: sample.Shares()},
+				{Length: 2000, Amount: sample.Shares()},
+			}),
+			valid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.option.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
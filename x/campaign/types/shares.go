@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -113,3 +114,86 @@ func (shares Shares) IsAllLTE(cmpShares Shares) bool {
 func (shares Shares) AmountOf(denom string) int64 {
 	return sdk.Coins(shares).AmountOf(denom).Int64()
 }
+
+// CoinsFromTotalSupply returns the coins represented by the shares from the total supply of a campaign
+// For each denom, the amount is share amount * supply / total shares, rounded down
+// Denoms not specified in totalShares uses DefaultTotalShareNumber as the number of total shares
+func (shares Shares) CoinsFromTotalSupply(totalSupply sdk.Coins, totalShares Shares) (sdk.Coins, error) {
+	if err := CheckShares(shares); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins()
+	for _, share := range shares {
+		denom := strings.TrimPrefix(share.Denom, SharePrefix)
+		total := sdk.Coins(totalShares).AmountOf(share.Denom)
+		if total.IsZero() {
+			total = sdk.NewInt(DefaultTotalShareNumber)
+		}
+		amount := share.Amount.Mul(totalSupply.AmountOf(denom)).Quo(total)
+		coins = coins.Add(sdk.NewCoin(denom, amount))
+	}
+	return coins, nil
+}
+
+// DistributeTotalSupply returns the coins represented by each of the shares from the total supply of a campaign
+// The amounts are first rounded down, the remainders are then distributed one by one to the shares with the
+// largest fractional parts, ties being broken by the order of the shares, until the sum of the amounts is
+// the amount represented by the sum of the shares
+// Denoms not specified in totalShares uses DefaultTotalShareNumber as the number of total shares
+func DistributeTotalSupply(totalSupply sdk.Coins, totalShares Shares, shares []Shares) ([]sdk.Coins, error) {
+	distributed := make([]sdk.Coins, len(shares))
+	for i, share := range shares {
+		if err := CheckShares(share); err != nil {
+			return nil, err
+		}
+		distributed[i] = sdk.NewCoins()
+	}
+
+	for _, supply := range totalSupply {
+		shareDenom := SharePrefix + supply.Denom
+		total := sdk.Coins(totalShares).AmountOf(shareDenom)
+		if total.IsZero() {
+			total = sdk.NewInt(DefaultTotalShareNumber)
+		}
+
+		// compute the rounded down amount and the fractional part of each share
+		amounts := make([]sdk.Int, len(shares))
+		remainders := make([]sdk.Int, len(shares))
+		sumShares, sumAmounts := sdk.ZeroInt(), sdk.ZeroInt()
+		for i, share := range shares {
+			shareAmount := sdk.Coins(share).AmountOf(shareDenom)
+			product := shareAmount.Mul(supply.Amount)
+			amounts[i] = product.Quo(total)
+			remainders[i] = product.Mod(total)
+			sumShares = sumShares.Add(shareAmount)
+			sumAmounts = sumAmounts.Add(amounts[i])
+		}
+
+		// distribute the remainder to the shares with the largest fractional parts
+		order := make([]int, len(shares))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return remainders[order[i]].GT(remainders[order[j]])
+		})
+		remainder := sumShares.Mul(supply.Amount).Quo(total).Sub(sumAmounts)
+		for _, i := range order {
+			if !remainder.IsPositive() {
+				break
+			}
+			if remainders[i].IsZero() {
+				continue
+			}
+			amounts[i] = amounts[i].AddRaw(1)
+			remainder = remainder.SubRaw(1)
+		}
+
+		for i, amount := range amounts {
+			distributed[i] = distributed[i].Add(sdk.NewCoin(supply.Denom, amount))
+		}
+	}
+
+	return distributed, nil
+}
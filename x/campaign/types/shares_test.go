@@ -298,3 +298,191 @@ func TestIsEqualShares(t *testing.T) {
 		})
 	}
 }
+
+func TestSharesCoinsFromTotalSupply(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		shares      campaign.Shares
+		totalSupply sdk.Coins
+		totalShares campaign.Shares
+		coins       sdk.Coins
+		err         bool
+	}{
+		{
+			desc:        "empty shares",
+			shares:      campaign.EmptyShares(),
+			totalSupply: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000))),
+			coins:       sdk.NewCoins(),
+		},
+		{
+			desc: "shares from the default total shares",
+			shares: campaign.Shares(sdk.NewCoins(
+				sdk.NewCoin(prefixedShareFoo, sdk.NewInt(campaign.DefaultTotalShareNumber/2)),
+			)),
+			totalSupply: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000))),
+			coins:       sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(500))),
+		},
+		{
+			desc: "shares from custom total shares",
+			shares: campaign.Shares(sdk.NewCoins(
+				sdk.NewCoin(prefixedShareFoo, sdk.NewInt(10)),
+				sdk.NewCoin(prefixedShareBar, sdk.NewInt(campaign.DefaultTotalShareNumber/4)),
+			)),
+			totalSupply: sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(1000)),
+				sdk.NewCoin("bar", sdk.NewInt(1000)),
+			),
+			totalShares: campaign.Shares(sdk.NewCoins(
+				sdk.NewCoin(prefixedShareFoo, sdk.NewInt(100)),
+			)),
+			coins: sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(100)),
+				sdk.NewCoin("bar", sdk.NewInt(250)),
+			),
+		},
+		{
+			desc: "amounts are rounded down",
+			shares: campaign.Shares(sdk.NewCoins(
+				sdk.NewCoin(prefixedShareFoo, sdk.NewInt(1)),
+			)),
+			totalSupply: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(5))),
+			totalShares: campaign.Shares(sdk.NewCoins(
+				sdk.NewCoin(prefixedShareFoo, sdk.NewInt(3)),
+			)),
+			coins: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1))),
+		},
+		{
+			desc: "denom not in the total supply",
+			shares: campaign.Shares(sdk.NewCoins(
+				sdk.NewCoin(prefixedShareFoo, sdk.NewInt(100)),
+			)),
+			totalSupply: sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(1000))),
+			coins:       sdk.NewCoins(),
+		},
+		{
+			desc: "invalid shares",
+			shares: campaign.Shares(sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(100)),
+			)),
+			totalSupply: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000))),
+			err:         true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			coins, err := tc.shares.CoinsFromTotalSupply(tc.totalSupply, tc.totalShares)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, coins.IsEqual(tc.coins), "%s != %s", coins, tc.coins)
+		})
+	}
+}
+
+func TestDistributeTotalSupply(t *testing.T) {
+	newShares := func(str string) campaign.Shares {
+		shares, err := campaign.NewShares(str)
+		require.NoError(t, err)
+		return shares
+	}
+	newCoins := func(str string) sdk.Coins {
+		coins, err := sdk.ParseCoinsNormalized(str)
+		require.NoError(t, err)
+		return coins
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		totalSupply sdk.Coins
+		totalShares campaign.Shares
+		shares      []campaign.Shares
+		distributed []sdk.Coins
+		err         bool
+	}{
+		{
+			desc:        "no shares",
+			totalSupply: newCoins("1000foo"),
+			shares:      []campaign.Shares{},
+			distributed: []sdk.Coins{},
+		},
+		{
+			desc:        "no remainder",
+			totalSupply: newCoins("1000foo,1000bar"),
+			shares: []campaign.Shares{
+				newShares("50000foo"),
+				newShares("25000foo,10000bar"),
+				campaign.EmptyShares(),
+			},
+			distributed: []sdk.Coins{
+				newCoins("500foo"),
+				newCoins("250foo,100bar"),
+				sdk.NewCoins(),
+			},
+		},
+		{
+			desc:        "remainders distributed by order for equal fractional parts",
+			totalSupply: newCoins("10foo"),
+			totalShares: newShares("3foo"),
+			shares: []campaign.Shares{
+				newShares("1foo"),
+				newShares("1foo"),
+				newShares("1foo"),
+			},
+			distributed: []sdk.Coins{
+				newCoins("4foo"),
+				newCoins("3foo"),
+				newCoins("3foo"),
+			},
+		},
+		{
+			desc:        "remainders distributed to the largest fractional parts",
+			totalSupply: newCoins("10foo"),
+			totalShares: newShares("3foo"),
+			shares: []campaign.Shares{
+				newShares("1foo"),
+				newShares("2foo"),
+			},
+			distributed: []sdk.Coins{
+				newCoins("3foo"),
+				newCoins("7foo"),
+			},
+		},
+		{
+			desc:        "remainders limited to the amount represented by the sum of the shares",
+			totalSupply: newCoins("10foo"),
+			totalShares: newShares("4foo"),
+			shares: []campaign.Shares{
+				newShares("1foo"),
+				newShares("1foo"),
+				newShares("1foo"),
+			},
+			distributed: []sdk.Coins{
+				newCoins("3foo"),
+				newCoins("2foo"),
+				newCoins("2foo"),
+			},
+		},
+		{
+			desc:        "invalid shares",
+			totalSupply: newCoins("1000foo"),
+			shares: []campaign.Shares{
+				campaign.Shares(newCoins("1000foo")),
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			distributed, err := campaign.DistributeTotalSupply(tc.totalSupply, tc.totalShares, tc.shares)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, distributed, len(tc.distributed))
+			for i, coins := range distributed {
+				require.True(t, coins.IsEqual(tc.distributed[i]), "%s != %s", coins, tc.distributed[i])
+			}
+		})
+	}
+}
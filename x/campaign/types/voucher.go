@@ -11,10 +11,17 @@ import (
 
 const (
 	// VoucherSeparator is used in voucher denom to separate the denom component
-	VoucherSeparator = "/"
+	// A slash can't be used since ICS-20 only allows slashes in the denoms of IBC vouchers
+	VoucherSeparator = "-"
 
 	// VoucherPrefix is the prefix used to represent a voucher denomination
 	VoucherPrefix = "v" + VoucherSeparator
+
+	// LegacyVoucherSeparator is the separator of the voucher denoms before the version 2 of the module
+	LegacyVoucherSeparator = "/"
+
+	// LegacyVoucherPrefix is the prefix of the voucher denoms before the version 2 of the module
+	LegacyVoucherPrefix = "v" + LegacyVoucherSeparator
 )
 
 // SharesToVouchers returns new Coins vouchers from the Shares representation
@@ -73,17 +80,34 @@ func VoucherToShareDenom(campaignID uint64, denom string) string {
 
 // VoucherCampaign returns the campaign associated to a voucher denom
 func VoucherCampaign(denom string) (uint64, error) {
-	if !strings.HasPrefix(denom, VoucherPrefix) {
-		return 0, errors.New("no voucher prefix")
+	campaignID, _, err := parseVoucherDenom(denom, VoucherPrefix, VoucherSeparator)
+	return campaignID, err
+}
+
+// LegacyVoucherDenom returns the campaign ID and the actual denom of a voucher denom
+// using the format of the version 1 of the module
+func LegacyVoucherDenom(denom string) (uint64, string, error) {
+	return parseVoucherDenom(denom, LegacyVoucherPrefix, LegacyVoucherSeparator)
+}
+
+// parseVoucherDenom returns the campaign ID and the actual denom of a voucher denom
+// The actual denom may contain the separator
+func parseVoucherDenom(denom, prefix, separator string) (uint64, string, error) {
+	if !strings.HasPrefix(denom, prefix) {
+		return 0, "", errors.New("no voucher prefix")
 	}
-	denom = strings.TrimPrefix(denom, VoucherPrefix)
+	denom = strings.TrimPrefix(denom, prefix)
 
-	parsed := strings.Split(denom, VoucherSeparator)
+	parsed := strings.SplitN(denom, separator, 2)
 	if len(parsed) != 2 {
-		return 0, errors.New("invalid format")
+		return 0, "", errors.New("invalid format")
 	}
 	if parsed[1] == "" {
-		return 0, errors.New("actual denom is empty")
+		return 0, "", errors.New("actual denom is empty")
+	}
+	campaignID, err := strconv.ParseUint(parsed[0], 10, 64)
+	if err != nil {
+		return 0, "", err
 	}
-	return strconv.ParseUint(parsed[0], 10, 64)
+	return campaignID, parsed[1], nil
 }
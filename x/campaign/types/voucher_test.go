@@ -44,7 +44,7 @@ func TestCheckVouchers(t *testing.T) {
 				sdk.NewCoin(prefixedVoucherFoo, sdk.NewInt(100)),
 				sdk.NewCoin("foo", sdk.NewInt(200)),
 			),
-			err: errors.New("foo doesn't contain the voucher prefix v/10/"),
+			err: errors.New("foo doesn't contain the voucher prefix v-10-"),
 		},
 		{
 			name:       "one invalid coin",
@@ -52,7 +52,7 @@ func TestCheckVouchers(t *testing.T) {
 			vouchers: sdk.NewCoins(
 				sdk.NewCoin("foo", sdk.NewInt(200)),
 			),
-			err: errors.New("foo doesn't contain the voucher prefix v/10/"),
+			err: errors.New("foo doesn't contain the voucher prefix v-10-"),
 		},
 		{
 			name:       "invalid campaign id",
@@ -60,7 +60,7 @@ func TestCheckVouchers(t *testing.T) {
 			vouchers: sdk.NewCoins(
 				sdk.NewCoin(prefixedVoucherFoo, sdk.NewInt(200)),
 			),
-			err: errors.New("v/10/foo doesn't contain the voucher prefix v/1000/"),
+			err: errors.New("v-10-foo doesn't contain the voucher prefix v-1000-"),
 		},
 	}
 	for _, tt := range tests {
@@ -115,9 +115,9 @@ func TestSharesToVouchers(t *testing.T) {
 				sdk.NewCoin(prefixedShareFoobar, sdk.NewInt(12)),
 			)),
 			want: sdk.NewCoins(
-				sdk.NewCoin("v/1000/foo", sdk.NewInt(10)),
-				sdk.NewCoin("v/1000/bar", sdk.NewInt(11)),
-				sdk.NewCoin("v/1000/foobar", sdk.NewInt(12)),
+				sdk.NewCoin("v-1000-foo", sdk.NewInt(10)),
+				sdk.NewCoin("v-1000-bar", sdk.NewInt(11)),
+				sdk.NewCoin("v-1000-foobar", sdk.NewInt(12)),
 			),
 		},
 		{
@@ -151,22 +151,22 @@ func TestVoucherName(t *testing.T) {
 		want       string
 	}{
 		{
-			name:       "test 10/foo",
+			name:       "test 10-foo",
 			campaignID: 10,
 			coin:       "foo",
-			want:       "v/10/foo",
+			want:       "v-10-foo",
 		},
 		{
-			name:       "test 0/foo",
+			name:       "test 0-foo",
 			campaignID: 0,
 			coin:       "foo",
-			want:       "v/0/foo",
+			want:       "v-0-foo",
 		},
 		{
 			name:       "test empty denom",
 			campaignID: 10,
 			coin:       "",
-			want:       "v/10/",
+			want:       "v-10-",
 		},
 	}
 	for _, tt := range tests {
@@ -214,7 +214,7 @@ func TestVouchersToShares(t *testing.T) {
 				sdk.NewCoin(prefixedVoucherFoo, sdk.NewInt(10)),
 				sdk.NewCoin(prefixedVoucherBar, sdk.NewInt(11)),
 			),
-			err: errors.New("v/10/bar doesn't contain the voucher prefix v/1000/"),
+			err: errors.New("v-10-bar doesn't contain the voucher prefix v-1000-"),
 		},
 	}
 	for _, tt := range tests {
@@ -274,39 +274,45 @@ func TestVoucherCampaign(t *testing.T) {
 	}{
 		{
 			name:       "campaign is 0",
-			denom:      "v/0/foo",
+			denom:      "v-0-foo",
 			campaignID: uint64(0),
 			valid:      true,
 		},
 		{
 			name:       "campaign is 50",
-			denom:      "v/50/bar",
+			denom:      "v-50-bar",
 			campaignID: uint64(50),
 			valid:      true,
 		},
 		{
 			name:  "no voucher prefix",
-			denom: "0/foo",
+			denom: "0-foo",
 			valid: false,
 		},
+		{
+			name:       "actual denom containing the separator",
+			denom:      "v-0-foo-bar",
+			campaignID: uint64(0),
+			valid:      true,
+		},
 		{
 			name:  "invalid format",
-			denom: "v/0/foo/bar",
+			denom: "v-0",
 			valid: false,
 		},
 		{
 			name:  "campaign ID is not a number",
-			denom: "v/foo/foo",
+			denom: "v-foo-foo",
 			valid: false,
 		},
 		{
 			name:  "empty campaign ID",
-			denom: "v//foo",
+			denom: "v--foo",
 			valid: false,
 		},
 		{
 			name:  "actual denom is empty",
-			denom: "v/0/",
+			denom: "v-0-",
 			valid: false,
 		},
 	}
@@ -34,6 +34,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListGenesisAccount())
 	cmd.AddCommand(CmdShowRequest())
 	cmd.AddCommand(CmdListRequest())
+	cmd.AddCommand(CmdShowGenesis())
+	cmd.AddCommand(CmdShowPersistentPeers())
+	cmd.AddCommand(CmdShowRewardPool())
+	cmd.AddCommand(CmdListRewardPool())
+	cmd.AddCommand(CmdShowParticipantList())
+	cmd.AddCommand(CmdListParticipantList())
 	cmd.AddCommand(CmdQueryParams())
 	// this line is used by starport scaffolding # 1
 
@@ -24,6 +24,8 @@ func networkWithFooObjects(t *testing.T, n int) (*network.Network, []types.Chain
 
 	for i := 0; i < n; i++ {
 		chain := sample.Chain(uint64(i), uint64(i))
+		chain.Limits = sample.ChainLimits()
+		chain.AutoApprovePolicy = sample.AutoApprovePolicy()
 		state.ChainList = append(
 			state.ChainList,
 			chain,
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+const flagInitialGenesis = "initial-genesis"
+
+func CmdShowGenesis() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis [launch-id]",
+		Short: "shows the final genesis of a chain with its hash",
+		Long: `Shows the final genesis of a chain with its hash, the launch information of the chain applied on its initial genesis.
+The genesis downloaded from the URL of a chain with a genesis URL must be provided with --initial-genesis.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGenesisRequest{
+				LaunchID: launchID,
+			}
+
+			initialGenesisFile, err := cmd.Flags().GetString(flagInitialGenesis)
+			if err != nil {
+				return err
+			}
+			if initialGenesisFile != "" {
+				initialGenesis, err := os.ReadFile(initialGenesisFile)
+				if err != nil {
+					return err
+				}
+				params.InitialGenesis = string(initialGenesis)
+			}
+
+			res, err := queryClient.Genesis(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagInitialGenesis, "", "File of the genesis downloaded from the genesis URL of the chain")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
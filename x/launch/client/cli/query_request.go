@@ -2,7 +2,9 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -11,12 +13,24 @@ import (
 	"github.com/tendermint/spn/x/launch/types"
 )
 
+const (
+	flagStatus      = "status"
+	flagContentType = "content-type"
+	flagCreator     = "creator"
+)
+
 func CmdListRequest() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-request [launch-id]",
 		Short: "list all request",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				status, _      = cmd.Flags().GetString(flagStatus)
+				contentType, _ = cmd.Flags().GetString(flagContentType)
+				creator, _     = cmd.Flags().GetString(flagCreator)
+			)
+
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
@@ -32,8 +46,11 @@ func CmdListRequest() *cobra.Command {
 			}
 
 			params := &types.QueryAllRequestRequest{
-				LaunchID:   launchID,
-				Pagination: pageReq,
+				LaunchID:    launchID,
+				Pagination:  pageReq,
+				Status:      status,
+				ContentType: contentType,
+				Creator:     creator,
 			}
 
 			res, err := queryClient.RequestAll(context.Background(), params)
@@ -45,6 +62,18 @@ func CmdListRequest() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagStatus, "", "Filter the requests by status (pending, approved, rejected, failed or canceled)")
+	cmd.Flags().String(flagContentType, "", fmt.Sprintf(
+		"Filter the requests by content type (%s)",
+		strings.Join([]string{
+			types.RequestContentTypeGenesisAccount,
+			types.RequestContentTypeVestingAccount,
+			types.RequestContentTypeGenesisValidator,
+			types.RequestContentTypeAccountRemoval,
+			types.RequestContentTypeValidatorRemoval,
+		}, ", "),
+	))
+	cmd.Flags().String(flagCreator, "", "Filter the requests by creator address")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
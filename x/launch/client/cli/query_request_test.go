@@ -26,6 +26,7 @@ func networkWithRequestObjects(t *testing.T, n int) (*network.Network, []types.R
 	for i := 0; i < n; i++ {
 		request := sample.Request(0, sample.Address())
 		request.RequestID = uint64(i)
+		request.Deposit = sample.Coins()
 		state.RequestList = append(
 			state.RequestList,
 			request,
@@ -149,4 +150,36 @@ func TestListRequest(t *testing.T) {
 		require.Equal(t, len(objs), int(resp.Pagination.Total))
 		require.ElementsMatch(t, objs, resp.Request)
 	})
+	t.Run("Filtered", func(t *testing.T) {
+		for _, tc := range []struct {
+			desc     string
+			args     []string
+			expected []types.Request
+		}{
+			{
+				desc:     "by status",
+				args:     []string{"--status=pending"},
+				expected: objs,
+			},
+			{
+				desc:     "by content type",
+				args:     []string{"--content-type=" + types.RequestContentTypeGenesisValidator},
+				expected: nil,
+			},
+			{
+				desc:     "by creator",
+				args:     []string{"--creator=" + objs[0].Creator},
+				expected: objs[0:1],
+			},
+		} {
+			t.Run(tc.desc, func(t *testing.T) {
+				args := append(request("0", nil, 0, uint64(len(objs)), false), tc.args...)
+				out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListRequest(), args)
+				require.NoError(t, err)
+				var resp types.QueryAllRequestResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.ElementsMatch(t, tc.expected, resp.Request)
+			})
+		}
+	})
 }
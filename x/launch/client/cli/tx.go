@@ -8,6 +8,11 @@ import (
 	"github.com/tendermint/spn/x/launch/types"
 )
 
+// requestDepositHelp describes the deposit of the requests in the help of the request commands
+const requestDepositHelp = `The request deposit defined in the module parameters is escrowed from the account of the creator
+until the request is settled. The deposit is not covered by the fee allowance granted by the coordinator
+with grant-request-allowance, the creator must hold the deposit even if the fees are paid by the coordinator`
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -22,12 +27,20 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdEditChain())
 	cmd.AddCommand(CmdRequestAddAccount())
 	cmd.AddCommand(CmdRequestAddVestingAccount())
+	cmd.AddCommand(CmdRequestAddPeriodicVestingAccount())
 	cmd.AddCommand(CmdRequestRemoveAccount())
 	cmd.AddCommand(CmdRequestAddValidator())
 	cmd.AddCommand(CmdRequestRemoveValidator())
 	cmd.AddCommand(CmdSettleRequest())
+	cmd.AddCommand(CmdSettleRequests())
+	cmd.AddCommand(CmdCancelRequest())
 	cmd.AddCommand(CmdTriggerLaunch())
 	cmd.AddCommand(CmdRevertLaunch())
+	cmd.AddCommand(CmdSetRewards())
+	cmd.AddCommand(CmdDistributeRewards())
+	cmd.AddCommand(CmdClaimRewards())
+	cmd.AddCommand(CmdGrantRequestAllowance())
+	cmd.AddCommand(CmdSetParticipantList())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -9,13 +9,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/spn/x/launch/types"
 )
 
 const (
-	flagGenesisURL = "genesis-url"
-	flagCampaignID = "campaign-id"
+	flagGenesisURL         = "genesis-url"
+	flagCampaignID         = "campaign-id"
+	flagMaxValidators      = "max-validators"
+	flagMaxGenesisAccounts = "max-genesis-accounts"
+	flagMaxAccountCoins    = "max-account-coins"
+	flagMinSelfDelegation  = "min-self-delegation"
+	flagMaxSelfDelegation  = "max-self-delegation"
 )
 
 func CmdCreateChain() *cobra.Command {
@@ -53,6 +59,11 @@ func CmdCreateChain() *cobra.Command {
 				}
 			}
 
+			limits, err := getChainLimitsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateChain(
 				clientCtx.GetFromAddress().String(),
 				args[0],
@@ -62,6 +73,7 @@ func CmdCreateChain() *cobra.Command {
 				genesisHash,
 				hasCampaign,
 				campaignID,
+				limits,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -72,11 +84,74 @@ func CmdCreateChain() *cobra.Command {
 
 	cmd.Flags().String(flagGenesisURL, "", "URL for a custom genesis")
 	cmd.Flags().Int64(flagCampaignID, -1, "The campaign id")
+	addChainLimitsFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// addChainLimitsFlags adds the flags to set the limits of a chain
+func addChainLimitsFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagMaxValidators, 0, "Maximum number of genesis validators, 0 for no limit")
+	cmd.Flags().Uint64(flagMaxGenesisAccounts, 0, "Maximum number of genesis accounts, 0 for no limit")
+	cmd.Flags().String(flagMaxAccountCoins, "", "Maximum coins of a genesis account")
+	cmd.Flags().String(flagMinSelfDelegation, "", "Minimum self delegation of a genesis validator")
+	cmd.Flags().String(flagMaxSelfDelegation, "", "Maximum self delegation of a genesis validator")
+}
+
+// chainLimitsFlagsChanged returns true if one of the chain limits flags is set
+func chainLimitsFlagsChanged(cmd *cobra.Command) bool {
+	for _, flag := range []string{
+		flagMaxValidators,
+		flagMaxGenesisAccounts,
+		flagMaxAccountCoins,
+		flagMinSelfDelegation,
+		flagMaxSelfDelegation,
+	} {
+		if cmd.Flags().Changed(flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// getChainLimitsFromFlags returns the chain limits from the flags of the command
+func getChainLimitsFromFlags(cmd *cobra.Command) (limits types.ChainLimits, err error) {
+	if limits.MaxValidators, err = cmd.Flags().GetUint64(flagMaxValidators); err != nil {
+		return limits, err
+	}
+	if limits.MaxGenesisAccounts, err = cmd.Flags().GetUint64(flagMaxGenesisAccounts); err != nil {
+		return limits, err
+	}
+
+	maxAccountCoins, err := cmd.Flags().GetString(flagMaxAccountCoins)
+	if err != nil {
+		return limits, err
+	}
+	if limits.MaxAccountCoins, err = sdk.ParseCoinsNormalized(maxAccountCoins); err != nil {
+		return limits, err
+	}
+
+	if limits.MinSelfDelegation, err = getOptionalCoinFromFlag(cmd, flagMinSelfDelegation); err != nil {
+		return limits, err
+	}
+	limits.MaxSelfDelegation, err = getOptionalCoinFromFlag(cmd, flagMaxSelfDelegation)
+	return limits, err
+}
+
+// getOptionalCoinFromFlag returns the coin set for a flag or nil if the flag is empty
+func getOptionalCoinFromFlag(cmd *cobra.Command, flag string) (*sdk.Coin, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	coin, err := sdk.ParseCoinNormalized(value)
+	if err != nil {
+		return nil, err
+	}
+	return &coin, nil
+}
+
 // getHashFromURL fetches content from url and returns the hash based on the genesis hash method
 func getHashFromURL(ctx context.Context, url string) (string, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func CmdDistributeRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute-rewards [launch-id] [last-block-height]",
+		Short: "Distribute the rewards of a launched chain to its genesis validators",
+		Long: `Distribute the rewards of a launched chain to its genesis validators.
+Once the reward distribution delay is reached, the coordinator of the chain reports the last block height reached by the chain
+and the unearned rewards are refunded to their provider. Once the reward distribution timeout is reached, anyone can distribute
+the rewards and the last block height is ignored: the chain is considered to have reached the last reward height.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			lastBlockHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDistributeRewards(clientCtx.GetFromAddress().String(), launchID, lastBlockHeight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/spn/x/launch/types"
 )
@@ -16,6 +17,11 @@ const (
 	flagSourceURL      = "source-url"
 	flagSourceHash     = "source-hash"
 	flagDefaultGenesis = "default-genesis"
+
+	flagAutoApproveMaxAccountCoins   = "auto-approve-max-account-coins"
+	flagAutoApproveMinSelfDelegation = "auto-approve-min-self-delegation"
+	flagAutoApproveMaxSelfDelegation = "auto-approve-max-self-delegation"
+	flagAutoApproveAddresses         = "auto-approve-addresses"
 )
 
 func CmdEditChain() *cobra.Command {
@@ -58,6 +64,26 @@ func CmdEditChain() *cobra.Command {
 				return err
 			}
 
+			// The limits of the chain are replaced as a whole if one of the limits flags is set
+			var limits *types.ChainLimits
+			if chainLimitsFlagsChanged(cmd) {
+				chainLimits, err := getChainLimitsFromFlags(cmd)
+				if err != nil {
+					return err
+				}
+				limits = &chainLimits
+			}
+
+			// The auto approve policy is also replaced as a whole
+			var autoApprovePolicy *types.AutoApprovePolicy
+			if autoApprovePolicyFlagsChanged(cmd) {
+				policy, err := getAutoApprovePolicyFromFlags(cmd)
+				if err != nil {
+					return err
+				}
+				autoApprovePolicy = &policy
+			}
+
 			msg := types.NewMsgEditChain(
 				clientCtx.GetFromAddress().String(),
 				launchID,
@@ -65,6 +91,8 @@ func CmdEditChain() *cobra.Command {
 				sourceURL,
 				sourceHash,
 				initialGenesis,
+				limits,
+				autoApprovePolicy,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -78,7 +106,47 @@ func CmdEditChain() *cobra.Command {
 	cmd.Flags().String(flagSourceHash, "", "Hash from the new source URL for the chain")
 	cmd.Flags().Bool(flagDefaultGenesis, false, "Set the initial genesis to the default genesis of the chain")
 	cmd.Flags().String(flagGenesisURL, "", "Set the initial genesis from a URL containing a custom genesis")
+	addChainLimitsFlags(cmd)
+	cmd.Flags().String(flagAutoApproveMaxAccountCoins, "", "Automatically approve the genesis accounts with coins lower or equal to this amount")
+	cmd.Flags().String(flagAutoApproveMinSelfDelegation, "", "Automatically approve the genesis validators with a self delegation higher or equal to this amount")
+	cmd.Flags().String(flagAutoApproveMaxSelfDelegation, "", "Automatically approve the genesis validators with a self delegation lower or equal to this amount")
+	cmd.Flags().StringSlice(flagAutoApproveAddresses, []string{}, "Automatically approve the genesis accounts and validators requested from these addresses")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// autoApprovePolicyFlagsChanged returns true if one of the auto approve policy flags is set
+func autoApprovePolicyFlagsChanged(cmd *cobra.Command) bool {
+	for _, flag := range []string{
+		flagAutoApproveMaxAccountCoins,
+		flagAutoApproveMinSelfDelegation,
+		flagAutoApproveMaxSelfDelegation,
+		flagAutoApproveAddresses,
+	} {
+		if cmd.Flags().Changed(flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAutoApprovePolicyFromFlags returns the auto approve policy from the flags of the command
+func getAutoApprovePolicyFromFlags(cmd *cobra.Command) (policy types.AutoApprovePolicy, err error) {
+	maxAccountCoins, err := cmd.Flags().GetString(flagAutoApproveMaxAccountCoins)
+	if err != nil {
+		return policy, err
+	}
+	if policy.MaxAccountCoins, err = sdk.ParseCoinsNormalized(maxAccountCoins); err != nil {
+		return policy, err
+	}
+	if policy.MinSelfDelegation, err = getOptionalCoinFromFlag(cmd, flagAutoApproveMinSelfDelegation); err != nil {
+		return policy, err
+	}
+	if policy.MaxSelfDelegation, err = getOptionalCoinFromFlag(cmd, flagAutoApproveMaxSelfDelegation); err != nil {
+		return policy, err
+	}
+
+	policy.AllowedAddresses, err = cmd.Flags().GetStringSlice(flagAutoApproveAddresses)
+	return policy, err
+}
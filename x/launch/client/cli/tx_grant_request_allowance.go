@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+const flagExpiration = "expiration"
+
+func CmdGrantRequestAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-request-allowance [launch-id] [grantee] [spend-limit]",
+		Short: "Grant a fee allowance for the requests of a chain",
+		Long: `Grant a fee allowance to an address for the requests of a chain.
+The fees of the grantee are paid by the coordinator up to the spend limit
+as long as the transaction only contains request messages for the chain.
+The allowance replaces any allowance previously granted by the coordinator to the grantee.
+The allowance only covers the transaction fees, the grantee must still hold the request deposit
+defined in the module parameters to send requests.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			expiration, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantRequestAllowance(
+				clientCtx.GetFromAddress().String(),
+				launchID,
+				args[1],
+				spendLimit,
+				expiration,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(flagExpiration, 0, "Unix timestamp the allowance expires at, no expiration if zero")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
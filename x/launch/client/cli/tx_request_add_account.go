@@ -16,6 +16,7 @@ func CmdRequestAddAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "request-add-account [launch-id] [coins]",
 		Short: "Request to add an account",
+		Long:  "Request to add an account.\n" + requestDepositHelp,
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
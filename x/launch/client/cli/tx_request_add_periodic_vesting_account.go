@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+// periodicVestingFile is the content of the file describing the periods of a periodic vesting
+type periodicVestingFile struct {
+	StartTime int64 `json:"start_time"`
+	Periods   []struct {
+		Coins  string `json:"coins"`
+		Length int64  `json:"length_seconds"`
+	} `json:"periods"`
+}
+
+func CmdRequestAddPeriodicVestingAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-add-periodic-vesting-account [launch-id] [starting-balance] [periods-file]",
+		Short: "Request to add a periodic vesting account",
+		Long: `Request to add a periodic vesting account.
+The periods file is a JSON file describing the consecutive vesting periods, e.g.
+{
+  "start_time": 1625204910,
+  "periods": [
+    {"coins": "10stake", "length_seconds": 2592000},
+    {"coins": "10stake", "length_seconds": 2592000}
+  ]
+}
+` + requestDepositHelp,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startingBalance, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse coins: %w", err)
+			}
+
+			bz, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+			var file periodicVestingFile
+			if err := json.Unmarshal(bz, &file); err != nil {
+				return fmt.Errorf("failed to parse periods file: %w", err)
+			}
+
+			periods := make([]types.VestingPeriod, len(file.Periods))
+			for i, period := range file.Periods {
+				amount, err := sdk.ParseCoinsNormalized(period.Coins)
+				if err != nil {
+					return fmt.Errorf("failed to parse coins of period %d: %w", i, err)
+				}
+				periods[i] = types.VestingPeriod{
+					Length: period.Length,
+					Amount: amount,
+				}
+			}
+
+			msg := types.NewMsgRequestAddVestingAccount(
+				clientCtx.GetFromAddress().String(),
+				launchID,
+				startingBalance,
+				*types.NewPeriodicVesting(file.StartTime, periods),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
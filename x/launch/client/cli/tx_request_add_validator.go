@@ -1,22 +1,36 @@
 package cli
 
 import (
+	"encoding/base64"
+	"fmt"
 	"io/ioutil"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/spn/x/launch/types"
 )
 
+const flagHTTPTunnel = "http-tunnel"
+
 func CmdRequestAddValidator() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "request-add-validator [launch-id] [gentx-file] [consensus-public-key] [self-delegation] [peer]",
 		Short: "Send a request for a genesis validator",
-		Args:  cobra.ExactArgs(5),
+		Long: `Send a request for a genesis validator.
+The gentx must be signed for the genesis chain ID of the chain and create a validator with the provided
+self-delegation and consensus public key. The consensus public key is the JSON encoded key returned by
+"show-validator", e.g. '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}', or the base64 encoded key bytes.
+The peer is formatted as nodeid@host:port, or as nodeid@address if the node is reachable through an HTTP tunnel
+whose name is provided with --http-tunnel
+` + requestDepositHelp,
+		Args: cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -40,13 +54,29 @@ func CmdRequestAddValidator() *cobra.Command {
 				return err
 			}
 
+			consPubKey, err := parseConsPubKey(clientCtx.Codec, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid consensus public key: %w", err)
+			}
+
+			var peer types.Peer
+			tunnel, _ := cmd.Flags().GetString(flagHTTPTunnel)
+			if tunnel != "" {
+				peer, err = types.ParsePeerTunnel(args[4], tunnel)
+			} else {
+				peer, err = types.ParsePeer(args[4])
+			}
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgRequestAddValidator(
 				clientCtx.GetFromAddress().String(),
 				launchID,
 				gentxBytes,
-				[]byte(args[2]),
+				consPubKey,
 				selfDelegation,
-				args[4],
+				peer,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -55,7 +85,22 @@ func CmdRequestAddValidator() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagHTTPTunnel, "", "Name of the HTTP tunnel the node is reachable through")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseConsPubKey returns the consensus public key bytes from the JSON output of "show-validator"
+// or from the base64 encoded key bytes
+func parseConsPubKey(cdc codec.Codec, consPubKey string) ([]byte, error) {
+	if !strings.HasPrefix(strings.TrimSpace(consPubKey), "{") {
+		return base64.StdEncoding.DecodeString(consPubKey)
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := cdc.UnmarshalInterfaceJSON([]byte(consPubKey), &pubKey); err != nil {
+		return nil, err
+	}
+	return pubKey.Bytes(), nil
+}
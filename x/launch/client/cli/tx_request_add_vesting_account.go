@@ -12,11 +12,17 @@ import (
 	"github.com/tendermint/spn/x/launch/types"
 )
 
+const flagVestingStartTime = "vesting-start-time"
+
 func CmdRequestAddVestingAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "request-add-vesting-account [launch-id] [starting-balance] [vesting-coins] [vesting-end-time]",
 		Short: "Request to add a vesting account",
-		Args:  cobra.ExactArgs(4),
+		Long: `Request to add a vesting account.
+The vesting coins are vested at the end time, or linearly between the start time and the end time
+if --vesting-start-time is provided
+` + requestDepositHelp,
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -35,7 +41,11 @@ func CmdRequestAddVestingAccount() *cobra.Command {
 
 			endTime, _ := strconv.ParseUint(args[3], 10, 64)
 
-			delayedVesting := *types.NewDelayedVesting(vestingCoins, int64(endTime))
+			vestingOptions := *types.NewDelayedVesting(vestingCoins, int64(endTime))
+			startTime, _ := cmd.Flags().GetInt64(flagVestingStartTime)
+			if startTime != 0 {
+				vestingOptions = *types.NewContinuousVesting(vestingCoins, startTime, int64(endTime))
+			}
 
 			launchID, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
@@ -46,7 +56,7 @@ func CmdRequestAddVestingAccount() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				launchID,
 				startingBalance,
-				delayedVesting,
+				vestingOptions,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -55,6 +65,7 @@ func CmdRequestAddVestingAccount() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Int64(flagVestingStartTime, 0, "Start time of a continuous vesting")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
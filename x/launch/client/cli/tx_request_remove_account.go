@@ -14,6 +14,7 @@ func CmdRequestRemoveAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "request-remove-account [launch-id] [address]",
 		Short: "Request to remove an account",
+		Long:  "Request to remove an account.\n" + requestDepositHelp,
 		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
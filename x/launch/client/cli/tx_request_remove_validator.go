@@ -14,6 +14,7 @@ func CmdRequestRemoveValidator() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "request-remove-validator [launch-id] [validator-address]",
 		Short: "Request to remove a genesis validator",
+		Long:  "Request to remove a genesis validator.\n" + requestDepositHelp,
 		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
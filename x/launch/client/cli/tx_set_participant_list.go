@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func CmdSetParticipantList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-participant-list [launch-id] [allowlist|denylist] [addresses]",
+		Short: "Set the addresses allowed or denied to request genesis accounts and validators for a chain",
+		Long: `Set the addresses allowed or denied to request genesis accounts and validators for a chain.
+The addresses are comma separated and replace the previous participant list of the chain.
+Providing empty addresses ("") removes the participant list of the chain.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			listType, err := types.ParseParticipantListType(args[1])
+			if err != nil {
+				return err
+			}
+
+			var addresses []string
+			for _, address := range strings.Split(args[2], ",") {
+				if address = strings.TrimSpace(address); address != "" {
+					addresses = append(addresses, address)
+				}
+			}
+
+			msg := types.NewMsgSetParticipantList(clientCtx.GetFromAddress().String(), launchID, listType, addresses)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
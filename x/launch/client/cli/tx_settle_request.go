@@ -32,6 +32,8 @@ func CmdSettleRequest() *cobra.Command {
 				)
 			}
 
+			rejectionReason, _ := cmd.Flags().GetString(flagRejectionReason)
+
 			requestID, err := strconv.ParseUint(args[2], 10, 64)
 			if err != nil {
 				return err
@@ -52,6 +54,7 @@ func CmdSettleRequest() *cobra.Command {
 				launchID,
 				requestID,
 				approve,
+				rejectionReason,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -60,6 +63,7 @@ func CmdSettleRequest() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagRejectionReason, "", "Reason stored on the request if it is rejected")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
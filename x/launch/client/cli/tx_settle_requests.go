@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/pkg/numbers"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+const (
+	flagApprove         = "approve"
+	flagReject          = "reject"
+	flagRejectionReason = "rejection-reason"
+)
+
+func CmdSettleRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-requests [launch-id]",
+		Short: "Approve and reject several pending requests at once",
+		Long: `Approve and reject several pending requests at once.
+The request IDs are provided as comma separated lists of IDs and ranges, e.g. --approve 1-50,52 --reject 51
+The approval is not atomic: an approved request whose content can't be applied doesn't make the
+transaction fail, it is settled with the FAILED status and the other requests are still settled.
+The status of each settled request is returned in the transaction response`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				approve, _         = cmd.Flags().GetString(flagApprove)
+				reject, _          = cmd.Flags().GetString(flagReject)
+				rejectionReason, _ = cmd.Flags().GetString(flagRejectionReason)
+			)
+			if approve == "" && reject == "" {
+				return errors.New("no request to settle, at least one of --approve or --reject must be provided")
+			}
+
+			var approvedRequestIDs, rejectedRequestIDs []uint64
+			var err error
+			if approve != "" {
+				approvedRequestIDs, err = numbers.ParseList(approve)
+				if err != nil {
+					return err
+				}
+			}
+			if reject != "" {
+				rejectedRequestIDs, err = numbers.ParseList(reject)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSettleRequests(
+				clientCtx.GetFromAddress().String(),
+				launchID,
+				approvedRequestIDs,
+				rejectedRequestIDs,
+				rejectionReason,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagApprove, "", "List of the request IDs to approve, e.g. 1-50,52")
+	cmd.Flags().String(flagReject, "", "List of the request IDs to reject, e.g. 51,53-60")
+	cmd.Flags().String(flagRejectionReason, "", "Reason stored on the rejected requests")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
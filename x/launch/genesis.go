@@ -13,6 +13,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the chain
 	for _, elem := range genState.ChainList {
 		k.SetChain(ctx, elem)
+
+		// Chains waiting for their launch timestamp are added in the launch queue
+		if elem.LaunchTriggered && !elem.Launched {
+			k.EnqueueLaunch(ctx, elem.LaunchTimestamp, elem.LaunchID)
+		}
 	}
 
 	k.SetChainCounter(ctx, genState.ChainCounter)
@@ -42,6 +47,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetRequestCounter(ctx, elem.LaunchID, elem.Counter)
 	}
 
+	// Set all the rewardPool
+	for _, elem := range genState.RewardPoolList {
+		k.SetRewardPool(ctx, elem)
+	}
+
+	// Set all the participantList
+	for _, elem := range genState.ParticipantListList {
+		k.SetParticipantList(ctx, elem)
+	}
+
+	// Set all the requestAllowanceGrant
+	for _, elem := range genState.RequestAllowanceGrantList {
+		k.SetRequestAllowanceGrant(ctx, elem)
+	}
+
 	k.SetParams(ctx, genState.Params)
 }
 
@@ -55,6 +75,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.VestingAccountList = k.GetAllVestingAccount(ctx)
 	genesis.GenesisValidatorList = k.GetAllGenesisValidator(ctx)
 	genesis.RequestList = k.GetAllRequest(ctx)
+	genesis.RewardPoolList = k.GetAllRewardPool(ctx)
+	genesis.ParticipantListList = k.GetAllParticipantList(ctx)
+	genesis.RequestAllowanceGrantList = k.GetAllRequestAllowanceGrant(ctx)
 	genesis.Params = k.GetParams(ctx)
 
 	// Get request counts
@@ -7,6 +7,7 @@ import (
 	testkeeper "github.com/tendermint/spn/testutil/keeper"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch"
+	"github.com/tendermint/spn/x/launch/types"
 )
 
 /*
@@ -18,6 +19,10 @@ func TestGenesis(t *testing.T) {
 	keeper, ctx := testkeeper.Launch(t)
 
 	genesisState := sample.LaunchGenesisState()
+	genesisState.RewardPoolList = []types.RewardPool{
+		sample.RewardPool(0),
+		sample.RewardPool(1),
+	}
 	launch.InitGenesis(ctx, *keeper, genesisState)
 	got := launch.ExportGenesis(ctx, *keeper)
 
@@ -30,8 +35,26 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.GenesisValidatorList, got.GenesisValidatorList)
 	require.ElementsMatch(t, genesisState.RequestList, got.RequestList)
 	require.ElementsMatch(t, genesisState.RequestCounterList, got.RequestCounterList)
+	require.ElementsMatch(t, genesisState.RewardPoolList, got.RewardPoolList)
+	require.ElementsMatch(t, genesisState.ParticipantListList, got.ParticipantListList)
+	require.ElementsMatch(t, genesisState.RequestAllowanceGrantList, got.RequestAllowanceGrantList)
 
 	require.Equal(t, genesisState.Params, got.Params)
 
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisLaunchQueue(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+
+	genesisState := sample.LaunchGenesisState()
+	genesisState.ChainList[0].LaunchTriggered = true
+	genesisState.ChainList[0].LaunchTimestamp = 1000
+	genesisState.ChainList[1].LaunchTriggered = true
+	genesisState.ChainList[1].LaunchTimestamp = 1000
+	genesisState.ChainList[1].Launched = true
+	launch.InitGenesis(ctx, *keeper, genesisState)
+
+	// Only the chains waiting for their launch are added in the launch queue
+	require.Equal(t, []uint64{genesisState.ChainList[0].LaunchID}, keeper.GetLaunchQueue(ctx, 1000))
+}
@@ -37,10 +37,24 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err = msgServer.RequestRemoveValidator(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgSettleRequest:
 			res, err = msgServer.SettleRequest(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgSettleRequests:
+			res, err = msgServer.SettleRequests(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgCancelRequest:
+			res, err = msgServer.CancelRequest(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgTriggerLaunch:
 			res, err = msgServer.TriggerLaunch(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgRevertLaunch:
 			res, err = msgServer.RevertLaunch(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgSetRewards:
+			res, err = msgServer.SetRewards(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgDistributeRewards:
+			res, err = msgServer.DistributeRewards(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgClaimRewards:
+			res, err = msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgGrantRequestAllowance:
+			res, err = msgServer.GrantRequestAllowance(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgSetParticipantList:
+			res, err = msgServer.SetParticipantList(sdk.WrapSDKContext(ctx), msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			err = sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
 	"github.com/tendermint/spn/x/launch/types"
 )
 
@@ -21,6 +22,7 @@ func (k Keeper) CreateNewChain(
 	hasCampaign bool,
 	campaignID uint64,
 	isMainnet bool,
+	limits types.ChainLimits,
 ) (uint64, error) {
 	chain := types.Chain{
 		CoordinatorID:   coordinatorID,
@@ -33,6 +35,7 @@ func (k Keeper) CreateNewChain(
 		IsMainnet:       isMainnet,
 		LaunchTriggered: false,
 		LaunchTimestamp: 0,
+		Limits:          limits,
 	}
 
 	// Initialize initial genesis
@@ -61,6 +64,13 @@ func (k Keeper) CreateNewChain(
 		}
 	}
 
+	// The creation fee is paid to the community pool by the coordinator, the fee is waived for mainnets
+	if !isMainnet {
+		if err := k.chargeChainCreationFee(ctx, coordinatorID); err != nil {
+			return 0, err
+		}
+	}
+
 	// Append the chain to the store
 	launchID := k.AppendChain(ctx, chain)
 
@@ -74,6 +84,51 @@ func (k Keeper) CreateNewChain(
 	return launchID, nil
 }
 
+// CreateNewMainnet creates the mainnet chain of a campaign in the store, mainnets have no limits
+func (k Keeper) CreateNewMainnet(
+	ctx sdk.Context,
+	coordinatorID uint64,
+	mainnetChainID,
+	sourceURL,
+	sourceHash string,
+	campaignID uint64,
+) (uint64, error) {
+	return k.CreateNewChain(
+		ctx,
+		coordinatorID,
+		mainnetChainID,
+		sourceURL,
+		sourceHash,
+		"",
+		"",
+		true,
+		campaignID,
+		true,
+		types.ChainLimits{},
+	)
+}
+
+// chargeChainCreationFee sends the chain creation fee from the coordinator to the community pool
+func (k Keeper) chargeChainCreationFee(ctx sdk.Context, coordinatorID uint64) error {
+	fee := k.ChainCreationFee(ctx)
+	if fee.Empty() {
+		return nil
+	}
+
+	coordAddress, found := k.profileKeeper.GetCoordinatorAddressFromID(ctx, coordinatorID)
+	if !found {
+		return fmt.Errorf("coordinator %d doesn't exist", coordinatorID)
+	}
+	coordAccAddr, err := sdk.AccAddressFromBech32(coordAddress)
+	if err != nil {
+		return spnerrors.Criticalf("can't parse coordinator address %s", err.Error())
+	}
+	if err := k.distrKeeper.FundCommunityPool(ctx, fee, coordAccAddr); err != nil {
+		return fmt.Errorf("can't pay the chain creation fee %s: %s", fee.String(), err.Error())
+	}
+	return nil
+}
+
 // GetChainCounter get the counter for chains
 func (k Keeper) GetChainCounter(ctx sdk.Context) uint64 {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
@@ -108,6 +163,7 @@ func (k Keeper) AppendChain(ctx sdk.Context, chain types.Chain) uint64 {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainKeyPrefix))
 	appendedValue := k.cdc.MustMarshal(&chain)
 	store.Set(types.ChainKey(chain.LaunchID), appendedValue)
+	k.setChainByCoordinator(ctx, chain.CoordinatorID, chain.LaunchID)
 
 	// Update chain counter
 	k.SetChainCounter(ctx, counter+1)
@@ -117,9 +173,15 @@ func (k Keeper) AppendChain(ctx sdk.Context, chain types.Chain) uint64 {
 
 // SetChain set a specific chain in the store from its index
 func (k Keeper) SetChain(ctx sdk.Context, chain types.Chain) {
+	// The chain is no longer indexed by its previous coordinator if it changed
+	if previous, found := k.GetChain(ctx, chain.LaunchID); found && previous.CoordinatorID != chain.CoordinatorID {
+		k.removeChainByCoordinator(ctx, previous.CoordinatorID, chain.LaunchID)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainKeyPrefix))
 	b := k.cdc.MustMarshal(&chain)
 	store.Set(types.ChainKey(chain.LaunchID), b)
+	k.setChainByCoordinator(ctx, chain.CoordinatorID, chain.LaunchID)
 }
 
 // GetChain returns a chain from its index
@@ -135,8 +197,22 @@ func (k Keeper) GetChain(ctx sdk.Context, launchID uint64) (val types.Chain, fou
 	return val, true
 }
 
+// IsChainLaunched returns true if the chain has been launched
+// It is used by the modules that can't depend on the chain type
+func (k Keeper) IsChainLaunched(ctx sdk.Context, launchID uint64) (launched bool, found bool) {
+	chain, found := k.GetChain(ctx, launchID)
+	if !found {
+		return false, false
+	}
+	return chain.Launched, true
+}
+
 // RemoveChain removes a chain from the store
 func (k Keeper) RemoveChain(ctx sdk.Context, launchID uint64) {
+	if chain, found := k.GetChain(ctx, launchID); found {
+		k.removeChainByCoordinator(ctx, chain.CoordinatorID, launchID)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainKeyPrefix))
 	store.Delete(types.ChainKey(launchID))
 }
@@ -156,3 +232,34 @@ func (k Keeper) GetAllChain(ctx sdk.Context) (list []types.Chain) {
 
 	return
 }
+
+// GetAllChainByCoordinatorID returns all the chains of a coordinator
+func (k Keeper) GetAllChainByCoordinatorID(ctx sdk.Context, coordinatorID uint64) (list []types.Chain) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainByCoordinatorKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainByCoordinatorAllKey(coordinatorID))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		chain, found := k.GetChain(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if found {
+			list = append(list, chain)
+		}
+	}
+
+	return
+}
+
+// setChainByCoordinator indexes a chain by its coordinator
+func (k Keeper) setChainByCoordinator(ctx sdk.Context, coordinatorID, launchID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainByCoordinatorKeyPrefix))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, launchID)
+	store.Set(types.ChainByCoordinatorKey(coordinatorID, launchID), bz)
+}
+
+// removeChainByCoordinator removes a chain from the index of the chains of its coordinator
+func (k Keeper) removeChainByCoordinator(ctx sdk.Context, coordinatorID, launchID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainByCoordinatorKeyPrefix))
+	store.Delete(types.ChainByCoordinatorKey(coordinatorID, launchID))
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+// CheckChainLimits checks the content of a request doesn't exceed the limits of the chain
+// Only the contents adding accounts or validators to the genesis are limited
+func CheckChainLimits(ctx sdk.Context, k Keeper, chain types.Chain, content types.RequestContent) error {
+	limits := chain.Limits
+
+	switch requestContent := content.Content.(type) {
+	case *types.RequestContent_GenesisAccount:
+		if err := checkMaxGenesisAccounts(ctx, k, chain); err != nil {
+			return err
+		}
+		if err := limits.CheckAccountCoins(requestContent.GenesisAccount.Coins); err != nil {
+			return sdkerrors.Wrap(types.ErrChainLimitExceeded, err.Error())
+		}
+	case *types.RequestContent_VestingAccount:
+		if err := checkMaxGenesisAccounts(ctx, k, chain); err != nil {
+			return err
+		}
+		va := requestContent.VestingAccount
+		totalVesting, err := va.VestingOptions.TotalVesting()
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidCoins, err.Error())
+		}
+		if err := limits.CheckAccountCoins(va.StartingBalance.Add(totalVesting...)); err != nil {
+			return sdkerrors.Wrap(types.ErrChainLimitExceeded, err.Error())
+		}
+	case *types.RequestContent_GenesisValidator:
+		gv := requestContent.GenesisValidator
+		if limits.MaxValidators > 0 {
+			if k.GetGenesisValidatorCount(ctx, chain.LaunchID) >= limits.MaxValidators {
+				return sdkerrors.Wrapf(types.ErrChainLimitExceeded,
+					"chain %d reached the max number of validators %d",
+					chain.LaunchID,
+					limits.MaxValidators,
+				)
+			}
+		}
+		if err := limits.CheckSelfDelegation(gv.SelfDelegation); err != nil {
+			return sdkerrors.Wrap(types.ErrChainLimitExceeded, err.Error())
+		}
+	}
+	return nil
+}
+
+// checkMaxGenesisAccounts checks a genesis account can be added to the chain
+// genesis accounts and vesting accounts are both counted
+func checkMaxGenesisAccounts(ctx sdk.Context, k Keeper, chain types.Chain) error {
+	maxGenesisAccounts := chain.Limits.MaxGenesisAccounts
+	if maxGenesisAccounts == 0 {
+		return nil
+	}
+	nb := k.GetGenesisAccountCount(ctx, chain.LaunchID) + k.GetVestingAccountCount(ctx, chain.LaunchID)
+	if nb >= maxGenesisAccounts {
+		return sdkerrors.Wrapf(types.ErrChainLimitExceeded,
+			"chain %d reached the max number of genesis accounts %d",
+			chain.LaunchID,
+			maxGenesisAccounts,
+		)
+	}
+	return nil
+}
+
+// getLaunchIDCounter returns the counter of a chain stored under the provided prefix
+func (k Keeper) getLaunchIDCounter(ctx sdk.Context, keyPrefix string, launchID uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	bz := store.Get(types.LaunchIDCounterKey(launchID))
+
+	// Counter doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setLaunchIDCounter sets the counter of a chain stored under the provided prefix
+func (k Keeper) setLaunchIDCounter(ctx sdk.Context, keyPrefix string, launchID, counter uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	if counter == 0 {
+		store.Delete(types.LaunchIDCounterKey(launchID))
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, counter)
+	store.Set(types.LaunchIDCounterKey(launchID), bz)
+}
+
+// incrementLaunchIDCounter increments the counter of a chain stored under the provided prefix
+func (k Keeper) incrementLaunchIDCounter(ctx sdk.Context, keyPrefix string, launchID uint64) {
+	k.setLaunchIDCounter(ctx, keyPrefix, launchID, k.getLaunchIDCounter(ctx, keyPrefix, launchID)+1)
+}
+
+// decrementLaunchIDCounter decrements the counter of a chain stored under the provided prefix
+func (k Keeper) decrementLaunchIDCounter(ctx sdk.Context, keyPrefix string, launchID uint64) {
+	if counter := k.getLaunchIDCounter(ctx, keyPrefix, launchID); counter > 0 {
+		k.setLaunchIDCounter(ctx, keyPrefix, launchID, counter-1)
+	}
+}
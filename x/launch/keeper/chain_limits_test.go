@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestCheckChainLimits(t *testing.T) {
+	k, ctx := testkeeper.Launch(t)
+
+	var (
+		fullChain         = sample.Chain(0, 0)
+		limitedChain      = sample.Chain(1, 0)
+		noLimitChain      = sample.Chain(2, 0)
+		minSelfDelegation = sdk.NewCoin("stake", sdk.NewInt(10))
+		maxSelfDelegation = sdk.NewCoin("stake", sdk.NewInt(100))
+		maxAccountCoins   = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)))
+	)
+
+	// The full chain reached the max number of genesis accounts and validators
+	fullChain.Limits = types.ChainLimits{
+		MaxValidators:      1,
+		MaxGenesisAccounts: 2,
+	}
+	k.SetGenesisAccount(ctx, sample.GenesisAccount(fullChain.LaunchID, sample.Address()))
+	k.SetVestingAccount(ctx, sample.VestingAccount(fullChain.LaunchID, sample.Address()))
+	k.SetGenesisValidator(ctx, sample.GenesisValidator(fullChain.LaunchID, sample.Address()))
+
+	// The limited chain has a single genesis account and no validator
+	limitedChain.Limits = types.ChainLimits{
+		MaxValidators:      1,
+		MaxGenesisAccounts: 2,
+		MaxAccountCoins:    maxAccountCoins,
+		MinSelfDelegation:  &minSelfDelegation,
+		MaxSelfDelegation:  &maxSelfDelegation,
+	}
+	k.SetGenesisAccount(ctx, sample.GenesisAccount(limitedChain.LaunchID, sample.Address()))
+
+	noLimitChain.Limits = types.ChainLimits{}
+
+	var (
+		fooCoins = func(amount int64) sdk.Coins {
+			return sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(amount)))
+		}
+		genesisAccount = func(launchID uint64, coins sdk.Coins) types.RequestContent {
+			return types.NewGenesisAccount(launchID, sample.Address(), coins)
+		}
+		vestingAccount = func(launchID uint64, startingBalance, vesting sdk.Coins) types.RequestContent {
+			return types.NewVestingAccount(
+				launchID,
+				sample.Address(),
+				startingBalance,
+				*types.NewDelayedVesting(vesting, time.Now().Unix()),
+			)
+		}
+		genesisValidator = func(launchID uint64, selfDelegation sdk.Coin) types.RequestContent {
+			return types.NewGenesisValidator(
+				launchID,
+				sample.Address(),
+				sample.Bytes(300),
+				sample.Bytes(30),
+				selfDelegation,
+				sample.Peer(),
+			)
+		}
+	)
+
+	for _, tc := range []struct {
+		desc    string
+		chain   types.Chain
+		content types.RequestContent
+		err     error
+	}{
+		{
+			desc:    "genesis account within the limits",
+			chain:   limitedChain,
+			content: genesisAccount(limitedChain.LaunchID, fooCoins(100)),
+		},
+		{
+			desc:    "vesting account within the limits",
+			chain:   limitedChain,
+			content: vestingAccount(limitedChain.LaunchID, fooCoins(50), fooCoins(50)),
+		},
+		{
+			desc:    "genesis validator within the limits",
+			chain:   limitedChain,
+			content: genesisValidator(limitedChain.LaunchID, sdk.NewCoin("stake", sdk.NewInt(50))),
+		},
+		{
+			desc:    "account removal is never limited",
+			chain:   fullChain,
+			content: types.NewAccountRemoval(sample.Address()),
+		},
+		{
+			desc:    "validator removal is never limited",
+			chain:   fullChain,
+			content: types.NewValidatorRemoval(sample.Address()),
+		},
+		{
+			desc:    "genesis account for a chain without limits",
+			chain:   noLimitChain,
+			content: genesisAccount(noLimitChain.LaunchID, sample.Coins()),
+		},
+		{
+			desc:    "genesis validator for a chain without limits",
+			chain:   noLimitChain,
+			content: genesisValidator(noLimitChain.LaunchID, sample.Coin()),
+		},
+		{
+			desc:    "max genesis accounts reached with a genesis account",
+			chain:   fullChain,
+			content: genesisAccount(fullChain.LaunchID, sample.Coins()),
+			err:     types.ErrChainLimitExceeded,
+		},
+		{
+			desc:    "max genesis accounts reached with a vesting account",
+			chain:   fullChain,
+			content: vestingAccount(fullChain.LaunchID, sample.Coins(), sample.Coins()),
+			err:     types.ErrChainLimitExceeded,
+		},
+		{
+			desc:    "max validators reached",
+			chain:   fullChain,
+			content: genesisValidator(fullChain.LaunchID, sample.Coin()),
+			err:     types.ErrChainLimitExceeded,
+		},
+		{
+			desc:    "genesis account coins exceeding the limit",
+			chain:   limitedChain,
+			content: genesisAccount(limitedChain.LaunchID, fooCoins(101)),
+			err:     types.ErrChainLimitExceeded,
+		},
+		{
+			desc:    "vesting account total coins exceeding the limit",
+			chain:   limitedChain,
+			content: vestingAccount(limitedChain.LaunchID, fooCoins(51), fooCoins(50)),
+			err:     types.ErrChainLimitExceeded,
+		},
+		{
+			desc:    "self delegation lower than the min",
+			chain:   limitedChain,
+			content: genesisValidator(limitedChain.LaunchID, sdk.NewCoin("stake", sdk.NewInt(9))),
+			err:     types.ErrChainLimitExceeded,
+		},
+		{
+			desc:    "self delegation higher than the max",
+			chain:   limitedChain,
+			content: genesisValidator(limitedChain.LaunchID, sdk.NewCoin("stake", sdk.NewInt(101))),
+			err:     types.ErrChainLimitExceeded,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := keeper.CheckChainLimits(ctx, *k, tc.chain, tc.content)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
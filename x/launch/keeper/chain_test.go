@@ -4,11 +4,15 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/stretchr/testify/require"
 	testkeeper "github.com/tendermint/spn/testutil/keeper"
 	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 	"github.com/tendermint/spn/x/launch/keeper"
 	"github.com/tendermint/spn/x/launch/types"
+	profilekeeper "github.com/tendermint/spn/x/profile/keeper"
 )
 
 func TestKeeper_CreateNewChain(t *testing.T) {
@@ -147,6 +151,7 @@ func TestKeeper_CreateNewChain(t *testing.T) {
 				tc.hasCampaign,
 				tc.campaignID,
 				tc.isMainnet,
+				types.ChainLimits{},
 			)
 
 			if !tc.valid {
@@ -186,9 +191,80 @@ func TestKeeper_CreateNewChain(t *testing.T) {
 	}
 }
 
+func TestKeeper_CreateNewChainFee(t *testing.T) {
+	var (
+		campaignKeeper, k, profileKeeper, bk, sdkCtx = testkeeper.AllKeepers(t)
+		profileSrv                                   = profilekeeper.NewMsgServerImpl(*profileKeeper)
+		ctx                                          = sdk.WrapSDKContext(sdkCtx)
+		coordAddr                                    = sample.AccAddress()
+		fee                                          = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)))
+		communityPoolAddr                            = authtypes.NewModuleAddress(distrtypes.ModuleName)
+	)
+
+	msgCreateCoordinator := sample.MsgCreateCoordinator(coordAddr.String())
+	res, err := profileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+	coordID := res.CoordinatorId
+
+	params := types.DefaultParams()
+	params.ChainCreationFee = fee
+	k.SetParams(sdkCtx, params)
+
+	// the coordinator can pay the fee of a single chain
+	require.NoError(t, bk.MintCoins(sdkCtx, campaigntypes.ModuleName, fee))
+	require.NoError(t, bk.SendCoinsFromModuleToAccount(sdkCtx, campaigntypes.ModuleName, coordAddr, fee))
+
+	// createChain creates a chain for the coordinator, a campaign is required for mainnets
+	createChain := func(isMainnet bool) (uint64, error) {
+		var campaignID uint64
+		if isMainnet {
+			campaign := sample.Campaign(0)
+			campaign.CoordinatorID = coordID
+			campaignID = campaignKeeper.AppendCampaign(sdkCtx, campaign)
+		}
+		return k.CreateNewChain(
+			sdkCtx,
+			coordID,
+			sample.GenesisChainID(),
+			sample.String(30),
+			sample.String(20),
+			"",
+			"",
+			isMainnet,
+			campaignID,
+			isMainnet,
+			types.ChainLimits{},
+		)
+	}
+
+	t.Run("should send the chain creation fee to the community pool", func(t *testing.T) {
+		_, err := createChain(false)
+		require.NoError(t, err)
+		require.True(t, bk.GetAllBalances(sdkCtx, coordAddr).IsZero())
+		require.True(t, fee.IsEqual(bk.GetAllBalances(sdkCtx, communityPoolAddr)))
+	})
+
+	t.Run("should prevent creating a chain without balance for the fee", func(t *testing.T) {
+		counter := k.GetChainCounter(sdkCtx)
+		_, err := createChain(false)
+		require.Error(t, err)
+		require.Equal(t, counter, k.GetChainCounter(sdkCtx))
+	})
+
+	t.Run("should waive the chain creation fee for mainnets", func(t *testing.T) {
+		launchID, err := createChain(true)
+		require.NoError(t, err)
+		chain, found := k.GetChain(sdkCtx, launchID)
+		require.True(t, found)
+		require.True(t, chain.IsMainnet)
+		require.True(t, fee.IsEqual(bk.GetAllBalances(sdkCtx, communityPoolAddr)))
+	})
+}
+
 func createNChain(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Chain {
 	items := make([]types.Chain, n)
 	for i := range items {
+		items[i].InitialGenesis = types.NewDefaultInitialGenesis()
 		items[i].LaunchID = keeper.AppendChain(ctx, items[i])
 	}
 	return items
@@ -213,6 +289,25 @@ func TestGetChain(t *testing.T) {
 	}
 }
 
+func TestIsChainLaunched(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNChain(keeper, ctx, 2)
+	items[1].LaunchTriggered = true
+	items[1].Launched = true
+	keeper.SetChain(ctx, items[1])
+
+	launched, found := keeper.IsChainLaunched(ctx, items[0].LaunchID)
+	require.True(t, found)
+	require.False(t, launched)
+
+	launched, found = keeper.IsChainLaunched(ctx, items[1].LaunchID)
+	require.True(t, found)
+	require.True(t, launched)
+
+	_, found = keeper.IsChainLaunched(ctx, 1000)
+	require.False(t, found)
+}
+
 func TestRemoveChain(t *testing.T) {
 	keeper, ctx := testkeeper.Launch(t)
 	items := createNChain(keeper, ctx, 10)
@@ -230,6 +325,23 @@ func TestGetAllChain(t *testing.T) {
 	require.ElementsMatch(t, items, keeper.GetAllChain(ctx))
 }
 
+func TestGetAllChainByCoordinatorID(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNChainForCoordinator(keeper, ctx, 0, 5)
+	otherItems := createNChainForCoordinator(keeper, ctx, 1, 5)
+
+	require.ElementsMatch(t, items, keeper.GetAllChainByCoordinatorID(ctx, 0))
+	require.ElementsMatch(t, otherItems, keeper.GetAllChainByCoordinatorID(ctx, 1))
+	require.Empty(t, keeper.GetAllChainByCoordinatorID(ctx, 2))
+
+	// the index follows the coordinator of the chain and its removal
+	items[0].CoordinatorID = 2
+	keeper.SetChain(ctx, items[0])
+	keeper.RemoveChain(ctx, items[1].LaunchID)
+	require.ElementsMatch(t, items[2:], keeper.GetAllChainByCoordinatorID(ctx, 0))
+	require.ElementsMatch(t, items[:1], keeper.GetAllChainByCoordinatorID(ctx, 2))
+}
+
 func TestChainCounter(t *testing.T) {
 	keeper, ctx := testkeeper.Launch(t)
 	items := createNChain(keeper, ctx, 10)
@@ -9,11 +9,15 @@ import (
 // SetGenesisAccount set a specific genesisAccount in the store from its index
 func (k Keeper) SetGenesisAccount(ctx sdk.Context, genesisAccount types.GenesisAccount) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GenesisAccountKeyPrefix))
+	key := types.GenesisAccountKey(genesisAccount.LaunchID, genesisAccount.Address)
+
+	// Increment the counter of the chain if the entry is new
+	if !store.Has(key) {
+		k.incrementLaunchIDCounter(ctx, types.GenesisAccountCounterKeyPrefix, genesisAccount.LaunchID)
+	}
+
 	b := k.cdc.MustMarshal(&genesisAccount)
-	store.Set(types.GenesisAccountKey(
-		genesisAccount.LaunchID,
-		genesisAccount.Address,
-	), b)
+	store.Set(key, b)
 }
 
 // GetGenesisAccount returns a genesisAccount from its index
@@ -40,7 +44,19 @@ func (k Keeper) RemoveGenesisAccount(
 	address string,
 ) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GenesisAccountKeyPrefix))
-	store.Delete(types.GenesisAccountKey(launchID, address))
+	key := types.GenesisAccountKey(launchID, address)
+
+	// Decrement the counter of the chain if the entry exists
+	if store.Has(key) {
+		k.decrementLaunchIDCounter(ctx, types.GenesisAccountCounterKeyPrefix, launchID)
+	}
+
+	store.Delete(key)
+}
+
+// GetGenesisAccountCount returns the number of genesisAccount of a chain
+func (k Keeper) GetGenesisAccountCount(ctx sdk.Context, launchID uint64) uint64 {
+	return k.getLaunchIDCounter(ctx, types.GenesisAccountCounterKeyPrefix, launchID)
 }
 
 // GetAllGenesisAccount returns all genesisAccount
@@ -58,3 +74,19 @@ func (k Keeper) GetAllGenesisAccount(ctx sdk.Context) (list []types.GenesisAccou
 
 	return
 }
+
+// GetAllGenesisAccountByLaunchID returns all genesisAccount for a launch ID
+func (k Keeper) GetAllGenesisAccountByLaunchID(ctx sdk.Context, launchID uint64) (list []types.GenesisAccount) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GenesisAccountAllKey(launchID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GenesisAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
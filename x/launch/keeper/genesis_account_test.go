@@ -54,3 +54,29 @@ func TestGenesisAccountGetAll(t *testing.T) {
 	items := createNGenesisAccount(keeper, ctx, 10)
 	require.ElementsMatch(t, items, keeper.GetAllGenesisAccount(ctx))
 }
+
+func TestGenesisAccountGetAllByLaunchID(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNGenesisAccountForChainID(keeper, ctx, 10, 0)
+	createNGenesisAccountForChainID(keeper, ctx, 5, 1)
+	require.ElementsMatch(t, items, keeper.GetAllGenesisAccountByLaunchID(ctx, 0))
+	require.Len(t, keeper.GetAllGenesisAccountByLaunchID(ctx, 1), 5)
+	require.Empty(t, keeper.GetAllGenesisAccountByLaunchID(ctx, 2))
+}
+
+func TestGenesisAccountCount(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNGenesisAccountForChainID(keeper, ctx, 10, 0)
+	createNGenesisAccountForChainID(keeper, ctx, 5, 1)
+	require.EqualValues(t, 10, keeper.GetGenesisAccountCount(ctx, 0))
+	require.Zero(t, keeper.GetGenesisAccountCount(ctx, 2))
+
+	// Updating an existing entry doesn't change the count
+	keeper.SetGenesisAccount(ctx, items[0])
+	require.EqualValues(t, 10, keeper.GetGenesisAccountCount(ctx, 0))
+
+	// Removing an entry decrements the count only if it exists
+	keeper.RemoveGenesisAccount(ctx, items[0].LaunchID, items[0].Address)
+	keeper.RemoveGenesisAccount(ctx, items[0].LaunchID, items[0].Address)
+	require.EqualValues(t, 9, keeper.GetGenesisAccountCount(ctx, 0))
+}
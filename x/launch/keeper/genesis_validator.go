@@ -9,11 +9,15 @@ import (
 // SetGenesisValidator set a specific genesisValidator in the store from its index
 func (k Keeper) SetGenesisValidator(ctx sdk.Context, genesisValidator types.GenesisValidator) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GenesisValidatorKeyPrefix))
+	key := types.GenesisValidatorKey(genesisValidator.LaunchID, genesisValidator.Address)
+
+	// Increment the counter of the chain if the entry is new
+	if !store.Has(key) {
+		k.incrementLaunchIDCounter(ctx, types.GenesisValidatorCounterKeyPrefix, genesisValidator.LaunchID)
+	}
+
 	b := k.cdc.MustMarshal(&genesisValidator)
-	store.Set(types.GenesisValidatorKey(
-		genesisValidator.LaunchID,
-		genesisValidator.Address,
-	), b)
+	store.Set(key, b)
 }
 
 // GetGenesisValidator returns a genesisValidator from its index
@@ -42,7 +46,19 @@ func (k Keeper) RemoveGenesisValidator(
 
 ) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GenesisValidatorKeyPrefix))
-	store.Delete(types.GenesisValidatorKey(launchID, address))
+	key := types.GenesisValidatorKey(launchID, address)
+
+	// Decrement the counter of the chain if the entry exists
+	if store.Has(key) {
+		k.decrementLaunchIDCounter(ctx, types.GenesisValidatorCounterKeyPrefix, launchID)
+	}
+
+	store.Delete(key)
+}
+
+// GetGenesisValidatorCount returns the number of genesisValidator of a chain
+func (k Keeper) GetGenesisValidatorCount(ctx sdk.Context, launchID uint64) uint64 {
+	return k.getLaunchIDCounter(ctx, types.GenesisValidatorCounterKeyPrefix, launchID)
 }
 
 // GetAllGenesisValidator returns all genesisValidator
@@ -60,3 +76,19 @@ func (k Keeper) GetAllGenesisValidator(ctx sdk.Context) (list []types.GenesisVal
 
 	return
 }
+
+// GetAllGenesisValidatorByLaunchID returns all genesisValidator for a launch ID
+func (k Keeper) GetAllGenesisValidatorByLaunchID(ctx sdk.Context, launchID uint64) (list []types.GenesisValidator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GenesisValidatorAllKey(launchID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GenesisValidator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
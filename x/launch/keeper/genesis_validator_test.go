@@ -54,3 +54,29 @@ func TestGenesisValidatorGetAll(t *testing.T) {
 	items := createNGenesisValidator(keeper, ctx, 10)
 	require.ElementsMatch(t, items, keeper.GetAllGenesisValidator(ctx))
 }
+
+func TestGenesisValidatorGetAllByLaunchID(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNGenesisValidatorForChainID(keeper, ctx, 10, 0)
+	createNGenesisValidatorForChainID(keeper, ctx, 5, 1)
+	require.ElementsMatch(t, items, keeper.GetAllGenesisValidatorByLaunchID(ctx, 0))
+	require.Len(t, keeper.GetAllGenesisValidatorByLaunchID(ctx, 1), 5)
+	require.Empty(t, keeper.GetAllGenesisValidatorByLaunchID(ctx, 2))
+}
+
+func TestGenesisValidatorCount(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNGenesisValidatorForChainID(keeper, ctx, 10, 0)
+	createNGenesisValidatorForChainID(keeper, ctx, 5, 1)
+	require.EqualValues(t, 10, keeper.GetGenesisValidatorCount(ctx, 0))
+	require.Zero(t, keeper.GetGenesisValidatorCount(ctx, 2))
+
+	// Updating an existing entry doesn't change the count
+	keeper.SetGenesisValidator(ctx, items[0])
+	require.EqualValues(t, 10, keeper.GetGenesisValidatorCount(ctx, 0))
+
+	// Removing an entry decrements the count only if it exists
+	keeper.RemoveGenesisValidator(ctx, items[0].LaunchID, items[0].Address)
+	keeper.RemoveGenesisValidator(ctx, items[0].LaunchID, items[0].Address)
+	require.EqualValues(t, 9, keeper.GetGenesisValidatorCount(ctx, 0))
+}
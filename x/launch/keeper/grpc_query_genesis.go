@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spn/x/launch/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Genesis(c context.Context, req *types.QueryGenesisRequest) (*types.QueryGenesisResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chain, found := k.GetChain(ctx, req.LaunchID)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	genesis, hash, err := k.GenerateGenesis(ctx, req.LaunchID, req.InitialGenesis)
+	switch {
+	case errors.Is(err, types.ErrInvalidInitialGenesis):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGenesisResponse{
+		InitialGenesis: chain.InitialGenesis,
+		Genesis:        genesis,
+		Hash:           hash,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGenesisQuery(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	chains := createNChain(keeper, ctx, 4)
+
+	// chain with accounts and validators
+	launchID := chains[0].LaunchID
+	keeper.SetGenesisAccount(ctx, sample.GenesisAccount(launchID, sample.Address()))
+	keeper.SetGenesisAccount(ctx, sample.GenesisAccount(launchID, sample.Address()))
+	keeper.SetVestingAccount(ctx, sample.VestingAccount(launchID, sample.Address()))
+	validator := sample.GenesisValidator(launchID, sample.Address())
+	validator.GenTx = []byte(`{"body":{}}`)
+	keeper.SetGenesisValidator(ctx, validator)
+
+	// chain with an invalid gentx
+	invalidLaunchID := chains[1].LaunchID
+	keeper.SetGenesisValidator(ctx, sample.GenesisValidator(invalidLaunchID, sample.Address()))
+
+	// chain with a genesis URL
+	urlChain := chains[3]
+	urlGenesis, err := types.DefaultGenesisDocument(urlChain.GenesisChainID)
+	require.NoError(t, err)
+	urlChain.InitialGenesis = types.NewGenesisURL("foo.com", types.GenesisURLHash(string(urlGenesis)))
+	keeper.SetChain(ctx, urlChain)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGenesisRequest
+		launchID uint64
+		err      error
+	}{
+		{
+			desc:     "chain with launch information",
+			request:  &types.QueryGenesisRequest{LaunchID: launchID},
+			launchID: launchID,
+		},
+		{
+			desc:     "chain without launch information",
+			request:  &types.QueryGenesisRequest{LaunchID: chains[2].LaunchID},
+			launchID: chains[2].LaunchID,
+		},
+		{
+			desc: "chain with a genesis URL",
+			request: &types.QueryGenesisRequest{
+				LaunchID:       urlChain.LaunchID,
+				InitialGenesis: string(urlGenesis),
+			},
+			launchID: urlChain.LaunchID,
+		},
+		{
+			desc:    "chain with a genesis URL without its content",
+			request: &types.QueryGenesisRequest{LaunchID: urlChain.LaunchID},
+			err:     status.Error(codes.InvalidArgument, ""),
+		},
+		{
+			desc: "chain with a genesis URL with an invalid content",
+			request: &types.QueryGenesisRequest{
+				LaunchID:       urlChain.LaunchID,
+				InitialGenesis: "{}",
+			},
+			err: status.Error(codes.InvalidArgument, ""),
+		},
+		{
+			desc:    "chain with invalid gentx",
+			request: &types.QueryGenesisRequest{LaunchID: invalidLaunchID},
+			err:     status.Error(codes.Internal, ""),
+		},
+		{
+			desc:    "chain not found",
+			request: &types.QueryGenesisRequest{LaunchID: 1000},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "invalid request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Genesis(wctx, tc.request)
+			if tc.err != nil {
+				require.Equal(t, status.Code(tc.err), status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			chain, found := keeper.GetChain(ctx, tc.launchID)
+			require.True(t, found)
+			require.EqualValues(t, chain.InitialGenesis, response.InitialGenesis)
+
+			genesisJSON, hash, err := keeper.GenerateGenesis(ctx, tc.launchID, tc.request.InitialGenesis)
+			require.NoError(t, err)
+			require.EqualValues(t, genesisJSON, response.Genesis)
+			require.EqualValues(t, hash, response.Hash)
+			require.EqualValues(t, types.GenesisURLHash(response.Genesis), response.Hash)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/spn/x/launch/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ParticipantListAll(c context.Context, req *types.QueryAllParticipantListRequest) (*types.QueryAllParticipantListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var participantLists []types.ParticipantList
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	participantListStore := prefix.NewStore(store, types.KeyPrefix(types.ParticipantListKeyPrefix))
+
+	pageRes, err := query.Paginate(participantListStore, req.Pagination, func(key []byte, value []byte) error {
+		var participantList types.ParticipantList
+		if err := k.cdc.Unmarshal(value, &participantList); err != nil {
+			return err
+		}
+
+		participantLists = append(participantLists, participantList)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllParticipantListResponse{ParticipantList: participantLists, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ParticipantList(c context.Context, req *types.QueryGetParticipantListRequest) (*types.QueryGetParticipantListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetParticipantList(ctx, req.LaunchID)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryGetParticipantListResponse{ParticipantList: val}, nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParticipantListQuerySingle(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNParticipantList(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetParticipantListRequest
+		response *types.QueryGetParticipantListResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetParticipantListRequest{LaunchID: msgs[0].LaunchID},
+			response: &types.QueryGetParticipantListResponse{ParticipantList: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetParticipantListRequest{LaunchID: msgs[1].LaunchID},
+			response: &types.QueryGetParticipantListResponse{ParticipantList: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetParticipantListRequest{LaunchID: uint64(1000)},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ParticipantList(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestParticipantListQueryPaginated(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNParticipantList(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllParticipantListRequest {
+		return &types.QueryAllParticipantListRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ParticipantListAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ParticipantList), step)
+			require.Subset(t, msgs, resp.ParticipantList)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.ParticipantListAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ParticipantList), step)
+			require.Subset(t, msgs, resp.ParticipantList)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.ParticipantListAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t, msgs, resp.ParticipantList)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.ParticipantListAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
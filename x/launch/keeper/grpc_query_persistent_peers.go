@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spn/x/launch/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PersistentPeers(c context.Context, req *types.QueryPersistentPeersRequest) (*types.QueryPersistentPeersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetChain(ctx, req.LaunchID); !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	// Only the peers reachable through TCP can be set as persistent peers
+	var peers []string
+	for _, validator := range k.GetAllGenesisValidatorByLaunchID(ctx, req.LaunchID) {
+		if peer, ok := validator.Peer.PersistentPeer(); ok {
+			peers = append(peers, peer)
+		}
+	}
+
+	return &types.QueryPersistentPeersResponse{
+		PersistentPeers: strings.Join(peers, types.PersistentPeersSeparator),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPersistentPeersQuery(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	chains := createNChain(keeper, ctx, 3)
+
+	// chain with validators reachable through TCP and through a tunnel
+	launchID := chains[0].LaunchID
+	validator1 := sample.GenesisValidator(launchID, sample.Address())
+	validator1.Peer = types.NewPeerConn(sample.NodeID(), "foo.com:26656")
+	validator2 := sample.GenesisValidator(launchID, sample.Address())
+	validator2.Peer = types.NewPeerConn(sample.NodeID(), "10.0.0.1:26656")
+	validator3 := sample.GenesisValidator(launchID, sample.Address())
+	validator3.Peer = types.NewPeerTunnel(sample.NodeID(), "chisel", "https://bar.com")
+	keeper.SetGenesisValidator(ctx, validator1)
+	keeper.SetGenesisValidator(ctx, validator2)
+	keeper.SetGenesisValidator(ctx, validator3)
+
+	// validator of another chain
+	keeper.SetGenesisValidator(ctx, sample.GenesisValidator(chains[1].LaunchID, sample.Address()))
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryPersistentPeersRequest
+		expected []string
+		err      error
+	}{
+		{
+			desc:    "chain with validators",
+			request: &types.QueryPersistentPeersRequest{LaunchID: launchID},
+			expected: []string{
+				validator1.Peer.Id + "@foo.com:26656",
+				validator2.Peer.Id + "@10.0.0.1:26656",
+			},
+		},
+		{
+			desc:    "chain without validators",
+			request: &types.QueryPersistentPeersRequest{LaunchID: chains[2].LaunchID},
+		},
+		{
+			desc:    "chain not found",
+			request: &types.QueryPersistentPeersRequest{LaunchID: 1000},
+			err:     status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "invalid request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PersistentPeers(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			if len(tc.expected) == 0 {
+				require.Empty(t, response.PersistentPeers)
+				return
+			}
+			require.ElementsMatch(t, tc.expected, strings.Split(response.PersistentPeers, types.PersistentPeersSeparator))
+		})
+	}
+}
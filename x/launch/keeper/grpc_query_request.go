@@ -16,6 +16,19 @@ func (k Keeper) RequestAll(c context.Context, req *types.QueryAllRequestRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	// Parse the filters
+	var requestStatus types.Request_Status
+	if req.Status != "" {
+		var err error
+		requestStatus, err = types.ParseRequestStatus(req.Status)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.ContentType != "" && !types.IsValidRequestContentType(req.ContentType) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request content type %s", req.ContentType)
+	}
+
 	var requests []types.Request
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -23,14 +36,22 @@ func (k Keeper) RequestAll(c context.Context, req *types.QueryAllRequestRequest)
 	keyPrefix := append(types.KeyPrefix(types.RequestKeyPrefix), types.RequestPoolKey(req.LaunchID)...)
 	requestStore := prefix.NewStore(store, keyPrefix)
 
-	pageRes, err := query.Paginate(requestStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(requestStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var request types.Request
 		if err := k.cdc.Unmarshal(value, &request); err != nil {
-			return err
+			return false, err
 		}
 
-		requests = append(requests, request)
-		return nil
+		if (req.Status != "" && request.Status != requestStatus) ||
+			(req.ContentType != "" && request.Content.Type() != req.ContentType) ||
+			(req.Creator != "" && request.Creator != req.Creator) {
+			return false, nil
+		}
+
+		if accumulate {
+			requests = append(requests, request)
+		}
+		return true, nil
 	})
 
 	if err != nil {
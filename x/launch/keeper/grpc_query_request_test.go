@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -110,3 +111,132 @@ func TestRequestQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestRequestQueryFiltered(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	launchID := uint64(0)
+
+	// requests with each content type
+	requests := createRequests(keeper, ctx, launchID, sample.AllRequestContents(
+		launchID,
+		sample.Address(),
+		sample.Address(),
+		sample.Address(),
+	))
+	requests[0].Status = types.Request_APPROVED
+	requests[1].Status = types.Request_REJECTED
+	requests[2].Status = types.Request_FAILED
+	requests[3].Creator = requests[4].Creator
+	for _, request := range requests {
+		keeper.SetRequest(ctx, request)
+	}
+
+	// request of another chain
+	createRequests(keeper, ctx, launchID+1, []types.RequestContent{
+		sample.GenesisAccountContent(launchID+1, sample.Address()),
+	})
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryAllRequestRequest
+		expected []types.Request
+		err      error
+	}{
+		{
+			desc:     "no filter",
+			request:  &types.QueryAllRequestRequest{LaunchID: launchID},
+			expected: requests,
+		},
+		{
+			desc:     "pending requests",
+			request:  &types.QueryAllRequestRequest{LaunchID: launchID, Status: "pending"},
+			expected: requests[3:],
+		},
+		{
+			desc:     "approved requests",
+			request:  &types.QueryAllRequestRequest{LaunchID: launchID, Status: "APPROVED"},
+			expected: requests[0:1],
+		},
+		{
+			desc:     "failed requests",
+			request:  &types.QueryAllRequestRequest{LaunchID: launchID, Status: "failed"},
+			expected: requests[2:3],
+		},
+		{
+			desc: "genesis account requests",
+			request: &types.QueryAllRequestRequest{
+				LaunchID:    launchID,
+				ContentType: types.RequestContentTypeGenesisAccount,
+			},
+			expected: requests[0:1],
+		},
+		{
+			desc: "account removal requests",
+			request: &types.QueryAllRequestRequest{
+				LaunchID:    launchID,
+				ContentType: types.RequestContentTypeAccountRemoval,
+			},
+			expected: []types.Request{requests[1], requests[3]},
+		},
+		{
+			desc: "requests of a creator",
+			request: &types.QueryAllRequestRequest{
+				LaunchID: launchID,
+				Creator:  requests[4].Creator,
+			},
+			expected: requests[3:5],
+		},
+		{
+			desc: "combined filters",
+			request: &types.QueryAllRequestRequest{
+				LaunchID:    launchID,
+				Status:      "pending",
+				ContentType: types.RequestContentTypeAccountRemoval,
+				Creator:     requests[4].Creator,
+			},
+			expected: requests[3:4],
+		},
+		{
+			desc: "no matching request",
+			request: &types.QueryAllRequestRequest{
+				LaunchID:    launchID,
+				Status:      "rejected",
+				ContentType: types.RequestContentTypeGenesisValidator,
+			},
+			expected: nil,
+		},
+		{
+			desc:    "invalid status",
+			request: &types.QueryAllRequestRequest{LaunchID: launchID, Status: "foo"},
+			err:     status.Error(codes.InvalidArgument, "invalid request status foo"),
+		},
+		{
+			desc:    "invalid content type",
+			request: &types.QueryAllRequestRequest{LaunchID: launchID, ContentType: "foo"},
+			err:     status.Error(codes.InvalidArgument, "invalid request content type foo"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := keeper.RequestAll(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resp.Request)
+		})
+	}
+
+	t.Run("paginated", func(t *testing.T) {
+		resp, err := keeper.RequestAll(wctx, &types.QueryAllRequestRequest{
+			LaunchID:   launchID,
+			Status:     "pending",
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, requests[3:5], resp.Request)
+		require.EqualValues(t, 3, resp.Pagination.Total)
+	})
+}
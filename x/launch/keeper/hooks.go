@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+// ProfileHooks implements the hooks called by the profile module
+type ProfileHooks struct {
+	k Keeper
+}
+
+var _ profiletypes.ProfileHooks = ProfileHooks{}
+
+// ProfileHooks returns the profile hooks of the launch module
+func (k Keeper) ProfileHooks() ProfileHooks {
+	return ProfileHooks{k}
+}
+
+// BeforeCoordinatorDeleted prevents the deletion of a coordinator that still manages a chain
+// that is not launched or a chain with a reward pool not distributed yet
+func (h ProfileHooks) BeforeCoordinatorDeleted(ctx sdk.Context, coordinatorID uint64) error {
+	for _, chain := range h.k.GetAllChainByCoordinatorID(ctx, coordinatorID) {
+		if !chain.Launched {
+			return sdkerrors.Wrapf(types.ErrCoordinatorActiveChain, "chain %d is not launched", chain.LaunchID)
+		}
+		if _, found := h.k.GetRewardPool(ctx, chain.LaunchID); found {
+			return sdkerrors.Wrapf(types.ErrCoordinatorActiveChain, "chain %d has undistributed rewards", chain.LaunchID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestProfileHooksBeforeCoordinatorDeleted(t *testing.T) {
+	k, ctx := testkeeper.Launch(t)
+	hooks := k.ProfileHooks()
+	coordID := uint64(1)
+
+	// A non-launched chain of another coordinator
+	otherChain := sample.Chain(0, coordID+1)
+	otherChain.LaunchID = k.AppendChain(ctx, otherChain)
+	require.NoError(t, hooks.BeforeCoordinatorDeleted(ctx, coordID))
+
+	// A non-launched chain of the coordinator
+	chain := sample.Chain(0, coordID)
+	chain.LaunchID = k.AppendChain(ctx, chain)
+	err := hooks.BeforeCoordinatorDeleted(ctx, coordID)
+	require.ErrorIs(t, err, types.ErrCoordinatorActiveChain)
+
+	// The chain is launched
+	chain.LaunchTriggered = true
+	chain.Launched = true
+	k.SetChain(ctx, chain)
+	require.NoError(t, hooks.BeforeCoordinatorDeleted(ctx, coordID))
+
+	// The launched chain has a reward pool not distributed
+	k.SetRewardPool(ctx, sample.RewardPool(chain.LaunchID))
+	err = hooks.BeforeCoordinatorDeleted(ctx, coordID)
+	require.ErrorIs(t, err, types.ErrCoordinatorActiveChain)
+
+	k.RemoveRewardPool(ctx, chain.LaunchID)
+	require.NoError(t, hooks.BeforeCoordinatorDeleted(ctx, coordID))
+}
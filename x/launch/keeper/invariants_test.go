@@ -30,14 +30,20 @@ func TestZeroLaunchTimestampInvariant(t *testing.T) {
 	k, _, _, _, _, _, ctx := setupMsgServer(t) //nolint
 	t.Run("valid case", func(t *testing.T) {
 		chain := sample.Chain(0, 0)
+		chain.LaunchTriggered = true
 		chain.LaunchTimestamp = 1000
 		chain.LaunchID = k.AppendChain(ctx, chain)
+
+		// the launch timestamp is not set for a chain whose launch is not triggered
+		k.AppendChain(ctx, sample.Chain(0, 0))
+
 		_, isValid := keeper.ZeroLaunchTimestampInvariant(*k)(ctx)
 		require.Equal(t, false, isValid)
 	})
 
 	t.Run("invalid case", func(t *testing.T) {
 		chain := sample.Chain(0, 0)
+		chain.LaunchTriggered = true
 		chain.LaunchTimestamp = 0
 		chain.LaunchID = k.AppendChain(ctx, chain)
 		_, isValid := keeper.ZeroLaunchTimestampInvariant(*k)(ctx)
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -17,7 +18,11 @@ type (
 		memKey         sdk.StoreKey
 		paramstore     paramtypes.Subspace
 		profileKeeper  types.ProfileKeeper
+		bankKeeper     types.BankKeeper
+		feegrantKeeper types.FeegrantKeeper
+		distrKeeper    types.DistributionKeeper
 		campaignKeeper types.CampaignKeeper
+		txConfig       client.TxConfig
 	}
 )
 
@@ -27,6 +32,10 @@ func NewKeeper(
 	memKey sdk.StoreKey,
 	ps paramtypes.Subspace,
 	profileKeeper types.ProfileKeeper,
+	bankKeeper types.BankKeeper,
+	feegrantKeeper types.FeegrantKeeper,
+	distrKeeper types.DistributionKeeper,
+	txConfig client.TxConfig,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -34,11 +43,15 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		memKey:        memKey,
-		paramstore:    ps,
-		profileKeeper: profileKeeper,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		memKey:         memKey,
+		paramstore:     ps,
+		profileKeeper:  profileKeeper,
+		bankKeeper:     bankKeeper,
+		feegrantKeeper: feegrantKeeper,
+		distrKeeper:    distrKeeper,
+		txConfig:       txConfig,
 	}
 }
 
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+// EnqueueLaunch adds a chain in the launch queue to be launched once its launch timestamp is passed
+func (k Keeper) EnqueueLaunch(ctx sdk.Context, launchTimestamp int64, launchID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LaunchQueueKeyPrefix))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, launchID)
+	store.Set(types.LaunchQueueKey(launchTimestamp, launchID), bz)
+}
+
+// DequeueLaunch removes a chain from the launch queue
+func (k Keeper) DequeueLaunch(ctx sdk.Context, launchTimestamp int64, launchID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LaunchQueueKeyPrefix))
+	store.Delete(types.LaunchQueueKey(launchTimestamp, launchID))
+}
+
+// GetLaunchQueue returns the launch IDs of the chains in the launch queue
+// whose launch timestamp is lower or equal to the provided timestamp
+func (k Keeper) GetLaunchQueue(ctx sdk.Context, timestamp int64) (list []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LaunchQueueKeyPrefix))
+	iterator := store.Iterator(nil, types.LaunchQueueTimestampKey(timestamp+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, binary.BigEndian.Uint64(iterator.Value()))
+	}
+
+	return
+}
+
+// LaunchChains sets as launched all the chains of the launch queue whose launch timestamp is passed
+// The hash of the final genesis generated at launch is stored in the chain if it has a default initial genesis
+func (k Keeper) LaunchChains(ctx sdk.Context) {
+	for _, launchID := range k.GetLaunchQueue(ctx, ctx.BlockTime().Unix()) {
+		chain, found := k.GetChain(ctx, launchID)
+		if !found {
+			k.Logger(ctx).Error("chain in launch queue not found", "launchID", launchID)
+			continue
+		}
+		k.DequeueLaunch(ctx, chain.LaunchTimestamp, launchID)
+
+		// The chain is not launched if its genesis can't be generated, the coordinator can revert the launch
+		// The final genesis of a chain with a genesis URL can't be generated on-chain, only its launch information is checked
+		var err error
+		if chain.InitialGenesis.GetDefaultInitialGenesis() != nil {
+			_, chain.FinalGenesisHash, err = k.GenerateGenesis(ctx, launchID, "")
+		} else {
+			_, err = k.GenerateLaunchGenesis(ctx, launchID)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("genesis generation failed for chain in launch queue", "launchID", launchID, "error", err)
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventChainLaunchFailed{
+				LaunchID: launchID,
+				Error:    err.Error(),
+			}); err != nil {
+				k.Logger(ctx).Error("event emission failed", "launchID", launchID, "error", err)
+			}
+			continue
+		}
+
+		chain.Launched = true
+		k.SetChain(ctx, chain)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventChainLaunched{
+			LaunchID:         launchID,
+			FinalGenesisHash: chain.FinalGenesisHash,
+		}); err != nil {
+			k.Logger(ctx).Error("event emission failed", "launchID", launchID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+// GenerateLaunchGenesis generates the genesis of a chain from its approved launch information
+func (k Keeper) GenerateLaunchGenesis(ctx sdk.Context, launchID uint64) (types.LaunchGenesis, error) {
+	chain, found := k.GetChain(ctx, launchID)
+	if !found {
+		return types.LaunchGenesis{}, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", launchID)
+	}
+
+	// The accounts of a mainnet are computed from the shares of its campaign
+	genesisAccounts := k.GetAllGenesisAccountByLaunchID(ctx, launchID)
+	vestingAccounts := k.GetAllVestingAccountByLaunchID(ctx, launchID)
+	if chain.IsMainnet {
+		var err error
+		genesisAccounts, vestingAccounts, err = k.campaignKeeper.MainnetGenesisAccounts(ctx, chain.CampaignID)
+		if err != nil {
+			return types.LaunchGenesis{}, err
+		}
+	}
+
+	return types.NewLaunchGenesis(
+		chain,
+		genesisAccounts,
+		vestingAccounts,
+		k.GetAllGenesisValidatorByLaunchID(ctx, launchID),
+	)
+}
+
+// GenerateGenesis generates the final genesis of a chain by applying its launch information on its initial genesis
+// initialGenesis is the content of the genesis URL of the chain, it is ignored for a default initial genesis
+// It returns the JSON encoded final genesis and its sha256 hash
+func (k Keeper) GenerateGenesis(ctx sdk.Context, launchID uint64, initialGenesis string) (string, string, error) {
+	genesis, err := k.GenerateLaunchGenesis(ctx, launchID)
+	if err != nil {
+		return "", "", err
+	}
+
+	chain, found := k.GetChain(ctx, launchID)
+	if !found {
+		return "", "", sdkerrors.Wrapf(types.ErrChainNotFound, "%d", launchID)
+	}
+	document, err := chain.InitialGenesis.Document(chain.GenesisChainID, initialGenesis)
+	if err != nil {
+		return "", "", err
+	}
+
+	return genesis.Apply(document)
+}
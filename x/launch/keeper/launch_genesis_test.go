@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestKeeper_GenerateLaunchGenesisMainnet(t *testing.T) {
+	campaignKeeper, launchKeeper, _, _, ctx := testkeeper.AllKeepers(t)
+
+	campaign := sample.Campaign(0)
+	campaign.TotalSupply = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+	campaign.Id = campaignKeeper.AppendCampaign(ctx, campaign)
+
+	chain := sample.Chain(0, 0)
+	chain.HasCampaign = true
+	chain.CampaignID = campaign.Id
+	chain.IsMainnet = true
+	launchID := launchKeeper.AppendChain(ctx, chain)
+
+	campaign.MainnetID = launchID
+	campaign.MainnetInitialized = true
+	campaignKeeper.SetCampaign(ctx, campaign)
+
+	// the genesis accounts of a mainnet are computed from the campaign shares
+	address := sample.Address()
+	campaignKeeper.SetMainnetAccount(ctx, campaigntypes.MainnetAccount{
+		CampaignID: campaign.Id,
+		Address:    address,
+		Shares:     campaigntypes.NewSharesFromCoins(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(50000)))),
+	})
+	launchKeeper.SetGenesisAccount(ctx, sample.GenesisAccount(launchID, sample.Address()))
+
+	genesis, err := launchKeeper.GenerateLaunchGenesis(ctx, launchID)
+	require.NoError(t, err)
+
+	require.Len(t, genesis.Balances, 1)
+
+	var balance banktypes.Balance
+	require.NoError(t, sample.Codec().UnmarshalJSON(genesis.Balances[0], &balance))
+	require.Equal(t, address, balance.Address)
+	require.True(t, balance.Coins.IsEqual(sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(500)))))
+
+	t.Run("should prevent generating the genesis of a mainnet with no initialized mainnet", func(t *testing.T) {
+		campaign.MainnetInitialized = false
+		campaignKeeper.SetCampaign(ctx, campaign)
+
+		_, err := launchKeeper.GenerateLaunchGenesis(ctx, launchID)
+		require.ErrorIs(t, err, campaigntypes.ErrMainnetNotInitialized)
+	})
+
+	t.Run("should prevent generating the genesis of a non existing chain", func(t *testing.T) {
+		_, err := launchKeeper.GenerateLaunchGenesis(ctx, 1000)
+		require.ErrorIs(t, err, types.ErrChainNotFound)
+	})
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestLaunchQueue(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+
+	keeper.EnqueueLaunch(ctx, 1000, 0)
+	keeper.EnqueueLaunch(ctx, 1000, 1)
+	keeper.EnqueueLaunch(ctx, 2000, 2)
+	keeper.EnqueueLaunch(ctx, 3000, 3)
+
+	require.Empty(t, keeper.GetLaunchQueue(ctx, 999))
+	require.Equal(t, []uint64{0, 1}, keeper.GetLaunchQueue(ctx, 1000))
+	require.Equal(t, []uint64{0, 1, 2}, keeper.GetLaunchQueue(ctx, 2999))
+	require.Equal(t, []uint64{0, 1, 2, 3}, keeper.GetLaunchQueue(ctx, 3000))
+
+	keeper.DequeueLaunch(ctx, 1000, 1)
+	keeper.DequeueLaunch(ctx, 3000, 3)
+	require.Equal(t, []uint64{0, 2}, keeper.GetLaunchQueue(ctx, 3000))
+}
+
+func TestLaunchChains(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	now := ctx.BlockTime().Unix()
+
+	chains := createNChain(keeper, ctx, 6)
+	triggerLaunch := func(chain types.Chain, launchTimestamp int64) {
+		chain.LaunchTriggered = true
+		chain.LaunchTimestamp = launchTimestamp
+		keeper.SetChain(ctx, chain)
+		keeper.EnqueueLaunch(ctx, launchTimestamp, chain.LaunchID)
+	}
+
+	// launch timestamp passed
+	triggerLaunch(chains[0], now-1)
+	keeper.SetGenesisAccount(ctx, sample.GenesisAccount(chains[0].LaunchID, sample.Address()))
+
+	// launch timestamp reached
+	triggerLaunch(chains[1], now)
+
+	// launch timestamp not reached
+	triggerLaunch(chains[2], now+1)
+
+	// chains[3] launch is not triggered
+
+	// launch timestamp passed but the genesis can't be generated from an invalid gentx
+	triggerLaunch(chains[4], now-1)
+	keeper.SetGenesisValidator(ctx, sample.GenesisValidator(chains[4].LaunchID, sample.Address()))
+
+	// launch timestamp passed for a chain with a genesis URL
+	chains[5].InitialGenesis = types.NewGenesisURL("foo.com", types.GenesisURLHash("foo"))
+	triggerLaunch(chains[5], now-1)
+
+	keeper.LaunchChains(ctx)
+
+	for _, launchID := range []uint64{chains[0].LaunchID, chains[1].LaunchID} {
+		chain, found := keeper.GetChain(ctx, launchID)
+		require.True(t, found)
+		require.True(t, chain.Launched)
+
+		_, hash, err := keeper.GenerateGenesis(ctx, launchID, "")
+		require.NoError(t, err)
+		require.EqualValues(t, hash, chain.FinalGenesisHash)
+	}
+
+	// the final genesis hash of a chain with a genesis URL is not set at launch
+	chain, found := keeper.GetChain(ctx, chains[5].LaunchID)
+	require.True(t, found)
+	require.True(t, chain.Launched)
+	require.Empty(t, chain.FinalGenesisHash)
+
+	for _, launchID := range []uint64{chains[2].LaunchID, chains[3].LaunchID} {
+		chain, found := keeper.GetChain(ctx, launchID)
+		require.True(t, found)
+		require.False(t, chain.Launched)
+		require.Empty(t, chain.FinalGenesisHash)
+	}
+
+	// launched chains are removed from the queue
+	require.Equal(t, []uint64{chains[2].LaunchID}, keeper.GetLaunchQueue(ctx, now+1))
+
+	// events are emitted for the launched chains
+	launchedEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "tendermint.spn.launch.EventChainLaunched" {
+			launchedEvents++
+		}
+	}
+	require.Equal(t, 3, launchedEvents)
+
+	// the chain whose genesis can't be generated is not launched and can be reverted
+	chain, found = keeper.GetChain(ctx, chains[4].LaunchID)
+	require.True(t, found)
+	require.True(t, chain.LaunchTriggered)
+	require.False(t, chain.Launched)
+	require.Empty(t, chain.FinalGenesisHash)
+	failedEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "tendermint.spn.launch.EventChainLaunchFailed" {
+			failedEvents++
+		}
+	}
+	require.Equal(t, 1, failedEvents)
+
+	// the remaining chain is launched once its launch timestamp is passed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	keeper.LaunchChains(ctx)
+	chain, found = keeper.GetChain(ctx, chains[2].LaunchID)
+	require.True(t, found)
+	require.True(t, chain.Launched)
+	require.Empty(t, keeper.GetLaunchQueue(ctx, now+1))
+}
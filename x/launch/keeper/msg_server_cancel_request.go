@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func (k msgServer) CancelRequest(
+	goCtx context.Context,
+	msg *types.MsgCancelRequest,
+) (*types.MsgCancelRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The requests can be canceled once the launch is triggered to refund their deposit
+	// since they can no longer be settled
+	if _, found := k.GetChain(ctx, msg.LaunchID); !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	request, found := k.GetRequest(ctx, msg.LaunchID, msg.RequestID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRequestNotFound,
+			"request %d for chain %d not found",
+			msg.RequestID,
+			msg.LaunchID,
+		)
+	}
+
+	if request.Creator != msg.Creator {
+		return nil, sdkerrors.Wrap(types.ErrNotRequestCreator, msg.Creator)
+	}
+
+	if request.Status != types.Request_PENDING {
+		return nil, sdkerrors.Wrapf(types.ErrRequestSettled,
+			"request %d for chain %d is %s",
+			msg.RequestID,
+			msg.LaunchID,
+			request.Status.String(),
+		)
+	}
+
+	// The request is kept in the store to preserve the history of the chain requests
+	request.Status = types.Request_CANCELED
+	request.SettledHeight = ctx.BlockHeight()
+	if err := SettleRequestDeposit(ctx, k.Keeper, request); err != nil {
+		return nil, err
+	}
+	k.SetRequest(ctx, request)
+
+	return &types.MsgCancelRequestResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventRequestCanceled{
+		LaunchID:  msg.LaunchID,
+		RequestID: msg.RequestID,
+		Creator:   msg.Creator,
+	})
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgCancelRequest(t *testing.T) {
+	var (
+		coordinator                 = sample.Coordinator(sample.Address())
+		invalidChain                = uint64(1000)
+		k, pk, _, srv, _, _, sdkCtx = setupMsgServer(t)
+		ctx                         = sdk.WrapSDKContext(sdkCtx)
+	)
+	sdkCtx = sdkCtx.WithBlockHeight(10)
+	ctx = sdk.WrapSDKContext(sdkCtx)
+
+	coordinator.CoordinatorId = pk.AppendCoordinator(sdkCtx, coordinator)
+	chains := createNChainForCoordinator(k, sdkCtx, coordinator.CoordinatorId, 2)
+	chains[0].LaunchTriggered = true
+	k.SetChain(sdkCtx, chains[0])
+
+	triggeredRequests := createRequests(k, sdkCtx, chains[0].LaunchID, []types.RequestContent{
+		sample.GenesisAccountContent(chains[0].LaunchID, sample.Address()),
+	})
+	requests := createRequests(k, sdkCtx, chains[1].LaunchID, []types.RequestContent{
+		sample.GenesisAccountContent(chains[1].LaunchID, sample.Address()),
+		sample.GenesisAccountContent(chains[1].LaunchID, sample.Address()),
+	})
+	requests[1].Status = types.Request_REJECTED
+	requests[1].SettledHeight = 5
+	k.SetRequest(sdkCtx, requests[1])
+
+	tests := []struct {
+		name string
+		msg  types.MsgCancelRequest
+		err  error
+	}{
+		{
+			name: "invalid chain",
+			msg: types.MsgCancelRequest{
+				Creator:   requests[0].Creator,
+				LaunchID:  invalidChain,
+				RequestID: requests[0].RequestID,
+			},
+			err: types.ErrChainNotFound,
+		},
+		{
+			name: "request not found",
+			msg: types.MsgCancelRequest{
+				Creator:   requests[0].Creator,
+				LaunchID:  chains[1].LaunchID,
+				RequestID: 99999999,
+			},
+			err: types.ErrRequestNotFound,
+		},
+		{
+			name: "not the request creator",
+			msg: types.MsgCancelRequest{
+				Creator:   sample.Address(),
+				LaunchID:  chains[1].LaunchID,
+				RequestID: requests[0].RequestID,
+			},
+			err: types.ErrNotRequestCreator,
+		},
+		{
+			name: "settled request",
+			msg: types.MsgCancelRequest{
+				Creator:   requests[1].Creator,
+				LaunchID:  chains[1].LaunchID,
+				RequestID: requests[1].RequestID,
+			},
+			err: types.ErrRequestSettled,
+		},
+		{
+			name: "cancel a pending request",
+			msg: types.MsgCancelRequest{
+				Creator:   requests[0].Creator,
+				LaunchID:  chains[1].LaunchID,
+				RequestID: requests[0].RequestID,
+			},
+		},
+		{
+			name: "cancel a pending request of a launch triggered chain",
+			msg: types.MsgCancelRequest{
+				Creator:   triggeredRequests[0].Creator,
+				LaunchID:  chains[0].LaunchID,
+				RequestID: triggeredRequests[0].RequestID,
+			},
+		},
+		{
+			name: "cancel an already canceled request",
+			msg: types.MsgCancelRequest{
+				Creator:   requests[0].Creator,
+				LaunchID:  chains[1].LaunchID,
+				RequestID: requests[0].RequestID,
+			},
+			err: types.ErrRequestSettled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.CancelRequest(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, tt.msg.RequestID)
+			require.True(t, found, "request not found")
+			require.Equal(t, types.Request_CANCELED, request.Status)
+			require.EqualValues(t, 10, request.SettledHeight)
+
+			// The request content must not be applied
+			_, found = k.GetGenesisAccount(sdkCtx, tt.msg.LaunchID, request.Content.GetGenesisAccount().Address)
+			require.False(t, found)
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRequestCanceled{
+				LaunchID:  tt.msg.LaunchID,
+				RequestID: tt.msg.RequestID,
+				Creator:   tt.msg.Creator,
+			})
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func (k msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	if !chain.Launched {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotLaunched, "%d", msg.LaunchID)
+	}
+
+	rewardPool, found := k.GetRewardPool(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRewardPoolNotFound, "%d", msg.LaunchID)
+	}
+
+	// The validators claim their rewards once the coordinator can no longer report the last block height
+	if ctx.BlockTime().Unix() < chain.LaunchTimestamp+types.RewardDistributionTimeout {
+		return nil, sdkerrors.Wrapf(types.ErrRewardTimeoutNotReached, "%d", msg.LaunchID)
+	}
+
+	if _, found := k.GetGenesisValidator(ctx, msg.LaunchID, msg.Validator); !found {
+		return nil, sdkerrors.Wrapf(types.ErrValidatorNotFound, "%s", msg.Validator)
+	}
+	if rewardPool.IsClaimed(msg.Validator) {
+		return nil, sdkerrors.Wrapf(types.ErrRewardsAlreadyClaimed, "%s", msg.Validator)
+	}
+
+	// The rewards are claimed as if the chain reached the last reward height
+	validators := k.GetAllGenesisValidatorByLaunchID(ctx, msg.LaunchID)
+	rewards, _ := rewardPool.ValidatorRewards(rewardPool.LastRewardHeight, int64(len(validators)))
+	if !rewards.Empty() {
+		address, err := sdk.AccAddressFromBech32(msg.Validator)
+		if err != nil {
+			return nil, spnerrors.Criticalf("can't parse validator address %s", err.Error())
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, rewards); err != nil {
+			return nil, spnerrors.Criticalf("can't distribute rewards %s", err.Error())
+		}
+	}
+
+	rewardPool.Claimed = append(rewardPool.Claimed, msg.Validator)
+	k.SetRewardPool(ctx, rewardPool)
+
+	return &types.MsgClaimRewardsResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventRewardsClaimed{
+		LaunchID:  msg.LaunchID,
+		Validator: msg.Validator,
+		Rewards:   rewards,
+	})
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	"github.com/tendermint/spn/x/launch/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgClaimRewards(t *testing.T) {
+	var (
+		provider = sample.Address()
+		valAddr1 = sample.Address()
+		valAddr2 = sample.Address()
+		rewards  = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+
+		_, launchKeeper, _, bankKeeper, sdkCtx = testkeeper.AllKeepers(t)
+
+		srv = keeper.NewMsgServerImpl(*launchKeeper)
+		ctx = sdk.WrapSDKContext(sdkCtx)
+		now = sdkCtx.BlockTime().Unix()
+	)
+
+	// Create the chains with their genesis validators and reward pools
+	newChain := func(launchTimestamp int64, withRewards bool) uint64 {
+		chain := sample.Chain(0, 0)
+		if launchTimestamp > 0 {
+			chain.LaunchTriggered = true
+			chain.LaunchTimestamp = launchTimestamp
+			chain.Launched = true
+		}
+		launchID := launchKeeper.AppendChain(sdkCtx, chain)
+		launchKeeper.SetGenesisValidator(sdkCtx, sample.GenesisValidator(launchID, valAddr1))
+		launchKeeper.SetGenesisValidator(sdkCtx, sample.GenesisValidator(launchID, valAddr2))
+		if withRewards {
+			require.NoError(t, bankKeeper.MintCoins(sdkCtx, campaigntypes.ModuleName, rewards))
+			require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(
+				sdkCtx,
+				campaigntypes.ModuleName,
+				types.ModuleName,
+				rewards,
+			))
+			launchKeeper.SetRewardPool(sdkCtx, types.NewRewardPool(launchID, provider, rewards, 100))
+		}
+		return launchID
+	}
+	timedOutTime := now - types.RewardDistributionTimeout
+	launchID := newChain(timedOutTime, true)
+	notLaunchedLaunchID := newChain(0, true)
+	noRewardsLaunchID := newChain(timedOutTime, false)
+	recentLaunchID := newChain(timedOutTime+1, true)
+
+	for _, tc := range []struct {
+		name    string
+		msg     types.MsgClaimRewards
+		rewards sdk.Coins
+		err     error
+	}{
+		{
+			name: "non existing chain",
+			msg:  *types.NewMsgClaimRewards(valAddr1, 1000),
+			err:  types.ErrChainNotFound,
+		},
+		{
+			name: "chain not launched",
+			msg:  *types.NewMsgClaimRewards(valAddr1, notLaunchedLaunchID),
+			err:  types.ErrChainNotLaunched,
+		},
+		{
+			name: "chain without rewards",
+			msg:  *types.NewMsgClaimRewards(valAddr1, noRewardsLaunchID),
+			err:  types.ErrRewardPoolNotFound,
+		},
+		{
+			name: "reward distribution timeout not reached",
+			msg:  *types.NewMsgClaimRewards(valAddr1, recentLaunchID),
+			err:  types.ErrRewardTimeoutNotReached,
+		},
+		{
+			name: "not a genesis validator",
+			msg:  *types.NewMsgClaimRewards(sample.Address(), launchID),
+			err:  types.ErrValidatorNotFound,
+		},
+		{
+			name:    "claim the rewards of a validator",
+			msg:     *types.NewMsgClaimRewards(valAddr1, launchID),
+			rewards: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(500))),
+		},
+		{
+			name: "rewards already claimed",
+			msg:  *types.NewMsgClaimRewards(valAddr1, launchID),
+			err:  types.ErrRewardsAlreadyClaimed,
+		},
+		{
+			name:    "claim the rewards of another validator",
+			msg:     *types.NewMsgClaimRewards(valAddr2, launchID),
+			rewards: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(500))),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := sdk.AccAddressFromBech32(tc.msg.Validator)
+			require.NoError(t, err)
+			previousBalance := bankKeeper.GetAllBalances(sdkCtx, addr)
+
+			_, err = srv.ClaimRewards(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// Check the validator has been rewarded and its claim recorded
+			require.True(t, bankKeeper.GetAllBalances(sdkCtx, addr).IsEqual(previousBalance.Add(tc.rewards...)))
+			rewardPool, found := launchKeeper.GetRewardPool(sdkCtx, tc.msg.LaunchID)
+			require.True(t, found)
+			require.True(t, rewardPool.IsClaimed(tc.msg.Validator))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRewardsClaimed{
+				LaunchID:  tc.msg.LaunchID,
+				Validator: tc.msg.Validator,
+				Rewards:   tc.rewards,
+			})
+		})
+	}
+}
@@ -29,6 +29,7 @@ func (k msgServer) CreateChain(goCtx context.Context, msg *types.MsgCreateChain)
 		msg.HasCampaign,
 		msg.CampaignID,
 		false,
+		msg.Limits,
 	)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrCreateChainFail, err.Error())
@@ -36,5 +37,9 @@ func (k msgServer) CreateChain(goCtx context.Context, msg *types.MsgCreateChain)
 
 	return &types.MsgCreateChainResponse{
 		LaunchID: id,
-	}, nil
+	}, ctx.EventManager().EmitTypedEvent(&types.EventChainCreated{
+		LaunchID:           id,
+		CoordinatorAddress: msg.Coordinator,
+		CoordinatorID:      coordID,
+	})
 }
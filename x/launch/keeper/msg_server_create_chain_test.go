@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -33,6 +34,9 @@ func TestMsgCreateChain(t *testing.T) {
 	require.NoError(t, err)
 	campaignID := resCampaign.CampaignID
 
+	msgWithLimits := sample.MsgCreateChain(coordAddress, "", false, campaignID)
+	msgWithLimits.Limits = sample.ChainLimits()
+
 	for _, tc := range []struct {
 		name          string
 		msg           types.MsgCreateChain
@@ -59,6 +63,11 @@ func TestMsgCreateChain(t *testing.T) {
 			msg:           sample.MsgCreateChain(coordAddress, "", true, campaignID),
 			wantedChainID: 3,
 		},
+		{
+			name:          "creates message with limits",
+			msg:           msgWithLimits,
+			wantedChainID: 4,
+		},
 		{
 			name: "coordinator doesn't exist for the chain",
 			msg:  sample.MsgCreateChain(sample.Address(), "", false, 0),
@@ -92,6 +101,7 @@ func TestMsgCreateChain(t *testing.T) {
 			require.EqualValues(t, tc.msg.GenesisChainID, chain.GenesisChainID)
 			require.EqualValues(t, tc.msg.SourceURL, chain.SourceURL)
 			require.EqualValues(t, tc.msg.SourceHash, chain.SourceHash)
+			require.EqualValues(t, tc.msg.Limits, chain.Limits)
 
 			// Compare initial genesis
 			if tc.msg.GenesisURL == "" {
@@ -115,6 +125,12 @@ func TestMsgCreateChain(t *testing.T) {
 				require.True(t, found)
 				require.Contains(t, campaignChains.Chains, chain.LaunchID)
 			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventChainCreated{
+				LaunchID:           got.LaunchID,
+				CoordinatorAddress: tc.msg.Coordinator,
+				CoordinatorID:      coordID,
+			})
 		})
 	}
 }
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) DistributeRewards(goCtx context.Context, msg *types.MsgDistributeRewards) (*types.MsgDistributeRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	if !chain.Launched {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotLaunched, "%d", msg.LaunchID)
+	}
+
+	rewardPool, found := k.GetRewardPool(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRewardPoolNotFound, "%d", msg.LaunchID)
+	}
+
+	// The rewards can't be distributed, and the unearned rewards refunded, right after the launch of the chain
+	if ctx.BlockTime().Unix() < chain.LaunchTimestamp+types.RewardDistributionDelay {
+		return nil, sdkerrors.Wrapf(types.ErrRewardDelayNotReached, "%d", msg.LaunchID)
+	}
+
+	// Until the reward distribution timeout, the coordinator of the chain reports the last block height reached by the chain
+	// Afterward, anyone can distribute the rewards as if the chain reached the last reward height
+	lastBlockHeight := rewardPool.LastRewardHeight
+	if ctx.BlockTime().Unix() < chain.LaunchTimestamp+types.RewardDistributionTimeout {
+		coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Signer)
+		if !found {
+			return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Signer)
+		}
+		if chain.CoordinatorID != coordinatorID {
+			return nil, sdkerrors.Wrapf(
+				profiletypes.ErrCoordInvalid,
+				"coordinator of the chain is %d",
+				chain.CoordinatorID,
+			)
+		}
+		lastBlockHeight = msg.LastBlockHeight
+	}
+
+	// The genesis validators earn the rewards for the blocks produced by the chain
+	// If the chain failed before the last reward height, the unearned rewards are refunded
+	// The validators that already claimed their rewards are skipped
+	validators := k.GetAllGenesisValidatorByLaunchID(ctx, msg.LaunchID)
+	rewards, refund := rewardPool.ValidatorRewards(lastBlockHeight, int64(len(validators)))
+	if !rewards.Empty() {
+		for _, validator := range validators {
+			if rewardPool.IsClaimed(validator.Address) {
+				continue
+			}
+			address, err := sdk.AccAddressFromBech32(validator.Address)
+			if err != nil {
+				return nil, spnerrors.Criticalf("invalid genesis validator address %s", err.Error())
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, rewards); err != nil {
+				return nil, spnerrors.Criticalf("can't distribute rewards %s", err.Error())
+			}
+		}
+	}
+	if !refund.Empty() {
+		provider, err := sdk.AccAddressFromBech32(rewardPool.Provider)
+		if err != nil {
+			return nil, spnerrors.Criticalf("invalid reward pool provider %s", err.Error())
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, provider, refund); err != nil {
+			return nil, spnerrors.Criticalf("can't refund rewards %s", err.Error())
+		}
+	}
+
+	k.RemoveRewardPool(ctx, msg.LaunchID)
+
+	return &types.MsgDistributeRewardsResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventRewardsDistributed{
+		LaunchID:        msg.LaunchID,
+		LastBlockHeight: lastBlockHeight,
+		Distributed:     rewardPool.Coins.Sub(refund),
+		Refunded:        refund,
+	})
+}
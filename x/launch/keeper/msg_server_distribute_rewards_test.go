@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	"github.com/tendermint/spn/x/launch/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+	profilekeeper "github.com/tendermint/spn/x/profile/keeper"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgDistributeRewards(t *testing.T) {
+	var (
+		coordAddr   = sample.Address()
+		noCoordAddr = sample.Address()
+		otherAddr   = sample.Address()
+		provider    = sample.AccAddress()
+		valAddr1    = sample.AccAddress()
+		valAddr2    = sample.AccAddress()
+		rewards     = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)))
+
+		_, launchKeeper, profileKeeper, bankKeeper, sdkCtx = testkeeper.AllKeepers(t)
+
+		srv        = keeper.NewMsgServerImpl(*launchKeeper)
+		profileSrv = profilekeeper.NewMsgServerImpl(*profileKeeper)
+		ctx        = sdk.WrapSDKContext(sdkCtx)
+	)
+
+	msgCreateCoordinator := sample.MsgCreateCoordinator(coordAddr)
+	res, err := profileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+	coordID := res.CoordinatorId
+	msgCreateCoordinator = sample.MsgCreateCoordinator(otherAddr)
+	_, err = profileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+
+	// Create the chains with their genesis validators and reward pools
+	now := sdkCtx.BlockTime().Unix()
+	newChain := func(launchTimestamp int64, withRewards bool, validators ...sdk.AccAddress) uint64 {
+		chain := sample.Chain(0, coordID)
+		if launchTimestamp > 0 {
+			chain.LaunchTriggered = true
+			chain.LaunchTimestamp = launchTimestamp
+			chain.Launched = true
+		}
+		launchID := launchKeeper.AppendChain(sdkCtx, chain)
+		for _, validator := range validators {
+			launchKeeper.SetGenesisValidator(sdkCtx, sample.GenesisValidator(launchID, validator.String()))
+		}
+		if withRewards {
+			require.NoError(t, bankKeeper.MintCoins(sdkCtx, campaigntypes.ModuleName, rewards))
+			require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(
+				sdkCtx,
+				campaigntypes.ModuleName,
+				types.ModuleName,
+				rewards,
+			))
+			launchKeeper.SetRewardPool(sdkCtx, types.NewRewardPool(launchID, provider.String(), rewards, 100))
+		}
+		return launchID
+	}
+	distributableTime := now - types.RewardDistributionDelay
+	launchID := newChain(distributableTime, true, valAddr1, valAddr2)
+	failedLaunchID := newChain(distributableTime, true, valAddr1, valAddr2)
+	noValidatorLaunchID := newChain(distributableTime, true)
+	notLaunchedLaunchID := newChain(0, true, valAddr1, valAddr2)
+	noRewardsLaunchID := newChain(distributableTime, false, valAddr1, valAddr2)
+	recentLaunchID := newChain(distributableTime+1, true, valAddr1, valAddr2)
+	timedOutLaunchID := newChain(now-types.RewardDistributionTimeout, true, valAddr1, valAddr2)
+
+	// valAddr1 claimed its rewards from the chain that reached the distribution timeout
+	claimedRewards := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(500)))
+	timedOutPool, found := launchKeeper.GetRewardPool(sdkCtx, timedOutLaunchID)
+	require.True(t, found)
+	timedOutPool.Claimed = []string{valAddr1.String()}
+	launchKeeper.SetRewardPool(sdkCtx, timedOutPool)
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, valAddr1, claimedRewards))
+
+	for _, tc := range []struct {
+		name            string
+		msg             types.MsgDistributeRewards
+		lastBlockHeight int64
+		validatorReward sdk.Coins
+		refund          sdk.Coins
+		err             error
+	}{
+		{
+			name: "non existing chain",
+			msg:  *types.NewMsgDistributeRewards(coordAddr, 1000, 100),
+			err:  types.ErrChainNotFound,
+		},
+		{
+			name: "non existing coordinator",
+			msg:  *types.NewMsgDistributeRewards(noCoordAddr, launchID, 100),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "invalid coordinator",
+			msg:  *types.NewMsgDistributeRewards(otherAddr, launchID, 100),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "chain not launched",
+			msg:  *types.NewMsgDistributeRewards(coordAddr, notLaunchedLaunchID, 100),
+			err:  types.ErrChainNotLaunched,
+		},
+		{
+			name: "chain without rewards",
+			msg:  *types.NewMsgDistributeRewards(coordAddr, noRewardsLaunchID, 100),
+			err:  types.ErrRewardPoolNotFound,
+		},
+		{
+			name: "reward distribution delay not reached",
+			msg:  *types.NewMsgDistributeRewards(coordAddr, recentLaunchID, 0),
+			err:  types.ErrRewardDelayNotReached,
+		},
+		{
+			name:            "distribute rewards for a chain stopped before the last reward height",
+			msg:             *types.NewMsgDistributeRewards(coordAddr, launchID, 50),
+			lastBlockHeight: 50,
+			validatorReward: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(250))),
+			refund:          sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(500))),
+		},
+		{
+			name: "rewards already distributed",
+			msg:  *types.NewMsgDistributeRewards(coordAddr, launchID, 100),
+			err:  types.ErrRewardPoolNotFound,
+		},
+		{
+			name:            "refund the rewards of a failed chain",
+			msg:             *types.NewMsgDistributeRewards(coordAddr, failedLaunchID, 0),
+			validatorReward: sdk.NewCoins(),
+			refund:          rewards,
+		},
+		{
+			name:            "refund the rewards of a chain without validators",
+			msg:             *types.NewMsgDistributeRewards(coordAddr, noValidatorLaunchID, 100),
+			lastBlockHeight: 100,
+			validatorReward: sdk.NewCoins(),
+			refund:          rewards,
+		},
+		{
+			name:            "distribute all the rewards by anyone once the distribution timeout is reached",
+			msg:             *types.NewMsgDistributeRewards(noCoordAddr, timedOutLaunchID, 0),
+			lastBlockHeight: 100,
+			validatorReward: claimedRewards,
+			refund:          sdk.NewCoins(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				validators       = launchKeeper.GetAllGenesisValidatorByLaunchID(sdkCtx, tc.msg.LaunchID)
+				previousBalances = make(map[string]sdk.Coins)
+				previousRefund   = bankKeeper.GetAllBalances(sdkCtx, provider)
+			)
+			rewardPool, _ := launchKeeper.GetRewardPool(sdkCtx, tc.msg.LaunchID)
+			for _, validator := range validators {
+				addr, err := sdk.AccAddressFromBech32(validator.Address)
+				require.NoError(t, err)
+				previousBalances[validator.Address] = bankKeeper.GetAllBalances(sdkCtx, addr)
+			}
+
+			_, err := srv.DistributeRewards(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, found := launchKeeper.GetRewardPool(sdkCtx, tc.msg.LaunchID)
+			require.False(t, found)
+
+			// Check the validators that didn't claim their rewards have been rewarded and the provider refunded
+			for _, validator := range validators {
+				addr, err := sdk.AccAddressFromBech32(validator.Address)
+				require.NoError(t, err)
+				expected := previousBalances[validator.Address]
+				if !rewardPool.IsClaimed(validator.Address) {
+					expected = expected.Add(tc.validatorReward...)
+				}
+				require.True(t, bankKeeper.GetAllBalances(sdkCtx, addr).IsEqual(expected))
+			}
+			refund := bankKeeper.GetAllBalances(sdkCtx, provider).Sub(previousRefund)
+			require.True(t, tc.refund.IsEqual(refund))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRewardsDistributed{
+				LaunchID:        tc.msg.LaunchID,
+				LastBlockHeight: tc.lastBlockHeight,
+				Distributed:     rewards.Sub(tc.refund),
+				Refunded:        tc.refund,
+			})
+		})
+	}
+}
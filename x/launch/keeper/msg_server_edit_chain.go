@@ -30,6 +30,10 @@ func (k msgServer) EditChain(goCtx context.Context, msg *types.MsgEditChain) (*t
 		))
 	}
 
+	if chain.Launched {
+		return nil, sdkerrors.Wrapf(types.ErrChainLaunched, "%d", msg.LaunchID)
+	}
+
 	// Modify from provided values
 	if msg.GenesisChainID != "" {
 		chain.GenesisChainID = msg.GenesisChainID
@@ -43,8 +47,17 @@ func (k msgServer) EditChain(goCtx context.Context, msg *types.MsgEditChain) (*t
 	if msg.InitialGenesis != nil {
 		chain.InitialGenesis = *msg.InitialGenesis
 	}
+	if msg.Limits != nil {
+		chain.Limits = *msg.Limits
+	}
+	if msg.AutoApprovePolicy != nil {
+		chain.AutoApprovePolicy = *msg.AutoApprovePolicy
+	}
 
 	k.SetChain(ctx, chain)
 
-	return &types.MsgEditChainResponse{}, nil
+	return &types.MsgEditChainResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventChainEdited{
+		LaunchID:      msg.LaunchID,
+		CoordinatorID: coordinatorID,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -33,6 +34,35 @@ func TestMsgEditChain(t *testing.T) {
 	require.NoError(t, err)
 	launchID := res.LaunchID
 
+	// Create a launched chain
+	res, err = srv.CreateChain(ctx, &msgCreateChain)
+	require.NoError(t, err)
+	launchedID := res.LaunchID
+	launched, found := k.GetChain(sdkCtx, launchedID)
+	require.True(t, found)
+	launched.LaunchTriggered = true
+	launched.LaunchTimestamp = sdkCtx.BlockTime().Unix()
+	launched.Launched = true
+	k.SetChain(sdkCtx, launched)
+
+	msgEditLimits := sample.MsgEditChain(coordAddress, launchID,
+		false,
+		false,
+		false,
+		false,
+	)
+	limits := sample.ChainLimits()
+	msgEditLimits.Limits = &limits
+
+	msgEditAutoApprovePolicy := sample.MsgEditChain(coordAddress, launchID,
+		false,
+		false,
+		false,
+		false,
+	)
+	autoApprovePolicy := sample.AutoApprovePolicy()
+	msgEditAutoApprovePolicy.AutoApprovePolicy = &autoApprovePolicy
+
 	for _, tc := range []struct {
 		name string
 		msg  types.MsgEditChain
@@ -83,6 +113,14 @@ func TestMsgEditChain(t *testing.T) {
 				true,
 			),
 		},
+		{
+			name: "edit limits",
+			msg:  msgEditLimits,
+		},
+		{
+			name: "edit auto approve policy",
+			msg:  msgEditAutoApprovePolicy,
+		},
 		{
 			name: "non existent launch id",
 			msg: sample.MsgEditChain(coordAddress, launchIDNoExist,
@@ -113,6 +151,16 @@ func TestMsgEditChain(t *testing.T) {
 			),
 			err: profiletypes.ErrCoordInvalid,
 		},
+		{
+			name: "launched chain",
+			msg: sample.MsgEditChain(coordAddress, launchedID,
+				false,
+				true,
+				false,
+				false,
+			),
+			err: types.ErrChainLaunched,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			// Fetch the previous state of the chain to perform checks
@@ -160,6 +208,23 @@ func TestMsgEditChain(t *testing.T) {
 			} else {
 				require.EqualValues(t, previousChain.InitialGenesis, chain.InitialGenesis)
 			}
+
+			if tc.msg.Limits != nil {
+				require.EqualValues(t, *tc.msg.Limits, chain.Limits)
+			} else {
+				require.EqualValues(t, previousChain.Limits, chain.Limits)
+			}
+
+			if tc.msg.AutoApprovePolicy != nil {
+				require.EqualValues(t, *tc.msg.AutoApprovePolicy, chain.AutoApprovePolicy)
+			} else {
+				require.EqualValues(t, previousChain.AutoApprovePolicy, chain.AutoApprovePolicy)
+			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventChainEdited{
+				LaunchID:      tc.msg.LaunchID,
+				CoordinatorID: chain.CoordinatorID,
+			})
 		})
 	}
 }
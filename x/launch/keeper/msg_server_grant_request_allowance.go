@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) GrantRequestAllowance(
+	goCtx context.Context,
+	msg *types.MsgGrantRequestAllowance,
+) (*types.MsgGrantRequestAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	// Check sender is the coordinator of the chain
+	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	if !found {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
+	}
+	if chain.CoordinatorID != coordinatorID {
+		return nil, sdkerrors.Wrapf(
+			profiletypes.ErrCoordInvalid,
+			"coordinator of the chain is %d",
+			chain.CoordinatorID,
+		)
+	}
+
+	// Requests can't be sent once the launch is triggered
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	basicAllowance := &feegrant.BasicAllowance{
+		SpendLimit: msg.SpendLimit,
+	}
+	if msg.Expiration != 0 {
+		if msg.Expiration <= ctx.BlockTime().Unix() {
+			return nil, sdkerrors.Wrapf(
+				types.ErrInvalidExpiration,
+				"the expiration %d must be after the block time %d",
+				msg.Expiration,
+				ctx.BlockTime().Unix(),
+			)
+		}
+		expiration := time.Unix(msg.Expiration, 0).UTC()
+		basicAllowance.Expiration = &expiration
+	}
+	allowance, err := types.NewLaunchRequestAllowance(basicAllowance, msg.LaunchID)
+	if err != nil {
+		return nil, spnerrors.Criticalf("can't create the allowance %s", err.Error())
+	}
+
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return nil, spnerrors.Criticalf("can't parse coordinator address %s", err.Error())
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, spnerrors.Criticalf("can't parse grantee address %s", err.Error())
+	}
+	if err := k.feegrantKeeper.GrantAllowance(ctx, coordinator, grantee, allowance); err != nil {
+		return nil, err
+	}
+
+	// The grant is recorded to close the allowance when the launch is triggered
+	k.SetRequestAllowanceGrant(ctx, types.NewRequestAllowanceGrant(msg.LaunchID, msg.Coordinator, msg.Grantee))
+
+	return &types.MsgGrantRequestAllowanceResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventRequestAllowanceGranted{
+		LaunchID:    msg.LaunchID,
+		Coordinator: msg.Coordinator,
+		Grantee:     msg.Grantee,
+		SpendLimit:  msg.SpendLimit,
+		Expiration:  msg.Expiration,
+	})
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgGrantRequestAllowance(t *testing.T) {
+	var (
+		coordAddr                                      = sample.Address()
+		noCoordAddr                                    = sample.Address()
+		otherAddr                                      = sample.Address()
+		grantee                                        = sample.Address()
+		spendLimit                                     = sample.Coins()
+		launchKeeper, _, _, srv, profileSrv, _, sdkCtx = setupMsgServer(t)
+		ctx                                            = sdk.WrapSDKContext(sdkCtx)
+		blockTime                                      = sdkCtx.BlockTime().Unix()
+	)
+
+	msgCreateCoordinator := sample.MsgCreateCoordinator(coordAddr)
+	res, err := profileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+	coordID := res.CoordinatorId
+	msgCreateCoordinator = sample.MsgCreateCoordinator(otherAddr)
+	_, err = profileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+
+	launchID := launchKeeper.AppendChain(sdkCtx, sample.Chain(0, coordID))
+	triggeredChain := sample.Chain(0, coordID)
+	triggeredChain.LaunchTriggered = true
+	triggeredLaunchID := launchKeeper.AppendChain(sdkCtx, triggeredChain)
+
+	for _, tc := range []struct {
+		name string
+		msg  types.MsgGrantRequestAllowance
+		err  error
+	}{
+		{
+			name: "non existing chain",
+			msg:  *types.NewMsgGrantRequestAllowance(coordAddr, 1000, grantee, spendLimit, 0),
+			err:  types.ErrChainNotFound,
+		},
+		{
+			name: "non existing coordinator",
+			msg:  *types.NewMsgGrantRequestAllowance(noCoordAddr, launchID, grantee, spendLimit, 0),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "invalid coordinator",
+			msg:  *types.NewMsgGrantRequestAllowance(otherAddr, launchID, grantee, spendLimit, 0),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "chain with launch triggered",
+			msg:  *types.NewMsgGrantRequestAllowance(coordAddr, triggeredLaunchID, grantee, spendLimit, 0),
+			err:  types.ErrTriggeredLaunch,
+		},
+		{
+			name: "expiration not after the block time",
+			msg:  *types.NewMsgGrantRequestAllowance(coordAddr, launchID, grantee, spendLimit, blockTime),
+			err:  types.ErrInvalidExpiration,
+		},
+		{
+			name: "grant allowance without expiration",
+			msg:  *types.NewMsgGrantRequestAllowance(coordAddr, launchID, grantee, spendLimit, 0),
+		},
+		{
+			name: "grant allowance with expiration",
+			msg:  *types.NewMsgGrantRequestAllowance(coordAddr, launchID, sample.Address(), spendLimit, blockTime+1000),
+		},
+		{
+			name: "replace existing allowance",
+			msg:  *types.NewMsgGrantRequestAllowance(coordAddr, launchID, grantee, sample.Coins(), blockTime+1000),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.GrantRequestAllowance(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// the grant is recorded to close the allowance at launch
+			grant, found := launchKeeper.GetRequestAllowanceGrant(sdkCtx, tc.msg.LaunchID, tc.msg.Grantee)
+			require.True(t, found)
+			require.Equal(t, types.NewRequestAllowanceGrant(tc.msg.LaunchID, tc.msg.Coordinator, tc.msg.Grantee), grant)
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRequestAllowanceGranted{
+				LaunchID:    tc.msg.LaunchID,
+				Coordinator: tc.msg.Coordinator,
+				Grantee:     tc.msg.Grantee,
+				SpendLimit:  tc.msg.SpendLimit,
+				Expiration:  tc.msg.Expiration,
+			})
+		})
+	}
+}
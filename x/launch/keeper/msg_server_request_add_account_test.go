@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -24,7 +25,7 @@ func TestMsgRequestAddAccount(t *testing.T) {
 	coordID := pk.AppendCoordinator(sdkCtx, profiletypes.Coordinator{
 		Address: coordAddr,
 	})
-	chains := createNChainForCoordinator(k, sdkCtx, coordID, 6)
+	chains := createNChainForCoordinator(k, sdkCtx, coordID, 10)
 	chains[0].LaunchTriggered = true
 	k.SetChain(sdkCtx, chains[0])
 	chains[1].CoordinatorID = 99999
@@ -32,6 +33,21 @@ func TestMsgRequestAddAccount(t *testing.T) {
 	chains[5].IsMainnet = true
 	chains[5].HasCampaign = true
 	k.SetChain(sdkCtx, chains[5])
+	chains[6].Limits.MaxGenesisAccounts = 1
+	k.SetChain(sdkCtx, chains[6])
+	k.SetGenesisAccount(sdkCtx, sample.GenesisAccount(chains[6].LaunchID, sample.Address()))
+	chains[7].AutoApprovePolicy = types.AutoApprovePolicy{
+		MaxAccountCoins:  sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100))),
+		AllowedAddresses: []string{addr2},
+	}
+	k.SetChain(sdkCtx, chains[7])
+	k.SetParticipantList(sdkCtx, types.NewParticipantList(chains[8].LaunchID, types.ParticipantList_ALLOWLIST, []string{addr1}))
+	k.SetParticipantList(sdkCtx, types.NewParticipantList(chains[9].LaunchID, types.ParticipantList_DENYLIST, []string{addr1}))
+
+	matchingMaxAccountCoins := sample.MsgRequestAddAccount(addr1, chains[7].LaunchID)
+	matchingMaxAccountCoins.Coins = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)))
+	exceedingMaxAccountCoins := sample.MsgRequestAddAccount(addr3, chains[7].LaunchID)
+	exceedingMaxAccountCoins.Coins = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(101)))
 
 	tests := []struct {
 		name        string
@@ -88,6 +104,7 @@ func TestMsgRequestAddAccount(t *testing.T) {
 		{
 			name:        "request from coordinator is pre-approved",
 			msg:         sample.MsgRequestAddAccount(coordAddr, chains[4].LaunchID),
+			wantID:      3,
 			wantApprove: true,
 		},
 		{
@@ -100,6 +117,53 @@ func TestMsgRequestAddAccount(t *testing.T) {
 			msg:  sample.MsgRequestAddAccount(coordAddr, chains[5].LaunchID),
 			err:  types.ErrAddMainnetAccount,
 		},
+		{
+			name: "chain limits exceeded",
+			msg:  sample.MsgRequestAddAccount(addr1, chains[6].LaunchID),
+			err:  types.ErrChainLimitExceeded,
+		},
+		{
+			name:        "request matching the auto approve max account coins",
+			msg:         matchingMaxAccountCoins,
+			wantApprove: true,
+		},
+		{
+			name:        "request from an auto approved address",
+			msg:         sample.MsgRequestAddAccount(addr2, chains[7].LaunchID),
+			wantID:      1,
+			wantApprove: true,
+		},
+		{
+			name:   "request not matching the auto approve policy",
+			msg:    exceedingMaxAccountCoins,
+			wantID: 2,
+		},
+		{
+			name:   "request from an address in the allowlist",
+			msg:    sample.MsgRequestAddAccount(addr1, chains[8].LaunchID),
+			wantID: 0,
+		},
+		{
+			name: "request from an address not in the allowlist",
+			msg:  sample.MsgRequestAddAccount(addr2, chains[8].LaunchID),
+			err:  types.ErrParticipantNotAllowed,
+		},
+		{
+			name:        "request from coordinator not in the allowlist",
+			msg:         sample.MsgRequestAddAccount(coordAddr, chains[8].LaunchID),
+			wantID:      1,
+			wantApprove: true,
+		},
+		{
+			name: "request from an address in the denylist",
+			msg:  sample.MsgRequestAddAccount(addr1, chains[9].LaunchID),
+			err:  types.ErrParticipantNotAllowed,
+		},
+		{
+			name:   "request from an address not in the denylist",
+			msg:    sample.MsgRequestAddAccount(addr2, chains[9].LaunchID),
+			wantID: 0,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -112,20 +176,34 @@ func TestMsgRequestAddAccount(t *testing.T) {
 			require.Equal(t, tt.wantID, got.RequestID)
 			require.Equal(t, tt.wantApprove, got.AutoApproved)
 
-			if !tt.wantApprove {
-				request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
-				require.True(t, found, "request not found")
-				require.Equal(t, tt.wantID, request.RequestID)
+			request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
+			require.True(t, found, "request not found")
+			require.Equal(t, tt.wantID, request.RequestID)
 
-				content := request.Content.GetGenesisAccount()
-				require.NotNil(t, content)
-				require.Equal(t, tt.msg.Address, content.Address)
-				require.Equal(t, tt.msg.LaunchID, content.LaunchID)
-				require.Equal(t, tt.msg.Coins, content.Coins)
-			} else {
+			wantStatus := types.Request_PENDING
+			if tt.wantApprove {
+				wantStatus = types.Request_APPROVED
+			}
+			require.Equal(t, wantStatus, request.Status)
+
+			content := request.Content.GetGenesisAccount()
+			require.NotNil(t, content)
+			require.Equal(t, tt.msg.Address, content.Address)
+			require.Equal(t, tt.msg.LaunchID, content.LaunchID)
+			require.Equal(t, tt.msg.Coins, content.Coins)
+
+			if tt.wantApprove {
 				_, found := k.GetGenesisAccount(sdkCtx, tt.msg.LaunchID, tt.msg.Address)
 				require.True(t, found, "genesis account not found")
 			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRequestCreated{
+				LaunchID:     tt.msg.LaunchID,
+				RequestID:    got.RequestID,
+				Creator:      tt.msg.Address,
+				Content:      types.NewGenesisAccount(tt.msg.LaunchID, tt.msg.Address, tt.msg.Coins),
+				AutoApproved: got.AutoApproved,
+			})
 		})
 	}
 }
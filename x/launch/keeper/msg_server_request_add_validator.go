@@ -29,6 +29,11 @@ func (k msgServer) RequestAddValidator(
 			"the chain %d coordinator has been deleted", chain.LaunchID)
 	}
 
+	if msg.ValAddress != coordAddress && !k.IsParticipantAllowed(ctx, msg.LaunchID, msg.ValAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrParticipantNotAllowed,
+			"%s can't submit requests to the chain %d", msg.ValAddress, msg.LaunchID)
+	}
+
 	content := types.NewGenesisValidator(
 		msg.LaunchID,
 		msg.ValAddress,
@@ -37,6 +42,11 @@ func (k msgServer) RequestAddValidator(
 		msg.SelfDelegation,
 		msg.Peer,
 	)
+
+	// Check the gentx creates the requested validator for the chain
+	if err := content.GetGenesisValidator().ValidateGenTx(k.txConfig, chain.GenesisChainID); err != nil {
+		return nil, err
+	}
 	request := types.Request{
 		LaunchID:  msg.LaunchID,
 		Creator:   msg.ValAddress,
@@ -44,20 +54,34 @@ func (k msgServer) RequestAddValidator(
 		Content:   content,
 	}
 
-	var requestID uint64
-	approved := false
-	if msg.ValAddress == coordAddress {
-		err := ApplyRequest(ctx, k.Keeper, msg.LaunchID, request)
-		if err != nil {
-			return nil, err
-		}
+	if err := CheckChainLimits(ctx, k.Keeper, chain, request.Content); err != nil {
+		return nil, err
+	}
+
+	var (
+		requestID uint64
+		approved  bool
+		err       error
+	)
+	// Requests from the coordinator or matching the auto approve policy of the chain are applied and recorded as approved
+	if msg.ValAddress == coordAddress || chain.AutoApprovePolicy.Matches(request.Creator, request.Content) {
+		requestID, err = AutoApproveRequest(ctx, k.Keeper, request)
 		approved = true
 	} else {
-		requestID = k.AppendRequest(ctx, request)
+		requestID, err = SubmitRequest(ctx, k.Keeper, request)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.MsgRequestAddValidatorResponse{
 		RequestID:    requestID,
 		AutoApproved: approved,
-	}, nil
+	}, ctx.EventManager().EmitTypedEvent(&types.EventRequestCreated{
+		LaunchID:     msg.LaunchID,
+		RequestID:    requestID,
+		Creator:      request.Creator,
+		Content:      request.Content,
+		AutoApproved: approved,
+	})
 }
@@ -3,8 +3,10 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -13,9 +15,11 @@ import (
 func TestMsgRequestAddValidator(t *testing.T) {
 	var (
 		invalidChain                = uint64(1000)
-		coordAddr                   = sample.Address()
-		addr1                       = sample.Address()
-		addr2                       = sample.Address()
+		coordKey                    = sample.PrivKey()
+		coordAddr                   = sdk.AccAddress(coordKey.PubKey().Address()).String()
+		key1                        = sample.PrivKey()
+		key2                        = sample.PrivKey()
+		key3                        = sample.PrivKey()
 		k, pk, _, srv, _, _, sdkCtx = setupMsgServer(t)
 		ctx                         = sdk.WrapSDKContext(sdkCtx)
 	)
@@ -23,12 +27,37 @@ func TestMsgRequestAddValidator(t *testing.T) {
 	coordID := pk.AppendCoordinator(sdkCtx, profiletypes.Coordinator{
 		Address: coordAddr,
 	})
-	chains := createNChainForCoordinator(k, sdkCtx, coordID, 4)
+	chains := createNChainForCoordinator(k, sdkCtx, coordID, 6)
 	chains[0].LaunchTriggered = true
 	k.SetChain(sdkCtx, chains[0])
 	chains[1].CoordinatorID = 99999
 	k.SetChain(sdkCtx, chains[1])
 
+	// The self delegation bounds of the auto approve policy are set from a matching request
+	matchingSelfDelegation := sample.MsgRequestAddValidator(key1, chains[4].LaunchID, chains[4].GenesisChainID)
+	notMatchingSelfDelegation := sample.MsgRequestAddValidator(key2, chains[4].LaunchID, chains[4].GenesisChainID)
+	chains[4].AutoApprovePolicy = types.AutoApprovePolicy{
+		MinSelfDelegation: &matchingSelfDelegation.SelfDelegation,
+		MaxSelfDelegation: &matchingSelfDelegation.SelfDelegation,
+		AllowedAddresses:  []string{sdk.AccAddress(key3.PubKey().Address()).String()},
+	}
+	k.SetChain(sdkCtx, chains[4])
+	k.SetParticipantList(sdkCtx, types.NewParticipantList(
+		chains[5].LaunchID,
+		types.ParticipantList_ALLOWLIST,
+		[]string{sdk.AccAddress(key1.PubKey().Address()).String()},
+	))
+
+	invalidSignature := sample.MsgRequestAddValidator(key1, chains[2].LaunchID, sample.GenesisChainID())
+	invalidSigner := sample.MsgRequestAddValidator(key1, chains[2].LaunchID, chains[2].GenesisChainID)
+	invalidSigner.ValAddress = sdk.AccAddress(key2.PubKey().Address()).String()
+	invalidSelfDelegation := sample.MsgRequestAddValidator(key1, chains[2].LaunchID, chains[2].GenesisChainID)
+	invalidSelfDelegation.SelfDelegation = sample.Coin()
+	invalidConsPubKey := sample.MsgRequestAddValidator(key1, chains[2].LaunchID, chains[2].GenesisChainID)
+	invalidConsPubKey.ConsPubKey = ed25519.GenPrivKey().PubKey().Bytes()
+	invalidGenTx := sample.MsgRequestAddValidator(key1, chains[2].LaunchID, chains[2].GenesisChainID)
+	invalidGenTx.GenTx = sample.Bytes(500)
+
 	for _, tc := range []struct {
 		name        string
 		msg         types.MsgRequestAddValidator
@@ -38,45 +67,97 @@ func TestMsgRequestAddValidator(t *testing.T) {
 	}{
 		{
 			name: "invalid chain",
-			msg:  sample.MsgRequestAddValidator(addr1, invalidChain),
+			msg:  sample.MsgRequestAddValidator(key1, invalidChain, sample.GenesisChainID()),
 			err:  types.ErrChainNotFound,
 		},
 		{
 			name: "chain with triggered launch",
-			msg:  sample.MsgRequestAddValidator(addr1, chains[0].LaunchID),
+			msg:  sample.MsgRequestAddValidator(key1, chains[0].LaunchID, chains[0].GenesisChainID),
 			err:  types.ErrTriggeredLaunch,
 		},
 		{
 			name: "chain without coordinator",
-			msg:  sample.MsgRequestAddValidator(addr1, chains[1].LaunchID),
+			msg:  sample.MsgRequestAddValidator(key1, chains[1].LaunchID, chains[1].GenesisChainID),
 			err:  types.ErrChainInactive,
 		},
+		{
+			name: "gentx signed for another chain",
+			msg:  invalidSignature,
+			err:  types.ErrInvalidGenTx,
+		},
+		{
+			name: "gentx from another validator",
+			msg:  invalidSigner,
+			err:  types.ErrInvalidGenTx,
+		},
+		{
+			name: "gentx with another self delegation",
+			msg:  invalidSelfDelegation,
+			err:  types.ErrInvalidSelfDelegation,
+		},
+		{
+			name: "gentx with another consensus public key",
+			msg:  invalidConsPubKey,
+			err:  types.ErrInvalidConsPubKey,
+		},
+		{
+			name: "undecodable gentx",
+			msg:  invalidGenTx,
+			err:  types.ErrInvalidGenTx,
+		},
 		{
 			name:   "request to a chain 3",
-			msg:    sample.MsgRequestAddValidator(addr1, chains[2].LaunchID),
+			msg:    sample.MsgRequestAddValidator(key1, chains[2].LaunchID, chains[2].GenesisChainID),
 			wantID: 0,
 		},
 		{
 			name:   "second request to a chain 3",
-			msg:    sample.MsgRequestAddValidator(addr2, chains[2].LaunchID),
+			msg:    sample.MsgRequestAddValidator(key2, chains[2].LaunchID, chains[2].GenesisChainID),
 			wantID: 1,
 		},
 		{
 			name:   "request to a chain 4",
-			msg:    sample.MsgRequestAddValidator(addr1, chains[3].LaunchID),
+			msg:    sample.MsgRequestAddValidator(key1, chains[3].LaunchID, chains[3].GenesisChainID),
 			wantID: 0,
 		},
 		{
 			name:        "request from coordinator is pre-approved",
-			msg:         sample.MsgRequestAddValidator(coordAddr, chains[3].LaunchID),
+			msg:         sample.MsgRequestAddValidator(coordKey, chains[3].LaunchID, chains[3].GenesisChainID),
+			wantID:      1,
 			wantApprove: true,
 		},
 		{
 			name:        "failing request from coordinator",
-			msg:         sample.MsgRequestAddValidator(coordAddr, chains[3].LaunchID),
+			msg:         sample.MsgRequestAddValidator(coordKey, chains[3].LaunchID, chains[3].GenesisChainID),
 			err:         types.ErrValidatorAlreadyExist,
 			wantApprove: true,
 		},
+		{
+			name:        "request matching the auto approve self delegation",
+			msg:         matchingSelfDelegation,
+			wantApprove: true,
+		},
+		{
+			name:        "request from an auto approved address",
+			msg:         sample.MsgRequestAddValidator(key3, chains[4].LaunchID, chains[4].GenesisChainID),
+			wantID:      1,
+			wantApprove: true,
+		},
+		{
+			name:   "request not matching the auto approve policy",
+			msg:    notMatchingSelfDelegation,
+			wantID: 2,
+		},
+		{
+			name:   "request from an address in the allowlist",
+			msg:    sample.MsgRequestAddValidator(key1, chains[5].LaunchID, chains[5].GenesisChainID),
+			wantID: 0,
+		},
+		{
+			name: "request from an address not in the allowlist",
+			msg:  sample.MsgRequestAddValidator(key2, chains[5].LaunchID, chains[5].GenesisChainID),
+			err:  types.ErrParticipantNotAllowed,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			got, err := srv.RequestAddValidator(ctx, &tc.msg)
@@ -88,23 +169,44 @@ func TestMsgRequestAddValidator(t *testing.T) {
 			require.Equal(t, tc.wantID, got.RequestID)
 			require.Equal(t, tc.wantApprove, got.AutoApproved)
 
-			if !tc.wantApprove {
-				request, found := k.GetRequest(sdkCtx, tc.msg.LaunchID, got.RequestID)
-				require.True(t, found, "request not found")
-				require.Equal(t, tc.wantID, request.RequestID)
+			request, found := k.GetRequest(sdkCtx, tc.msg.LaunchID, got.RequestID)
+			require.True(t, found, "request not found")
+			require.Equal(t, tc.wantID, request.RequestID)
 
-				content := request.Content.GetGenesisValidator()
-				require.NotNil(t, content)
-				require.Equal(t, tc.msg.ValAddress, content.Address)
-				require.Equal(t, tc.msg.LaunchID, content.LaunchID)
-				require.True(t, tc.msg.SelfDelegation.Equal(content.SelfDelegation))
-				require.Equal(t, tc.msg.GenTx, content.GenTx)
-				require.Equal(t, tc.msg.Peer, content.Peer)
-				require.Equal(t, tc.msg.ConsPubKey, content.ConsPubKey)
-			} else {
+			wantStatus := types.Request_PENDING
+			if tc.wantApprove {
+				wantStatus = types.Request_APPROVED
+			}
+			require.Equal(t, wantStatus, request.Status)
+
+			content := request.Content.GetGenesisValidator()
+			require.NotNil(t, content)
+			require.Equal(t, tc.msg.ValAddress, content.Address)
+			require.Equal(t, tc.msg.LaunchID, content.LaunchID)
+			require.True(t, tc.msg.SelfDelegation.Equal(content.SelfDelegation))
+			require.Equal(t, tc.msg.GenTx, content.GenTx)
+			require.Equal(t, tc.msg.Peer, content.Peer)
+			require.Equal(t, tc.msg.ConsPubKey, content.ConsPubKey)
+
+			if tc.wantApprove {
 				_, found := k.GetGenesisValidator(sdkCtx, tc.msg.LaunchID, tc.msg.ValAddress)
 				require.True(t, found, "genesis validator not found")
 			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRequestCreated{
+				LaunchID:  tc.msg.LaunchID,
+				RequestID: got.RequestID,
+				Creator:   tc.msg.ValAddress,
+				Content: types.NewGenesisValidator(
+					tc.msg.LaunchID,
+					tc.msg.ValAddress,
+					tc.msg.GenTx,
+					tc.msg.ConsPubKey,
+					tc.msg.SelfDelegation,
+					tc.msg.Peer,
+				),
+				AutoApproved: got.AutoApproved,
+			})
 		})
 	}
 }
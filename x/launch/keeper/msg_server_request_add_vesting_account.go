@@ -37,6 +37,11 @@ func (k msgServer) RequestAddVestingAccount(
 			"the chain %d coordinator has been deleted", chain.LaunchID)
 	}
 
+	if msg.Address != coordAddress && !k.IsParticipantAllowed(ctx, msg.LaunchID, msg.Address) {
+		return nil, sdkerrors.Wrapf(types.ErrParticipantNotAllowed,
+			"%s can't submit requests to the chain %d", msg.Address, msg.LaunchID)
+	}
+
 	content := types.NewVestingAccount(msg.LaunchID, msg.Address, msg.StartingBalance, msg.Options)
 	request := types.Request{
 		LaunchID:  msg.LaunchID,
@@ -45,20 +50,34 @@ func (k msgServer) RequestAddVestingAccount(
 		Content:   content,
 	}
 
-	var requestID uint64
-	approved := false
-	if msg.Address == coordAddress {
-		err := ApplyRequest(ctx, k.Keeper, msg.LaunchID, request)
-		if err != nil {
-			return nil, err
-		}
+	if err := CheckChainLimits(ctx, k.Keeper, chain, request.Content); err != nil {
+		return nil, err
+	}
+
+	var (
+		requestID uint64
+		approved  bool
+		err       error
+	)
+	// Requests from the coordinator or matching the auto approve policy of the chain are applied and recorded as approved
+	if msg.Address == coordAddress || chain.AutoApprovePolicy.Matches(request.Creator, request.Content) {
+		requestID, err = AutoApproveRequest(ctx, k.Keeper, request)
 		approved = true
 	} else {
-		requestID = k.AppendRequest(ctx, request)
+		requestID, err = SubmitRequest(ctx, k.Keeper, request)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.MsgRequestAddVestingAccountResponse{
 		RequestID:    requestID,
 		AutoApproved: approved,
-	}, nil
+	}, ctx.EventManager().EmitTypedEvent(&types.EventRequestCreated{
+		LaunchID:     msg.LaunchID,
+		RequestID:    requestID,
+		Creator:      request.Creator,
+		Content:      request.Content,
+		AutoApproved: approved,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -24,7 +25,7 @@ func TestMsgRequestAddVestingAccount(t *testing.T) {
 	coordID := pk.AppendCoordinator(sdkCtx, profiletypes.Coordinator{
 		Address: coordAddr,
 	})
-	chains := createNChainForCoordinator(k, sdkCtx, coordID, 6)
+	chains := createNChainForCoordinator(k, sdkCtx, coordID, 8)
 	chains[0].LaunchTriggered = true
 	k.SetChain(sdkCtx, chains[0])
 	chains[1].CoordinatorID = 99999
@@ -32,6 +33,11 @@ func TestMsgRequestAddVestingAccount(t *testing.T) {
 	chains[5].IsMainnet = true
 	chains[5].HasCampaign = true
 	k.SetChain(sdkCtx, chains[5])
+	chains[6].AutoApprovePolicy = types.AutoApprovePolicy{
+		AllowedAddresses: []string{addr2},
+	}
+	k.SetChain(sdkCtx, chains[6])
+	k.SetParticipantList(sdkCtx, types.NewParticipantList(chains[7].LaunchID, types.ParticipantList_DENYLIST, []string{addr1}))
 
 	tests := []struct {
 		name        string
@@ -88,6 +94,7 @@ func TestMsgRequestAddVestingAccount(t *testing.T) {
 		{
 			name:        "request from coordinator is pre-approved",
 			msg:         sample.MsgRequestAddVestingAccount(coordAddr, chains[4].LaunchID),
+			wantID:      3,
 			wantApprove: true,
 		},
 		{
@@ -100,6 +107,26 @@ func TestMsgRequestAddVestingAccount(t *testing.T) {
 			msg:  sample.MsgRequestAddVestingAccount(coordAddr, chains[5].LaunchID),
 			err:  types.ErrAddMainnetVestingAccount,
 		},
+		{
+			name:        "request from an auto approved address",
+			msg:         sample.MsgRequestAddVestingAccount(addr2, chains[6].LaunchID),
+			wantApprove: true,
+		},
+		{
+			name:   "request not matching the auto approve policy",
+			msg:    sample.MsgRequestAddVestingAccount(addr1, chains[6].LaunchID),
+			wantID: 1,
+		},
+		{
+			name: "request from an address in the denylist",
+			msg:  sample.MsgRequestAddVestingAccount(addr1, chains[7].LaunchID),
+			err:  types.ErrParticipantNotAllowed,
+		},
+		{
+			name:   "request from an address not in the denylist",
+			msg:    sample.MsgRequestAddVestingAccount(addr2, chains[7].LaunchID),
+			wantID: 0,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -112,21 +139,35 @@ func TestMsgRequestAddVestingAccount(t *testing.T) {
 			require.Equal(t, tt.wantID, got.RequestID)
 			require.Equal(t, tt.wantApprove, got.AutoApproved)
 
-			if !tt.wantApprove {
-				request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
-				require.True(t, found, "request not found")
-				require.Equal(t, tt.wantID, request.RequestID)
+			request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
+			require.True(t, found, "request not found")
+			require.Equal(t, tt.wantID, request.RequestID)
 
-				content := request.Content.GetVestingAccount()
-				require.NotNil(t, content)
-				require.Equal(t, tt.msg.Address, content.Address)
-				require.Equal(t, tt.msg.LaunchID, content.LaunchID)
-				require.Equal(t, tt.msg.StartingBalance, content.StartingBalance)
-				require.Equal(t, tt.msg.Options.String(), content.VestingOptions.String())
-			} else {
+			wantStatus := types.Request_PENDING
+			if tt.wantApprove {
+				wantStatus = types.Request_APPROVED
+			}
+			require.Equal(t, wantStatus, request.Status)
+
+			content := request.Content.GetVestingAccount()
+			require.NotNil(t, content)
+			require.Equal(t, tt.msg.Address, content.Address)
+			require.Equal(t, tt.msg.LaunchID, content.LaunchID)
+			require.Equal(t, tt.msg.StartingBalance, content.StartingBalance)
+			require.Equal(t, tt.msg.Options.String(), content.VestingOptions.String())
+
+			if tt.wantApprove {
 				_, found := k.GetVestingAccount(sdkCtx, tt.msg.LaunchID, tt.msg.Address)
 				require.True(t, found, "vesting account not found")
 			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRequestCreated{
+				LaunchID:     tt.msg.LaunchID,
+				RequestID:    got.RequestID,
+				Creator:      tt.msg.Address,
+				Content:      types.NewVestingAccount(tt.msg.LaunchID, tt.msg.Address, tt.msg.StartingBalance, tt.msg.Options),
+				AutoApproved: got.AutoApproved,
+			})
 		})
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -97,6 +98,7 @@ func TestMsgRequestRemoveAccount(t *testing.T) {
 				Creator:  coordAddr,
 				Address:  addr1,
 			},
+			wantID:      0,
 			wantApprove: true,
 		},
 		{
@@ -106,7 +108,7 @@ func TestMsgRequestRemoveAccount(t *testing.T) {
 				Creator:  addr2,
 				Address:  addr2,
 			},
-			wantID: 0,
+			wantID: 1,
 		},
 		{
 			name: "add chain 5 request 1",
@@ -124,6 +126,7 @@ func TestMsgRequestRemoveAccount(t *testing.T) {
 				Creator:  coordAddr,
 				Address:  addr2,
 			},
+			wantID:      1,
 			wantApprove: true,
 		},
 		{
@@ -133,7 +136,7 @@ func TestMsgRequestRemoveAccount(t *testing.T) {
 				Creator:  addr3,
 				Address:  addr3,
 			},
-			wantID: 1,
+			wantID: 2,
 		},
 		{
 			name: "request from coordinator is pre-approved",
@@ -142,6 +145,7 @@ func TestMsgRequestRemoveAccount(t *testing.T) {
 				Creator:  coordAddr,
 				Address:  addr4,
 			},
+			wantID:      3,
 			wantApprove: true,
 		},
 		{
@@ -174,20 +178,33 @@ func TestMsgRequestRemoveAccount(t *testing.T) {
 			require.Equal(t, tt.wantID, got.RequestID)
 			require.Equal(t, tt.wantApprove, got.AutoApproved)
 
-			if !tt.wantApprove {
-				request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
-				require.True(t, found, "request not found")
-				require.Equal(t, tt.wantID, request.RequestID)
+			request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
+			require.True(t, found, "request not found")
+			require.Equal(t, tt.wantID, request.RequestID)
 
-				content := request.Content.GetAccountRemoval()
-				require.NotNil(t, content)
-				require.Equal(t, tt.msg.Address, content.Address)
-			} else {
+			wantStatus := types.Request_PENDING
+			if tt.wantApprove {
+				wantStatus = types.Request_APPROVED
+			}
+			require.Equal(t, wantStatus, request.Status)
+
+			content := request.Content.GetAccountRemoval()
+			require.NotNil(t, content)
+			require.Equal(t, tt.msg.Address, content.Address)
+			if tt.wantApprove {
 				_, foundGenesis := k.GetGenesisAccount(sdkCtx, tt.msg.LaunchID, tt.msg.Address)
 				require.False(t, foundGenesis, "genesis account not removed")
 				_, foundVesting := k.GetVestingAccount(sdkCtx, tt.msg.LaunchID, tt.msg.Address)
 				require.False(t, foundVesting, "vesting account not removed")
 			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRequestCreated{
+				LaunchID:     tt.msg.LaunchID,
+				RequestID:    got.RequestID,
+				Creator:      tt.msg.Address,
+				Content:      types.NewAccountRemoval(tt.msg.Address),
+				AutoApproved: got.AutoApproved,
+			})
 		})
 	}
 }
@@ -43,18 +43,26 @@ func (k msgServer) RequestRemoveValidator(
 		Content:   content,
 	}
 
+	// Requests from the coordinator are applied and recorded as approved
+	var err error
 	if msg.Creator == coordAddress {
-		err := ApplyRequest(ctx, k.Keeper, msg.LaunchID, request)
-		if err != nil {
-			return nil, err
-		}
+		requestID, err = AutoApproveRequest(ctx, k.Keeper, request)
 		approved = true
 	} else {
-		requestID = k.AppendRequest(ctx, request)
+		requestID, err = SubmitRequest(ctx, k.Keeper, request)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.MsgRequestRemoveValidatorResponse{
 		RequestID:    requestID,
 		AutoApproved: approved,
-	}, nil
+	}, ctx.EventManager().EmitTypedEvent(&types.EventRequestCreated{
+		LaunchID:     msg.LaunchID,
+		RequestID:    requestID,
+		Creator:      request.Creator,
+		Content:      request.Content,
+		AutoApproved: approved,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
@@ -94,6 +95,7 @@ func TestMsgRequestRemoveValidator(t *testing.T) {
 				Creator:          coordAddr,
 				ValidatorAddress: addr1,
 			},
+			wantID:      0,
 			wantApprove: true,
 		},
 		{
@@ -103,7 +105,7 @@ func TestMsgRequestRemoveValidator(t *testing.T) {
 				Creator:          addr2,
 				ValidatorAddress: addr2,
 			},
-			wantID: 0,
+			wantID: 1,
 		},
 		{
 			name: "add chain 5 request 1",
@@ -121,6 +123,7 @@ func TestMsgRequestRemoveValidator(t *testing.T) {
 				Creator:          coordAddr,
 				ValidatorAddress: addr2,
 			},
+			wantID:      1,
 			wantApprove: true,
 		},
 		{
@@ -130,7 +133,7 @@ func TestMsgRequestRemoveValidator(t *testing.T) {
 				Creator:          addr3,
 				ValidatorAddress: addr3,
 			},
-			wantID: 1,
+			wantID: 2,
 		},
 		{
 			name: "request from coordinator is pre-approved",
@@ -139,6 +142,7 @@ func TestMsgRequestRemoveValidator(t *testing.T) {
 				Creator:          coordAddr,
 				ValidatorAddress: addr4,
 			},
+			wantID:      3,
 			wantApprove: true,
 		},
 		{
@@ -162,18 +166,31 @@ func TestMsgRequestRemoveValidator(t *testing.T) {
 			require.Equal(t, tt.wantID, got.RequestID)
 			require.Equal(t, tt.wantApprove, got.AutoApproved)
 
-			if !tt.wantApprove {
-				request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
-				require.True(t, found, "request not found")
-				require.Equal(t, tt.wantID, request.RequestID)
+			request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
+			require.True(t, found, "request not found")
+			require.Equal(t, tt.wantID, request.RequestID)
 
-				content := request.Content.GetValidatorRemoval()
-				require.NotNil(t, content)
-				require.Equal(t, tt.msg.ValidatorAddress, content.ValAddress)
-			} else {
+			wantStatus := types.Request_PENDING
+			if tt.wantApprove {
+				wantStatus = types.Request_APPROVED
+			}
+			require.Equal(t, wantStatus, request.Status)
+
+			content := request.Content.GetValidatorRemoval()
+			require.NotNil(t, content)
+			require.Equal(t, tt.msg.ValidatorAddress, content.ValAddress)
+			if tt.wantApprove {
 				_, found := k.GetGenesisValidator(sdkCtx, tt.msg.LaunchID, tt.msg.ValidatorAddress)
 				require.False(t, found, "genesis validator not removed")
 			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRequestCreated{
+				LaunchID:     tt.msg.LaunchID,
+				RequestID:    got.RequestID,
+				Creator:      tt.msg.ValidatorAddress,
+				Content:      types.NewValidatorRemoval(tt.msg.ValidatorAddress),
+				AutoApproved: got.AutoApproved,
+			})
 		})
 	}
 }
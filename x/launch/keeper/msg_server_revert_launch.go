@@ -18,17 +18,18 @@ func (k msgServer) RevertLaunch(goCtx context.Context, msg *types.MsgRevertLaunc
 		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
 	}
 
-	// Check sender is the coordinator of the chain
-	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
-	if !found {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
+	// Check sender is the coordinator of the chain or an authorized operator
+	if err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		chain.CoordinatorID,
+		msg.Coordinator,
+		profiletypes.CoordinatorOperator_TRIGGER_LAUNCH,
+	); err != nil {
+		return nil, err
 	}
-	if chain.CoordinatorID != coordinatorID {
-		return nil, sdkerrors.Wrapf(
-			profiletypes.ErrCoordInvalid,
-			"coordinator of the chain is %d",
-			chain.CoordinatorID,
-		)
+
+	if chain.Launched {
+		return nil, sdkerrors.Wrapf(types.ErrChainLaunched, "%d", msg.LaunchID)
 	}
 
 	if !chain.LaunchTriggered {
@@ -45,9 +46,12 @@ func (k msgServer) RevertLaunch(goCtx context.Context, msg *types.MsgRevertLaunc
 		return nil, sdkerrors.Wrapf(types.ErrRevertDelayNotReached, "%d", msg.LaunchID)
 	}
 
+	k.DequeueLaunch(ctx, chain.LaunchTimestamp, chain.LaunchID)
 	chain.LaunchTriggered = false
 	chain.LaunchTimestamp = 0
 	k.SetChain(ctx, chain)
 
-	return &types.MsgRevertLaunchResponse{}, nil
+	return &types.MsgRevertLaunchResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventLaunchReverted{
+		LaunchID: msg.LaunchID,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	testkeeper "github.com/tendermint/spn/testutil/keeper"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
@@ -51,6 +52,17 @@ func TestMsgRevertLaunch(t *testing.T) {
 	chain.LaunchTriggered = true
 	chain.LaunchTimestamp = testkeeper.ExampleTimestamp.Unix() - types.RevertDelay
 	k.SetChain(sdkCtx, chain)
+	k.EnqueueLaunch(sdkCtx, chain.LaunchTimestamp, delayReached)
+
+	res, err = srv.CreateChain(ctx, &msgCreateChain)
+	require.NoError(t, err)
+	launched := res.LaunchID
+	chain, found = k.GetChain(sdkCtx, launched)
+	require.True(t, found)
+	chain.LaunchTriggered = true
+	chain.LaunchTimestamp = testkeeper.ExampleTimestamp.Unix() - types.RevertDelay
+	chain.Launched = true
+	k.SetChain(sdkCtx, chain)
 
 	for _, tc := range []struct {
 		name string
@@ -71,6 +83,11 @@ func TestMsgRevertLaunch(t *testing.T) {
 			msg:  *types.NewMsgRevertLaunch(coordAddress, notLaunched),
 			err:  types.ErrNotTriggeredLaunch,
 		},
+		{
+			name: "launched chain",
+			msg:  *types.NewMsgRevertLaunch(coordAddress, launched),
+			err:  types.ErrChainLaunched,
+		},
 		{
 			name: "non existent coordinator",
 			msg:  *types.NewMsgRevertLaunch(coordNoExist, delayReached),
@@ -101,6 +118,13 @@ func TestMsgRevertLaunch(t *testing.T) {
 			require.True(t, found)
 			require.False(t, chain.LaunchTriggered)
 			require.EqualValues(t, int64(0), chain.LaunchTimestamp)
+
+			// The chain must be removed from the launch queue
+			require.NotContains(t, k.GetLaunchQueue(sdkCtx, sdkCtx.BlockTime().Unix()), tc.msg.LaunchID)
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventLaunchReverted{
+				LaunchID: tc.msg.LaunchID,
+			})
 		})
 	}
 }
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) SetParticipantList(
+	goCtx context.Context,
+	msg *types.MsgSetParticipantList,
+) (*types.MsgSetParticipantListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	// Check sender is the coordinator of the chain
+	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	if !found {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
+	}
+	if chain.CoordinatorID != coordinatorID {
+		return nil, sdkerrors.Wrapf(
+			profiletypes.ErrCoordInvalid,
+			"coordinator of the chain is %d",
+			chain.CoordinatorID,
+		)
+	}
+
+	// Requests can no longer be submitted once the launch is triggered
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	if len(msg.Addresses) == 0 {
+		k.RemoveParticipantList(ctx, msg.LaunchID)
+	} else {
+		k.Keeper.SetParticipantList(ctx, types.NewParticipantList(msg.LaunchID, msg.ListType, msg.Addresses))
+	}
+
+	return &types.MsgSetParticipantListResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventParticipantListSet{
+		LaunchID:  msg.LaunchID,
+		ListType:  msg.ListType,
+		Addresses: msg.Addresses,
+	})
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgSetParticipantList(t *testing.T) {
+	var (
+		coordAddr                           = sample.Address()
+		noCoordAddr                         = sample.Address()
+		otherAddr                           = sample.Address()
+		addresses                           = []string{sample.Address(), sample.Address()}
+		newAddresses                        = []string{sample.Address()}
+		k, _, _, srv, profileSrv, _, sdkCtx = setupMsgServer(t)
+		ctx                                 = sdk.WrapSDKContext(sdkCtx)
+	)
+
+	// Create coordinators
+	msgCreateCoordinator := sample.MsgCreateCoordinator(coordAddr)
+	res, err := profileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+	coordID := res.CoordinatorId
+	msgCreateCoordinator = sample.MsgCreateCoordinator(otherAddr)
+	_, err = profileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+
+	launchID := k.AppendChain(sdkCtx, sample.Chain(0, coordID))
+	triggeredChain := sample.Chain(0, coordID)
+	triggeredChain.LaunchTriggered = true
+	triggeredChain.LaunchTimestamp = 1000
+	triggeredLaunchID := k.AppendChain(sdkCtx, triggeredChain)
+
+	for _, tc := range []struct {
+		name string
+		msg  types.MsgSetParticipantList
+		err  error
+	}{
+		{
+			name: "non existing chain",
+			msg:  *types.NewMsgSetParticipantList(coordAddr, 1000, types.ParticipantList_ALLOWLIST, addresses),
+			err:  types.ErrChainNotFound,
+		},
+		{
+			name: "non existing coordinator",
+			msg:  *types.NewMsgSetParticipantList(noCoordAddr, launchID, types.ParticipantList_ALLOWLIST, addresses),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "invalid coordinator",
+			msg:  *types.NewMsgSetParticipantList(otherAddr, launchID, types.ParticipantList_ALLOWLIST, addresses),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "chain with triggered launch",
+			msg:  *types.NewMsgSetParticipantList(coordAddr, triggeredLaunchID, types.ParticipantList_ALLOWLIST, addresses),
+			err:  types.ErrTriggeredLaunch,
+		},
+		{
+			name: "set an allowlist",
+			msg:  *types.NewMsgSetParticipantList(coordAddr, launchID, types.ParticipantList_ALLOWLIST, addresses),
+		},
+		{
+			name: "replace with a denylist",
+			msg:  *types.NewMsgSetParticipantList(coordAddr, launchID, types.ParticipantList_DENYLIST, newAddresses),
+		},
+		{
+			name: "remove the participant list",
+			msg:  *types.NewMsgSetParticipantList(coordAddr, launchID, types.ParticipantList_ALLOWLIST, []string{}),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.SetParticipantList(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			participantList, found := k.GetParticipantList(sdkCtx, tc.msg.LaunchID)
+			if len(tc.msg.Addresses) == 0 {
+				require.False(t, found, "participant list not removed")
+			} else {
+				require.True(t, found, "participant list not found")
+				require.Equal(t, tc.msg.ListType, participantList.ListType)
+				require.Equal(t, tc.msg.Addresses, participantList.Addresses)
+			}
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventParticipantListSet{
+				LaunchID:  tc.msg.LaunchID,
+				ListType:  tc.msg.ListType,
+				Addresses: tc.msg.Addresses,
+			})
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) SetRewards(goCtx context.Context, msg *types.MsgSetRewards) (*types.MsgSetRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	// Check sender is the coordinator of the chain
+	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	if !found {
+		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
+	}
+	if chain.CoordinatorID != coordinatorID {
+		return nil, sdkerrors.Wrapf(
+			profiletypes.ErrCoordInvalid,
+			"coordinator of the chain is %d",
+			chain.CoordinatorID,
+		)
+	}
+
+	// Rewards can't be modified once the validators are committed to the launch of the chain
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	// The previous rewards of the chain are refunded to their provider
+	rewardPool, found := k.GetRewardPool(ctx, msg.LaunchID)
+	if found {
+		provider, err := sdk.AccAddressFromBech32(rewardPool.Provider)
+		if err != nil {
+			return nil, spnerrors.Criticalf("invalid reward pool provider %s", err.Error())
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, provider, rewardPool.Coins); err != nil {
+			return nil, spnerrors.Criticalf("can't refund rewards %s", err.Error())
+		}
+	}
+
+	if msg.Coins.Empty() {
+		k.RemoveRewardPool(ctx, msg.LaunchID)
+	} else {
+		coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+		if err != nil {
+			return nil, spnerrors.Criticalf("can't parse coordinator address %s", err.Error())
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, coordinator, types.ModuleName, msg.Coins); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+		k.SetRewardPool(ctx, types.NewRewardPool(msg.LaunchID, msg.Coordinator, msg.Coins, msg.LastRewardHeight))
+	}
+
+	return &types.MsgSetRewardsResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventRewardsSet{
+		LaunchID:         msg.LaunchID,
+		Provider:         msg.Coordinator,
+		Coins:            msg.Coins,
+		LastRewardHeight: msg.LastRewardHeight,
+	})
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	"github.com/tendermint/spn/x/launch/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+	profilekeeper "github.com/tendermint/spn/x/profile/keeper"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgSetRewards(t *testing.T) {
+	var (
+		coordAccAddr = sample.AccAddress()
+		coordAddr    = coordAccAddr.String()
+		noCoordAddr  = sample.Address()
+		otherAddr    = sample.Address()
+		balance      = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1000)), sdk.NewCoin("bar", sdk.NewInt(1000)))
+		rewards      = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)))
+		newRewards   = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(200)), sdk.NewCoin("bar", sdk.NewInt(300)))
+
+		_, launchKeeper, profileKeeper, bankKeeper, sdkCtx = testkeeper.AllKeepers(t)
+
+		srv        = keeper.NewMsgServerImpl(*launchKeeper)
+		profileSrv = profilekeeper.NewMsgServerImpl(*profileKeeper)
+		ctx        = sdk.WrapSDKContext(sdkCtx)
+		moduleAddr = authtypes.NewModuleAddress(types.ModuleName)
+	)
+
+	// Create coordinators and fund the coordinator of the chains
+	msgCreateCoordinator := sample.MsgCreateCoordinator(coordAddr)
+	res, err := profileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+	coordID := res.CoordinatorId
+	msgCreateCoordinator = sample.MsgCreateCoordinator(otherAddr)
+	_, err = profileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+
+	require.NoError(t, bankKeeper.MintCoins(sdkCtx, campaigntypes.ModuleName, balance))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(
+		sdkCtx,
+		campaigntypes.ModuleName,
+		coordAccAddr,
+		balance,
+	))
+
+	launchID := launchKeeper.AppendChain(sdkCtx, sample.Chain(0, coordID))
+	triggeredChain := sample.Chain(0, coordID)
+	triggeredChain.LaunchTriggered = true
+	triggeredChain.LaunchTimestamp = 1000
+	triggeredLaunchID := launchKeeper.AppendChain(sdkCtx, triggeredChain)
+
+	for _, tc := range []struct {
+		name string
+		msg  types.MsgSetRewards
+		err  error
+	}{
+		{
+			name: "non existing chain",
+			msg:  *types.NewMsgSetRewards(coordAddr, 1000, rewards, 100),
+			err:  types.ErrChainNotFound,
+		},
+		{
+			name: "non existing coordinator",
+			msg:  *types.NewMsgSetRewards(noCoordAddr, launchID, rewards, 100),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "invalid coordinator",
+			msg:  *types.NewMsgSetRewards(otherAddr, launchID, rewards, 100),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "chain with launch triggered",
+			msg:  *types.NewMsgSetRewards(coordAddr, triggeredLaunchID, rewards, 100),
+			err:  types.ErrTriggeredLaunch,
+		},
+		{
+			name: "insufficient balance",
+			msg:  *types.NewMsgSetRewards(coordAddr, launchID, balance.Add(balance...), 100),
+			err:  sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name: "set rewards",
+			msg:  *types.NewMsgSetRewards(coordAddr, launchID, rewards, 100),
+		},
+		{
+			name: "update rewards",
+			msg:  *types.NewMsgSetRewards(coordAddr, launchID, newRewards, 500),
+		},
+		{
+			name: "remove rewards",
+			msg:  *types.NewMsgSetRewards(coordAddr, launchID, sdk.NewCoins(), 0),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.SetRewards(ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// The previous rewards are refunded and the new ones escrowed
+			rewardPool, found := launchKeeper.GetRewardPool(sdkCtx, tc.msg.LaunchID)
+			if tc.msg.Coins.Empty() {
+				require.False(t, found)
+			} else {
+				require.True(t, found)
+				require.Equal(t, types.NewRewardPool(
+					tc.msg.LaunchID,
+					tc.msg.Coordinator,
+					tc.msg.Coins,
+					tc.msg.LastRewardHeight,
+				), rewardPool)
+			}
+			require.True(t, tc.msg.Coins.IsEqual(bankKeeper.GetAllBalances(sdkCtx, moduleAddr)))
+			require.True(t, balance.Sub(tc.msg.Coins).IsEqual(
+				bankKeeper.GetAllBalances(sdkCtx, coordAccAddr),
+			))
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRewardsSet{
+				LaunchID:         tc.msg.LaunchID,
+				Provider:         tc.msg.Coordinator,
+				Coins:            tc.msg.Coins,
+				LastRewardHeight: tc.msg.LastRewardHeight,
+			})
+		})
+	}
+}
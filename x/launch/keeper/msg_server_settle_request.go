@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
 )
 
 func (k msgServer) SettleRequest(
@@ -14,42 +15,54 @@ func (k msgServer) SettleRequest(
 ) (*types.MsgSettleRequestResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	chain, found := k.GetChain(ctx, msg.LaunchID)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	if err := k.checkSettlementPermission(ctx, msg.LaunchID, msg.Coordinator); err != nil {
+		return nil, err
 	}
 
-	if chain.LaunchTriggered {
-		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	request, err := SettleRequest(
+		ctx,
+		k.Keeper,
+		msg.LaunchID,
+		msg.RequestID,
+		msg.Approve,
+		msg.RejectionReason,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	coordAddress, found := k.profileKeeper.GetCoordinatorAddressFromID(ctx, chain.CoordinatorID)
+	return &types.MsgSettleRequestResponse{
+		Status: request.Status,
+	}, ctx.EventManager().EmitTypedEvent(&types.EventRequestSettled{
+		LaunchID:    msg.LaunchID,
+		RequestID:   msg.RequestID,
+		Coordinator: msg.Coordinator,
+		Approved:    msg.Approve,
+		Status:      request.Status,
+	})
+}
+
+// checkSettlementPermission checks the requests of the chain can be settled by the provided address,
+// either the coordinator of the chain or one of its operators
+func (k msgServer) checkSettlementPermission(ctx sdk.Context, launchID uint64, coordinator string) error {
+	chain, found := k.GetChain(ctx, launchID)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrChainInactive,
-			"the chain %d coordinator has been deleted", chain.LaunchID)
-	}
-	if msg.Coordinator != coordAddress {
-		return nil, sdkerrors.Wrap(types.ErrNoAddressPermission, msg.Coordinator)
+		return sdkerrors.Wrapf(types.ErrChainNotFound, "%d", launchID)
 	}
 
-	// first check if the request exists
-	request, found := k.GetRequest(ctx, msg.LaunchID, msg.RequestID)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrRequestNotFound,
-			"request %d for chain %d not found",
-			msg.RequestID,
-			msg.LaunchID,
-		)
+	if chain.LaunchTriggered {
+		return sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", launchID)
 	}
 
-	// perform request action
-	k.RemoveRequest(ctx, msg.LaunchID, request.RequestID)
-	if msg.Approve {
-		err := ApplyRequest(ctx, k.Keeper, msg.LaunchID, request)
-		if err != nil {
-			return nil, err
-		}
+	if _, found := k.profileKeeper.GetCoordinatorAddressFromID(ctx, chain.CoordinatorID); !found {
+		return sdkerrors.Wrapf(types.ErrChainInactive,
+			"the chain %d coordinator has been deleted", chain.LaunchID)
 	}
 
-	return &types.MsgSettleRequestResponse{}, nil
+	return k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		chain.CoordinatorID,
+		coordinator,
+		profiletypes.CoordinatorOperator_SETTLE_REQUESTS,
+	)
 }
@@ -5,8 +5,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
 )
 
 func TestMsgSettleRequest(t *testing.T) {
@@ -19,12 +21,33 @@ func TestMsgSettleRequest(t *testing.T) {
 		coordinator1                = sample.Coordinator(sample.Address())
 		coordinator2                = sample.Coordinator(sample.Address())
 		invalidChain                = uint64(1000)
+		operator                    = sample.Address()
+		operatorNoPermission        = sample.Address()
 		k, pk, _, srv, _, _, sdkCtx = setupMsgServer(t)
 		ctx                         = sdk.WrapSDKContext(sdkCtx)
 	)
+	sdkCtx = sdkCtx.WithBlockHeight(10)
+	ctx = sdk.WrapSDKContext(sdkCtx)
 
 	coordinator1.CoordinatorId = pk.AppendCoordinator(sdkCtx, coordinator1)
 	coordinator2.CoordinatorId = pk.AppendCoordinator(sdkCtx, coordinator2)
+	for _, coord := range []profiletypes.Coordinator{coordinator1, coordinator2} {
+		pk.SetCoordinatorByAddress(sdkCtx, profiletypes.CoordinatorByAddress{
+			Address:       coord.Address,
+			CoordinatorId: coord.CoordinatorId,
+		})
+	}
+
+	pk.SetCoordinatorOperator(sdkCtx, profiletypes.NewCoordinatorOperator(
+		coordinator1.CoordinatorId,
+		operator,
+		[]profiletypes.CoordinatorOperator_Permission{profiletypes.CoordinatorOperator_SETTLE_REQUESTS},
+	))
+	pk.SetCoordinatorOperator(sdkCtx, profiletypes.NewCoordinatorOperator(
+		coordinator1.CoordinatorId,
+		operatorNoPermission,
+		[]profiletypes.CoordinatorOperator_Permission{profiletypes.CoordinatorOperator_TRIGGER_LAUNCH},
+	))
 
 	chains := createNChainForCoordinator(k, sdkCtx, coordinator1.CoordinatorId, 3)
 	chains[0].LaunchTriggered = true
@@ -38,13 +61,15 @@ func TestMsgSettleRequest(t *testing.T) {
 		sample.GenesisAccountContent(chains[2].LaunchID, addr3),
 		sample.GenesisAccountContent(chains[2].LaunchID, addr4),
 		sample.GenesisAccountContent(chains[2].LaunchID, addr5),
+		types.NewAccountRemoval(sample.Address()),
 	})
 
 	tests := []struct {
-		name      string
-		msg       types.MsgSettleRequest
-		checkAddr string
-		err       error
+		name           string
+		msg            types.MsgSettleRequest
+		checkAddr      string
+		expectedStatus types.Request_Status
+		err            error
 	}{
 		{
 			name: "invalid chain",
@@ -84,7 +109,17 @@ func TestMsgSettleRequest(t *testing.T) {
 				RequestID:   requests[0].RequestID,
 				Approve:     true,
 			},
-			err: types.ErrNoAddressPermission,
+			err: profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "operator without permission",
+			msg: types.MsgSettleRequest{
+				LaunchID:    chains[2].LaunchID,
+				Coordinator: operatorNoPermission,
+				RequestID:   requests[0].RequestID,
+				Approve:     true,
+			},
+			err: profiletypes.ErrOperatorUnauthorized,
 		},
 		{
 			name: "approve an invalid request",
@@ -104,7 +139,8 @@ func TestMsgSettleRequest(t *testing.T) {
 				RequestID:   requests[0].RequestID,
 				Approve:     true,
 			},
-			checkAddr: addr1,
+			checkAddr:      addr1,
+			expectedStatus: types.Request_APPROVED,
 		},
 		{
 			name: "approve chain request 2",
@@ -114,7 +150,8 @@ func TestMsgSettleRequest(t *testing.T) {
 				RequestID:   requests[1].RequestID,
 				Approve:     true,
 			},
-			checkAddr: addr2,
+			checkAddr:      addr2,
+			expectedStatus: types.Request_APPROVED,
 		},
 		{
 			name: "approve chain request 3",
@@ -124,43 +161,87 @@ func TestMsgSettleRequest(t *testing.T) {
 				RequestID:   requests[2].RequestID,
 				Approve:     true,
 			},
-			checkAddr: addr3,
+			checkAddr:      addr3,
+			expectedStatus: types.Request_APPROVED,
 		},
 		{
-			name: "approve chain request 4",
+			name: "approve chain request 4 from an operator",
 			msg: types.MsgSettleRequest{
 				LaunchID:    chains[2].LaunchID,
-				Coordinator: coordinator1.Address,
+				Coordinator: operator,
 				RequestID:   requests[3].RequestID,
 				Approve:     true,
 			},
-			checkAddr: addr4,
+			checkAddr:      addr4,
+			expectedStatus: types.Request_APPROVED,
 		},
 		{
 			name: "reject chain request 5",
+			msg: types.MsgSettleRequest{
+				LaunchID:        chains[2].LaunchID,
+				Coordinator:     coordinator1.Address,
+				RequestID:       requests[4].RequestID,
+				Approve:         false,
+				RejectionReason: "invalid account",
+			},
+			checkAddr:      addr5,
+			expectedStatus: types.Request_REJECTED,
+		},
+		{
+			name: "approve a request that can't be applied",
+			msg: types.MsgSettleRequest{
+				LaunchID:    chains[2].LaunchID,
+				Coordinator: coordinator1.Address,
+				RequestID:   requests[5].RequestID,
+				Approve:     true,
+			},
+			expectedStatus: types.Request_FAILED,
+		},
+		{
+			name: "settle an already settled request",
 			msg: types.MsgSettleRequest{
 				LaunchID:    chains[2].LaunchID,
 				Coordinator: coordinator1.Address,
 				RequestID:   requests[4].RequestID,
-				Approve:     false,
+				Approve:     true,
 			},
-			checkAddr: addr5,
+			err: types.ErrRequestSettled,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := srv.SettleRequest(ctx, &tt.msg)
+			got, err := srv.SettleRequest(ctx, &tt.msg)
 			if tt.err != nil {
-				require.ErrorIs(t, tt.err, err)
+				require.ErrorIs(t, err, tt.err)
 				return
 			}
 			require.NoError(t, err)
+			require.Equal(t, tt.expectedStatus, got.Status)
 
-			_, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, tt.msg.RequestID)
-			require.False(t, found, "request not removed")
+			// The settled request is kept with its status
+			request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, tt.msg.RequestID)
+			require.True(t, found, "request not found")
+			require.Equal(t, tt.expectedStatus, request.Status)
+			require.EqualValues(t, 10, request.SettledHeight)
+			if tt.expectedStatus == types.Request_FAILED {
+				require.NotEmpty(t, request.FailureReason)
+			} else {
+				require.Empty(t, request.FailureReason)
+			}
+			require.Equal(t, tt.msg.RejectionReason, request.RejectionReason)
+
+			if tt.checkAddr != "" {
+				_, found = k.GetGenesisAccount(sdkCtx, tt.msg.LaunchID, tt.checkAddr)
+				require.Equal(t, tt.msg.Approve, found, "request apply not performed")
+			}
 
-			_, found = k.GetGenesisAccount(sdkCtx, tt.msg.LaunchID, tt.checkAddr)
-			require.Equal(t, tt.msg.Approve, found, "request apply not performed")
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventRequestSettled{
+				LaunchID:    tt.msg.LaunchID,
+				RequestID:   tt.msg.RequestID,
+				Coordinator: tt.msg.Coordinator,
+				Approved:    tt.msg.Approve,
+				Status:      tt.expectedStatus,
+			})
 		})
 	}
 }
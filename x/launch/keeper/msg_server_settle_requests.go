@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func (k msgServer) SettleRequests(
+	goCtx context.Context,
+	msg *types.MsgSettleRequests,
+) (*types.MsgSettleRequestsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkSettlementPermission(ctx, msg.LaunchID, msg.Coordinator); err != nil {
+		return nil, err
+	}
+
+	// Requests are settled in their creation order
+	settlements := make([]types.RequestSettlement, 0, len(msg.ApprovedRequestIDs)+len(msg.RejectedRequestIDs))
+	for _, requestID := range msg.ApprovedRequestIDs {
+		settlements = append(settlements, types.RequestSettlement{RequestID: requestID, Approved: true})
+	}
+	for _, requestID := range msg.RejectedRequestIDs {
+		settlements = append(settlements, types.RequestSettlement{RequestID: requestID, Approved: false})
+	}
+	sort.Slice(settlements, func(i, j int) bool {
+		return settlements[i].RequestID < settlements[j].RequestID
+	})
+
+	// If a request can't be settled, the whole message fails and no request is settled
+	// The approval of the batch is not atomic: an approved request whose content can't be applied,
+	// like a genesis account added by a previous request of the batch, doesn't make the message fail
+	// and is settled with a failed status while the other requests of the batch are settled
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, settlement := range settlements {
+		request, err := SettleRequest(
+			cacheCtx,
+			k.Keeper,
+			msg.LaunchID,
+			settlement.RequestID,
+			settlement.Approved,
+			msg.RejectionReason,
+		)
+		if err != nil {
+			return nil, err
+		}
+		settlements[i].Status = request.Status
+
+		if err := cacheCtx.EventManager().EmitTypedEvent(&types.EventRequestSettled{
+			LaunchID:    msg.LaunchID,
+			RequestID:   settlement.RequestID,
+			Coordinator: msg.Coordinator,
+			Approved:    settlement.Approved,
+			Status:      request.Status,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return &types.MsgSettleRequestsResponse{
+		Settlements: settlements,
+	}, nil
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgSettleRequests(t *testing.T) {
+	var (
+		addr1                       = sample.Address()
+		addr2                       = sample.Address()
+		addr3                       = sample.Address()
+		coordinator1                = sample.Coordinator(sample.Address())
+		coordinator2                = sample.Coordinator(sample.Address())
+		invalidChain                = uint64(1000)
+		k, pk, _, srv, _, _, sdkCtx = setupMsgServer(t)
+		ctx                         = sdk.WrapSDKContext(sdkCtx)
+	)
+	sdkCtx = sdkCtx.WithBlockHeight(10)
+	ctx = sdk.WrapSDKContext(sdkCtx)
+
+	coordinator1.CoordinatorId = pk.AppendCoordinator(sdkCtx, coordinator1)
+	coordinator2.CoordinatorId = pk.AppendCoordinator(sdkCtx, coordinator2)
+	for _, coord := range []profiletypes.Coordinator{coordinator1, coordinator2} {
+		pk.SetCoordinatorByAddress(sdkCtx, profiletypes.CoordinatorByAddress{
+			Address:       coord.Address,
+			CoordinatorId: coord.CoordinatorId,
+		})
+	}
+
+	chains := createNChainForCoordinator(k, sdkCtx, coordinator1.CoordinatorId, 3)
+	chains[0].LaunchTriggered = true
+	k.SetChain(sdkCtx, chains[0])
+	chains[1].CoordinatorID = 99999
+	k.SetChain(sdkCtx, chains[1])
+
+	requests := createRequests(k, sdkCtx, chains[2].LaunchID, []types.RequestContent{
+		sample.GenesisAccountContent(chains[2].LaunchID, addr1),
+		sample.GenesisAccountContent(chains[2].LaunchID, addr2),
+		sample.GenesisAccountContent(chains[2].LaunchID, addr3),
+		types.NewAccountRemoval(sample.Address()),
+	})
+
+	tests := []struct {
+		name                string
+		msg                 types.MsgSettleRequests
+		expectedSettlements []types.RequestSettlement
+		err                 error
+	}{
+		{
+			name: "invalid chain",
+			msg: *types.NewMsgSettleRequests(
+				coordinator1.Address,
+				invalidChain,
+				[]uint64{requests[0].RequestID},
+				nil,
+				"",
+			),
+			err: types.ErrChainNotFound,
+		},
+		{
+			name: "launch triggered chain",
+			msg: *types.NewMsgSettleRequests(
+				coordinator1.Address,
+				chains[0].LaunchID,
+				[]uint64{requests[0].RequestID},
+				nil,
+				"",
+			),
+			err: types.ErrTriggeredLaunch,
+		},
+		{
+			name: "coordinator not found",
+			msg: *types.NewMsgSettleRequests(
+				coordinator1.Address,
+				chains[1].LaunchID,
+				[]uint64{requests[0].RequestID},
+				nil,
+				"",
+			),
+			err: types.ErrChainInactive,
+		},
+		{
+			name: "no permission error",
+			msg: *types.NewMsgSettleRequests(
+				coordinator2.Address,
+				chains[2].LaunchID,
+				[]uint64{requests[0].RequestID},
+				nil,
+				"",
+			),
+			err: profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "a request doesn't exist",
+			msg: *types.NewMsgSettleRequests(
+				coordinator1.Address,
+				chains[2].LaunchID,
+				[]uint64{requests[0].RequestID, 99999999},
+				nil,
+				"",
+			),
+			err: types.ErrRequestNotFound,
+		},
+		{
+			name: "approve and reject requests",
+			msg: *types.NewMsgSettleRequests(
+				coordinator1.Address,
+				chains[2].LaunchID,
+				[]uint64{requests[1].RequestID, requests[0].RequestID, requests[3].RequestID},
+				[]uint64{requests[2].RequestID},
+				"invalid account",
+			),
+			expectedSettlements: []types.RequestSettlement{
+				{RequestID: requests[0].RequestID, Approved: true, Status: types.Request_APPROVED},
+				{RequestID: requests[1].RequestID, Approved: true, Status: types.Request_APPROVED},
+				{RequestID: requests[2].RequestID, Approved: false, Status: types.Request_REJECTED},
+				{RequestID: requests[3].RequestID, Approved: true, Status: types.Request_FAILED},
+			},
+		},
+		{
+			name: "a request is already settled",
+			msg: *types.NewMsgSettleRequests(
+				coordinator1.Address,
+				chains[2].LaunchID,
+				nil,
+				[]uint64{requests[0].RequestID},
+				"",
+			),
+			err: types.ErrRequestSettled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previousRequests := k.GetAllRequest(sdkCtx)
+
+			got, err := srv.SettleRequests(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				// No request must be settled by the message
+				require.Equal(t, previousRequests, k.GetAllRequest(sdkCtx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSettlements, got.Settlements)
+
+			for _, settlement := range tt.expectedSettlements {
+				request, found := k.GetRequest(sdkCtx, tt.msg.LaunchID, settlement.RequestID)
+				require.True(t, found, "request not found")
+				require.Equal(t, settlement.Status, request.Status)
+				require.EqualValues(t, 10, request.SettledHeight)
+				if settlement.Approved {
+					require.Empty(t, request.RejectionReason)
+				} else {
+					require.Equal(t, tt.msg.RejectionReason, request.RejectionReason)
+				}
+
+				if content := request.Content.GetGenesisAccount(); content != nil {
+					_, found = k.GetGenesisAccount(sdkCtx, tt.msg.LaunchID, content.Address)
+					require.Equal(t, settlement.Approved, found, "request apply not performed")
+				}
+
+				events.RequireTypedEvent(t, sdkCtx, &types.EventRequestSettled{
+					LaunchID:    tt.msg.LaunchID,
+					RequestID:   settlement.RequestID,
+					Coordinator: tt.msg.Coordinator,
+					Approved:    settlement.Approved,
+					Status:      settlement.Status,
+				})
+			}
+		})
+	}
+}
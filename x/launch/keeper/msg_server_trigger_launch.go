@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,16 +17,14 @@ func (k msgServer) TriggerLaunch(goCtx context.Context, msg *types.MsgTriggerLau
 		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
 	}
 
-	// Check sender is the coordinator of the chain
-	coordinatorID, found := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
-	if !found {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordAddressNotFound, msg.Coordinator)
-	}
-	if chain.CoordinatorID != coordinatorID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the chain is %d",
-			chain.CoordinatorID,
-		))
+	// Check sender is the coordinator of the chain or an authorized operator
+	if err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		chain.CoordinatorID,
+		msg.Coordinator,
+		profiletypes.CoordinatorOperator_TRIGGER_LAUNCH,
+	); err != nil {
+		return nil, err
 	}
 
 	if chain.LaunchTriggered {
@@ -41,9 +38,24 @@ func (k msgServer) TriggerLaunch(goCtx context.Context, msg *types.MsgTriggerLau
 		return nil, sdkerrors.Wrapf(types.ErrLaunchTimeTooHigh, "%d", msg.RemainingTime)
 	}
 
+	// The launch genesis must be generated at launch, the launch information can't be settled once the launch is triggered
+	if _, err := k.GenerateLaunchGenesis(ctx, msg.LaunchID); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLaunchGenesis, "%d: %s", msg.LaunchID, err.Error())
+	}
+
+	// Requests can't be sent once the launch is triggered, the fee allowances granted for them are closed
+	// The allowances are not restored if the launch is reverted
+	if err := k.CloseRequestAllowances(ctx, msg.LaunchID); err != nil {
+		return nil, err
+	}
+
 	chain.LaunchTriggered = true
 	chain.LaunchTimestamp = ctx.BlockTime().Unix() + int64(msg.RemainingTime)
 	k.SetChain(ctx, chain)
+	k.EnqueueLaunch(ctx, chain.LaunchTimestamp, chain.LaunchID)
 
-	return &types.MsgTriggerLaunchResponse{}, nil
+	return &types.MsgTriggerLaunchResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventLaunchTriggered{
+		LaunchID:        msg.LaunchID,
+		LaunchTimestamp: chain.LaunchTimestamp,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	testkeeper "github.com/tendermint/spn/testutil/keeper"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
@@ -18,6 +19,8 @@ func TestMsgTriggerLaunch(t *testing.T) {
 	coordAddress := sample.Address()
 	coordAddress2 := sample.Address()
 	coordNoExist := sample.Address()
+	operator := sample.Address()
+	operatorNoPermission := sample.Address()
 	chainIDNoExist := uint64(1000)
 
 	launchTimeTooLow := types.DefaultMinLaunchTime - 1
@@ -46,6 +49,31 @@ func TestMsgTriggerLaunch(t *testing.T) {
 	require.NoError(t, err)
 	alreadyLaunched := res.LaunchID
 
+	res, err = srv.CreateChain(ctx, &msgCreateChain)
+	require.NoError(t, err)
+	chainID3 := res.LaunchID
+
+	res, err = srv.CreateChain(ctx, &msgCreateChain)
+	require.NoError(t, err)
+	invalidGenesis := res.LaunchID
+
+	// Set a validator with an invalid gentx preventing the genesis generation
+	k.SetGenesisValidator(sdkCtx, sample.GenesisValidator(invalidGenesis, sample.Address()))
+
+	// Set operators of the coordinator
+	_, err = profileSrv.SetOperator(ctx, profiletypes.NewMsgSetOperator(
+		coordAddress,
+		operator,
+		[]profiletypes.CoordinatorOperator_Permission{profiletypes.CoordinatorOperator_TRIGGER_LAUNCH},
+	))
+	require.NoError(t, err)
+	_, err = profileSrv.SetOperator(ctx, profiletypes.NewMsgSetOperator(
+		coordAddress,
+		operatorNoPermission,
+		[]profiletypes.CoordinatorOperator_Permission{profiletypes.CoordinatorOperator_SETTLE_REQUESTS},
+	))
+	require.NoError(t, err)
+
 	// Set a chain as already launched
 	chain, found := k.GetChain(sdkCtx, alreadyLaunched)
 	require.True(t, found)
@@ -76,6 +104,15 @@ func TestMsgTriggerLaunch(t *testing.T) {
 			msg:  sample.MsgTriggerLaunch(coordAddress2, chainID2),
 			err:  profiletypes.ErrCoordInvalid,
 		},
+		{
+			name: "launch chain from an operator",
+			msg:  sample.MsgTriggerLaunch(operator, chainID3),
+		},
+		{
+			name: "operator without permission",
+			msg:  sample.MsgTriggerLaunch(operatorNoPermission, chainID2),
+			err:  profiletypes.ErrOperatorUnauthorized,
+		},
 		{
 			name: "chain launch already triggered",
 			msg:  sample.MsgTriggerLaunch(coordAddress, alreadyLaunched),
@@ -91,6 +128,11 @@ func TestMsgTriggerLaunch(t *testing.T) {
 			msg:  *types.NewMsgTriggerLaunch(coordAddress, chainID2, launchTimeTooHigh),
 			err:  types.ErrLaunchTimeTooHigh,
 		},
+		{
+			name: "launch genesis can't be generated",
+			msg:  sample.MsgTriggerLaunch(coordAddress, invalidGenesis),
+			err:  types.ErrInvalidLaunchGenesis,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			// Send the message
@@ -106,6 +148,14 @@ func TestMsgTriggerLaunch(t *testing.T) {
 			require.True(t, found)
 			require.True(t, chain.LaunchTriggered)
 			require.EqualValues(t, testkeeper.ExampleTimestamp.Unix()+int64(tc.msg.RemainingTime), chain.LaunchTimestamp)
+
+			// The chain must be added in the launch queue
+			require.Contains(t, k.GetLaunchQueue(sdkCtx, chain.LaunchTimestamp), tc.msg.LaunchID)
+
+			events.RequireLastTypedEvent(t, sdkCtx, &types.EventLaunchTriggered{
+				LaunchID:        tc.msg.LaunchID,
+				LaunchTimestamp: chain.LaunchTimestamp,
+			})
 		})
 	}
 }
@@ -17,11 +17,25 @@ func (k Keeper) MaxLaunchTime(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// RequestDeposit returns the request deposit param
+func (k Keeper) RequestDeposit(ctx sdk.Context) (res sdk.Coins) {
+	k.paramstore.Get(ctx, types.KeyRequestDeposit, &res)
+	return
+}
+
+// ChainCreationFee returns the chain creation fee param
+func (k Keeper) ChainCreationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramstore.Get(ctx, types.KeyChainCreationFee, &res)
+	return
+}
+
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.MinLaunchTime(ctx),
 		k.MaxLaunchTime(ctx),
+		k.RequestDeposit(ctx),
+		k.ChainCreationFee(ctx),
 	)
 }
 
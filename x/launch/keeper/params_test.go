@@ -17,4 +17,6 @@ func Test_GetParams(t *testing.T) {
 	require.EqualValues(t, params, k.GetParams(ctx))
 	require.EqualValues(t, params.MaxLaunchTime, k.MaxLaunchTime(ctx))
 	require.EqualValues(t, params.MinLaunchTime, k.MinLaunchTime(ctx))
+	require.EqualValues(t, params.RequestDeposit, k.RequestDeposit(ctx))
+	require.EqualValues(t, params.ChainCreationFee, k.ChainCreationFee(ctx))
 }
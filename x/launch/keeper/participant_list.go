@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+// SetParticipantList set a specific participantList in the store from its index
+func (k Keeper) SetParticipantList(ctx sdk.Context, participantList types.ParticipantList) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ParticipantListKeyPrefix))
+	b := k.cdc.MustMarshal(&participantList)
+	store.Set(types.ParticipantListKey(participantList.LaunchID), b)
+}
+
+// GetParticipantList returns a participantList from its index
+func (k Keeper) GetParticipantList(ctx sdk.Context, launchID uint64) (val types.ParticipantList, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ParticipantListKeyPrefix))
+
+	b := store.Get(types.ParticipantListKey(launchID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveParticipantList removes a participantList from the store
+func (k Keeper) RemoveParticipantList(ctx sdk.Context, launchID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ParticipantListKeyPrefix))
+	store.Delete(types.ParticipantListKey(launchID))
+}
+
+// GetAllParticipantList returns all participantList
+func (k Keeper) GetAllParticipantList(ctx sdk.Context) (list []types.ParticipantList) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ParticipantListKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ParticipantList
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsParticipantAllowed returns true if the address is allowed to submit requests to the chain
+// All addresses are allowed if the chain has no participant list
+func (k Keeper) IsParticipantAllowed(ctx sdk.Context, launchID uint64, address string) bool {
+	participantList, found := k.GetParticipantList(ctx, launchID)
+	return !found || participantList.IsAllowed(address)
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func createNParticipantList(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.ParticipantList {
+	items := make([]types.ParticipantList, n)
+	for i := range items {
+		items[i] = sample.ParticipantList(uint64(i))
+		keeper.SetParticipantList(ctx, items[i])
+	}
+	return items
+}
+
+func TestParticipantListGet(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNParticipantList(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetParticipantList(ctx, item.LaunchID)
+		require.True(t, found)
+		require.Equal(t, item, rst)
+	}
+}
+
+func TestParticipantListRemove(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNParticipantList(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveParticipantList(ctx, item.LaunchID)
+		_, found := keeper.GetParticipantList(ctx, item.LaunchID)
+		require.False(t, found)
+	}
+}
+
+func TestParticipantListGetAll(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNParticipantList(keeper, ctx, 10)
+	require.ElementsMatch(t, items, keeper.GetAllParticipantList(ctx))
+}
+
+func TestIsParticipantAllowed(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	listed := sample.Address()
+	keeper.SetParticipantList(ctx, types.NewParticipantList(0, types.ParticipantList_ALLOWLIST, []string{listed}))
+	keeper.SetParticipantList(ctx, types.NewParticipantList(1, types.ParticipantList_DENYLIST, []string{listed}))
+
+	for _, tc := range []struct {
+		desc     string
+		launchID uint64
+		address  string
+		allowed  bool
+	}{
+		{
+			desc:     "address in allowlist",
+			launchID: 0,
+			address:  listed,
+			allowed:  true,
+		},
+		{
+			desc:     "address not in allowlist",
+			launchID: 0,
+			address:  sample.Address(),
+			allowed:  false,
+		},
+		{
+			desc:     "address in denylist",
+			launchID: 1,
+			address:  listed,
+			allowed:  false,
+		},
+		{
+			desc:     "address not in denylist",
+			launchID: 1,
+			address:  sample.Address(),
+			allowed:  true,
+		},
+		{
+			desc:     "chain without participant list",
+			launchID: 2,
+			address:  sample.Address(),
+			allowed:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.EqualValues(t, tc.allowed, keeper.IsParticipantAllowed(ctx, tc.launchID, tc.address))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -125,6 +126,116 @@ func CheckAccount(ctx sdk.Context, k Keeper, launchID uint64, address string) (b
 	return foundGenesis || foundVesting, nil
 }
 
+// SubmitRequest escrows the request deposit from the creator of the request and appends the request
+func SubmitRequest(ctx sdk.Context, k Keeper, request types.Request) (uint64, error) {
+	request.Deposit = k.RequestDeposit(ctx)
+	if !request.Deposit.Empty() {
+		creator, err := sdk.AccAddressFromBech32(request.Creator)
+		if err != nil {
+			return 0, spnerrors.Criticalf("can't parse request creator address %s", err.Error())
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, request.Deposit); err != nil {
+			return 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "can't escrow the request deposit %s", err.Error())
+		}
+	}
+	return k.AppendRequest(ctx, request), nil
+}
+
+// AutoApproveRequest applies the content of a request approved on submission, from the coordinator
+// or matching the auto approve policy of the chain, and appends the request as approved, no deposit is escrowed
+func AutoApproveRequest(ctx sdk.Context, k Keeper, request types.Request) (uint64, error) {
+	if err := ApplyRequest(ctx, k, request.LaunchID, request); err != nil {
+		return 0, err
+	}
+	request.Status = types.Request_APPROVED
+	request.SettledHeight = ctx.BlockHeight()
+	return k.AppendRequest(ctx, request), nil
+}
+
+// SettleRequestDeposit settles the deposit of a settled or canceled request
+// The deposit is burned if the request is rejected, otherwise it is refunded to the creator of the request
+func SettleRequestDeposit(ctx sdk.Context, k Keeper, request types.Request) error {
+	if request.Deposit.Empty() {
+		return nil
+	}
+
+	switch request.Status {
+	case types.Request_PENDING:
+		return spnerrors.Criticalf("the deposit of the pending request %d can't be settled", request.RequestID)
+	case types.Request_REJECTED:
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, request.Deposit); err != nil {
+			return spnerrors.Criticalf("can't burn request deposit %s", err.Error())
+		}
+	default:
+		creator, err := sdk.AccAddressFromBech32(request.Creator)
+		if err != nil {
+			return spnerrors.Criticalf("can't parse request creator address %s", err.Error())
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, request.Deposit); err != nil {
+			return spnerrors.Criticalf("can't refund request deposit %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// SettleRequest settles a pending request of a chain
+// If the request is approved, the request content is applied and the request is set as approved
+// If the request content can't be applied, the request is set as failed with the failure reason
+// The deposit of the request is refunded unless the request is rejected
+// The optional rejection reason is stored on the request if it is rejected
+// The request is kept in the store with its status and the block height of the settlement
+func SettleRequest(
+	ctx sdk.Context,
+	k Keeper,
+	launchID,
+	requestID uint64,
+	approve bool,
+	rejectionReason string,
+) (types.Request, error) {
+	request, found := k.GetRequest(ctx, launchID, requestID)
+	if !found {
+		return request, sdkerrors.Wrapf(types.ErrRequestNotFound,
+			"request %d for chain %d not found",
+			requestID,
+			launchID,
+		)
+	}
+	if request.Status != types.Request_PENDING {
+		return request, sdkerrors.Wrapf(types.ErrRequestSettled,
+			"request %d for chain %d is %s",
+			requestID,
+			launchID,
+			request.Status.String(),
+		)
+	}
+
+	request.SettledHeight = ctx.BlockHeight()
+	request.Status = types.Request_REJECTED
+	if approve {
+		// The request content is applied in a cached context to not write partial changes if it fails
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := ApplyRequest(cacheCtx, k, launchID, request)
+		switch {
+		case errors.Is(err, spnerrors.ErrCritical):
+			return request, err
+		case err != nil:
+			request.Status = types.Request_FAILED
+			request.FailureReason = err.Error()
+		default:
+			writeCache()
+			request.Status = types.Request_APPROVED
+		}
+	} else {
+		request.RejectionReason = rejectionReason
+	}
+	if err := SettleRequestDeposit(ctx, k, request); err != nil {
+		return request, err
+	}
+	k.SetRequest(ctx, request)
+
+	return request, nil
+}
+
 // ApplyRequest approves the request and performs
 // the launch information changes
 func ApplyRequest(
@@ -137,6 +248,15 @@ func ApplyRequest(
 		return spnerrors.Critical(err.Error())
 	}
 
+	// The limits of the chain may have been edited since the request was submitted
+	chain, found := k.GetChain(ctx, launchID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChainNotFound, "%d", launchID)
+	}
+	if err := CheckChainLimits(ctx, k, chain, request.Content); err != nil {
+		return err
+	}
+
 	switch requestContent := request.Content.Content.(type) {
 	case *types.RequestContent_GenesisAccount:
 		ga := requestContent.GenesisAccount
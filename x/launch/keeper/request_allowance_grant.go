@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+// SetRequestAllowanceGrant set a specific requestAllowanceGrant in the store from its index
+func (k Keeper) SetRequestAllowanceGrant(ctx sdk.Context, grant types.RequestAllowanceGrant) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestAllowanceGrantKeyPrefix))
+	b := k.cdc.MustMarshal(&grant)
+	store.Set(types.RequestAllowanceGrantKey(grant.LaunchID, grant.Grantee), b)
+}
+
+// GetRequestAllowanceGrant returns a requestAllowanceGrant from its index
+func (k Keeper) GetRequestAllowanceGrant(
+	ctx sdk.Context,
+	launchID uint64,
+	grantee string,
+) (val types.RequestAllowanceGrant, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestAllowanceGrantKeyPrefix))
+
+	b := store.Get(types.RequestAllowanceGrantKey(launchID, grantee))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRequestAllowanceGrant removes a requestAllowanceGrant from the store
+func (k Keeper) RemoveRequestAllowanceGrant(ctx sdk.Context, launchID uint64, grantee string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestAllowanceGrantKeyPrefix))
+	store.Delete(types.RequestAllowanceGrantKey(launchID, grantee))
+}
+
+// GetAllRequestAllowanceGrant returns all requestAllowanceGrant
+func (k Keeper) GetAllRequestAllowanceGrant(ctx sdk.Context) (list []types.RequestAllowanceGrant) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestAllowanceGrantKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RequestAllowanceGrant
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllRequestAllowanceGrantByLaunchID returns all requestAllowanceGrant for a launch ID
+func (k Keeper) GetAllRequestAllowanceGrantByLaunchID(ctx sdk.Context, launchID uint64) (list []types.RequestAllowanceGrant) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RequestAllowanceGrantAllKey(launchID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RequestAllowanceGrant
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CloseRequestAllowances closes the request allowances granted for a chain once its launch is triggered
+// The allowances that are still granted for the chain no longer accept any fee and their records are removed
+func (k Keeper) CloseRequestAllowances(ctx sdk.Context, launchID uint64) error {
+	for _, grant := range k.GetAllRequestAllowanceGrantByLaunchID(ctx, launchID) {
+		k.RemoveRequestAllowanceGrant(ctx, launchID, grant.Grantee)
+
+		granter, err := sdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			return spnerrors.Criticalf("can't parse granter address %s", err.Error())
+		}
+		grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+		if err != nil {
+			return spnerrors.Criticalf("can't parse grantee address %s", err.Error())
+		}
+
+		// The allowance may have been used up, expired, revoked or replaced by the granter
+		allowance, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+		if err != nil {
+			continue
+		}
+		requestAllowance, ok := allowance.(*types.LaunchRequestAllowance)
+		if !ok || requestAllowance.LaunchID != launchID {
+			continue
+		}
+
+		requestAllowance.LaunchTriggered = true
+		if err := k.feegrantKeeper.GrantAllowance(ctx, granter, grantee, requestAllowance); err != nil {
+			return spnerrors.Criticalf("can't close the request allowance %s", err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func createNRequestAllowanceGrantForLaunchID(
+	keeper *keeper.Keeper,
+	ctx sdk.Context,
+	n int,
+	launchID uint64,
+) []types.RequestAllowanceGrant {
+	items := make([]types.RequestAllowanceGrant, n)
+	for i := range items {
+		items[i] = sample.RequestAllowanceGrant(launchID)
+		keeper.SetRequestAllowanceGrant(ctx, items[i])
+	}
+	return items
+}
+
+func createNRequestAllowanceGrant(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.RequestAllowanceGrant {
+	items := make([]types.RequestAllowanceGrant, n)
+	for i := range items {
+		items[i] = sample.RequestAllowanceGrant(uint64(i))
+		keeper.SetRequestAllowanceGrant(ctx, items[i])
+	}
+	return items
+}
+
+func TestRequestAllowanceGrantGet(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNRequestAllowanceGrant(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetRequestAllowanceGrant(ctx, item.LaunchID, item.Grantee)
+		require.True(t, found)
+		require.Equal(t, item, rst)
+	}
+}
+
+func TestRequestAllowanceGrantRemove(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNRequestAllowanceGrant(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveRequestAllowanceGrant(ctx, item.LaunchID, item.Grantee)
+		_, found := keeper.GetRequestAllowanceGrant(ctx, item.LaunchID, item.Grantee)
+		require.False(t, found)
+	}
+}
+
+func TestRequestAllowanceGrantGetAll(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNRequestAllowanceGrant(keeper, ctx, 10)
+	require.ElementsMatch(t, items, keeper.GetAllRequestAllowanceGrant(ctx))
+}
+
+func TestRequestAllowanceGrantGetAllByLaunchID(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	launchID := uint64(0)
+	items := createNRequestAllowanceGrantForLaunchID(keeper, ctx, 10, launchID)
+	createNRequestAllowanceGrantForLaunchID(keeper, ctx, 5, launchID+1)
+	require.ElementsMatch(t, items, keeper.GetAllRequestAllowanceGrantByLaunchID(ctx, launchID))
+}
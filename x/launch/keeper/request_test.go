@@ -4,9 +4,13 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
+	spnerrors "github.com/tendermint/spn/pkg/errors"
 	testkeeper "github.com/tendermint/spn/testutil/keeper"
 	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 	"github.com/tendermint/spn/x/launch/keeper"
 	"github.com/tendermint/spn/x/launch/types"
 )
@@ -88,6 +92,8 @@ func TestApplyRequest(t *testing.T) {
 		contents       = sample.AllRequestContents(launchID, genesisAcc, vestingAcc, validatorAcc)
 		invalidContent = types.NewGenesisAccount(launchID, "", sdk.NewCoins())
 	)
+	k.SetChain(ctx, sample.Chain(launchID, 0))
+
 	tests := []struct {
 		name    string
 		request types.Request
@@ -177,3 +183,139 @@ func TestApplyRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitRequest(t *testing.T) {
+	var (
+		_, k, _, bk, ctx = testkeeper.AllKeepers(t)
+		deposit          = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)))
+		creator          = sample.AccAddress()
+		moduleAddr       = authtypes.NewModuleAddress(types.ModuleName)
+	)
+	require.NoError(t, bk.MintCoins(ctx, campaigntypes.ModuleName, deposit))
+	require.NoError(t, bk.SendCoinsFromModuleToAccount(ctx, campaigntypes.ModuleName, creator, deposit))
+
+	t.Run("should append a request without deposit", func(t *testing.T) {
+		request := sample.Request(0, creator.String())
+		request.Creator = creator.String()
+		requestID, err := keeper.SubmitRequest(ctx, *k, request)
+		require.NoError(t, err)
+
+		stored, found := k.GetRequest(ctx, 0, requestID)
+		require.True(t, found)
+		require.True(t, stored.Deposit.Empty())
+		require.True(t, deposit.IsEqual(bk.GetAllBalances(ctx, creator)))
+	})
+
+	params := types.DefaultParams()
+	params.RequestDeposit = deposit
+	k.SetParams(ctx, params)
+
+	t.Run("should escrow the deposit of the request", func(t *testing.T) {
+		request := sample.Request(0, creator.String())
+		request.Creator = creator.String()
+		requestID, err := keeper.SubmitRequest(ctx, *k, request)
+		require.NoError(t, err)
+
+		stored, found := k.GetRequest(ctx, 0, requestID)
+		require.True(t, found)
+		require.True(t, deposit.IsEqual(stored.Deposit))
+		require.True(t, bk.GetAllBalances(ctx, creator).IsZero())
+		require.True(t, deposit.IsEqual(bk.GetAllBalances(ctx, moduleAddr)))
+	})
+
+	t.Run("should prevent submitting a request without balance for the deposit", func(t *testing.T) {
+		counter := k.GetRequestCounter(ctx, 0)
+		request := sample.Request(0, creator.String())
+		request.Creator = creator.String()
+		_, err := keeper.SubmitRequest(ctx, *k, request)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+		require.Equal(t, counter, k.GetRequestCounter(ctx, 0))
+	})
+}
+
+func TestSettleRequestDeposit(t *testing.T) {
+	var (
+		_, k, _, bk, ctx = testkeeper.AllKeepers(t)
+		deposit          = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)))
+		moduleAddr       = authtypes.NewModuleAddress(types.ModuleName)
+	)
+
+	for _, tc := range []struct {
+		desc     string
+		status   types.Request_Status
+		deposit  sdk.Coins
+		refunded bool
+		err      error
+	}{
+		{
+			desc:     "should refund the deposit of an approved request",
+			status:   types.Request_APPROVED,
+			deposit:  deposit,
+			refunded: true,
+		},
+		{
+			desc:     "should refund the deposit of a failed request",
+			status:   types.Request_FAILED,
+			deposit:  deposit,
+			refunded: true,
+		},
+		{
+			desc:     "should refund the deposit of a canceled request",
+			status:   types.Request_CANCELED,
+			deposit:  deposit,
+			refunded: true,
+		},
+		{
+			desc:    "should burn the deposit of a rejected request",
+			status:  types.Request_REJECTED,
+			deposit: deposit,
+		},
+		{
+			desc:    "should do nothing for a request without deposit",
+			status:  types.Request_REJECTED,
+			deposit: sdk.NewCoins(),
+		},
+		{
+			desc:    "should prevent settling the deposit of a pending request",
+			status:  types.Request_PENDING,
+			deposit: deposit,
+			err:     spnerrors.ErrCritical,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			creator := sample.AccAddress()
+			require.NoError(t, bk.MintCoins(ctx, campaigntypes.ModuleName, deposit))
+			require.NoError(t, bk.SendCoinsFromModuleToModule(ctx, campaigntypes.ModuleName, types.ModuleName, deposit))
+			supply := bk.GetSupply(ctx, "foo")
+
+			request := sample.Request(0, creator.String())
+			request.Creator = creator.String()
+			request.Status = tc.status
+			request.Deposit = tc.deposit
+			err := keeper.SettleRequestDeposit(ctx, *k, request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			switch {
+			case tc.deposit.Empty():
+				require.True(t, bk.GetAllBalances(ctx, creator).IsZero())
+				require.Equal(t, supply, bk.GetSupply(ctx, "foo"))
+			case tc.refunded:
+				require.True(t, tc.deposit.IsEqual(bk.GetAllBalances(ctx, creator)))
+				require.Equal(t, supply, bk.GetSupply(ctx, "foo"))
+			default:
+				require.True(t, bk.GetAllBalances(ctx, creator).IsZero())
+				require.Equal(t, supply.Sub(tc.deposit[0]), bk.GetSupply(ctx, "foo"))
+			}
+
+			// the remaining deposit is burned to not leak into the next cases
+			remaining := bk.GetAllBalances(ctx, moduleAddr)
+			if !remaining.Empty() {
+				require.NoError(t, bk.BurnCoins(ctx, types.ModuleName, remaining))
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func createNRewardPool(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.RewardPool {
+	items := make([]types.RewardPool, n)
+	for i := range items {
+		items[i] = sample.RewardPool(uint64(i))
+		keeper.SetRewardPool(ctx, items[i])
+	}
+	return items
+}
+
+func TestRewardPoolGet(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNRewardPool(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetRewardPool(ctx, item.LaunchID)
+		require.True(t, found)
+		require.Equal(t, item, rst)
+	}
+}
+
+func TestRewardPoolRemove(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNRewardPool(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveRewardPool(ctx, item.LaunchID)
+		_, found := keeper.GetRewardPool(ctx, item.LaunchID)
+		require.False(t, found)
+	}
+}
+
+func TestRewardPoolGetAll(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNRewardPool(keeper, ctx, 10)
+	require.ElementsMatch(t, items, keeper.GetAllRewardPool(ctx))
+}
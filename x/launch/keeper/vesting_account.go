@@ -9,11 +9,15 @@ import (
 // SetVestingAccount set a specific vestingAccount in the store from its index
 func (k Keeper) SetVestingAccount(ctx sdk.Context, vestingAccount types.VestingAccount) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VestingAccountKeyPrefix))
+	key := types.VestingAccountKey(vestingAccount.LaunchID, vestingAccount.Address)
+
+	// Increment the counter of the chain if the entry is new
+	if !store.Has(key) {
+		k.incrementLaunchIDCounter(ctx, types.VestingAccountCounterKeyPrefix, vestingAccount.LaunchID)
+	}
+
 	b := k.cdc.MustMarshal(&vestingAccount)
-	store.Set(types.VestingAccountKey(
-		vestingAccount.LaunchID,
-		vestingAccount.Address,
-	), b)
+	store.Set(key, b)
 }
 
 // GetVestingAccount returns a vestingAccount from its index
@@ -40,7 +44,19 @@ func (k Keeper) RemoveVestingAccount(
 	address string,
 ) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VestingAccountKeyPrefix))
-	store.Delete(types.VestingAccountKey(launchID, address))
+	key := types.VestingAccountKey(launchID, address)
+
+	// Decrement the counter of the chain if the entry exists
+	if store.Has(key) {
+		k.decrementLaunchIDCounter(ctx, types.VestingAccountCounterKeyPrefix, launchID)
+	}
+
+	store.Delete(key)
+}
+
+// GetVestingAccountCount returns the number of vestingAccount of a chain
+func (k Keeper) GetVestingAccountCount(ctx sdk.Context, launchID uint64) uint64 {
+	return k.getLaunchIDCounter(ctx, types.VestingAccountCounterKeyPrefix, launchID)
 }
 
 // GetAllVestingAccount returns all vestingAccount
@@ -58,3 +74,19 @@ func (k Keeper) GetAllVestingAccount(ctx sdk.Context) (list []types.VestingAccou
 
 	return
 }
+
+// GetAllVestingAccountByLaunchID returns all vestingAccount for a launch ID
+func (k Keeper) GetAllVestingAccountByLaunchID(ctx sdk.Context, launchID uint64) (list []types.VestingAccount) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingAccountAllKey(launchID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VestingAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
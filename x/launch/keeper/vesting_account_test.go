@@ -54,3 +54,29 @@ func TestVestingAccountGetAll(t *testing.T) {
 	items := createNVestingAccount(keeper, ctx, 10)
 	require.ElementsMatch(t, items, keeper.GetAllVestingAccount(ctx))
 }
+
+func TestVestingAccountGetAllByLaunchID(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNVestingAccountForLaunchID(keeper, ctx, 10, 0)
+	createNVestingAccountForLaunchID(keeper, ctx, 5, 1)
+	require.ElementsMatch(t, items, keeper.GetAllVestingAccountByLaunchID(ctx, 0))
+	require.Len(t, keeper.GetAllVestingAccountByLaunchID(ctx, 1), 5)
+	require.Empty(t, keeper.GetAllVestingAccountByLaunchID(ctx, 2))
+}
+
+func TestVestingAccountCount(t *testing.T) {
+	keeper, ctx := testkeeper.Launch(t)
+	items := createNVestingAccountForLaunchID(keeper, ctx, 10, 0)
+	createNVestingAccountForLaunchID(keeper, ctx, 5, 1)
+	require.EqualValues(t, 10, keeper.GetVestingAccountCount(ctx, 0))
+	require.Zero(t, keeper.GetVestingAccountCount(ctx, 2))
+
+	// Updating an existing entry doesn't change the count
+	keeper.SetVestingAccount(ctx, items[0])
+	require.EqualValues(t, 10, keeper.GetVestingAccountCount(ctx, 0))
+
+	// Removing an entry decrements the count only if it exists
+	keeper.RemoveVestingAccount(ctx, items[0].LaunchID, items[0].Address)
+	keeper.RemoveVestingAccount(ctx, items[0].LaunchID, items[0].Address)
+	require.EqualValues(t, 9, keeper.GetVestingAccountCount(ctx, 0))
+}
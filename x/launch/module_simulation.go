@@ -1,6 +1,7 @@
 package launch
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 
@@ -22,8 +23,15 @@ const (
 	defaultWeightMsgRequestAddValidator      int = 50
 	defaultWeightMsgRequestRemoveValidator   int = 15
 	defaultWeightMsgSettleRequest            int = 50
+	defaultWeightMsgSettleRequests           int = 10
+	defaultWeightMsgCancelRequest            int = 10
 	defaultWeightMsgTriggerLaunch            int = 15
 	defaultWeightMsgRevertLaunch             int = 0
+	defaultWeightMsgSetRewards               int = 20
+	defaultWeightMsgDistributeRewards        int = 20
+	defaultWeightMsgClaimRewards             int = 20
+	defaultWeightMsgGrantRequestAllowance    int = 10
+	defaultWeightMsgSetParticipantList       int = 5
 
 	opWeightMsgCreateChain              = "op_weight_msg_create_chain"
 	opWeightMsgEditChain                = "op_weight_msg_edit_chain"
@@ -35,6 +43,13 @@ const (
 	opWeightMsgTriggerLaunch            = "op_weight_msg_trigger_launch"
 	opWeightMsgRevertLaunch             = "op_weight_msg_revert_launch"
 	opWeightMsgSettleRequest            = "op_weight_msg_settle_request"
+	opWeightMsgSettleRequests           = "op_weight_msg_settle_requests"
+	opWeightMsgCancelRequest            = "op_weight_msg_cancel_request"
+	opWeightMsgSetRewards               = "op_weight_msg_set_rewards"
+	opWeightMsgDistributeRewards        = "op_weight_msg_distribute_rewards"
+	opWeightMsgClaimRewards             = "op_weight_msg_claim_rewards"
+	opWeightMsgGrantRequestAllowance    = "op_weight_msg_grant_request_allowance"
+	opWeightMsgSetParticipantList       = "op_weight_msg_set_participant_list"
 )
 
 // GenerateGenesisState creates a randomized GenState of the module
@@ -62,6 +77,20 @@ func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
 		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxLaunchTime), func(r *rand.Rand) string {
 			return fmt.Sprintf("\"%d\"", launchParams.MaxLaunchTime)
 		}),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRequestDeposit), func(r *rand.Rand) string {
+			deposit, err := json.Marshal(launchParams.RequestDeposit)
+			if err != nil {
+				panic(err)
+			}
+			return string(deposit)
+		}),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyChainCreationFee), func(r *rand.Rand) string {
+			fee, err := json.Marshal(launchParams.ChainCreationFee)
+			if err != nil {
+				panic(err)
+			}
+			return string(fee)
+		}),
 	}
 }
 
@@ -80,7 +109,14 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgRequestRemoveValidator   int
 		weightMsgTriggerLaunch            int
 		weightMsgRevertLaunch             int
+		weightMsgSetRewards               int
+		weightMsgDistributeRewards        int
+		weightMsgClaimRewards             int
+		weightMsgGrantRequestAllowance    int
+		weightMsgSetParticipantList       int
 		weightMsgSettleRequest            int
+		weightMsgSettleRequests           int
+		weightMsgCancelRequest            int
 	)
 
 	appParams := simState.AppParams
@@ -135,11 +171,46 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 			weightMsgRevertLaunch = defaultWeightMsgRevertLaunch
 		},
 	)
+	appParams.GetOrGenerate(cdc, opWeightMsgSetRewards, &weightMsgSetRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetRewards = defaultWeightMsgSetRewards
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgDistributeRewards, &weightMsgDistributeRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgDistributeRewards = defaultWeightMsgDistributeRewards
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgClaimRewards, &weightMsgClaimRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimRewards = defaultWeightMsgClaimRewards
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgGrantRequestAllowance, &weightMsgGrantRequestAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantRequestAllowance = defaultWeightMsgGrantRequestAllowance
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgSetParticipantList, &weightMsgSetParticipantList, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetParticipantList = defaultWeightMsgSetParticipantList
+		},
+	)
 	appParams.GetOrGenerate(cdc, opWeightMsgSettleRequest, &weightMsgSettleRequest, nil,
 		func(_ *rand.Rand) {
 			weightMsgSettleRequest = defaultWeightMsgSettleRequest
 		},
 	)
+	appParams.GetOrGenerate(cdc, opWeightMsgSettleRequests, &weightMsgSettleRequests, nil,
+		func(_ *rand.Rand) {
+			weightMsgSettleRequests = defaultWeightMsgSettleRequests
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgCancelRequest, &weightMsgCancelRequest, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelRequest = defaultWeightMsgCancelRequest
+		},
+	)
 
 	return []simtypes.WeightedOperation{
 		simulation.NewWeightedOperation(
@@ -178,9 +249,37 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 			weightMsgSettleRequest,
 			launchsimulation.SimulateMsgSettleRequest(am.accountKeeper, am.bankKeeper, am.keeper),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSettleRequests,
+			launchsimulation.SimulateMsgSettleRequests(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelRequest,
+			launchsimulation.SimulateMsgCancelRequest(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgRevertLaunch,
 			launchsimulation.SimulateMsgRevertLaunch(am.accountKeeper, am.bankKeeper, am.keeper),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSetRewards,
+			launchsimulation.SimulateMsgSetRewards(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDistributeRewards,
+			launchsimulation.SimulateMsgDistributeRewards(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClaimRewards,
+			launchsimulation.SimulateMsgClaimRewards(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgGrantRequestAllowance,
+			launchsimulation.SimulateMsgGrantRequestAllowance(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetParticipantList,
+			launchsimulation.SimulateMsgSetParticipantList(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
 	}
 }
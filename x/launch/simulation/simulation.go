@@ -50,7 +50,7 @@ func SimulateMsgCreateChain(ak types.AccountKeeper, bk types.BankKeeper, k keepe
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
+			CoinsSpentInMsg: k.ChainCreationFee(ctx),
 		}
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
@@ -66,6 +66,9 @@ func SimulateMsgEditChain(ak types.AccountKeeper, bk types.BankKeeper, k keeper.
 		if !found {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditChain, "chain not found"), nil, nil
 		}
+		if chain.Launched {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditChain, "chain launched"), nil, nil
+		}
 
 		simAccount, err := FindChainCoordinatorAccount(ctx, k, accs, chain.LaunchID)
 		if err != nil {
@@ -113,6 +116,9 @@ func SimulateMsgRequestAddGenesisAccount(ak types.AccountKeeper, bk types.BankKe
 
 		// Select a random account
 		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if !k.IsParticipantAllowed(ctx, chain.LaunchID, simAccount.Address.String()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestAddAccount, "participant not allowed"), nil, nil
+		}
 		msg := sample.MsgRequestAddAccount(
 			simAccount.Address.String(),
 			chain.LaunchID,
@@ -129,7 +135,7 @@ func SimulateMsgRequestAddGenesisAccount(ak types.AccountKeeper, bk types.BankKe
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
+			CoinsSpentInMsg: k.RequestDeposit(ctx),
 		}
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
@@ -150,10 +156,21 @@ func SimulateMsgRequestAddVestingAccount(ak types.AccountKeeper, bk types.BankKe
 		// Select a random account
 		simAccount, _ := simtypes.RandomAcc(r, accs)
 		creator := simAccount.Address.String()
+		if !k.IsParticipantAllowed(ctx, chain.LaunchID, creator) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestAddVestingAccount, "participant not allowed"), nil, nil
+		}
 		msg := sample.MsgRequestAddVestingAccount(
 			creator,
 			chain.LaunchID,
 		)
+
+		// Select the vesting schedule randomly
+		switch r.Intn(3) {
+		case 1:
+			msg.Options = sample.ContinuousVestingOptions()
+		case 2:
+			msg.Options = sample.PeriodicVestingOptions()
+		}
 		txCtx := simulation.OperationInput{
 			R:               r,
 			App:             app,
@@ -166,7 +183,7 @@ func SimulateMsgRequestAddVestingAccount(ak types.AccountKeeper, bk types.BankKe
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
+			CoinsSpentInMsg: k.RequestDeposit(ctx),
 		}
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
@@ -229,7 +246,7 @@ func SimulateMsgRequestRemoveAccount(ak types.AccountKeeper, bk types.BankKeeper
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
+			CoinsSpentInMsg: k.RequestDeposit(ctx),
 		}
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
@@ -248,10 +265,14 @@ func SimulateMsgRequestAddValidator(ak types.AccountKeeper, bk types.BankKeeper,
 		}
 		// Select a random account
 		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if !k.IsParticipantAllowed(ctx, chain.LaunchID, simAccount.Address.String()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestAddValidator, "participant not allowed"), nil, nil
+		}
 		// Select between new address or coordinator address randomly
 		msg := sample.MsgRequestAddValidator(
-			simAccount.Address.String(),
+			simAccount.PrivKey,
 			chain.LaunchID,
+			chain.GenesisChainID,
 		)
 		txCtx := simulation.OperationInput{
 			R:               r,
@@ -265,7 +286,7 @@ func SimulateMsgRequestAddValidator(ak types.AccountKeeper, bk types.BankKeeper,
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
+			CoinsSpentInMsg: k.RequestDeposit(ctx),
 		}
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
@@ -299,7 +320,7 @@ func SimulateMsgRequestRemoveValidator(ak types.AccountKeeper, bk types.BankKeep
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
+			CoinsSpentInMsg: k.RequestDeposit(ctx),
 		}
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
@@ -317,6 +338,11 @@ func SimulateMsgTriggerLaunch(ak types.AccountKeeper, bk types.BankKeeper, k kee
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTriggerLaunch, "non-triggered chain not found"), nil, nil
 		}
 
+		// The launch genesis must be generated for the launch to be triggered
+		if _, err := k.GenerateLaunchGenesis(ctx, chain.LaunchID); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTriggerLaunch, "invalid launch genesis"), nil, nil
+		}
+
 		// Find coordinator account
 		simAccount, err := FindChainCoordinatorAccount(ctx, k, accs, chain.LaunchID)
 		if err != nil {
@@ -383,6 +409,97 @@ func SimulateMsgSettleRequest(ak types.AccountKeeper, bk types.BankKeeper, k kee
 	}
 }
 
+// SimulateMsgSettleRequests simulates a MsgSettleRequests message
+func SimulateMsgSettleRequests(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// Select a random pending request without launch triggered
+		request, found := FindRandomRequest(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSettleRequests, "request for non-triggered chain not found"), nil, nil
+		}
+
+		// Find coordinator account
+		simAccount, err := FindChainCoordinatorAccount(ctx, k, accs, request.LaunchID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSettleRequests, err.Error()), nil, nil
+		}
+
+		// Reject the pending requests of the chain, the rejection of a request never fails
+		var rejected []uint64
+		for _, req := range k.GetAllRequest(ctx) {
+			if req.LaunchID == request.LaunchID && req.Status == types.Request_PENDING &&
+				len(rejected) < types.MaxSettledRequests {
+				rejected = append(rejected, req.RequestID)
+			}
+		}
+
+		msg := types.NewMsgSettleRequests(
+			simAccount.Address.String(),
+			request.LaunchID,
+			nil,
+			rejected,
+			"",
+		)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgCancelRequest simulates a MsgCancelRequest message
+func SimulateMsgCancelRequest(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// Select a random pending request without launch triggered
+		request, found := FindRandomRequest(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelRequest, "request for non-triggered chain not found"), nil, nil
+		}
+
+		// Find the request creator account
+		simAccount, err := FindAccount(accs, request.Creator)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelRequest, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgCancelRequest(
+			simAccount.Address.String(),
+			request.LaunchID,
+			request.RequestID,
+		)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // SimulateMsgRevertLaunch simulates a MsgRevertLaunch message
 func SimulateMsgRevertLaunch(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
@@ -423,3 +540,242 @@ func SimulateMsgRevertLaunch(ak types.AccountKeeper, bk types.BankKeeper, k keep
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
+
+// SimulateMsgSetRewards simulates a MsgSetRewards message
+func SimulateMsgSetRewards(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// Select a chain without launch triggered
+		chain, found := FindRandomChain(r, ctx, k, false, true)
+		if !found {
+			// No message if no non-triggered chain
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetRewards, "non-triggered chain not found"), nil, nil
+		}
+
+		// Find coordinator account
+		simAccount, err := FindChainCoordinatorAccount(ctx, k, accs, chain.LaunchID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetRewards, err.Error()), nil, nil
+		}
+
+		// Provide a random portion of the coordinator balance as rewards
+		coins := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, simAccount.Address))
+		if coins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetRewards, "no coins for rewards"), nil, nil
+		}
+		lastRewardHeight := r.Int63n(1000) + 1
+
+		msg := types.NewMsgSetRewards(simAccount.Address.String(), chain.LaunchID, coins, lastRewardHeight)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: coins,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgDistributeRewards simulates a MsgDistributeRewards message
+func SimulateMsgDistributeRewards(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// Select a launched chain with rewards whose reward distribution delay is reached
+		rewardPool, chain, found := FindRandomRewardPoolAfterDelay(r, ctx, k, types.RewardDistributionDelay)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDistributeRewards, "distributable reward pool not found"), nil, nil
+		}
+
+		// Anyone can distribute the rewards once the reward distribution timeout is reached
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if ctx.BlockTime().Unix() < chain.LaunchTimestamp+types.RewardDistributionTimeout {
+			var err error
+			simAccount, err = FindChainCoordinatorAccount(ctx, k, accs, rewardPool.LaunchID)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDistributeRewards, err.Error()), nil, nil
+			}
+		}
+
+		// The chain may have stopped before or after the last reward height
+		lastBlockHeight := r.Int63n(2 * rewardPool.LastRewardHeight)
+
+		msg := types.NewMsgDistributeRewards(simAccount.Address.String(), rewardPool.LaunchID, lastBlockHeight)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgClaimRewards simulates a MsgClaimRewards message
+func SimulateMsgClaimRewards(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// Select a launched chain with rewards whose reward distribution timeout is reached
+		rewardPool, _, found := FindRandomRewardPoolAfterDelay(r, ctx, k, types.RewardDistributionTimeout)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClaimRewards, "claimable reward pool not found"), nil, nil
+		}
+
+		// Find a genesis validator that didn't claim its rewards
+		var simAccount simtypes.Account
+		found = false
+		for _, validator := range k.GetAllGenesisValidatorByLaunchID(ctx, rewardPool.LaunchID) {
+			if rewardPool.IsClaimed(validator.Address) {
+				continue
+			}
+			for _, acc := range accs {
+				if acc.Address.String() == validator.Address {
+					simAccount, found = acc, true
+					break
+				}
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClaimRewards, "genesis validator without claim not found"), nil, nil
+		}
+
+		msg := types.NewMsgClaimRewards(simAccount.Address.String(), rewardPool.LaunchID)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgGrantRequestAllowance simulates a MsgGrantRequestAllowance message
+func SimulateMsgGrantRequestAllowance(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// Select a chain without launch triggered
+		chain, found := FindRandomChain(r, ctx, k, false, true)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantRequestAllowance, "non-triggered chain not found"), nil, nil
+		}
+
+		// Find coordinator account
+		simAccount, err := FindChainCoordinatorAccount(ctx, k, accs, chain.LaunchID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantRequestAllowance, err.Error()), nil, nil
+		}
+
+		// The allowance is granted to a new validator that doesn't hold any allowance from the coordinator
+		spendLimit := simtypes.RandSubsetCoins(r, bk.SpendableCoins(ctx, simAccount.Address))
+		if spendLimit.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantRequestAllowance, "no coins for spend limit"), nil, nil
+		}
+		grantee := simtypes.RandomAccounts(r, 1)[0]
+		var expiration int64
+		if r.Intn(2) == 0 {
+			expiration = ctx.BlockTime().Unix() + r.Int63n(1000) + 1
+		}
+
+		msg := types.NewMsgGrantRequestAllowance(
+			simAccount.Address.String(),
+			chain.LaunchID,
+			grantee.Address.String(),
+			spendLimit,
+			expiration,
+		)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSetParticipantList simulates a MsgSetParticipantList message
+func SimulateMsgSetParticipantList(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// Select a chain without launch triggered
+		chain, found := FindRandomChain(r, ctx, k, false, true)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetParticipantList, "non-triggered chain not found"), nil, nil
+		}
+
+		// Find coordinator account
+		simAccount, err := FindChainCoordinatorAccount(ctx, k, accs, chain.LaunchID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetParticipantList, err.Error()), nil, nil
+		}
+
+		// The list contains a random subset of the accounts, an empty list removes the participant list
+		listType := types.ParticipantList_ALLOWLIST
+		if r.Intn(2) == 0 {
+			listType = types.ParticipantList_DENYLIST
+		}
+		var addresses []string
+		for _, acc := range accs {
+			if r.Intn(4) == 0 {
+				addresses = append(addresses, acc.Address.String())
+			}
+		}
+
+		msg := types.NewMsgSetParticipantList(simAccount.Address.String(), chain.LaunchID, listType, addresses)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -95,6 +95,9 @@ func FindRandomRequest(
 		requests[i], requests[j] = requests[j], requests[i]
 	})
 	for _, req := range requests {
+		if req.Status != types.Request_PENDING {
+			continue
+		}
 		chain, chainFound := k.GetChain(ctx, req.LaunchID)
 		if !chainFound || chain.LaunchTriggered {
 			continue
@@ -155,3 +158,24 @@ func FindRandomValidator(
 	}
 	return simAccount, valAcc, found
 }
+
+// FindRandomRewardPoolAfterDelay find a random reward pool of a launched chain whose launch time is passed by the delay
+func FindRandomRewardPoolAfterDelay(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	delay int64,
+) (rewardPool types.RewardPool, chain types.Chain, found bool) {
+	rewardPools := k.GetAllRewardPool(ctx)
+	r.Shuffle(len(rewardPools), func(i, j int) {
+		rewardPools[i], rewardPools[j] = rewardPools[j], rewardPools[i]
+	})
+	for _, rp := range rewardPools {
+		c, chainFound := k.GetChain(ctx, rp.LaunchID)
+		if !chainFound || !c.Launched || ctx.BlockTime().Unix() < c.LaunchTimestamp+delay {
+			continue
+		}
+		return rp, c, true
+	}
+	return rewardPool, chain, false
+}
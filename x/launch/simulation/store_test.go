@@ -353,6 +353,20 @@ func TestFindRandomRequest(t *testing.T) {
 		require.False(t, gotFound)
 	})
 
+	t.Run("settled request", func(t *testing.T) {
+		msgCreateCoord := sample.MsgCreateCoordinator(sample.Address())
+		res, err := profileSrv.CreateCoordinator(ctx, &msgCreateCoord)
+		require.NoError(t, err)
+
+		chainID := k.AppendChain(sdkCtx, sample.Chain(0, res.CoordinatorId))
+		request := sample.Request(chainID, sample.Address())
+		request.Status = types.Request_REJECTED
+		k.AppendRequest(sdkCtx, request)
+		gotRequest, gotFound := launchsimulation.FindRandomRequest(r, sdkCtx, *k)
+		require.Equal(t, types.Request{}, gotRequest)
+		require.False(t, gotFound)
+	})
+
 	t.Run("get a valid request", func(t *testing.T) {
 		msgCreateCoord := sample.MsgCreateCoordinator(sample.Address())
 		res, err := profileSrv.CreateCoordinator(ctx, &msgCreateCoord)
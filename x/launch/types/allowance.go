@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+var (
+	_ feegrant.FeeAllowanceI             = (*LaunchRequestAllowance)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*LaunchRequestAllowance)(nil)
+)
+
+// NewLaunchRequestAllowance returns a new fee allowance restricted to the request messages of a chain
+func NewLaunchRequestAllowance(allowance feegrant.FeeAllowanceI, launchID uint64) (*LaunchRequestAllowance, error) {
+	a := &LaunchRequestAllowance{
+		LaunchID: launchID,
+	}
+	return a, a.SetAllowance(allowance)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage
+func (a *LaunchRequestAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// GetFeeAllowance returns the allowance applied to the fees of the request messages
+func (a *LaunchRequestAllowance) GetFeeAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+	return allowance, nil
+}
+
+// SetAllowance sets the allowance applied to the fees of the request messages
+func (a *LaunchRequestAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	a.Allowance = any
+	return nil
+}
+
+// Accept implements FeeAllowanceI
+// The fees are accepted if all the messages are requests for the chain of the allowance
+// and the launch of the chain is not triggered, the allowance is removed otherwise
+func (a *LaunchRequestAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if a.LaunchTriggered {
+		return true, sdkerrors.Wrapf(ErrTriggeredLaunch, "requests can't be sent for the chain %d", a.LaunchID)
+	}
+
+	for _, msg := range msgs {
+		launchID, ok := RequestMsgLaunchID(msg)
+		if !ok || launchID != a.LaunchID {
+			return false, sdkerrors.Wrapf(
+				feegrant.ErrMessageNotAllowed,
+				"%s is not a request for the chain %d",
+				sdk.MsgTypeURL(msg),
+				a.LaunchID,
+			)
+		}
+	}
+
+	allowance, err := a.GetFeeAllowance()
+	if err != nil {
+		return false, err
+	}
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err != nil || remove {
+		return remove, err
+	}
+
+	// the allowance is packed again to save its updated state
+	return false, a.SetAllowance(allowance)
+}
+
+// ValidateBasic implements FeeAllowanceI
+func (a *LaunchRequestAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+	allowance, err := a.GetFeeAllowance()
+	if err != nil {
+		return err
+	}
+	return allowance.ValidateBasic()
+}
+
+// NewRequestAllowanceGrant returns a new record of a request allowance granted for a chain
+func NewRequestAllowanceGrant(launchID uint64, granter, grantee string) RequestAllowanceGrant {
+	return RequestAllowanceGrant{
+		LaunchID: launchID,
+		Granter:  granter,
+		Grantee:  grantee,
+	}
+}
+
+// Validate checks the request allowance grant is valid
+func (m RequestAllowanceGrant) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Granter); err != nil {
+		return fmt.Errorf("invalid granter address: %s", err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee address: %s", err.Error())
+	}
+	return nil
+}
+
+// RequestMsgLaunchID returns the launch ID of the chain targeted by the message
+// if the message is a request message
+func RequestMsgLaunchID(msg sdk.Msg) (uint64, bool) {
+	switch msg := msg.(type) {
+	case *MsgRequestAddAccount:
+		return msg.LaunchID, true
+	case *MsgRequestAddVestingAccount:
+		return msg.LaunchID, true
+	case *MsgRequestRemoveAccount:
+		return msg.LaunchID, true
+	case *MsgRequestAddValidator:
+		return msg.LaunchID, true
+	case *MsgRequestRemoveValidator:
+		return msg.LaunchID, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,672 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: launch/allowance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LaunchRequestAllowance is a fee allowance restricted to the request messages of a chain
+type LaunchRequestAllowance struct {
+	// allowance is the allowance applied to the fees of the request messages
+	Allowance *types.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	LaunchID  uint64     `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	// launchTriggered is set when the launch of the chain is triggered, the allowance no longer accepts any fee
+	LaunchTriggered bool `protobuf:"varint,3,opt,name=launchTriggered,proto3" json:"launchTriggered,omitempty"`
+}
+
+func (m *LaunchRequestAllowance) Reset()         { *m = LaunchRequestAllowance{} }
+func (m *LaunchRequestAllowance) String() string { return proto.CompactTextString(m) }
+func (*LaunchRequestAllowance) ProtoMessage()    {}
+func (*LaunchRequestAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6450a3fbba6e8a18, []int{0}
+}
+func (m *LaunchRequestAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LaunchRequestAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaunchRequestAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LaunchRequestAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaunchRequestAllowance.Merge(m, src)
+}
+func (m *LaunchRequestAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaunchRequestAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaunchRequestAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LaunchRequestAllowance proto.InternalMessageInfo
+
+func (m *LaunchRequestAllowance) GetAllowance() *types.Any {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+func (m *LaunchRequestAllowance) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *LaunchRequestAllowance) GetLaunchTriggered() bool {
+	if m != nil {
+		return m.LaunchTriggered
+	}
+	return false
+}
+
+// RequestAllowanceGrant records a request allowance granted by the coordinator of a chain
+// The allowances are closed when the launch of the chain is triggered
+type RequestAllowanceGrant struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Granter  string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee  string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *RequestAllowanceGrant) Reset()         { *m = RequestAllowanceGrant{} }
+func (m *RequestAllowanceGrant) String() string { return proto.CompactTextString(m) }
+func (*RequestAllowanceGrant) ProtoMessage()    {}
+func (*RequestAllowanceGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6450a3fbba6e8a18, []int{1}
+}
+func (m *RequestAllowanceGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestAllowanceGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestAllowanceGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestAllowanceGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestAllowanceGrant.Merge(m, src)
+}
+func (m *RequestAllowanceGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestAllowanceGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestAllowanceGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestAllowanceGrant proto.InternalMessageInfo
+
+func (m *RequestAllowanceGrant) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *RequestAllowanceGrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *RequestAllowanceGrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*LaunchRequestAllowance)(nil), "tendermint.spn.launch.LaunchRequestAllowance")
+	proto.RegisterType((*RequestAllowanceGrant)(nil), "tendermint.spn.launch.RequestAllowanceGrant")
+}
+
+func init() { proto.RegisterFile("launch/allowance.proto", fileDescriptor_6450a3fbba6e8a18) }
+
+var fileDescriptor_6450a3fbba6e8a18 = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xeb, 0xaf, 0x9f, 0xa0, 0x35, 0x42, 0xa8, 0x11, 0xad, 0xd2, 0x0e, 0x56, 0xd5, 0x29,
+	0x0b, 0xb6, 0x04, 0x1b, 0x5b, 0x2b, 0xfe, 0xa8, 0x12, 0x53, 0xc4, 0xc4, 0x82, 0xd2, 0xf4, 0xe2,
+	0x56, 0x4a, 0xed, 0x60, 0x3b, 0x82, 0xbc, 0x05, 0x0f, 0xc3, 0xc0, 0x23, 0x20, 0xa6, 0x8a, 0x89,
+	0x11, 0x25, 0x2f, 0x82, 0x88, 0x49, 0x02, 0xd9, 0x7c, 0x7c, 0xcf, 0xf1, 0xef, 0xc8, 0x17, 0x0f,
+	0xa2, 0x20, 0x11, 0xe1, 0x8a, 0x05, 0x51, 0x24, 0x1f, 0x02, 0x11, 0x02, 0x8d, 0x95, 0x34, 0xd2,
+	0xe9, 0x1b, 0x10, 0x4b, 0x50, 0x9b, 0xb5, 0x30, 0x54, 0xc7, 0x82, 0x5a, 0xdb, 0x68, 0xc8, 0xa5,
+	0xe4, 0x11, 0xb0, 0xc2, 0xb4, 0x48, 0xee, 0x58, 0x20, 0x52, 0x9b, 0x18, 0x0d, 0x43, 0xa9, 0x37,
+	0x52, 0xdf, 0x16, 0x8a, 0x59, 0x61, 0x47, 0x93, 0x17, 0x84, 0x07, 0x57, 0xc5, 0x03, 0x3e, 0xdc,
+	0x27, 0xa0, 0xcd, 0xb4, 0xa4, 0x39, 0xe7, 0xb8, 0x5b, 0xa1, 0x5d, 0x34, 0x46, 0xde, 0xde, 0xf1,
+	0x21, 0xb5, 0x10, 0x5a, 0x42, 0xe8, 0x54, 0xa4, 0xb3, 0xde, 0xdb, 0xf3, 0xd1, 0xfe, 0x05, 0x40,
+	0x15, 0x9d, 0xfb, 0x75, 0xd2, 0x19, 0xe1, 0x8e, 0x6d, 0x38, 0x3f, 0x73, 0xff, 0x8d, 0x91, 0xf7,
+	0xdf, 0xaf, 0xb4, 0xe3, 0xe1, 0x03, 0x7b, 0xbe, 0x56, 0x6b, 0xce, 0x41, 0xc1, 0xd2, 0x6d, 0x8f,
+	0x91, 0xd7, 0xf1, 0x9b, 0xd7, 0xa7, 0xbd, 0xf7, 0x26, 0x63, 0xc2, 0x71, 0xbf, 0xd9, 0xf9, 0x52,
+	0x05, 0xc2, 0xfc, 0x21, 0xa2, 0x06, 0xd1, 0xc5, 0xbb, 0xfc, 0xdb, 0x04, 0xaa, 0x28, 0xd3, 0xf5,
+	0x4b, 0x59, 0x4f, 0xc0, 0x6d, 0xff, 0x9e, 0xc0, 0x6c, 0xf6, 0x9a, 0x11, 0xb4, 0xcd, 0x08, 0xfa,
+	0xcc, 0x08, 0x7a, 0xca, 0x49, 0x6b, 0x9b, 0x93, 0xd6, 0x47, 0x4e, 0x5a, 0x37, 0x1e, 0x5f, 0x9b,
+	0x55, 0xb2, 0xa0, 0xa1, 0xdc, 0xb0, 0x7a, 0x2b, 0x4c, 0xc7, 0x82, 0x3d, 0xb2, 0x9f, 0xf5, 0x99,
+	0x34, 0x06, 0xbd, 0xd8, 0x29, 0x7e, 0xec, 0xe4, 0x6b, 0x00, 0xb7, 0x26, 0x97, 0x0b, 0xd5, 0x01,
+	0x00, 0x00,
+}
+
+func (m *LaunchRequestAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaunchRequestAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaunchRequestAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LaunchTriggered {
+		i--
+		if m.LaunchTriggered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintAllowance(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAllowance(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestAllowanceGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestAllowanceGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestAllowanceGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintAllowance(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintAllowance(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintAllowance(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAllowance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAllowance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LaunchRequestAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovAllowance(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovAllowance(uint64(m.LaunchID))
+	}
+	if m.LaunchTriggered {
+		n += 2
+	}
+	return n
+}
+
+func (m *RequestAllowanceGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovAllowance(uint64(m.LaunchID))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovAllowance(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovAllowance(uint64(l))
+	}
+	return n
+}
+
+func sovAllowance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAllowance(x uint64) (n int) {
+	return sovAllowance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LaunchRequestAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllowance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LaunchRequestAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LaunchRequestAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchTriggered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LaunchTriggered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllowance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestAllowanceGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllowance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestAllowanceGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestAllowanceGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllowance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAllowance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAllowance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAllowance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAllowance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAllowance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAllowance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAllowance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAllowance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,165 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestLaunchRequestAllowance_Accept(t *testing.T) {
+	var (
+		launchID   = uint64(10)
+		addr       = sample.Address()
+		now        = time.Now()
+		past       = now.Add(-time.Hour)
+		ctx        = sdk.Context{}.WithBlockTime(now)
+		spendLimit = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	)
+
+	newAllowance := func(t *testing.T, expiration *time.Time) *types.LaunchRequestAllowance {
+		allowance, err := types.NewLaunchRequestAllowance(&feegrant.BasicAllowance{
+			SpendLimit: spendLimit,
+			Expiration: expiration,
+		}, launchID)
+		require.NoError(t, err)
+		return allowance
+	}
+	requestMsgs := []sdk.Msg{
+		types.NewMsgRequestAddAccount(addr, launchID, sample.Coins()),
+		types.NewMsgRequestRemoveAccount(launchID, addr, sample.Address()),
+		types.NewMsgRequestRemoveValidator(launchID, addr, sample.Address()),
+	}
+
+	for _, tc := range []struct {
+		desc            string
+		expiration      *time.Time
+		launchTriggered bool
+		fee             sdk.Coins
+		msgs            []sdk.Msg
+		remove          bool
+		remaining       sdk.Coins
+		err             error
+	}{
+		{
+			desc:      "should accept fees for requests of the chain",
+			fee:       sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(30))),
+			msgs:      requestMsgs,
+			remaining: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(70))),
+		},
+		{
+			desc:   "should remove the allowance when the spend limit is reached",
+			fee:    spendLimit,
+			msgs:   requestMsgs,
+			remove: true,
+		},
+		{
+			desc: "should prevent using the allowance for requests of another chain",
+			fee:  sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(30))),
+			msgs: []sdk.Msg{
+				types.NewMsgRequestAddAccount(addr, launchID, sample.Coins()),
+				types.NewMsgRequestAddAccount(addr, launchID+1, sample.Coins()),
+			},
+			err: feegrant.ErrMessageNotAllowed,
+		},
+		{
+			desc: "should prevent using the allowance for messages other than requests",
+			fee:  sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(30))),
+			msgs: []sdk.Msg{
+				types.NewMsgRequestAddAccount(addr, launchID, sample.Coins()),
+				types.NewMsgSettleRequest(addr, launchID, 0, true, ""),
+			},
+			err: feegrant.ErrMessageNotAllowed,
+		},
+		{
+			desc: "should prevent using the allowance above the spend limit",
+			fee:  sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(101))),
+			msgs: requestMsgs,
+			err:  feegrant.ErrFeeLimitExceeded,
+		},
+		{
+			desc:       "should prevent using an expired allowance",
+			expiration: &past,
+			fee:        sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(30))),
+			msgs:       requestMsgs,
+			remove:     true,
+			err:        feegrant.ErrFeeLimitExpired,
+		},
+		{
+			desc:            "should prevent using the allowance once the launch is triggered",
+			launchTriggered: true,
+			fee:             sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(30))),
+			msgs:            requestMsgs,
+			remove:          true,
+			err:             types.ErrTriggeredLaunch,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			allowance := newAllowance(t, tc.expiration)
+			allowance.LaunchTriggered = tc.launchTriggered
+			remove, err := allowance.Accept(ctx, tc.fee, tc.msgs)
+			require.Equal(t, tc.remove, remove)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			if tc.remove {
+				return
+			}
+
+			// the updated state of the allowance is saved
+			updated, err := allowance.GetFeeAllowance()
+			require.NoError(t, err)
+			basicAllowance, ok := updated.(*feegrant.BasicAllowance)
+			require.True(t, ok)
+			require.Equal(t, tc.remaining, basicAllowance.SpendLimit)
+		})
+	}
+}
+
+func TestLaunchRequestAllowance_ValidateBasic(t *testing.T) {
+	validAllowance, err := types.NewLaunchRequestAllowance(&feegrant.BasicAllowance{
+		SpendLimit: sample.Coins(),
+	}, 0)
+	require.NoError(t, err)
+	invalidAllowance, err := types.NewLaunchRequestAllowance(&feegrant.BasicAllowance{
+		SpendLimit: sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}},
+	}, 0)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc      string
+		allowance *types.LaunchRequestAllowance
+		valid     bool
+	}{
+		{
+			desc:      "valid allowance",
+			allowance: validAllowance,
+			valid:     true,
+		},
+		{
+			desc:      "no allowance",
+			allowance: &types.LaunchRequestAllowance{},
+			valid:     false,
+		},
+		{
+			desc:      "invalid allowance",
+			allowance: invalidAllowance,
+			valid:     false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks the auto approve policy is valid
+func (m AutoApprovePolicy) Validate() error {
+	if err := m.MaxAccountCoins.Validate(); err != nil {
+		return fmt.Errorf("invalid max account coins: %s", err.Error())
+	}
+	if err := validateSelfDelegationBounds(m.MinSelfDelegation, m.MaxSelfDelegation); err != nil {
+		return err
+	}
+
+	allowedAddresses := make(map[string]struct{})
+	for _, address := range m.AllowedAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid allowed address %s: %s", address, err.Error())
+		}
+		if _, ok := allowedAddresses[address]; ok {
+			return fmt.Errorf("duplicated allowed address %s", address)
+		}
+		allowedAddresses[address] = struct{}{}
+	}
+	return nil
+}
+
+// Matches returns true if a request with the content submitted by the creator is automatically approved
+// Only the contents adding accounts or validators to the genesis can be automatically approved
+func (m AutoApprovePolicy) Matches(creator string, content RequestContent) bool {
+	switch requestContent := content.Content.(type) {
+	case *RequestContent_GenesisAccount:
+		coins := requestContent.GenesisAccount.Coins
+		return m.isAllowedAddress(creator) ||
+			(!m.MaxAccountCoins.Empty() && coins.IsAllLTE(m.MaxAccountCoins))
+	case *RequestContent_VestingAccount:
+		return m.isAllowedAddress(creator)
+	case *RequestContent_GenesisValidator:
+		selfDelegation := requestContent.GenesisValidator.SelfDelegation
+		return m.isAllowedAddress(creator) ||
+			((m.MinSelfDelegation != nil || m.MaxSelfDelegation != nil) &&
+				checkSelfDelegationBounds(selfDelegation, m.MinSelfDelegation, m.MaxSelfDelegation) == nil)
+	}
+	return false
+}
+
+// isAllowedAddress returns true if the address is in the allowed addresses of the policy
+func (m AutoApprovePolicy) isAllowedAddress(address string) bool {
+	for _, allowedAddress := range m.AllowedAddresses {
+		if allowedAddress == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestAutoApprovePolicy_Validate(t *testing.T) {
+	var (
+		stake1      = sdk.NewCoin("stake", sdk.NewInt(1))
+		stake2      = sdk.NewCoin("stake", sdk.NewInt(2))
+		invalidCoin = sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}
+		address     = sample.Address()
+	)
+
+	for _, tc := range []struct {
+		desc   string
+		policy types.AutoApprovePolicy
+		valid  bool
+	}{
+		{
+			desc:   "valid policy",
+			policy: sample.AutoApprovePolicy(),
+			valid:  true,
+		},
+		{
+			desc:   "empty policy",
+			policy: types.AutoApprovePolicy{},
+			valid:  true,
+		},
+		{
+			desc: "invalid max account coins",
+			policy: types.AutoApprovePolicy{
+				MaxAccountCoins: sdk.Coins{invalidCoin},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid min self delegation",
+			policy: types.AutoApprovePolicy{
+				MinSelfDelegation: &invalidCoin,
+			},
+			valid: false,
+		},
+		{
+			desc: "min self delegation higher than max self delegation",
+			policy: types.AutoApprovePolicy{
+				MinSelfDelegation: &stake2,
+				MaxSelfDelegation: &stake1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid allowed address",
+			policy: types.AutoApprovePolicy{
+				AllowedAddresses: []string{"invalid"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowed address",
+			policy: types.AutoApprovePolicy{
+				AllowedAddresses: []string{address, address},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.EqualValues(t, tc.valid, tc.policy.Validate() == nil)
+		})
+	}
+}
+
+func TestAutoApprovePolicy_Matches(t *testing.T) {
+	var (
+		launchID          = uint64(0)
+		allowedAddress    = sample.Address()
+		minSelfDelegation = sdk.NewCoin("stake", sdk.NewInt(10))
+		maxSelfDelegation = sdk.NewCoin("stake", sdk.NewInt(100))
+		policy            = types.AutoApprovePolicy{
+			MaxAccountCoins:   sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100))),
+			MinSelfDelegation: &minSelfDelegation,
+			MaxSelfDelegation: &maxSelfDelegation,
+			AllowedAddresses:  []string{allowedAddress},
+		}
+		fooCoins = func(amount int64) sdk.Coins {
+			return sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(amount)))
+		}
+		genesisValidator = func(address string, selfDelegation sdk.Coin) types.RequestContent {
+			return types.NewGenesisValidator(
+				launchID,
+				address,
+				sample.Bytes(300),
+				sample.Bytes(30),
+				selfDelegation,
+				sample.Peer(),
+			)
+		}
+	)
+
+	for _, tc := range []struct {
+		desc    string
+		policy  types.AutoApprovePolicy
+		creator string
+		content types.RequestContent
+		matches bool
+	}{
+		{
+			desc:    "genesis account with coins lower than the max account coins",
+			policy:  policy,
+			creator: sample.Address(),
+			content: types.NewGenesisAccount(launchID, sample.Address(), fooCoins(100)),
+			matches: true,
+		},
+		{
+			desc:    "genesis account with coins higher than the max account coins",
+			policy:  policy,
+			creator: sample.Address(),
+			content: types.NewGenesisAccount(launchID, sample.Address(), fooCoins(101)),
+			matches: false,
+		},
+		{
+			desc:    "genesis account from an allowed address",
+			policy:  policy,
+			creator: allowedAddress,
+			content: types.NewGenesisAccount(launchID, allowedAddress, fooCoins(101)),
+			matches: true,
+		},
+		{
+			desc:    "vesting account from an allowed address",
+			policy:  policy,
+			creator: allowedAddress,
+			content: types.NewVestingAccount(launchID, allowedAddress, sample.Coins(), sample.VestingOptions()),
+			matches: true,
+		},
+		{
+			desc:    "vesting account from another address",
+			policy:  policy,
+			creator: sample.Address(),
+			content: types.NewVestingAccount(launchID, sample.Address(), fooCoins(1), sample.VestingOptions()),
+			matches: false,
+		},
+		{
+			desc:    "genesis validator with a self delegation within the bounds",
+			policy:  policy,
+			creator: sample.Address(),
+			content: genesisValidator(sample.Address(), sdk.NewCoin("stake", sdk.NewInt(50))),
+			matches: true,
+		},
+		{
+			desc:    "genesis validator with a self delegation out of the bounds",
+			policy:  policy,
+			creator: sample.Address(),
+			content: genesisValidator(sample.Address(), sdk.NewCoin("stake", sdk.NewInt(101))),
+			matches: false,
+		},
+		{
+			desc:    "genesis validator from an allowed address",
+			policy:  policy,
+			creator: allowedAddress,
+			content: genesisValidator(allowedAddress, sdk.NewCoin("stake", sdk.NewInt(101))),
+			matches: true,
+		},
+		{
+			desc:    "removal from an allowed address",
+			policy:  policy,
+			creator: allowedAddress,
+			content: types.NewAccountRemoval(allowedAddress),
+			matches: false,
+		},
+		{
+			desc:    "empty policy never matches a genesis account",
+			policy:  types.AutoApprovePolicy{},
+			creator: sample.Address(),
+			content: types.NewGenesisAccount(launchID, sample.Address(), fooCoins(1)),
+			matches: false,
+		},
+		{
+			desc:    "empty policy never matches a genesis validator",
+			policy:  types.AutoApprovePolicy{},
+			creator: sample.Address(),
+			content: genesisValidator(sample.Address(), sdk.NewCoin("stake", sdk.NewInt(50))),
+			matches: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.EqualValues(t, tc.matches, tc.policy.Matches(tc.creator, tc.content))
+		})
+	}
+}
@@ -17,10 +17,19 @@ func (m Chain) Validate() error {
 		return errors.New("launch timestamp must be defined when launch is triggered")
 	}
 
+	// A chain can only be launched if its launch has been triggered
+	if m.Launched && !m.LaunchTriggered {
+		return errors.New("chain is launched but its launch has not been triggered")
+	}
+
 	// A chain that is a mainnet is always associated to a campaign
 	if m.IsMainnet && !m.HasCampaign {
 		return errors.New("chain is a mainnet but not associated to a campaign")
 	}
 
-	return nil
+	if err := m.Limits.Validate(); err != nil {
+		return err
+	}
+
+	return m.AutoApprovePolicy.Validate()
 }
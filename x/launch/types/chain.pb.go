@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -36,6 +38,16 @@ type Chain struct {
 	IsMainnet       bool           `protobuf:"varint,10,opt,name=isMainnet,proto3" json:"isMainnet,omitempty"`
 	LaunchTriggered bool           `protobuf:"varint,11,opt,name=launchTriggered,proto3" json:"launchTriggered,omitempty"`
 	LaunchTimestamp int64          `protobuf:"varint,12,opt,name=launchTimestamp,proto3" json:"launchTimestamp,omitempty"`
+	// launched is set once the block time passes the launch timestamp of the chain
+	Launched bool `protobuf:"varint,13,opt,name=launched,proto3" json:"launched,omitempty"`
+	// finalGenesisHash is the hash of the final genesis generated when the chain is launched
+	// It is only set for the chains with a default initial genesis, the final genesis of a chain
+	// with a genesis URL is generated from the content of the URL with the genesis query
+	FinalGenesisHash string `protobuf:"bytes,14,opt,name=finalGenesisHash,proto3" json:"finalGenesisHash,omitempty"`
+	// limits are the optional limits set by the coordinator on the genesis of the chain
+	Limits ChainLimits `protobuf:"bytes,15,opt,name=limits,proto3" json:"limits"`
+	// autoApprovePolicy defines the requests automatically approved when they are submitted
+	AutoApprovePolicy AutoApprovePolicy `protobuf:"bytes,16,opt,name=autoApprovePolicy,proto3" json:"autoApprovePolicy"`
 }
 
 func (m *Chain) Reset()         { *m = Chain{} }
@@ -155,6 +167,191 @@ func (m *Chain) GetLaunchTimestamp() int64 {
 	return 0
 }
 
+func (m *Chain) GetLaunched() bool {
+	if m != nil {
+		return m.Launched
+	}
+	return false
+}
+
+func (m *Chain) GetFinalGenesisHash() string {
+	if m != nil {
+		return m.FinalGenesisHash
+	}
+	return ""
+}
+
+func (m *Chain) GetLimits() ChainLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return ChainLimits{}
+}
+
+func (m *Chain) GetAutoApprovePolicy() AutoApprovePolicy {
+	if m != nil {
+		return m.AutoApprovePolicy
+	}
+	return AutoApprovePolicy{}
+}
+
+// ChainLimits defines the limits on the genesis accounts and validators of a chain
+// A zero or empty value means no limit
+type ChainLimits struct {
+	// maxValidators is the maximum number of genesis validators
+	MaxValidators uint64 `protobuf:"varint,1,opt,name=maxValidators,proto3" json:"maxValidators,omitempty"`
+	// maxGenesisAccounts is the maximum number of genesis and vesting accounts
+	MaxGenesisAccounts uint64 `protobuf:"varint,2,opt,name=maxGenesisAccounts,proto3" json:"maxGenesisAccounts,omitempty"`
+	// maxAccountCoins is the maximum amount of coins of a genesis or vesting account
+	MaxAccountCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=maxAccountCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"maxAccountCoins"`
+	// minSelfDelegation is the minimum self delegation of a genesis validator
+	MinSelfDelegation *types.Coin `protobuf:"bytes,4,opt,name=minSelfDelegation,proto3" json:"minSelfDelegation,omitempty"`
+	// maxSelfDelegation is the maximum self delegation of a genesis validator
+	MaxSelfDelegation *types.Coin `protobuf:"bytes,5,opt,name=maxSelfDelegation,proto3" json:"maxSelfDelegation,omitempty"`
+}
+
+func (m *ChainLimits) Reset()         { *m = ChainLimits{} }
+func (m *ChainLimits) String() string { return proto.CompactTextString(m) }
+func (*ChainLimits) ProtoMessage()    {}
+func (*ChainLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e96f39bc2e1bde, []int{1}
+}
+func (m *ChainLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainLimits.Merge(m, src)
+}
+func (m *ChainLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainLimits proto.InternalMessageInfo
+
+func (m *ChainLimits) GetMaxValidators() uint64 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+func (m *ChainLimits) GetMaxGenesisAccounts() uint64 {
+	if m != nil {
+		return m.MaxGenesisAccounts
+	}
+	return 0
+}
+
+func (m *ChainLimits) GetMaxAccountCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAccountCoins
+	}
+	return nil
+}
+
+func (m *ChainLimits) GetMinSelfDelegation() *types.Coin {
+	if m != nil {
+		return m.MinSelfDelegation
+	}
+	return nil
+}
+
+func (m *ChainLimits) GetMaxSelfDelegation() *types.Coin {
+	if m != nil {
+		return m.MaxSelfDelegation
+	}
+	return nil
+}
+
+// AutoApprovePolicy defines the rules for which a request is approved on submission
+// A request matching any of the rules is approved, an empty value disables the rule
+type AutoApprovePolicy struct {
+	// maxAccountCoins approves the genesis accounts with coins lower or equal to this amount
+	MaxAccountCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=maxAccountCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"maxAccountCoins"`
+	// minSelfDelegation approves the genesis validators with a self delegation higher or equal to this amount
+	MinSelfDelegation *types.Coin `protobuf:"bytes,2,opt,name=minSelfDelegation,proto3" json:"minSelfDelegation,omitempty"`
+	// maxSelfDelegation approves the genesis validators with a self delegation lower or equal to this amount
+	MaxSelfDelegation *types.Coin `protobuf:"bytes,3,opt,name=maxSelfDelegation,proto3" json:"maxSelfDelegation,omitempty"`
+	// allowedAddresses approves the genesis accounts and validators requested from one of these addresses
+	AllowedAddresses []string `protobuf:"bytes,4,rep,name=allowedAddresses,proto3" json:"allowedAddresses,omitempty"`
+}
+
+func (m *AutoApprovePolicy) Reset()         { *m = AutoApprovePolicy{} }
+func (m *AutoApprovePolicy) String() string { return proto.CompactTextString(m) }
+func (*AutoApprovePolicy) ProtoMessage()    {}
+func (*AutoApprovePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e96f39bc2e1bde, []int{2}
+}
+func (m *AutoApprovePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoApprovePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoApprovePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoApprovePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoApprovePolicy.Merge(m, src)
+}
+func (m *AutoApprovePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoApprovePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoApprovePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoApprovePolicy proto.InternalMessageInfo
+
+func (m *AutoApprovePolicy) GetMaxAccountCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAccountCoins
+	}
+	return nil
+}
+
+func (m *AutoApprovePolicy) GetMinSelfDelegation() *types.Coin {
+	if m != nil {
+		return m.MinSelfDelegation
+	}
+	return nil
+}
+
+func (m *AutoApprovePolicy) GetMaxSelfDelegation() *types.Coin {
+	if m != nil {
+		return m.MaxSelfDelegation
+	}
+	return nil
+}
+
+func (m *AutoApprovePolicy) GetAllowedAddresses() []string {
+	if m != nil {
+		return m.AllowedAddresses
+	}
+	return nil
+}
+
 type InitialGenesis struct {
 	// Types that are valid to be assigned to Source:
 	//	*InitialGenesis_DefaultInitialGenesis
@@ -166,7 +363,7 @@ func (m *InitialGenesis) Reset()         { *m = InitialGenesis{} }
 func (m *InitialGenesis) String() string { return proto.CompactTextString(m) }
 func (*InitialGenesis) ProtoMessage()    {}
 func (*InitialGenesis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36e96f39bc2e1bde, []int{1}
+	return fileDescriptor_36e96f39bc2e1bde, []int{3}
 }
 func (m *InitialGenesis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +445,7 @@ func (m *DefaultInitialGenesis) Reset()         { *m = DefaultInitialGenesis{} }
 func (m *DefaultInitialGenesis) String() string { return proto.CompactTextString(m) }
 func (*DefaultInitialGenesis) ProtoMessage()    {}
 func (*DefaultInitialGenesis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36e96f39bc2e1bde, []int{2}
+	return fileDescriptor_36e96f39bc2e1bde, []int{4}
 }
 func (m *DefaultInitialGenesis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,7 +484,7 @@ func (m *GenesisURL) Reset()         { *m = GenesisURL{} }
 func (m *GenesisURL) String() string { return proto.CompactTextString(m) }
 func (*GenesisURL) ProtoMessage()    {}
 func (*GenesisURL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36e96f39bc2e1bde, []int{3}
+	return fileDescriptor_36e96f39bc2e1bde, []int{5}
 }
 func (m *GenesisURL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,6 +529,8 @@ func (m *GenesisURL) GetHash() string {
 
 func init() {
 	proto.RegisterType((*Chain)(nil), "tendermint.spn.launch.Chain")
+	proto.RegisterType((*ChainLimits)(nil), "tendermint.spn.launch.ChainLimits")
+	proto.RegisterType((*AutoApprovePolicy)(nil), "tendermint.spn.launch.AutoApprovePolicy")
 	proto.RegisterType((*InitialGenesis)(nil), "tendermint.spn.launch.InitialGenesis")
 	proto.RegisterType((*DefaultInitialGenesis)(nil), "tendermint.spn.launch.DefaultInitialGenesis")
 	proto.RegisterType((*GenesisURL)(nil), "tendermint.spn.launch.GenesisURL")
@@ -340,37 +539,54 @@ func init() {
 func init() { proto.RegisterFile("launch/chain.proto", fileDescriptor_36e96f39bc2e1bde) }
 
 var fileDescriptor_36e96f39bc2e1bde = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x33, 0x6d, 0xb7, 0xb6, 0x6f, 0xb5, 0xca, 0x60, 0x71, 0x58, 0x24, 0xc6, 0xa2, 0x92,
-	0x83, 0x24, 0x50, 0x3f, 0x81, 0x6d, 0x61, 0x1b, 0xd0, 0x4b, 0xd4, 0x8b, 0xb7, 0xd9, 0x64, 0x4c,
-	0x06, 0x9a, 0x99, 0x30, 0x33, 0x01, 0xfd, 0x16, 0x7e, 0x29, 0x61, 0x8f, 0x7b, 0x12, 0x4f, 0x22,
-	0xed, 0x17, 0x91, 0x4c, 0xca, 0x26, 0x2d, 0xdd, 0xdb, 0x3b, 0xbf, 0x79, 0xde, 0x27, 0xef, 0x9f,
-	0x0c, 0xe0, 0x2d, 0xad, 0x44, 0x92, 0x87, 0x49, 0x4e, 0xb9, 0x08, 0x4a, 0x25, 0x8d, 0xc4, 0x33,
-	0xc3, 0x44, 0xca, 0x54, 0xc1, 0x85, 0x09, 0x74, 0x29, 0x82, 0x46, 0x72, 0xf9, 0x34, 0x93, 0x99,
-	0xb4, 0x8a, 0xb0, 0x8e, 0x1a, 0xf1, 0xfc, 0x77, 0x1f, 0x2e, 0x56, 0x75, 0x32, 0xbe, 0x84, 0x51,
-	0xa3, 0x8c, 0xd6, 0x04, 0x79, 0xc8, 0x1f, 0xc4, 0x77, 0x67, 0xfc, 0x0a, 0x1e, 0x25, 0x52, 0xaa,
-	0x94, 0x0b, 0x6a, 0xa4, 0x8a, 0xd6, 0xa4, 0x67, 0x05, 0xc7, 0x10, 0xbf, 0x81, 0x69, 0xc6, 0x04,
-	0xd3, 0x5c, 0x5b, 0xc7, 0x68, 0x4d, 0xfa, 0x1e, 0xf2, 0xc7, 0xf1, 0x09, 0xc5, 0xcf, 0x61, 0x9c,
-	0x28, 0x46, 0x0d, 0x4b, 0xdf, 0x1b, 0x32, 0xf0, 0x90, 0xdf, 0x8f, 0x5b, 0x50, 0xdf, 0x6a, 0x59,
-	0xa9, 0x84, 0x7d, 0x89, 0x3f, 0x90, 0x0b, 0x6b, 0xd0, 0x02, 0xec, 0x02, 0x34, 0x87, 0x0d, 0xd5,
-	0x39, 0x19, 0xda, 0xeb, 0x0e, 0xc1, 0x9f, 0x60, 0xca, 0x05, 0x37, 0x9c, 0x6e, 0xaf, 0x9a, 0x8f,
-	0x92, 0x07, 0x1e, 0xf2, 0x27, 0x8b, 0xd7, 0xc1, 0xd9, 0xa9, 0x04, 0xd1, 0x91, 0x78, 0x39, 0xb8,
-	0xf9, 0xfb, 0xc2, 0x89, 0x4f, 0x2c, 0xb0, 0x07, 0x93, 0x9c, 0xea, 0x15, 0x2d, 0x4a, 0xca, 0x33,
-	0x41, 0x46, 0x1e, 0xf2, 0x47, 0x71, 0x17, 0xd5, 0x65, 0x25, 0x87, 0x38, 0x5a, 0x93, 0xb1, 0x9d,
-	0x4e, 0x87, 0xd4, 0x4d, 0x71, 0xfd, 0x91, 0x72, 0x21, 0x98, 0x21, 0x60, 0xf3, 0x5b, 0x80, 0x7d,
-	0x78, 0xdc, 0x94, 0xf3, 0x59, 0xf1, 0x2c, 0x63, 0x8a, 0xa5, 0x64, 0x62, 0x35, 0xa7, 0xb8, 0xa3,
-	0xe4, 0x05, 0xd3, 0x86, 0x16, 0x25, 0x79, 0x68, 0x07, 0x78, 0x8a, 0xe7, 0xbf, 0x10, 0x4c, 0x8f,
-	0x9b, 0xc3, 0x29, 0xcc, 0x52, 0xf6, 0x8d, 0x56, 0x5b, 0x73, 0x7c, 0x61, 0xd7, 0x3d, 0x59, 0xbc,
-	0xbd, 0x67, 0x44, 0xeb, 0x73, 0x39, 0x1b, 0x27, 0x3e, 0x6f, 0x86, 0x57, 0x00, 0x87, 0x7d, 0xd7,
-	0x0b, 0xec, 0x59, 0xeb, 0x97, 0xf7, 0x58, 0x5f, 0xdd, 0x09, 0x37, 0x4e, 0xdc, 0x49, 0x5b, 0x8e,
-	0x60, 0xd8, 0x2c, 0x75, 0xfe, 0x0c, 0x66, 0x67, 0x0b, 0x98, 0x2f, 0x00, 0xda, 0x74, 0xfc, 0x04,
-	0xfa, 0x95, 0xda, 0xda, 0x4e, 0xc6, 0x71, 0x1d, 0x62, 0x0c, 0x83, 0xbc, 0xfe, 0x47, 0x7a, 0x16,
-	0xd9, 0x78, 0xb9, 0xbc, 0xd9, 0xb9, 0xe8, 0x76, 0xe7, 0xa2, 0x7f, 0x3b, 0x17, 0xfd, 0xdc, 0xbb,
-	0xce, 0xed, 0xde, 0x75, 0xfe, 0xec, 0x5d, 0xe7, 0xab, 0x9f, 0x71, 0x93, 0x57, 0xd7, 0x41, 0x22,
-	0x8b, 0xb0, 0xad, 0x35, 0xd4, 0xa5, 0x08, 0xbf, 0x87, 0x87, 0x47, 0x66, 0x7e, 0x94, 0x4c, 0x5f,
-	0x0f, 0xed, 0xc3, 0x79, 0xf7, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x08, 0xed, 0x0d, 0x7b, 0x03,
-	0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x5d, 0x6f, 0xd3, 0x3a,
+	0x18, 0x6e, 0xfa, 0x75, 0x5a, 0xf7, 0xac, 0xdb, 0xac, 0x33, 0x1d, 0x9f, 0xe9, 0x28, 0x0b, 0x15,
+	0xa0, 0x08, 0x41, 0xc2, 0xca, 0x1f, 0xa0, 0x1f, 0xd2, 0x56, 0x69, 0x48, 0x28, 0x03, 0x2e, 0x10,
+	0x37, 0x6e, 0xe2, 0xa5, 0x16, 0x89, 0x1d, 0xc5, 0xce, 0xe8, 0xfe, 0x05, 0xbf, 0x83, 0x1f, 0xc1,
+	0x1d, 0xd2, 0x2e, 0x77, 0xc9, 0x15, 0xa0, 0xed, 0x96, 0x1f, 0x81, 0xe2, 0x84, 0x7e, 0xa4, 0x2d,
+	0x08, 0x09, 0x89, 0xab, 0xd8, 0x8f, 0x9f, 0xf7, 0xb1, 0xfd, 0xf8, 0x7d, 0xdf, 0x00, 0x18, 0xe0,
+	0x84, 0xb9, 0x13, 0xdb, 0x9d, 0x60, 0xca, 0xac, 0x28, 0xe6, 0x92, 0xc3, 0x3d, 0x49, 0x98, 0x47,
+	0xe2, 0x90, 0x32, 0x69, 0x89, 0x88, 0x59, 0x19, 0x65, 0xff, 0x1f, 0x9f, 0xfb, 0x5c, 0x31, 0xec,
+	0x74, 0x94, 0x91, 0xf7, 0x75, 0x97, 0x8b, 0x90, 0x0b, 0x7b, 0x8c, 0x05, 0xb1, 0xcf, 0x0f, 0xc7,
+	0x44, 0xe2, 0x43, 0xdb, 0xe5, 0xdf, 0xc5, 0x3a, 0xef, 0x6b, 0xa0, 0x36, 0x48, 0xc5, 0xe1, 0x3e,
+	0x68, 0x64, 0x4a, 0xa3, 0x21, 0xd2, 0x0c, 0xcd, 0xac, 0x3a, 0xb3, 0x39, 0xbc, 0x0d, 0xb6, 0x5c,
+	0xce, 0x63, 0x8f, 0x32, 0x2c, 0x79, 0x3c, 0x1a, 0xa2, 0xb2, 0x22, 0x2c, 0x83, 0xf0, 0x2e, 0x68,
+	0xfb, 0x84, 0x11, 0x41, 0x85, 0x52, 0x1c, 0x0d, 0x51, 0xc5, 0xd0, 0xcc, 0xa6, 0x53, 0x40, 0xe1,
+	0xff, 0xa0, 0xe9, 0xc6, 0x04, 0x4b, 0xe2, 0xf5, 0x24, 0xaa, 0x1a, 0x9a, 0x59, 0x71, 0xe6, 0x40,
+	0xba, 0x2a, 0x78, 0x12, 0xbb, 0xe4, 0xb9, 0x73, 0x82, 0x6a, 0x4a, 0x60, 0x0e, 0x40, 0x1d, 0x80,
+	0x6c, 0x72, 0x8c, 0xc5, 0x04, 0xd5, 0xd5, 0xf2, 0x02, 0x02, 0x4f, 0x41, 0x9b, 0x32, 0x2a, 0x29,
+	0x0e, 0x8e, 0xb2, 0x4d, 0xd1, 0x5f, 0x86, 0x66, 0xb6, 0xba, 0x77, 0xac, 0xb5, 0xae, 0x59, 0xa3,
+	0x25, 0x72, 0xbf, 0x7a, 0xf9, 0xe9, 0xa0, 0xe4, 0x14, 0x24, 0xa0, 0x01, 0x5a, 0x13, 0x2c, 0x06,
+	0x38, 0x8c, 0x30, 0xf5, 0x19, 0x6a, 0x18, 0x9a, 0xd9, 0x70, 0x16, 0xa1, 0xf4, 0x58, 0x6e, 0x3e,
+	0x1e, 0x0d, 0x51, 0x53, 0xb9, 0xb3, 0x80, 0xa4, 0x97, 0xa2, 0xe2, 0x09, 0xa6, 0x8c, 0x11, 0x89,
+	0x80, 0x8a, 0x9f, 0x03, 0xd0, 0x04, 0xdb, 0xd9, 0x71, 0x9e, 0xc5, 0xd4, 0xf7, 0x49, 0x4c, 0x3c,
+	0xd4, 0x52, 0x9c, 0x22, 0xbc, 0xc0, 0xa4, 0x21, 0x11, 0x12, 0x87, 0x11, 0xfa, 0x5b, 0x19, 0x58,
+	0x84, 0xe7, 0xcf, 0x49, 0x3c, 0xb4, 0xa5, 0xc4, 0x66, 0x73, 0x78, 0x0f, 0xec, 0x9c, 0x51, 0x36,
+	0xbb, 0x9f, 0xb2, 0xb2, 0xad, 0xac, 0x5c, 0xc1, 0xe1, 0x63, 0x50, 0x0f, 0x68, 0x48, 0xa5, 0x40,
+	0xdb, 0xca, 0xc8, 0xce, 0x06, 0x23, 0xd5, 0xe3, 0x9e, 0x28, 0x66, 0xee, 0x62, 0x1e, 0x07, 0x5f,
+	0x81, 0x5d, 0x9c, 0x48, 0xde, 0x8b, 0xa2, 0x98, 0x9f, 0x93, 0xa7, 0x3c, 0xa0, 0xee, 0x05, 0xda,
+	0x51, 0x62, 0xe6, 0x06, 0xb1, 0x5e, 0x91, 0x9f, 0x4b, 0xae, 0x0a, 0x75, 0xbe, 0x96, 0x41, 0x6b,
+	0x61, 0xef, 0x34, 0x55, 0x43, 0x3c, 0x7d, 0x81, 0x03, 0xea, 0xa5, 0x69, 0x29, 0xf2, 0x5c, 0x5e,
+	0x06, 0xa1, 0x05, 0x60, 0x88, 0xa7, 0xf9, 0x3d, 0x7b, 0xae, 0xcb, 0x13, 0x26, 0x45, 0x9e, 0xd5,
+	0x6b, 0x56, 0x60, 0x02, 0xb6, 0x43, 0x3c, 0xcd, 0xa7, 0x03, 0x4e, 0x99, 0x40, 0x15, 0xa3, 0x62,
+	0xb6, 0xba, 0xff, 0x59, 0x59, 0x81, 0x59, 0x69, 0x81, 0x59, 0x79, 0x81, 0x59, 0x29, 0xa3, 0xff,
+	0x30, 0x3d, 0xf2, 0xbb, 0xcf, 0x07, 0xa6, 0x4f, 0xe5, 0x24, 0x19, 0x5b, 0x2e, 0x0f, 0xed, 0xbc,
+	0x1a, 0xb3, 0xcf, 0x03, 0xe1, 0xbd, 0xb6, 0xe5, 0x45, 0x44, 0x84, 0x0a, 0x10, 0x4e, 0x71, 0x0f,
+	0x78, 0x04, 0x76, 0x43, 0xca, 0x4e, 0x49, 0x70, 0x36, 0x24, 0x01, 0xf1, 0xb1, 0xa4, 0x9c, 0xa9,
+	0x8a, 0xf9, 0xd1, 0xc6, 0xce, 0x6a, 0x8c, 0x12, 0xc2, 0xd3, 0x82, 0x50, 0xed, 0xe7, 0x42, 0xc5,
+	0x98, 0xce, 0x65, 0x19, 0xec, 0xae, 0xbc, 0xce, 0x3a, 0x7b, 0xb4, 0x3f, 0x65, 0x4f, 0xf9, 0x77,
+	0xd9, 0x53, 0xf9, 0x75, 0x7b, 0xd2, 0xca, 0xc2, 0x41, 0xc0, 0xdf, 0x10, 0xaf, 0xe7, 0x79, 0x31,
+	0x11, 0x82, 0x08, 0x54, 0x35, 0x2a, 0x69, 0x65, 0x15, 0xf1, 0xce, 0x07, 0x0d, 0xb4, 0x97, 0xdb,
+	0x0f, 0xf4, 0xc0, 0x9e, 0x47, 0xce, 0x70, 0x12, 0xc8, 0xe5, 0x05, 0x95, 0xc4, 0xad, 0xee, 0xfd,
+	0x0d, 0xe5, 0x32, 0x5c, 0x17, 0x73, 0x5c, 0x72, 0xd6, 0x8b, 0xc1, 0x01, 0x00, 0x79, 0x47, 0x4e,
+	0x5b, 0x6c, 0xe6, 0xd7, 0xad, 0x0d, 0xd2, 0x47, 0x33, 0xe2, 0x71, 0xc9, 0x59, 0x08, 0xeb, 0x37,
+	0x40, 0x3d, 0x6b, 0xbb, 0x9d, 0x7f, 0xc1, 0xde, 0xda, 0x03, 0x74, 0xba, 0x00, 0xcc, 0xc3, 0xe1,
+	0x0e, 0xa8, 0x24, 0x71, 0xa0, 0x6e, 0xd2, 0x74, 0xd2, 0x21, 0x84, 0xa0, 0x3a, 0x49, 0x5b, 0x4f,
+	0x59, 0x41, 0x6a, 0xdc, 0xef, 0x5f, 0x5e, 0xeb, 0xda, 0xd5, 0xb5, 0xae, 0x7d, 0xb9, 0xd6, 0xb5,
+	0xb7, 0x37, 0x7a, 0xe9, 0xea, 0x46, 0x2f, 0x7d, 0xbc, 0xd1, 0x4b, 0x2f, 0x17, 0xf3, 0x64, 0x7e,
+	0x56, 0x5b, 0x44, 0xcc, 0x9e, 0xda, 0xf9, 0x6f, 0x52, 0x65, 0xcb, 0xb8, 0xae, 0x7e, 0x6d, 0x8f,
+	0xbe, 0x0d, 0x00, 0xc7, 0x11, 0xe7, 0x9a, 0x3d, 0x07, 0x00, 0x00,
 }
 
 func (m *Chain) Marshal() (dAtA []byte, err error) {
@@ -393,6 +609,45 @@ func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.AutoApprovePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.FinalGenesisHash) > 0 {
+		i -= len(m.FinalGenesisHash)
+		copy(dAtA[i:], m.FinalGenesisHash)
+		i = encodeVarintChain(dAtA, i, uint64(len(m.FinalGenesisHash)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Launched {
+		i--
+		if m.Launched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.LaunchTimestamp != 0 {
 		i = encodeVarintChain(dAtA, i, uint64(m.LaunchTimestamp))
 		i--
@@ -482,6 +737,147 @@ func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSelfDelegation != nil {
+		{
+			size, err := m.MaxSelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinSelfDelegation != nil {
+		{
+			size, err := m.MinSelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxAccountCoins) > 0 {
+		for iNdEx := len(m.MaxAccountCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAccountCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxGenesisAccounts != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.MaxGenesisAccounts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintChain(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoApprovePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoApprovePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoApprovePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedAddresses) > 0 {
+		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedAddresses[iNdEx])
+			i = encodeVarintChain(dAtA, i, uint64(len(m.AllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxSelfDelegation != nil {
+		{
+			size, err := m.MaxSelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MinSelfDelegation != nil {
+		{
+			size, err := m.MinSelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MaxAccountCoins) > 0 {
+		for iNdEx := len(m.MaxAccountCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAccountCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *InitialGenesis) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -671,41 +1067,110 @@ func (m *Chain) Size() (n int) {
 	if m.LaunchTimestamp != 0 {
 		n += 1 + sovChain(uint64(m.LaunchTimestamp))
 	}
+	if m.Launched {
+		n += 2
+	}
+	l = len(m.FinalGenesisHash)
+	if l > 0 {
+		n += 1 + l + sovChain(uint64(l))
+	}
+	l = m.Limits.Size()
+	n += 1 + l + sovChain(uint64(l))
+	l = m.AutoApprovePolicy.Size()
+	n += 2 + l + sovChain(uint64(l))
 	return n
 }
 
-func (m *InitialGenesis) Size() (n int) {
+func (m *ChainLimits) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Source != nil {
-		n += m.Source.Size()
+	if m.MaxValidators != 0 {
+		n += 1 + sovChain(uint64(m.MaxValidators))
+	}
+	if m.MaxGenesisAccounts != 0 {
+		n += 1 + sovChain(uint64(m.MaxGenesisAccounts))
+	}
+	if len(m.MaxAccountCoins) > 0 {
+		for _, e := range m.MaxAccountCoins {
+			l = e.Size()
+			n += 1 + l + sovChain(uint64(l))
+		}
+	}
+	if m.MinSelfDelegation != nil {
+		l = m.MinSelfDelegation.Size()
+		n += 1 + l + sovChain(uint64(l))
+	}
+	if m.MaxSelfDelegation != nil {
+		l = m.MaxSelfDelegation.Size()
+		n += 1 + l + sovChain(uint64(l))
 	}
 	return n
 }
 
-func (m *InitialGenesis_DefaultInitialGenesis) Size() (n int) {
+func (m *AutoApprovePolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DefaultInitialGenesis != nil {
-		l = m.DefaultInitialGenesis.Size()
+	if len(m.MaxAccountCoins) > 0 {
+		for _, e := range m.MaxAccountCoins {
+			l = e.Size()
+			n += 1 + l + sovChain(uint64(l))
+		}
+	}
+	if m.MinSelfDelegation != nil {
+		l = m.MinSelfDelegation.Size()
+		n += 1 + l + sovChain(uint64(l))
+	}
+	if m.MaxSelfDelegation != nil {
+		l = m.MaxSelfDelegation.Size()
 		n += 1 + l + sovChain(uint64(l))
 	}
+	if len(m.AllowedAddresses) > 0 {
+		for _, s := range m.AllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovChain(uint64(l))
+		}
+	}
 	return n
 }
-func (m *InitialGenesis_GenesisURL) Size() (n int) {
+
+func (m *InitialGenesis) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GenesisURL != nil {
-		l = m.GenesisURL.Size()
+	if m.Source != nil {
+		n += m.Source.Size()
+	}
+	return n
+}
+
+func (m *InitialGenesis_DefaultInitialGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultInitialGenesis != nil {
+		l = m.DefaultInitialGenesis.Size()
+		n += 1 + l + sovChain(uint64(l))
+	}
+	return n
+}
+func (m *InitialGenesis_GenesisURL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GenesisURL != nil {
+		l = m.GenesisURL.Size()
 		n += 1 + l + sovChain(uint64(l))
 	}
 	return n
@@ -1055,6 +1520,506 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Launched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Launched = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalGenesisHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalGenesisHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApprovePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoApprovePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGenesisAccounts", wireType)
+			}
+			m.MaxGenesisAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGenesisAccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAccountCoins = append(m.MaxAccountCoins, types.Coin{})
+			if err := m.MaxAccountCoins[len(m.MaxAccountCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinSelfDelegation == nil {
+				m.MinSelfDelegation = &types.Coin{}
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSelfDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSelfDelegation == nil {
+				m.MaxSelfDelegation = &types.Coin{}
+			}
+			if err := m.MaxSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoApprovePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoApprovePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoApprovePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAccountCoins = append(m.MaxAccountCoins, types.Coin{})
+			if err := m.MaxAccountCoins[len(m.MaxAccountCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinSelfDelegation == nil {
+				m.MinSelfDelegation = &types.Coin{}
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSelfDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSelfDelegation == nil {
+				m.MaxSelfDelegation = &types.Coin{}
+			}
+			if err := m.MaxSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChain(dAtA[iNdEx:])
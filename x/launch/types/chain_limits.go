@@ -0,0 +1,67 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks the chain limits are valid
+func (m ChainLimits) Validate() error {
+	if err := m.MaxAccountCoins.Validate(); err != nil {
+		return fmt.Errorf("invalid max account coins: %s", err.Error())
+	}
+	return validateSelfDelegationBounds(m.MinSelfDelegation, m.MaxSelfDelegation)
+}
+
+// CheckAccountCoins checks the coins of an account don't exceed the max account coins
+func (m ChainLimits) CheckAccountCoins(coins sdk.Coins) error {
+	if !m.MaxAccountCoins.Empty() && !coins.IsAllLTE(m.MaxAccountCoins) {
+		return fmt.Errorf("account coins %s exceed the max account coins %s", coins, m.MaxAccountCoins)
+	}
+	return nil
+}
+
+// CheckSelfDelegation checks the self delegation of a validator is within the self delegation limits
+func (m ChainLimits) CheckSelfDelegation(selfDelegation sdk.Coin) error {
+	return checkSelfDelegationBounds(selfDelegation, m.MinSelfDelegation, m.MaxSelfDelegation)
+}
+
+// validateSelfDelegationBounds checks the optional min and max self delegations are valid
+func validateSelfDelegationBounds(min, max *sdk.Coin) error {
+	if min != nil {
+		if err := min.Validate(); err != nil {
+			return fmt.Errorf("invalid min self delegation: %s", err.Error())
+		}
+	}
+	if max != nil {
+		if err := max.Validate(); err != nil {
+			return fmt.Errorf("invalid max self delegation: %s", err.Error())
+		}
+	}
+	if min != nil && max != nil {
+		if min.Denom != max.Denom {
+			return errors.New("min and max self delegation must have the same denom")
+		}
+		if max.IsLT(*min) {
+			return errors.New("min self delegation can't be higher than max self delegation")
+		}
+	}
+	return nil
+}
+
+// checkSelfDelegationBounds checks a self delegation is within the optional min and max self delegations
+func checkSelfDelegationBounds(selfDelegation sdk.Coin, min, max *sdk.Coin) error {
+	if min != nil {
+		if selfDelegation.Denom != min.Denom || !selfDelegation.IsGTE(*min) {
+			return fmt.Errorf("self delegation %s is lower than the min self delegation %s", selfDelegation, min)
+		}
+	}
+	if max != nil {
+		if selfDelegation.Denom != max.Denom || max.IsLT(selfDelegation) {
+			return fmt.Errorf("self delegation %s is higher than the max self delegation %s", selfDelegation, max)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestChainLimits_Validate(t *testing.T) {
+	var (
+		stake1      = sdk.NewCoin("stake", sdk.NewInt(1))
+		stake2      = sdk.NewCoin("stake", sdk.NewInt(2))
+		foo1        = sdk.NewCoin("foo", sdk.NewInt(1))
+		invalidCoin = sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}
+	)
+
+	for _, tc := range []struct {
+		desc   string
+		limits types.ChainLimits
+		valid  bool
+	}{
+		{
+			desc:   "valid limits",
+			limits: sample.ChainLimits(),
+			valid:  true,
+		},
+		{
+			desc:   "empty limits",
+			limits: types.ChainLimits{},
+			valid:  true,
+		},
+		{
+			desc: "equal min and max self delegation",
+			limits: types.ChainLimits{
+				MinSelfDelegation: &stake1,
+				MaxSelfDelegation: &stake1,
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid max account coins",
+			limits: types.ChainLimits{
+				MaxAccountCoins: sdk.Coins{invalidCoin},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid min self delegation",
+			limits: types.ChainLimits{
+				MinSelfDelegation: &invalidCoin,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid max self delegation",
+			limits: types.ChainLimits{
+				MaxSelfDelegation: &invalidCoin,
+			},
+			valid: false,
+		},
+		{
+			desc: "min and max self delegation with different denoms",
+			limits: types.ChainLimits{
+				MinSelfDelegation: &foo1,
+				MaxSelfDelegation: &stake2,
+			},
+			valid: false,
+		},
+		{
+			desc: "min self delegation higher than max self delegation",
+			limits: types.ChainLimits{
+				MinSelfDelegation: &stake2,
+				MaxSelfDelegation: &stake1,
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.EqualValues(t, tc.valid, tc.limits.Validate() == nil)
+		})
+	}
+}
+
+func TestChainLimits_CheckAccountCoins(t *testing.T) {
+	limits := types.ChainLimits{
+		MaxAccountCoins: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)), sdk.NewCoin("bar", sdk.NewInt(100))),
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		limits types.ChainLimits
+		coins  sdk.Coins
+		valid  bool
+	}{
+		{
+			desc:   "no limit",
+			limits: types.ChainLimits{},
+			coins:  sample.Coins(),
+			valid:  true,
+		},
+		{
+			desc:   "coins lower than the limit",
+			limits: limits,
+			coins:  sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(50))),
+			valid:  true,
+		},
+		{
+			desc:   "coins equal to the limit",
+			limits: limits,
+			coins:  limits.MaxAccountCoins,
+			valid:  true,
+		},
+		{
+			desc:   "coins higher than the limit",
+			limits: limits,
+			coins:  sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(101))),
+			valid:  false,
+		},
+		{
+			desc:   "coins with a denom not in the limit",
+			limits: limits,
+			coins:  sdk.NewCoins(sdk.NewCoin("baz", sdk.NewInt(1))),
+			valid:  false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.EqualValues(t, tc.valid, tc.limits.CheckAccountCoins(tc.coins) == nil)
+		})
+	}
+}
+
+func TestChainLimits_CheckSelfDelegation(t *testing.T) {
+	var (
+		minSelfDelegation = sdk.NewCoin("stake", sdk.NewInt(10))
+		maxSelfDelegation = sdk.NewCoin("stake", sdk.NewInt(100))
+		limits            = types.ChainLimits{
+			MinSelfDelegation: &minSelfDelegation,
+			MaxSelfDelegation: &maxSelfDelegation,
+		}
+	)
+
+	for _, tc := range []struct {
+		desc           string
+		limits         types.ChainLimits
+		selfDelegation sdk.Coin
+		valid          bool
+	}{
+		{
+			desc:           "no limit",
+			limits:         types.ChainLimits{},
+			selfDelegation: sdk.NewCoin("foo", sdk.NewInt(1)),
+			valid:          true,
+		},
+		{
+			desc:           "self delegation within the limits",
+			limits:         limits,
+			selfDelegation: sdk.NewCoin("stake", sdk.NewInt(50)),
+			valid:          true,
+		},
+		{
+			desc:           "self delegation equal to the limits",
+			limits:         limits,
+			selfDelegation: minSelfDelegation,
+			valid:          true,
+		},
+		{
+			desc:           "self delegation lower than the min",
+			limits:         limits,
+			selfDelegation: sdk.NewCoin("stake", sdk.NewInt(9)),
+			valid:          false,
+		},
+		{
+			desc:           "self delegation higher than the max",
+			limits:         limits,
+			selfDelegation: sdk.NewCoin("stake", sdk.NewInt(101)),
+			valid:          false,
+		},
+		{
+			desc:           "self delegation with another denom",
+			limits:         limits,
+			selfDelegation: sdk.NewCoin("foo", sdk.NewInt(50)),
+			valid:          false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.EqualValues(t, tc.valid, tc.limits.CheckSelfDelegation(tc.selfDelegation) == nil)
+		})
+	}
+}
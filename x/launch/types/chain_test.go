@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
@@ -15,9 +16,18 @@ func TestChain_Validate(t *testing.T) {
 	invalidLaunchTimestamp := sample.Chain(0, 0)
 	invalidLaunchTimestamp.LaunchTriggered = true
 
+	launchedNotTriggered := sample.Chain(0, 0)
+	launchedNotTriggered.Launched = true
+
 	mainnetWithoutCampaign := sample.Chain(0, 0)
 	mainnetWithoutCampaign.IsMainnet = true
 
+	invalidLimits := sample.Chain(0, 0)
+	invalidLimits.Limits.MaxAccountCoins = sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}}
+
+	invalidAutoApprovePolicy := sample.Chain(0, 0)
+	invalidAutoApprovePolicy.AutoApprovePolicy.AllowedAddresses = []string{"invalid"}
+
 	for _, tc := range []struct {
 		desc  string
 		chain types.Chain
@@ -38,11 +48,26 @@ func TestChain_Validate(t *testing.T) {
 			chain: invalidLaunchTimestamp,
 			valid: false,
 		},
+		{
+			desc:  "launched chain with launch not triggered",
+			chain: launchedNotTriggered,
+			valid: false,
+		},
 		{
 			desc:  "mainnet without campaign",
 			chain: mainnetWithoutCampaign,
 			valid: false,
 		},
+		{
+			desc:  "invalid limits",
+			chain: invalidLimits,
+			valid: false,
+		},
+		{
+			desc:  "invalid auto approve policy",
+			chain: invalidAutoApprovePolicy,
+			valid: false,
+		},
 	} {
 		tc := tc
 		t.Run(tc.desc, func(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -16,8 +17,15 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRequestAddValidator{}, "launch/RequestAddValidator", nil)
 	cdc.RegisterConcrete(&MsgRequestRemoveValidator{}, "launch/RequestRemoveValidator", nil)
 	cdc.RegisterConcrete(&MsgSettleRequest{}, "launch/SettleRequest", nil)
+	cdc.RegisterConcrete(&MsgSettleRequests{}, "launch/SettleRequests", nil)
+	cdc.RegisterConcrete(&MsgCancelRequest{}, "launch/CancelRequest", nil)
 	cdc.RegisterConcrete(&MsgTriggerLaunch{}, "launch/TriggerLaunch", nil)
 	cdc.RegisterConcrete(&MsgRevertLaunch{}, "launch/RevertLaunch", nil)
+	cdc.RegisterConcrete(&MsgSetRewards{}, "launch/SetRewards", nil)
+	cdc.RegisterConcrete(&MsgDistributeRewards{}, "launch/DistributeRewards", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "launch/ClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgGrantRequestAllowance{}, "launch/GrantRequestAllowance", nil)
+	cdc.RegisterConcrete(&MsgSetParticipantList{}, "launch/SetParticipantList", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -31,8 +39,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRequestAddValidator{},
 		&MsgRequestRemoveValidator{},
 		&MsgSettleRequest{},
+		&MsgSettleRequests{},
+		&MsgCancelRequest{},
 		&MsgTriggerLaunch{},
 		&MsgRevertLaunch{},
+		&MsgSetRewards{},
+		&MsgDistributeRewards{},
+		&MsgClaimRewards{},
+		&MsgGrantRequestAllowance{},
+		&MsgSetParticipantList{},
+	)
+	registry.RegisterImplementations((*feegrant.FeeAllowanceI)(nil),
+		&LaunchRequestAllowance{},
 	)
 	// this line is used by starport scaffolding # 3
 
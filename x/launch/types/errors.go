@@ -33,4 +33,23 @@ var (
 	ErrRemoveMainnetAccount     = sdkerrors.Register(ModuleName, 24, "accounts can't be removed for mainnet")
 	ErrCreateChainFail          = sdkerrors.Register(ModuleName, 25, "fail to create a new chain")
 	ErrLaunchTimeTooHigh        = sdkerrors.Register(ModuleName, 26, "the remaining time is above authorized launch time")
+	ErrChainLaunched            = sdkerrors.Register(ModuleName, 27, "the chain is launched")
+	ErrInvalidRequestIDs        = sdkerrors.Register(ModuleName, 28, "the request ID list is invalid")
+	ErrRequestSettled           = sdkerrors.Register(ModuleName, 29, "request already settled")
+	ErrNotRequestCreator        = sdkerrors.Register(ModuleName, 30, "not the creator of the request")
+	ErrChainNotLaunched         = sdkerrors.Register(ModuleName, 31, "the chain is not launched")
+	ErrRewardPoolNotFound       = sdkerrors.Register(ModuleName, 32, "reward pool not found")
+	ErrInvalidRewardHeight      = sdkerrors.Register(ModuleName, 33, "the reward height is invalid")
+	ErrCoordinatorActiveChain   = sdkerrors.Register(ModuleName, 34, "the coordinator has an active chain")
+	ErrInvalidExpiration        = sdkerrors.Register(ModuleName, 35, "the expiration is invalid")
+	ErrInvalidChainLimits       = sdkerrors.Register(ModuleName, 36, "the chain limits are invalid")
+	ErrChainLimitExceeded       = sdkerrors.Register(ModuleName, 37, "a limit of the chain is exceeded")
+	ErrInvalidAutoApprovePolicy = sdkerrors.Register(ModuleName, 38, "the auto approve policy is invalid")
+	ErrInvalidParticipantList   = sdkerrors.Register(ModuleName, 39, "the participant list is invalid")
+	ErrParticipantNotAllowed    = sdkerrors.Register(ModuleName, 40, "the participant is not allowed to submit requests")
+	ErrInvalidLaunchGenesis     = sdkerrors.Register(ModuleName, 41, "the launch genesis can't be generated")
+	ErrInvalidRejectionReason   = sdkerrors.Register(ModuleName, 42, "the rejection reason is invalid")
+	ErrRewardDelayNotReached    = sdkerrors.Register(ModuleName, 43, "the reward distribution delay has not been reached")
+	ErrRewardTimeoutNotReached  = sdkerrors.Register(ModuleName, 44, "the reward distribution timeout has not been reached")
+	ErrRewardsAlreadyClaimed    = sdkerrors.Register(ModuleName, 45, "rewards already claimed")
 )
@@ -3,17 +3,26 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
 )
 
 type CampaignKeeper interface {
 	GetCampaign(ctx sdk.Context, id uint64) (campaigntypes.Campaign, bool)
 	AddChainToCampaign(ctx sdk.Context, campaignID, launchID uint64) error
+	MainnetGenesisAccounts(ctx sdk.Context, campaignID uint64) ([]GenesisAccount, []VestingAccount, error)
 }
 
 type ProfileKeeper interface {
 	CoordinatorIDFromAddress(ctx sdk.Context, address string) (id uint64, found bool)
 	GetCoordinatorAddressFromID(ctx sdk.Context, id uint64) (address string, found bool)
+	CheckCoordinatorPermission(
+		ctx sdk.Context,
+		coordinatorID uint64,
+		address string,
+		permission profiletypes.CoordinatorOperator_Permission,
+	) error
 }
 
 type AccountKeeper interface {
@@ -22,4 +31,16 @@ type AccountKeeper interface {
 
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+type FeegrantKeeper interface {
+	GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
+
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
@@ -6,14 +6,17 @@ import "fmt"
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		ChainList:            []Chain{},
-		ChainCounter:         1,
-		GenesisAccountList:   []GenesisAccount{},
-		VestingAccountList:   []VestingAccount{},
-		GenesisValidatorList: []GenesisValidator{},
-		RequestList:          []Request{},
-		RequestCounterList:   []RequestCounter{},
-		Params:               DefaultParams(),
+		ChainList:                 []Chain{},
+		ChainCounter:              1,
+		GenesisAccountList:        []GenesisAccount{},
+		VestingAccountList:        []VestingAccount{},
+		GenesisValidatorList:      []GenesisValidator{},
+		RequestList:               []Request{},
+		RequestCounterList:        []RequestCounter{},
+		Params:                    DefaultParams(),
+		RewardPoolList:            []RewardPool{},
+		ParticipantListList:       []ParticipantList{},
+		RequestAllowanceGrantList: []RequestAllowanceGrant{},
 	}
 }
 
@@ -34,6 +37,18 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := validateRewardPools(gs, launchIDMap); err != nil {
+		return err
+	}
+
+	if err := validateParticipantLists(gs, launchIDMap); err != nil {
+		return err
+	}
+
+	if err := validateRequestAllowanceGrants(gs, launchIDMap); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -108,6 +123,27 @@ func validateRequests(gs GenesisState, launchIDMap map[uint64]struct{}) error {
 				requestCounter,
 			)
 		}
+
+		// Check the settlement information is consistent with the request status
+		if _, ok := Request_Status_name[int32(elem.Status)]; !ok {
+			return fmt.Errorf("request %d of chain %d has an invalid status: %d",
+				elem.RequestID,
+				elem.LaunchID,
+				elem.Status,
+			)
+		}
+		if elem.Status == Request_PENDING && elem.SettledHeight != 0 {
+			return fmt.Errorf("pending request %d of chain %d has a settled height",
+				elem.RequestID,
+				elem.LaunchID,
+			)
+		}
+		if elem.Status != Request_FAILED && elem.FailureReason != "" {
+			return fmt.Errorf("request %d of chain %d has a failure reason while not failed",
+				elem.RequestID,
+				elem.LaunchID,
+			)
+		}
 	}
 
 	return nil
@@ -179,3 +215,70 @@ func validateAccounts(gs GenesisState, launchIDMap map[uint64]struct{}) error {
 
 	return nil
 }
+
+func validateRewardPools(gs GenesisState, launchIDMap map[uint64]struct{}) error {
+	// Check for duplicated index in rewardPool
+	rewardPoolIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.RewardPoolList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid reward pool for chain %d: %s", elem.LaunchID, err.Error())
+		}
+
+		if _, ok := rewardPoolIndexMap[elem.LaunchID]; ok {
+			return fmt.Errorf("duplicated index for rewardPool")
+		}
+		rewardPoolIndexMap[elem.LaunchID] = struct{}{}
+
+		// Each reward pool must be associated with an existing chain
+		if _, ok := launchIDMap[elem.LaunchID]; !ok {
+			return fmt.Errorf("reward pool is associated to a non-existing chain: %d", elem.LaunchID)
+		}
+	}
+
+	return nil
+}
+
+func validateParticipantLists(gs GenesisState, launchIDMap map[uint64]struct{}) error {
+	// Check for duplicated index in participantList
+	participantListIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.ParticipantListList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid participant list for chain %d: %s", elem.LaunchID, err.Error())
+		}
+
+		if _, ok := participantListIndexMap[elem.LaunchID]; ok {
+			return fmt.Errorf("duplicated index for participantList")
+		}
+		participantListIndexMap[elem.LaunchID] = struct{}{}
+
+		// Each participant list must be associated with an existing chain
+		if _, ok := launchIDMap[elem.LaunchID]; !ok {
+			return fmt.Errorf("participant list is associated to a non-existing chain: %d", elem.LaunchID)
+		}
+	}
+
+	return nil
+}
+
+func validateRequestAllowanceGrants(gs GenesisState, launchIDMap map[uint64]struct{}) error {
+	// Check for duplicated index in requestAllowanceGrant
+	requestAllowanceGrantIndexMap := make(map[string]struct{})
+	for _, elem := range gs.RequestAllowanceGrantList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid request allowance grant for chain %d: %s", elem.LaunchID, err.Error())
+		}
+
+		index := string(RequestAllowanceGrantKey(elem.LaunchID, elem.Grantee))
+		if _, ok := requestAllowanceGrantIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for requestAllowanceGrant")
+		}
+		requestAllowanceGrantIndexMap[index] = struct{}{}
+
+		// Each request allowance grant must be associated with an existing chain
+		if _, ok := launchIDMap[elem.LaunchID]; !ok {
+			return fmt.Errorf("request allowance grant is associated to a non-existing chain: %d", elem.LaunchID)
+		}
+	}
+
+	return nil
+}
@@ -26,14 +26,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the launch module's genesis state.
 type GenesisState struct {
 	// this line is used by starport scaffolding # genesis/proto/state
-	ChainList            []Chain            `protobuf:"bytes,1,rep,name=chainList,proto3" json:"chainList"`
-	ChainCounter         uint64             `protobuf:"varint,2,opt,name=ChainCounter,proto3" json:"ChainCounter,omitempty"`
-	GenesisAccountList   []GenesisAccount   `protobuf:"bytes,3,rep,name=genesisAccountList,proto3" json:"genesisAccountList"`
-	VestingAccountList   []VestingAccount   `protobuf:"bytes,4,rep,name=vestingAccountList,proto3" json:"vestingAccountList"`
-	GenesisValidatorList []GenesisValidator `protobuf:"bytes,5,rep,name=genesisValidatorList,proto3" json:"genesisValidatorList"`
-	RequestList          []Request          `protobuf:"bytes,6,rep,name=requestList,proto3" json:"requestList"`
-	RequestCounterList   []RequestCounter   `protobuf:"bytes,7,rep,name=requestCounterList,proto3" json:"requestCounterList"`
-	Params               Params             `protobuf:"bytes,8,opt,name=params,proto3" json:"params"`
+	ChainList                 []Chain                 `protobuf:"bytes,1,rep,name=chainList,proto3" json:"chainList"`
+	ChainCounter              uint64                  `protobuf:"varint,2,opt,name=ChainCounter,proto3" json:"ChainCounter,omitempty"`
+	GenesisAccountList        []GenesisAccount        `protobuf:"bytes,3,rep,name=genesisAccountList,proto3" json:"genesisAccountList"`
+	VestingAccountList        []VestingAccount        `protobuf:"bytes,4,rep,name=vestingAccountList,proto3" json:"vestingAccountList"`
+	GenesisValidatorList      []GenesisValidator      `protobuf:"bytes,5,rep,name=genesisValidatorList,proto3" json:"genesisValidatorList"`
+	RequestList               []Request               `protobuf:"bytes,6,rep,name=requestList,proto3" json:"requestList"`
+	RequestCounterList        []RequestCounter        `protobuf:"bytes,7,rep,name=requestCounterList,proto3" json:"requestCounterList"`
+	Params                    Params                  `protobuf:"bytes,8,opt,name=params,proto3" json:"params"`
+	RewardPoolList            []RewardPool            `protobuf:"bytes,9,rep,name=rewardPoolList,proto3" json:"rewardPoolList"`
+	ParticipantListList       []ParticipantList       `protobuf:"bytes,10,rep,name=participantListList,proto3" json:"participantListList"`
+	RequestAllowanceGrantList []RequestAllowanceGrant `protobuf:"bytes,11,rep,name=requestAllowanceGrantList,proto3" json:"requestAllowanceGrantList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +128,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRewardPoolList() []RewardPool {
+	if m != nil {
+		return m.RewardPoolList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParticipantListList() []ParticipantList {
+	if m != nil {
+		return m.ParticipantListList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRequestAllowanceGrantList() []RequestAllowanceGrant {
+	if m != nil {
+		return m.RequestAllowanceGrantList
+	}
+	return nil
+}
+
 type RequestCounter struct {
 	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
 	Counter  uint64 `protobuf:"varint,2,opt,name=counter,proto3" json:"counter,omitempty"`
@@ -185,34 +209,41 @@ func init() {
 func init() { proto.RegisterFile("launch/genesis.proto", fileDescriptor_02cd66d27edc51cd) }
 
 var fileDescriptor_02cd66d27edc51cd = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6e, 0xda, 0x40,
-	0x10, 0xc6, 0xed, 0xe2, 0x02, 0x5d, 0x50, 0x0f, 0x5b, 0x2a, 0x59, 0x88, 0x6e, 0x11, 0x52, 0x55,
-	0x9f, 0x6c, 0x89, 0x1e, 0x7b, 0x69, 0xa1, 0x02, 0x55, 0xea, 0xa1, 0x72, 0x24, 0x0e, 0xc9, 0x21,
-	0x5a, 0xcc, 0xca, 0x58, 0x82, 0xb5, 0xe3, 0x5d, 0xa3, 0xe4, 0x2d, 0xf2, 0x58, 0x1c, 0x39, 0xe6,
-	0x14, 0x45, 0xf0, 0x08, 0x79, 0x81, 0xc8, 0xeb, 0x31, 0x60, 0xfe, 0xdd, 0xbc, 0x33, 0xf3, 0xfd,
-	0xbe, 0x99, 0xd1, 0x18, 0x35, 0x66, 0x34, 0xe1, 0xde, 0xd4, 0xf1, 0x19, 0x67, 0x22, 0x10, 0x76,
-	0x14, 0x87, 0x32, 0xc4, 0x9f, 0x25, 0xe3, 0x13, 0x16, 0xcf, 0x03, 0x2e, 0x6d, 0x11, 0x71, 0x3b,
-	0x2b, 0x6a, 0x36, 0xfc, 0xd0, 0x0f, 0x55, 0x85, 0x93, 0x7e, 0x65, 0xc5, 0xcd, 0x1c, 0x11, 0xb3,
-	0xbb, 0x84, 0x09, 0x09, 0xd1, 0x16, 0x44, 0x17, 0x4c, 0xc8, 0x80, 0xfb, 0xb7, 0xd4, 0xf3, 0xc2,
-	0x84, 0x1f, 0x66, 0xc1, 0xf6, 0x20, 0x4b, 0x0e, 0xb2, 0x0b, 0x3a, 0x0b, 0x26, 0x54, 0x86, 0x31,
-	0xe4, 0x31, 0xe4, 0xbd, 0x29, 0x0d, 0x38, 0xc4, 0x3e, 0x41, 0x2c, 0xa2, 0x31, 0x9d, 0xc3, 0x1c,
-	0x9d, 0x57, 0x03, 0xd5, 0x87, 0x19, 0xe4, 0x4a, 0x52, 0xc9, 0xf0, 0x2f, 0xf4, 0x41, 0x89, 0xfe,
-	0x05, 0x42, 0x9a, 0x7a, 0xbb, 0x64, 0xd5, 0xba, 0x2d, 0xfb, 0xe4, 0xb0, 0x76, 0x3f, 0xad, 0xeb,
-	0x19, 0xcb, 0xe7, 0xaf, 0x9a, 0xbb, 0x13, 0xe1, 0x0e, 0xaa, 0xab, 0x4c, 0x3f, 0xed, 0x97, 0xc5,
-	0xe6, 0xbb, 0xb6, 0x6e, 0x19, 0x6e, 0x21, 0x86, 0x6f, 0x10, 0x86, 0xd6, 0x7f, 0x67, 0x73, 0x29,
-	0xbb, 0x92, 0xb2, 0xfb, 0x76, 0xc6, 0x6e, 0x58, 0x10, 0x80, 0xef, 0x09, 0x4c, 0x0a, 0x87, 0x9d,
-	0xee, 0xc3, 0x8d, 0x8b, 0xf0, 0x51, 0x41, 0x90, 0xc3, 0x8f, 0x31, 0x98, 0xa2, 0x06, 0x58, 0x8e,
-	0xf2, 0x9d, 0x2b, 0xfc, 0x7b, 0x85, 0xff, 0x7e, 0xb9, 0xf7, 0xad, 0x04, 0x0c, 0x4e, 0xa2, 0xf0,
-	0x00, 0xd5, 0xe0, 0x52, 0x14, 0xb9, 0xac, 0xc8, 0xe4, 0x0c, 0xd9, 0xcd, 0x2a, 0x01, 0xb8, 0x2f,
-	0x4c, 0xf7, 0x00, 0x4f, 0x58, 0xbb, 0xc2, 0x55, 0x2e, 0xee, 0xc1, 0x2d, 0x08, 0xf2, 0x3d, 0x1c,
-	0x63, 0xf0, 0x4f, 0x54, 0xce, 0x0e, 0xc9, 0xac, 0xb6, 0x75, 0xab, 0xd6, 0xfd, 0x72, 0x06, 0xf8,
-	0x5f, 0x15, 0x01, 0x08, 0x24, 0x9d, 0x01, 0xfa, 0x58, 0x34, 0xc2, 0x4d, 0x54, 0xcd, 0x04, 0x7f,
-	0xff, 0x98, 0xba, 0x3a, 0x98, 0xed, 0x1b, 0x9b, 0xa8, 0xe2, 0x15, 0x6e, 0x29, 0x7f, 0xf6, 0x7a,
-	0xcb, 0x35, 0xd1, 0x57, 0x6b, 0xa2, 0xbf, 0xac, 0x89, 0xfe, 0xb8, 0x21, 0xda, 0x6a, 0x43, 0xb4,
-	0xa7, 0x0d, 0xd1, 0xae, 0x2d, 0x3f, 0x90, 0xd3, 0x64, 0x6c, 0x7b, 0xe1, 0xdc, 0xd9, 0x35, 0xe6,
-	0x88, 0x88, 0x3b, 0xf7, 0x0e, 0xfc, 0x08, 0xf2, 0x21, 0x62, 0x62, 0x5c, 0x56, 0x3f, 0xc2, 0x8f,
-	0xb7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x45, 0x4e, 0x61, 0xe8, 0x03, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x1a, 0x92, 0x76, 0x53, 0xf5, 0xb0, 0x0d, 0xc8, 0x44, 0xad, 0x09, 0x91, 0x80,
+	0x1c, 0x90, 0x2d, 0x95, 0x23, 0x17, 0x9a, 0xa2, 0x46, 0x48, 0x48, 0x54, 0x41, 0xea, 0x01, 0x24,
+	0xa2, 0xad, 0xb3, 0x72, 0x56, 0x72, 0x76, 0x8d, 0x77, 0xd3, 0xc2, 0x5b, 0x70, 0xe2, 0x99, 0x7a,
+	0xec, 0x91, 0x13, 0x42, 0xc9, 0x8b, 0x54, 0x9e, 0x1d, 0x27, 0x71, 0x1a, 0xe7, 0x16, 0xcf, 0xcc,
+	0xff, 0xcd, 0x9f, 0xdf, 0xbb, 0x26, 0xcd, 0x98, 0x4d, 0x65, 0x38, 0x0e, 0x22, 0x2e, 0xb9, 0x16,
+	0xda, 0x4f, 0x52, 0x65, 0x14, 0x7d, 0x62, 0xb8, 0x1c, 0xf1, 0x74, 0x22, 0xa4, 0xf1, 0x75, 0x22,
+	0x7d, 0x3b, 0xd4, 0x6a, 0x46, 0x2a, 0x52, 0x30, 0x11, 0x64, 0xbf, 0xec, 0x70, 0x2b, 0x47, 0xa4,
+	0xfc, 0xc7, 0x94, 0x6b, 0x83, 0xd5, 0x23, 0xac, 0x5e, 0x73, 0x6d, 0x84, 0x8c, 0x86, 0x2c, 0x0c,
+	0xd5, 0x54, 0xae, 0x77, 0x71, 0xed, 0x5a, 0xd7, 0x5b, 0xeb, 0x5e, 0xb3, 0x58, 0x8c, 0x98, 0x51,
+	0x29, 0xf6, 0x29, 0xf6, 0xc3, 0x31, 0x13, 0x12, 0x6b, 0x87, 0x58, 0x4b, 0x58, 0xca, 0x26, 0xf8,
+	0x3f, 0x5a, 0xee, 0xc2, 0xda, 0x0d, 0x4b, 0x47, 0xc3, 0x44, 0xa9, 0x18, 0x3b, 0xc7, 0xcb, 0x71,
+	0x23, 0x42, 0x91, 0x30, 0x69, 0x86, 0xb1, 0x58, 0xb8, 0x7f, 0x8a, 0x6d, 0x16, 0xc7, 0xea, 0x86,
+	0xc9, 0x90, 0xdb, 0x7a, 0xe7, 0x4f, 0x9d, 0xec, 0xf7, 0xad, 0xab, 0x2f, 0x86, 0x19, 0x4e, 0xdf,
+	0x93, 0x3d, 0x70, 0xf1, 0x49, 0x68, 0xe3, 0x3a, 0xed, 0x9d, 0x6e, 0xe3, 0xe4, 0xc8, 0xdf, 0x98,
+	0x9e, 0x7f, 0x96, 0xcd, 0xf5, 0xaa, 0xb7, 0xff, 0x9e, 0x57, 0x06, 0x4b, 0x11, 0xed, 0x90, 0x7d,
+	0xe8, 0x9c, 0x65, 0x01, 0xf0, 0xd4, 0x7d, 0xd4, 0x76, 0xba, 0xd5, 0x41, 0xa1, 0x46, 0xbf, 0x11,
+	0x8a, 0x59, 0x9c, 0xda, 0xa0, 0x60, 0xdd, 0x0e, 0xac, 0x7b, 0x59, 0xb2, 0xae, 0x5f, 0x10, 0xe0,
+	0xde, 0x0d, 0x98, 0x0c, 0x8e, 0x2f, 0x69, 0x15, 0x5e, 0xdd, 0x0a, 0xbf, 0x2c, 0x08, 0x72, 0xf8,
+	0x43, 0x0c, 0x65, 0xa4, 0x89, 0x2b, 0x2f, 0xf3, 0x97, 0x08, 0xf8, 0xc7, 0x80, 0x7f, 0xbd, 0xdd,
+	0xfb, 0x42, 0x82, 0x0b, 0x36, 0xa2, 0xe8, 0x39, 0x69, 0xe0, 0xd1, 0x03, 0x72, 0x0d, 0xc8, 0x5e,
+	0x09, 0x79, 0x60, 0x27, 0x11, 0xb8, 0x2a, 0xcc, 0x72, 0xc0, 0x47, 0x8c, 0x1d, 0x70, 0xf5, 0xad,
+	0x39, 0x0c, 0x0a, 0x82, 0x3c, 0x87, 0x87, 0x18, 0xfa, 0x8e, 0xd4, 0xec, 0xc9, 0x74, 0x77, 0xdb,
+	0x4e, 0xb7, 0x71, 0x72, 0x5c, 0x02, 0xbc, 0x80, 0x21, 0x04, 0xa1, 0x84, 0x7e, 0x26, 0x07, 0xf6,
+	0x04, 0x5f, 0x28, 0x15, 0x83, 0xab, 0x3d, 0x70, 0xf5, 0xa2, 0xd4, 0x55, 0x3e, 0x8c, 0xa0, 0x35,
+	0x39, 0xfd, 0x4e, 0x0e, 0x57, 0x0e, 0x7e, 0x56, 0x02, 0x2a, 0x01, 0xea, 0xab, 0x72, 0x6b, 0xab,
+	0x0a, 0x44, 0x6f, 0x02, 0xd1, 0x84, 0x3c, 0xc3, 0x0c, 0x4e, 0xf3, 0x0b, 0xd4, 0x4f, 0x71, 0xc0,
+	0x6d, 0xc0, 0x96, 0x37, 0xdb, 0x13, 0x2d, 0xea, 0x70, 0x57, 0x39, 0xb4, 0x73, 0x4e, 0x0e, 0x8a,
+	0xef, 0x82, 0xb6, 0xc8, 0xae, 0x45, 0x7e, 0xfc, 0xe0, 0x3a, 0x70, 0xa7, 0x16, 0xcf, 0xd4, 0x25,
+	0xf5, 0xb0, 0x70, 0xdd, 0xf2, 0xc7, 0x5e, 0xef, 0x76, 0xe6, 0x39, 0x77, 0x33, 0xcf, 0xf9, 0x3f,
+	0xf3, 0x9c, 0xdf, 0x73, 0xaf, 0x72, 0x37, 0xf7, 0x2a, 0x7f, 0xe7, 0x5e, 0xe5, 0x6b, 0x37, 0x12,
+	0x66, 0x3c, 0xbd, 0xf2, 0x43, 0x35, 0x09, 0x96, 0xd6, 0x03, 0x9d, 0xc8, 0xe0, 0x67, 0x80, 0x9f,
+	0x0b, 0xf3, 0x2b, 0xe1, 0xfa, 0xaa, 0x06, 0xdf, 0x8a, 0xb7, 0xf7, 0x03, 0x00, 0xf0, 0x07, 0x6e,
+	0x6f, 0x5c, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +266,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RequestAllowanceGrantList) > 0 {
+		for iNdEx := len(m.RequestAllowanceGrantList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestAllowanceGrantList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ParticipantListList) > 0 {
+		for iNdEx := len(m.ParticipantListList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantListList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RewardPoolList) > 0 {
+		for iNdEx := len(m.RewardPoolList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPoolList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -428,6 +501,24 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RewardPoolList) > 0 {
+		for _, e := range m.RewardPoolList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParticipantListList) > 0 {
+		for _, e := range m.ParticipantListList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RequestAllowanceGrantList) > 0 {
+		for _, e := range m.RequestAllowanceGrantList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +828,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPoolList = append(m.RewardPoolList, RewardPool{})
+			if err := m.RewardPoolList[len(m.RewardPoolList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantListList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantListList = append(m.ParticipantListList, ParticipantList{})
+			if err := m.ParticipantListList[len(m.ParticipantListList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestAllowanceGrantList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestAllowanceGrantList = append(m.RequestAllowanceGrantList, RequestAllowanceGrant{})
+			if err := m.RequestAllowanceGrantList[len(m.RequestAllowanceGrantList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/capability"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/cosmos/ibc-go/modules/apps/transfer"
+	ibc "github.com/cosmos/ibc-go/modules/core"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// defaultGenesisModuleBasics are the modules of the default genesis of a Cosmos SDK chain
+var defaultGenesisModuleBasics = module.NewBasicManager(
+	auth.AppModuleBasic{},
+	genutil.AppModuleBasic{},
+	bank.AppModuleBasic{},
+	capability.AppModuleBasic{},
+	staking.AppModuleBasic{},
+	mint.AppModuleBasic{},
+	distr.AppModuleBasic{},
+	gov.AppModuleBasic{},
+	params.AppModuleBasic{},
+	crisis.AppModuleBasic{},
+	slashing.AppModuleBasic{},
+	feegrantmodule.AppModuleBasic{},
+	authzmodule.AppModuleBasic{},
+	ibc.AppModuleBasic{},
+	upgrade.AppModuleBasic{},
+	evidence.AppModuleBasic{},
+	transfer.AppModuleBasic{},
+	vesting.AppModuleBasic{},
+)
+
+// DefaultGenesisDocument returns the genesis generated by default by the CLI of a Cosmos SDK chain
+// This is the initial genesis of the chains with a default initial genesis
+func DefaultGenesisDocument(chainID string) ([]byte, error) {
+	appState, err := json.Marshal(defaultGenesisModuleBasics.DefaultGenesis(genesisCodec))
+	if err != nil {
+		return nil, err
+	}
+	return tmjson.Marshal(tmtypes.GenesisDoc{
+		ChainID:         chainID,
+		InitialHeight:   1,
+		ConsensusParams: tmtypes.DefaultConsensusParams(),
+		AppState:        appState,
+	})
+}
+
+// genesisDocument is a JSON genesis document whose entries are kept as raw JSON
+type genesisDocument map[string]json.RawMessage
+
+// get decodes the entry of the document with the provided key, a missing entry is left empty
+func (d genesisDocument) get(key string, v interface{}) error {
+	bz, ok := d[key]
+	if !ok || len(bz) == 0 || string(bz) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(bz, v); err != nil {
+		return fmt.Errorf("invalid genesis entry %s: %w", key, err)
+	}
+	return nil
+}
+
+// set encodes the entry of the document with the provided key
+func (d genesisDocument) set(key string, v interface{}) (err error) {
+	d[key], err = json.Marshal(v)
+	return err
+}
@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
@@ -67,6 +69,19 @@ var (
 			LaunchID:  launchID1,
 			RequestID: 1,
 		},
+		{
+			LaunchID:      launchID1,
+			RequestID:     2,
+			Status:        types.Request_APPROVED,
+			SettledHeight: 10,
+		},
+		{
+			LaunchID:      launchID1,
+			RequestID:     3,
+			Status:        types.Request_FAILED,
+			SettledHeight: 10,
+			FailureReason: "account not found",
+		},
 	}
 	sampleRequestCounterList = []types.RequestCounter{
 		{
@@ -97,6 +112,12 @@ func TestGenesisState_Validate(t *testing.T) {
 				GenesisValidatorList: sampleGenesisValidatorList,
 				RequestList:          sampleRequestList,
 				RequestCounterList:   sampleRequestCounterList,
+				RewardPoolList:       []types.RewardPool{sample.RewardPool(launchID1)},
+				ParticipantListList:  []types.ParticipantList{sample.ParticipantList(launchID1)},
+				RequestAllowanceGrantList: []types.RequestAllowanceGrant{
+					sample.RequestAllowanceGrant(launchID1),
+					sample.RequestAllowanceGrant(launchID1),
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			shouldBeValid: true,
@@ -369,6 +390,153 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			shouldBeValid: false,
 		},
+		{
+			desc: "request with an invalid status",
+			genState: &types.GenesisState{
+				ChainList:          sampleChainList,
+				ChainCounter:       10,
+				RequestCounterList: sampleRequestCounterList,
+				RequestList: []types.Request{
+					{
+						LaunchID:  launchID1,
+						RequestID: 0,
+						Status:    types.Request_Status(1000),
+					},
+				},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "pending request with a settled height",
+			genState: &types.GenesisState{
+				ChainList:          sampleChainList,
+				ChainCounter:       10,
+				RequestCounterList: sampleRequestCounterList,
+				RequestList: []types.Request{
+					{
+						LaunchID:      launchID1,
+						RequestID:     0,
+						Status:        types.Request_PENDING,
+						SettledHeight: 10,
+					},
+				},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "rejected request with a failure reason",
+			genState: &types.GenesisState{
+				ChainList:          sampleChainList,
+				ChainCounter:       10,
+				RequestCounterList: sampleRequestCounterList,
+				RequestList: []types.Request{
+					{
+						LaunchID:      launchID1,
+						RequestID:     0,
+						Status:        types.Request_REJECTED,
+						SettledHeight: 10,
+						FailureReason: "foo",
+					},
+				},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "duplicated reward pools",
+			genState: &types.GenesisState{
+				ChainList:    sampleChainList,
+				ChainCounter: 10,
+				RewardPoolList: []types.RewardPool{
+					sample.RewardPool(launchID1),
+					sample.RewardPool(launchID1),
+				},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "reward pool not associated with chain",
+			genState: &types.GenesisState{
+				ChainList:      sampleChainList,
+				ChainCounter:   10,
+				RewardPoolList: []types.RewardPool{sample.RewardPool(noExistLaunchID)},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "invalid reward pool",
+			genState: &types.GenesisState{
+				ChainList:    sampleChainList,
+				ChainCounter: 10,
+				RewardPoolList: []types.RewardPool{
+					types.NewRewardPool(launchID1, sample.Address(), sample.Coins(), 0),
+				},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "duplicated participant lists",
+			genState: &types.GenesisState{
+				ChainList:    sampleChainList,
+				ChainCounter: 10,
+				ParticipantListList: []types.ParticipantList{
+					sample.ParticipantList(launchID1),
+					sample.ParticipantList(launchID1),
+				},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "participant list not associated with chain",
+			genState: &types.GenesisState{
+				ChainList:           sampleChainList,
+				ChainCounter:        10,
+				ParticipantListList: []types.ParticipantList{sample.ParticipantList(noExistLaunchID)},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "invalid participant list",
+			genState: &types.GenesisState{
+				ChainList:    sampleChainList,
+				ChainCounter: 10,
+				ParticipantListList: []types.ParticipantList{
+					types.NewParticipantList(launchID1, types.ParticipantList_ALLOWLIST, []string{"invalid"}),
+				},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "duplicated request allowance grants",
+			genState: &types.GenesisState{
+				ChainList:    sampleChainList,
+				ChainCounter: 10,
+				RequestAllowanceGrantList: []types.RequestAllowanceGrant{
+					types.NewRequestAllowanceGrant(launchID1, sample.Address(), addr1),
+					types.NewRequestAllowanceGrant(launchID1, sample.Address(), addr1),
+				},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "request allowance grant not associated with chain",
+			genState: &types.GenesisState{
+				ChainList:                 sampleChainList,
+				ChainCounter:              10,
+				RequestAllowanceGrantList: []types.RequestAllowanceGrant{sample.RequestAllowanceGrant(noExistLaunchID)},
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "invalid request allowance grant",
+			genState: &types.GenesisState{
+				ChainList:    sampleChainList,
+				ChainCounter: 10,
+				RequestAllowanceGrantList: []types.RequestAllowanceGrant{
+					types.NewRequestAllowanceGrant(launchID1, "invalid", sample.Address()),
+				},
+			},
+			shouldBeValid: false,
+		},
 	} {
 		tc := tc
 		t.Run(tc.desc, func(t *testing.T) {
@@ -420,14 +588,38 @@ func TestGenesisState_ValidateParams(t *testing.T) {
 		{
 			desc: "max launch time above the max parametrable launch time",
 			genState: types.GenesisState{
-				Params: types.NewParams(types.DefaultMinLaunchTime, types.MaxParametrableLaunchTime+1),
+				Params: types.NewParams(types.DefaultMinLaunchTime, types.MaxParametrableLaunchTime+1, types.DefaultRequestDeposit, types.DefaultChainCreationFee),
 			},
 			shouldBeValid: false,
 		},
 		{
 			desc: "min launch time above max launch time",
 			genState: types.GenesisState{
-				Params: types.NewParams(types.DefaultMinLaunchTime+1, types.DefaultMinLaunchTime),
+				Params: types.NewParams(types.DefaultMinLaunchTime+1, types.DefaultMinLaunchTime, types.DefaultRequestDeposit, types.DefaultChainCreationFee),
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "invalid request deposit",
+			genState: types.GenesisState{
+				Params: types.NewParams(
+					types.DefaultMinLaunchTime,
+					types.DefaultMaxLaunchTime,
+					sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}},
+					types.DefaultChainCreationFee,
+				),
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "invalid chain creation fee",
+			genState: types.GenesisState{
+				Params: types.NewParams(
+					types.DefaultMinLaunchTime,
+					types.DefaultMaxLaunchTime,
+					types.DefaultRequestDeposit,
+					sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}},
+				),
 			},
 			shouldBeValid: false,
 		},
@@ -30,7 +30,7 @@ type GenesisValidator struct {
 	GenTx          []byte     `protobuf:"bytes,3,opt,name=genTx,proto3" json:"genTx,omitempty"`
 	ConsPubKey     []byte     `protobuf:"bytes,4,opt,name=consPubKey,proto3" json:"consPubKey,omitempty"`
 	SelfDelegation types.Coin `protobuf:"bytes,5,opt,name=selfDelegation,proto3" json:"selfDelegation"`
-	Peer           string     `protobuf:"bytes,6,opt,name=peer,proto3" json:"peer,omitempty"`
+	Peer           Peer       `protobuf:"bytes,6,opt,name=peer,proto3" json:"peer"`
 }
 
 func (m *GenesisValidator) Reset()         { *m = GenesisValidator{} }
@@ -101,41 +101,198 @@ func (m *GenesisValidator) GetSelfDelegation() types.Coin {
 	return types.Coin{}
 }
 
-func (m *GenesisValidator) GetPeer() string {
+func (m *GenesisValidator) GetPeer() Peer {
 	if m != nil {
 		return m.Peer
 	}
+	return Peer{}
+}
+
+// Peer describes how the validator node can be reached by the other nodes of the network
+type Peer struct {
+	// id is the node ID of the validator
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Connection:
+	//	*Peer_TcpAddress
+	//	*Peer_HttpTunnel
+	Connection isPeer_Connection `protobuf_oneof:"connection"`
+}
+
+func (m *Peer) Reset()         { *m = Peer{} }
+func (m *Peer) String() string { return proto.CompactTextString(m) }
+func (*Peer) ProtoMessage()    {}
+func (*Peer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7a6c7c23ebd0c1, []int{1}
+}
+func (m *Peer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Peer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Peer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Peer.Merge(m, src)
+}
+func (m *Peer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Peer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Peer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Peer proto.InternalMessageInfo
+
+type isPeer_Connection interface {
+	isPeer_Connection()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Peer_TcpAddress struct {
+	TcpAddress string `protobuf:"bytes,2,opt,name=tcpAddress,proto3,oneof" json:"tcpAddress,omitempty"`
+}
+type Peer_HttpTunnel struct {
+	HttpTunnel *Peer_HTTPTunnel `protobuf:"bytes,3,opt,name=httpTunnel,proto3,oneof" json:"httpTunnel,omitempty"`
+}
+
+func (*Peer_TcpAddress) isPeer_Connection() {}
+func (*Peer_HttpTunnel) isPeer_Connection() {}
+
+func (m *Peer) GetConnection() isPeer_Connection {
+	if m != nil {
+		return m.Connection
+	}
+	return nil
+}
+
+func (m *Peer) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Peer) GetTcpAddress() string {
+	if x, ok := m.GetConnection().(*Peer_TcpAddress); ok {
+		return x.TcpAddress
+	}
+	return ""
+}
+
+func (m *Peer) GetHttpTunnel() *Peer_HTTPTunnel {
+	if x, ok := m.GetConnection().(*Peer_HttpTunnel); ok {
+		return x.HttpTunnel
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Peer) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Peer_TcpAddress)(nil),
+		(*Peer_HttpTunnel)(nil),
+	}
+}
+
+type Peer_HTTPTunnel struct {
+	// name is the name of the tunneling method
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address of the tunnel
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *Peer_HTTPTunnel) Reset()         { *m = Peer_HTTPTunnel{} }
+func (m *Peer_HTTPTunnel) String() string { return proto.CompactTextString(m) }
+func (*Peer_HTTPTunnel) ProtoMessage()    {}
+func (*Peer_HTTPTunnel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7a6c7c23ebd0c1, []int{1, 0}
+}
+func (m *Peer_HTTPTunnel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Peer_HTTPTunnel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Peer_HTTPTunnel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Peer_HTTPTunnel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Peer_HTTPTunnel.Merge(m, src)
+}
+func (m *Peer_HTTPTunnel) XXX_Size() int {
+	return m.Size()
+}
+func (m *Peer_HTTPTunnel) XXX_DiscardUnknown() {
+	xxx_messageInfo_Peer_HTTPTunnel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Peer_HTTPTunnel proto.InternalMessageInfo
+
+func (m *Peer_HTTPTunnel) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Peer_HTTPTunnel) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
 	return ""
 }
 
 func init() {
 	proto.RegisterType((*GenesisValidator)(nil), "tendermint.spn.launch.GenesisValidator")
+	proto.RegisterType((*Peer)(nil), "tendermint.spn.launch.Peer")
+	proto.RegisterType((*Peer_HTTPTunnel)(nil), "tendermint.spn.launch.Peer.HTTPTunnel")
 }
 
 func init() { proto.RegisterFile("launch/genesis_validator.proto", fileDescriptor_da7a6c7c23ebd0c1) }
 
 var fileDescriptor_da7a6c7c23ebd0c1 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x1b, 0xed, 0xa6, 0x46, 0x11, 0x09, 0x13, 0xe2, 0x0e, 0xb1, 0x78, 0xea, 0x29, 0x61,
-	0xfa, 0x06, 0x73, 0x30, 0xc4, 0x8b, 0x14, 0xf1, 0xe0, 0x45, 0xd2, 0xf6, 0x6f, 0x17, 0xe8, 0x92,
-	0xd2, 0x64, 0x63, 0x7b, 0x0b, 0x1f, 0x6b, 0xc7, 0x1d, 0x05, 0x41, 0x64, 0x7b, 0x11, 0x59, 0xb3,
-	0xa9, 0x78, 0xfb, 0x7f, 0xf9, 0x7f, 0xc9, 0xf7, 0xe3, 0x0b, 0x66, 0xa5, 0x9c, 0xe8, 0x6c, 0x24,
-	0x0a, 0xd0, 0x60, 0x95, 0x7d, 0x99, 0xca, 0x52, 0xe5, 0xd2, 0x99, 0x9a, 0x57, 0xb5, 0x71, 0x86,
-	0x9c, 0x3b, 0xd0, 0x39, 0xd4, 0x63, 0xa5, 0x1d, 0xb7, 0x95, 0xe6, 0xde, 0xde, 0xed, 0x14, 0xa6,
-	0x30, 0x8d, 0x43, 0x6c, 0x26, 0x6f, 0xee, 0xb2, 0xcc, 0xd8, 0xb1, 0xb1, 0x22, 0x95, 0x16, 0xc4,
-	0xb4, 0x97, 0x82, 0x93, 0x3d, 0x91, 0x19, 0xa5, 0xfd, 0xfe, 0xea, 0x03, 0xe1, 0xb3, 0xa1, 0x0f,
-	0x7a, 0xda, 0xe5, 0x90, 0x2e, 0x3e, 0xf4, 0x8f, 0xde, 0x0d, 0x28, 0x8a, 0x50, 0x1c, 0x26, 0x3f,
-	0x9a, 0x50, 0x7c, 0x20, 0xf3, 0xbc, 0x06, 0x6b, 0xe9, 0x5e, 0x84, 0xe2, 0xa3, 0x64, 0x27, 0x49,
-	0x07, 0xb7, 0x0a, 0xd0, 0x8f, 0x33, 0xba, 0x1f, 0xa1, 0xf8, 0x24, 0xf1, 0x82, 0x30, 0x8c, 0x33,
-	0xa3, 0xed, 0xc3, 0x24, 0xbd, 0x87, 0x39, 0x0d, 0x9b, 0xd5, 0x9f, 0x13, 0x32, 0xc4, 0xa7, 0x16,
-	0xca, 0xd7, 0x01, 0x94, 0x50, 0x48, 0xa7, 0x8c, 0xa6, 0xad, 0x08, 0xc5, 0xc7, 0xd7, 0x17, 0xdc,
-	0x93, 0xf3, 0x0d, 0x39, 0xdf, 0x92, 0xf3, 0x5b, 0xa3, 0x74, 0x3f, 0x5c, 0x7c, 0x5e, 0x06, 0xc9,
-	0xbf, 0x6b, 0x84, 0xe0, 0xb0, 0x02, 0xa8, 0x69, 0xbb, 0xa1, 0x6a, 0xe6, 0x7e, 0x7f, 0xb1, 0x62,
-	0x68, 0xb9, 0x62, 0xe8, 0x6b, 0xc5, 0xd0, 0xdb, 0x9a, 0x05, 0xcb, 0x35, 0x0b, 0xde, 0xd7, 0x2c,
-	0x78, 0x8e, 0x0b, 0xe5, 0x46, 0x93, 0x94, 0x67, 0x66, 0x2c, 0x7e, 0xfb, 0x14, 0xb6, 0xd2, 0x62,
-	0x26, 0xb6, 0x1f, 0xe0, 0xe6, 0x15, 0xd8, 0xb4, 0xdd, 0x14, 0x75, 0xf3, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0x9d, 0xa7, 0xbb, 0x5d, 0x97, 0x01, 0x00, 0x00,
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xce, 0xac, 0x69, 0xb5, 0xaf, 0xa5, 0xc8, 0x50, 0x21, 0xae, 0x30, 0x86, 0x1e, 0x24, 0xa7,
+	0x19, 0x5a, 0xf1, 0xe2, 0xcd, 0xb5, 0xd0, 0x15, 0x2f, 0x4b, 0x58, 0x3c, 0x78, 0x91, 0x49, 0xf2,
+	0xcc, 0x0e, 0x64, 0x67, 0x42, 0x66, 0xb6, 0xb4, 0xff, 0x85, 0x7f, 0x56, 0x0f, 0x1e, 0x7a, 0xf4,
+	0x24, 0xb2, 0xfb, 0x7f, 0x88, 0x64, 0x26, 0x75, 0x17, 0x51, 0x6f, 0xef, 0xc7, 0xf7, 0xde, 0xfb,
+	0xbe, 0x8f, 0x07, 0xac, 0x91, 0x2b, 0x5d, 0x2e, 0x44, 0x8d, 0x1a, 0xad, 0xb2, 0x9f, 0xae, 0x64,
+	0xa3, 0x2a, 0xe9, 0x4c, 0xc7, 0xdb, 0xce, 0x38, 0x43, 0x9f, 0x38, 0xd4, 0x15, 0x76, 0x4b, 0xa5,
+	0x1d, 0xb7, 0xad, 0xe6, 0x01, 0x3e, 0x3e, 0xa9, 0x4d, 0x6d, 0x3c, 0x42, 0xf4, 0x51, 0x00, 0x8f,
+	0x59, 0x69, 0xec, 0xd2, 0x58, 0x51, 0x48, 0x8b, 0xe2, 0xea, 0xac, 0x40, 0x27, 0xcf, 0x44, 0x69,
+	0x94, 0x0e, 0xfd, 0xd3, 0x9f, 0x04, 0x1e, 0x5f, 0x86, 0x43, 0x1f, 0xee, 0xef, 0xd0, 0x31, 0x3c,
+	0x0a, 0x4b, 0xdf, 0x5d, 0x24, 0x24, 0x25, 0x59, 0x9c, 0xff, 0xce, 0x69, 0x02, 0x0f, 0x65, 0x55,
+	0x75, 0x68, 0x6d, 0x32, 0x4a, 0x49, 0x76, 0x90, 0xdf, 0xa7, 0xf4, 0x04, 0xf6, 0x6a, 0xd4, 0xf3,
+	0xeb, 0xe4, 0x41, 0x4a, 0xb2, 0xa3, 0x3c, 0x24, 0x94, 0x01, 0x94, 0x46, 0xdb, 0xd9, 0xaa, 0x78,
+	0x8f, 0x37, 0x49, 0xec, 0x5b, 0x3b, 0x15, 0x7a, 0x09, 0xc7, 0x16, 0x9b, 0xcf, 0x17, 0xd8, 0x60,
+	0x2d, 0x9d, 0x32, 0x3a, 0xd9, 0x4b, 0x49, 0x76, 0x78, 0xfe, 0x94, 0x07, 0xe6, 0xbc, 0x67, 0xce,
+	0x07, 0xe6, 0xfc, 0xad, 0x51, 0x7a, 0x12, 0xdf, 0x7e, 0x7f, 0x1e, 0xe5, 0x7f, 0x8c, 0xd1, 0x57,
+	0x10, 0xb7, 0x88, 0x5d, 0xb2, 0xef, 0xc7, 0x9f, 0xf1, 0xbf, 0xba, 0xc4, 0x67, 0x88, 0xdd, 0xb0,
+	0xc0, 0xc3, 0x4f, 0xbf, 0x12, 0x88, 0xfb, 0x22, 0x3d, 0x86, 0x91, 0xaa, 0xbc, 0xdc, 0x83, 0x7c,
+	0xa4, 0x2a, 0x9a, 0x02, 0xb8, 0xb2, 0x7d, 0xb3, 0xab, 0x75, 0x1a, 0xe5, 0x3b, 0x35, 0x3a, 0x05,
+	0x58, 0x38, 0xd7, 0xce, 0x57, 0x5a, 0x63, 0xe3, 0x55, 0x1f, 0x9e, 0xbf, 0xf8, 0xcf, 0x5d, 0x3e,
+	0x9d, 0xcf, 0x67, 0x01, 0xdd, 0x6f, 0xda, 0xce, 0x8e, 0x5f, 0x03, 0x6c, 0x7b, 0x94, 0x42, 0xac,
+	0xe5, 0x12, 0x07, 0x2e, 0x3e, 0xfe, 0xb7, 0xed, 0x93, 0x23, 0x6f, 0xb0, 0xc6, 0xb2, 0x77, 0x61,
+	0x32, 0xb9, 0x5d, 0x33, 0x72, 0xb7, 0x66, 0xe4, 0xc7, 0x9a, 0x91, 0x2f, 0x1b, 0x16, 0xdd, 0x6d,
+	0x58, 0xf4, 0x6d, 0xc3, 0xa2, 0x8f, 0x59, 0xad, 0xdc, 0x62, 0x55, 0xf0, 0xd2, 0x2c, 0xc5, 0x96,
+	0xa3, 0xb0, 0xad, 0x16, 0xd7, 0x62, 0x78, 0x39, 0x77, 0xd3, 0xa2, 0x2d, 0xf6, 0xfd, 0x6b, 0xbc,
+	0xfc, 0x35, 0x00, 0x88, 0x67, 0x6e, 0x86, 0x89, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisValidator) Marshal() (dAtA []byte, err error) {
@@ -158,13 +315,16 @@ func (m *GenesisValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Peer) > 0 {
-		i -= len(m.Peer)
-		copy(dAtA[i:], m.Peer)
-		i = encodeVarintGenesisValidator(dAtA, i, uint64(len(m.Peer)))
-		i--
-		dAtA[i] = 0x32
+	{
+		size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesisValidator(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size, err := m.SelfDelegation.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -204,6 +364,117 @@ func (m *GenesisValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Peer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Peer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Peer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Connection != nil {
+		{
+			size := m.Connection.Size()
+			i -= size
+			if _, err := m.Connection.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesisValidator(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Peer_TcpAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Peer_TcpAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.TcpAddress)
+	copy(dAtA[i:], m.TcpAddress)
+	i = encodeVarintGenesisValidator(dAtA, i, uint64(len(m.TcpAddress)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *Peer_HttpTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Peer_HttpTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HttpTunnel != nil {
+		{
+			size, err := m.HttpTunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesisValidator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Peer_HTTPTunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Peer_HTTPTunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Peer_HTTPTunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesisValidator(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesisValidator(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesisValidator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesisValidator(v)
 	base := offset
@@ -238,7 +509,60 @@ func (m *GenesisValidator) Size() (n int) {
 	}
 	l = m.SelfDelegation.Size()
 	n += 1 + l + sovGenesisValidator(uint64(l))
-	l = len(m.Peer)
+	l = m.Peer.Size()
+	n += 1 + l + sovGenesisValidator(uint64(l))
+	return n
+}
+
+func (m *Peer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesisValidator(uint64(l))
+	}
+	if m.Connection != nil {
+		n += m.Connection.Size()
+	}
+	return n
+}
+
+func (m *Peer_TcpAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TcpAddress)
+	n += 1 + l + sovGenesisValidator(uint64(l))
+	return n
+}
+func (m *Peer_HttpTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HttpTunnel != nil {
+		l = m.HttpTunnel.Size()
+		n += 1 + l + sovGenesisValidator(uint64(l))
+	}
+	return n
+}
+func (m *Peer_HTTPTunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesisValidator(uint64(l))
+	}
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovGenesisValidator(uint64(l))
 	}
@@ -436,6 +760,270 @@ func (m *GenesisValidator) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesisValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesisValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Peer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesisValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Peer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Peer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesisValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TcpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesisValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connection = &Peer_TcpAddress{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpTunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesisValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Peer_HTTPTunnel{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Connection = &Peer_HttpTunnel{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesisValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Peer_HTTPTunnel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesisValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPTunnel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPTunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesisValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesisValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -462,7 +1050,7 @@ func (m *GenesisValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Peer = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
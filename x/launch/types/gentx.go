@@ -0,0 +1,118 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ParseGenTx decodes a JSON encoded gentx and returns the transaction and its MsgCreateValidator message
+func ParseGenTx(txConfig client.TxConfig, genTx []byte) (authsigning.Tx, *stakingtypes.MsgCreateValidator, error) {
+	decoded, err := txConfig.TxJSONDecoder()(genTx)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidGenTx, "can't decode the gentx: %s", err.Error())
+	}
+
+	tx, ok := decoded.(authsigning.Tx)
+	if !ok {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidGenTx, "the gentx is not a signed transaction")
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidGenTx, "the gentx must contain one message, found %d", len(msgs))
+	}
+	msg, ok := msgs[0].(*stakingtypes.MsgCreateValidator)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidGenTx, "the gentx message must be a MsgCreateValidator, found %T", msgs[0])
+	}
+
+	return tx, msg, nil
+}
+
+// ValidateGenTx checks the gentx of the genesis validator creates the described validator
+// and is signed by the validator account for the provided chain ID
+func (m GenesisValidator) ValidateGenTx(txConfig client.TxConfig, chainID string) error {
+	tx, msg, err := ParseGenTx(txConfig, m.GenTx)
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGenTx, "invalid MsgCreateValidator: %s", err.Error())
+	}
+
+	// The validator must be created by the account of the genesis validator
+	accAddress, err := sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGenTx, "invalid validator address: %s", err.Error())
+	}
+	if msg.DelegatorAddress != m.Address {
+		return sdkerrors.Wrapf(ErrInvalidGenTx,
+			"the gentx delegator address %s doesn't match the validator address %s",
+			msg.DelegatorAddress,
+			m.Address,
+		)
+	}
+	if msg.ValidatorAddress != sdk.ValAddress(accAddress).String() {
+		return sdkerrors.Wrapf(ErrInvalidGenTx,
+			"the gentx validator operator address %s doesn't match the validator address %s",
+			msg.ValidatorAddress,
+			m.Address,
+		)
+	}
+
+	if msg.Value.Denom != m.SelfDelegation.Denom || !msg.Value.Amount.Equal(m.SelfDelegation.Amount) {
+		return sdkerrors.Wrapf(ErrInvalidSelfDelegation,
+			"the gentx self delegation %s doesn't match the self delegation %s",
+			msg.Value.String(),
+			m.SelfDelegation.String(),
+		)
+	}
+
+	pubKey, ok := msg.Pubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return sdkerrors.Wrap(ErrInvalidGenTx, "the gentx doesn't contain a valid consensus public key")
+	}
+	if !bytes.Equal(pubKey.Bytes(), m.ConsPubKey) {
+		return sdkerrors.Wrap(ErrInvalidConsPubKey, "the gentx consensus public key doesn't match the consensus public key")
+	}
+
+	return verifyGenTxSignature(txConfig, tx, accAddress, chainID)
+}
+
+// verifyGenTxSignature verifies the gentx is signed by the signer for the provided chain ID
+// gentxs are delivered at genesis, therefore they are signed with an account number and a sequence of 0
+func verifyGenTxSignature(txConfig client.TxConfig, tx authsigning.Tx, signer sdk.AccAddress, chainID string) error {
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGenTx, "can't get the gentx signatures: %s", err.Error())
+	}
+	if len(sigs) != 1 {
+		return sdkerrors.Wrapf(ErrInvalidGenTx, "the gentx must contain one signature, found %d", len(sigs))
+	}
+
+	sig := sigs[0]
+	if sig.PubKey == nil || !bytes.Equal(sig.PubKey.Address(), signer) {
+		return sdkerrors.Wrapf(ErrInvalidGenTx, "the gentx is not signed by the validator %s", signer.String())
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       chainID,
+		AccountNumber: 0,
+		Sequence:      0,
+	}
+	if err := authsigning.VerifySignature(sig.PubKey, signerData, sig.Data, txConfig.SignModeHandler(), tx); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGenTx,
+			"the gentx signature can't be verified for the chain %s: %s",
+			chainID,
+			err.Error(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestGenesisValidator_ValidateGenTx(t *testing.T) {
+	var (
+		txConfig       = sample.TxConfig()
+		chainID        = sample.GenesisChainID()
+		privKey        = sample.PrivKey()
+		address        = sdk.AccAddress(privKey.PubKey().Address()).String()
+		consPubKey     = ed25519.GenPrivKey().PubKey()
+		selfDelegation = sample.Coin()
+		genTx          = sample.GenTx(privKey, consPubKey, selfDelegation, chainID)
+	)
+
+	newGenesisValidator := func(address string, genTx []byte) types.GenesisValidator {
+		return types.GenesisValidator{
+			Address:        address,
+			GenTx:          genTx,
+			ConsPubKey:     consPubKey.Bytes(),
+			SelfDelegation: selfDelegation,
+			Peer:           sample.Peer(),
+		}
+	}
+
+	otherSelfDelegation := newGenesisValidator(address, genTx)
+	otherSelfDelegation.SelfDelegation = sample.Coin()
+	otherConsPubKey := newGenesisValidator(address, genTx)
+	otherConsPubKey.ConsPubKey = ed25519.GenPrivKey().PubKey().Bytes()
+
+	for _, tc := range []struct {
+		name    string
+		val     types.GenesisValidator
+		chainID string
+		err     error
+	}{
+		{
+			name:    "valid gentx",
+			val:     newGenesisValidator(address, genTx),
+			chainID: chainID,
+		},
+		{
+			name:    "undecodable gentx",
+			val:     newGenesisValidator(address, sample.Bytes(500)),
+			chainID: chainID,
+			err:     types.ErrInvalidGenTx,
+		},
+		{
+			name:    "invalid validator address",
+			val:     newGenesisValidator("invalid", genTx),
+			chainID: chainID,
+			err:     types.ErrInvalidGenTx,
+		},
+		{
+			name:    "gentx from another validator",
+			val:     newGenesisValidator(sample.Address(), genTx),
+			chainID: chainID,
+			err:     types.ErrInvalidGenTx,
+		},
+		{
+			name: "gentx signed by another account",
+			val: newGenesisValidator(
+				address,
+				sample.GenTx(sample.PrivKey(), consPubKey, selfDelegation, chainID),
+			),
+			chainID: chainID,
+			err:     types.ErrInvalidGenTx,
+		},
+		{
+			name:    "gentx with another self delegation",
+			val:     otherSelfDelegation,
+			chainID: chainID,
+			err:     types.ErrInvalidSelfDelegation,
+		},
+		{
+			name:    "gentx with another consensus public key",
+			val:     otherConsPubKey,
+			chainID: chainID,
+			err:     types.ErrInvalidConsPubKey,
+		},
+		{
+			name:    "gentx signed for another chain",
+			val:     newGenesisValidator(address, genTx),
+			chainID: sample.GenesisChainID(),
+			err:     types.ErrInvalidGenTx,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.val.ValidateGenTx(txConfig, tc.chainID)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
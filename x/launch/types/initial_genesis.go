@@ -5,6 +5,8 @@ import (
 
 	"crypto/sha256"
 	"encoding/hex"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const HashLength = 64
@@ -54,3 +56,24 @@ func GenesisURLHash(content string) string {
 	hash := sha256.Sum256([]byte(content))
 	return hex.EncodeToString(hash[:])
 }
+
+// Document returns the JSON document of the initial genesis of a chain
+// content is the genesis downloaded from the URL of a GenesisURL, it must match the hash of the URL
+func (m InitialGenesis) Document(chainID, content string) ([]byte, error) {
+	switch initialGenesis := m.Source.(type) {
+	case *InitialGenesis_DefaultInitialGenesis:
+		return DefaultGenesisDocument(chainID)
+	case *InitialGenesis_GenesisURL:
+		if content == "" {
+			return nil, sdkerrors.Wrapf(ErrInvalidInitialGenesis,
+				"the content of the genesis URL %s must be provided", initialGenesis.GenesisURL.Url)
+		}
+		if GenesisURLHash(content) != initialGenesis.GenesisURL.Hash {
+			return nil, sdkerrors.Wrapf(ErrInvalidInitialGenesis,
+				"the content doesn't match the hash of the genesis URL %s", initialGenesis.GenesisURL.Url)
+		}
+		return []byte(content), nil
+	default:
+		return nil, sdkerrors.Wrap(ErrInvalidInitialGenesis, "unrecognized initial genesis")
+	}
+}
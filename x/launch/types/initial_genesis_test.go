@@ -4,6 +4,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"testing"
 )
 
@@ -57,3 +58,36 @@ func TestGenesisURLHash(t *testing.T) {
 		types.GenesisURLHash("foo"),
 	)
 }
+
+func TestInitialGenesis_Document(t *testing.T) {
+	chainID := sample.GenesisChainID()
+	defaultGenesis, err := types.DefaultGenesisDocument(chainID)
+	require.NoError(t, err)
+	genesisURL := types.NewGenesisURL("foo.com", types.GenesisURLHash("foo"))
+
+	t.Run("should return the default genesis document", func(t *testing.T) {
+		document, err := types.NewDefaultInitialGenesis().Document(chainID, "bar")
+		require.NoError(t, err)
+		require.EqualValues(t, defaultGenesis, document)
+
+		genesisDoc, err := tmtypes.GenesisDocFromJSON(document)
+		require.NoError(t, err)
+		require.EqualValues(t, chainID, genesisDoc.ChainID)
+	})
+
+	t.Run("should return the content of the genesis URL", func(t *testing.T) {
+		document, err := genesisURL.Document(chainID, "foo")
+		require.NoError(t, err)
+		require.EqualValues(t, "foo", string(document))
+	})
+
+	t.Run("should prevent returning a genesis URL without content", func(t *testing.T) {
+		_, err := genesisURL.Document(chainID, "")
+		require.ErrorIs(t, err, types.ErrInvalidInitialGenesis)
+	})
+
+	t.Run("should prevent returning a genesis URL with a content not matching the hash", func(t *testing.T) {
+		_, err := genesisURL.Document(chainID, "bar")
+		require.ErrorIs(t, err, types.ErrInvalidInitialGenesis)
+	})
+}
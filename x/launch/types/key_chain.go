@@ -6,9 +6,36 @@ const (
 
 	// ChainCounterKey is the prefix to store chain counter
 	ChainCounterKey = "Chain/count/"
+
+	// ChainByCoordinatorKeyPrefix is the prefix to retrieve the chains of a coordinator
+	ChainByCoordinatorKeyPrefix = "Chain/coordinator/"
+
+	// LaunchQueueKeyPrefix is the prefix to retrieve the chains waiting for their launch timestamp
+	LaunchQueueKeyPrefix = "LaunchQueue/value/"
 )
 
 // ChainKey returns the store key to retrieve a Chain from the index fields
 func ChainKey(launchID uint64) []byte {
 	return append(uintBytes(launchID), byte('/'))
 }
+
+// ChainByCoordinatorKey returns the store key to retrieve a chain of a coordinator
+func ChainByCoordinatorKey(coordinatorID, launchID uint64) []byte {
+	return append(ChainByCoordinatorAllKey(coordinatorID), append(uintBytes(launchID), byte('/'))...)
+}
+
+// ChainByCoordinatorAllKey returns the store key prefix to retrieve all chains of a coordinator
+func ChainByCoordinatorAllKey(coordinatorID uint64) []byte {
+	return append(uintBytes(coordinatorID), byte('/'))
+}
+
+// LaunchQueueKey returns the store key of a chain in the launch queue
+// Chains are ordered by launch timestamp in the queue
+func LaunchQueueKey(launchTimestamp int64, launchID uint64) []byte {
+	return append(LaunchQueueTimestampKey(launchTimestamp), uintBytes(launchID)...)
+}
+
+// LaunchQueueTimestampKey returns the store key prefix of the chains in the launch queue for a launch timestamp
+func LaunchQueueTimestampKey(launchTimestamp int64) []byte {
+	return uintBytes(uint64(launchTimestamp))
+}
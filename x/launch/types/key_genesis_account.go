@@ -3,6 +3,9 @@ package types
 const (
 	// GenesisAccountKeyPrefix is the prefix to retrieve all GenesisAccount
 	GenesisAccountKeyPrefix = "GenesisAccount/value/"
+
+	// GenesisAccountCounterKeyPrefix is the prefix to retrieve the number of GenesisAccount of a chain
+	GenesisAccountCounterKeyPrefix = "GenesisAccount/count/"
 )
 
 // GenesisAccountKey returns the store key to retrieve a GenesisAccount from the index fields
@@ -3,6 +3,9 @@ package types
 const (
 	// GenesisValidatorKeyPrefix is the prefix to retrieve all GenesisValidator
 	GenesisValidatorKeyPrefix = "GenesisValidator/value/"
+
+	// GenesisValidatorCounterKeyPrefix is the prefix to retrieve the number of GenesisValidator of a chain
+	GenesisValidatorCounterKeyPrefix = "GenesisValidator/count/"
 )
 
 // GenesisValidatorKey returns the store key to retrieve a GenesisValidator from the index fields
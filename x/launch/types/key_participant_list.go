@@ -0,0 +1,11 @@
+package types
+
+const (
+	// ParticipantListKeyPrefix is the prefix to retrieve all ParticipantList
+	ParticipantListKeyPrefix = "ParticipantList/value/"
+)
+
+// ParticipantListKey returns the store key to retrieve a ParticipantList from the index fields
+func ParticipantListKey(launchID uint64) []byte {
+	return append(uintBytes(launchID), byte('/'))
+}
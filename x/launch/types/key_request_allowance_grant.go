@@ -0,0 +1,20 @@
+package types
+
+const (
+	// RequestAllowanceGrantKeyPrefix is the prefix to retrieve all RequestAllowanceGrant
+	RequestAllowanceGrantKeyPrefix = "RequestAllowanceGrant/value/"
+)
+
+// RequestAllowanceGrantKey returns the store key to retrieve a RequestAllowanceGrant from the index fields
+func RequestAllowanceGrantKey(launchID uint64, grantee string) []byte {
+	launchIDBytes := append(uintBytes(launchID), byte('/'))
+	granteeBytes := append([]byte(grantee), byte('/'))
+	return append(launchIDBytes, granteeBytes...)
+}
+
+// RequestAllowanceGrantAllKey returns the store key to retrieve all RequestAllowanceGrant by launchID
+func RequestAllowanceGrantAllKey(launchID uint64) []byte {
+	prefixBytes := []byte(RequestAllowanceGrantKeyPrefix)
+	launchIDBytes := append(uintBytes(launchID), byte('/'))
+	return append(prefixBytes, launchIDBytes...)
+}
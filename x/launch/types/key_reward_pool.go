@@ -0,0 +1,11 @@
+package types
+
+const (
+	// RewardPoolKeyPrefix is the prefix to retrieve all RewardPool
+	RewardPoolKeyPrefix = "RewardPool/value/"
+)
+
+// RewardPoolKey returns the store key to retrieve a RewardPool from the index fields
+func RewardPoolKey(launchID uint64) []byte {
+	return append(uintBytes(launchID), byte('/'))
+}
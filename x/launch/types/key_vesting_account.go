@@ -3,6 +3,9 @@ package types
 const (
 	// VestingAccountKeyPrefix is the prefix to retrieve all VestingAccount
 	VestingAccountKeyPrefix = "VestingAccount/value/"
+
+	// VestingAccountCounterKeyPrefix is the prefix to retrieve the number of VestingAccount of a chain
+	VestingAccountCounterKeyPrefix = "VestingAccount/count/"
 )
 
 // VestingAccountKey returns the store key to retrieve a VestingAccount from the index fields
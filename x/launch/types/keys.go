@@ -23,6 +23,11 @@ func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+// LaunchIDCounterKey returns the store key to retrieve a counter of a chain from its launch ID
+func LaunchIDCounterKey(launchID uint64) []byte {
+	return append(uintBytes(launchID), byte('/'))
+}
+
 func uintBytes(v uint64) []byte {
 	b := make([]byte, 8)
 	binary.BigEndian.PutUint64(b, v)
@@ -0,0 +1,201 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// genesisCodec is the codec used to encode the accounts of the generated genesis
+var genesisCodec = func() *codec.ProtoCodec {
+	registry := cdctypes.NewInterfaceRegistry()
+	defaultGenesisModuleBasics.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}()
+
+// LaunchGenesis contains the entries generated from the launch information of a chain
+// They are applied on the initial genesis of the chain to get its final genesis
+type LaunchGenesis struct {
+	GenesisTime time.Time
+	ChainID     string
+	Accounts    []json.RawMessage
+	Balances    []json.RawMessage
+	Supply      sdk.Coins
+	GenTxs      []json.RawMessage
+}
+
+// NewLaunchGenesis generates the launch genesis of a chain from its approved genesis accounts, vesting accounts and validators
+// The accounts and validators must be sorted to get a deterministic genesis
+func NewLaunchGenesis(
+	chain Chain,
+	genesisAccounts []GenesisAccount,
+	vestingAccounts []VestingAccount,
+	genesisValidators []GenesisValidator,
+) (genesis LaunchGenesis, err error) {
+	genesis.ChainID = chain.GenesisChainID
+	if chain.LaunchTriggered {
+		genesis.GenesisTime = time.Unix(chain.LaunchTimestamp, 0).UTC()
+	}
+
+	for _, acc := range genesisAccounts {
+		address, err := sdk.AccAddressFromBech32(acc.Address)
+		if err != nil {
+			return genesis, fmt.Errorf("invalid genesis account address %s: %w", acc.Address, err)
+		}
+		if err := genesis.appendAccount(authtypes.NewBaseAccountWithAddress(address), acc.Coins); err != nil {
+			return genesis, err
+		}
+	}
+
+	for _, acc := range vestingAccounts {
+		address, err := sdk.AccAddressFromBech32(acc.Address)
+		if err != nil {
+			return genesis, fmt.Errorf("invalid vesting account address %s: %w", acc.Address, err)
+		}
+		vestingAcc, balance, err := acc.GenesisAccount(authtypes.NewBaseAccountWithAddress(address))
+		if err != nil {
+			return genesis, err
+		}
+		if err := genesis.appendAccount(vestingAcc, balance); err != nil {
+			return genesis, err
+		}
+	}
+
+	for _, val := range genesisValidators {
+		if !json.Valid(val.GenTx) {
+			return genesis, fmt.Errorf("the gentx of the validator %s is not a valid JSON", val.Address)
+		}
+		genesis.GenTxs = append(genesis.GenTxs, val.GenTx)
+	}
+
+	return genesis, nil
+}
+
+// Apply applies the launch genesis on the initial genesis document of the chain
+// It returns the JSON encoded final genesis of the chain and its sha256 hash
+func (g LaunchGenesis) Apply(initialGenesis []byte) (string, string, error) {
+	var document genesisDocument
+	if err := json.Unmarshal(initialGenesis, &document); err != nil {
+		return "", "", fmt.Errorf("invalid initial genesis: %w", err)
+	}
+	if err := document.set("chain_id", g.ChainID); err != nil {
+		return "", "", err
+	}
+	if !g.GenesisTime.IsZero() {
+		if err := document.set("genesis_time", g.GenesisTime); err != nil {
+			return "", "", err
+		}
+	}
+
+	appState := make(genesisDocument)
+	if err := document.get("app_state", &appState); err != nil {
+		return "", "", err
+	}
+
+	// The accounts and the gentxs are appended as the add-genesis-account and collect-gentxs commands of the chain do
+	for _, entry := range []struct {
+		module string
+		key    string
+		values []json.RawMessage
+	}{
+		{module: authtypes.ModuleName, key: "accounts", values: g.Accounts},
+		{module: banktypes.ModuleName, key: "balances", values: g.Balances},
+		{module: genutiltypes.ModuleName, key: "gen_txs", values: g.GenTxs},
+	} {
+		moduleState := make(genesisDocument)
+		if err := appState.get(entry.module, &moduleState); err != nil {
+			return "", "", err
+		}
+		values := []json.RawMessage{}
+		if err := moduleState.get(entry.key, &values); err != nil {
+			return "", "", err
+		}
+		if err := moduleState.set(entry.key, append(values, entry.values...)); err != nil {
+			return "", "", err
+		}
+
+		// The supply of the bank module is increased by the balances of the accounts
+		if entry.module == banktypes.ModuleName {
+			supply := sdk.Coins{}
+			if err := moduleState.get("supply", &supply); err != nil {
+				return "", "", err
+			}
+			if !g.Supply.Empty() {
+				supply = supply.Add(g.Supply...)
+			}
+			if err := moduleState.set("supply", supply); err != nil {
+				return "", "", err
+			}
+		}
+
+		if err := appState.set(entry.module, moduleState); err != nil {
+			return "", "", err
+		}
+	}
+	if err := document.set("app_state", appState); err != nil {
+		return "", "", err
+	}
+
+	bz, err := json.Marshal(document)
+	if err != nil {
+		return "", "", err
+	}
+	hash := sha256.Sum256(bz)
+	return string(bz), hex.EncodeToString(hash[:]), nil
+}
+
+// GenesisAccount returns the genesis account of the vesting account with its total balance
+func (m VestingAccount) GenesisAccount(baseAccount *authtypes.BaseAccount) (authtypes.GenesisAccount, sdk.Coins, error) {
+	switch options := m.VestingOptions.Options.(type) {
+	case *VestingOptions_DelayedVesting:
+		dv := options.DelayedVesting
+		return vestingtypes.NewDelayedVestingAccount(baseAccount, dv.Vesting, dv.EndTime),
+			m.StartingBalance.Add(dv.Vesting...),
+			nil
+	case *VestingOptions_ContinuousVesting:
+		cv := options.ContinuousVesting
+		return vestingtypes.NewContinuousVestingAccount(baseAccount, cv.Vesting, cv.StartTime, cv.EndTime),
+			m.StartingBalance.Add(cv.Vesting...),
+			nil
+	case *VestingOptions_PeriodicVesting:
+		pv := options.PeriodicVesting
+		vesting, err := m.VestingOptions.TotalVesting()
+		if err != nil {
+			return nil, nil, err
+		}
+		return vestingtypes.NewPeriodicVestingAccount(baseAccount, vesting, pv.StartTime, pv.VestingPeriods()),
+			m.StartingBalance.Add(vesting...),
+			nil
+	default:
+		return nil, nil, fmt.Errorf("unrecognized vesting options for the account %s", m.Address)
+	}
+}
+
+// appendAccount appends a genesis account with its balance to the launch genesis
+func (g *LaunchGenesis) appendAccount(account authtypes.GenesisAccount, coins sdk.Coins) error {
+	accountBz, err := genesisCodec.MarshalInterfaceJSON(account)
+	if err != nil {
+		return err
+	}
+	balanceBz, err := genesisCodec.MarshalJSON(&banktypes.Balance{
+		Address: account.GetAddress().String(),
+		Coins:   coins,
+	})
+	if err != nil {
+		return err
+	}
+	g.Accounts = append(g.Accounts, accountBz)
+	g.Balances = append(g.Balances, balanceBz)
+	g.Supply = g.Supply.Add(coins...)
+	return nil
+}
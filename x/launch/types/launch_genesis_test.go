@@ -0,0 +1,215 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestNewLaunchGenesis(t *testing.T) {
+	chain := sample.Chain(0, 0)
+	chain.LaunchTriggered = true
+	chain.LaunchTimestamp = 1000
+
+	genesisAccount := sample.GenesisAccount(0, sample.Address())
+	vestingAccount := sample.VestingAccount(0, sample.Address())
+	genesisValidator := sample.GenesisValidator(0, sample.Address())
+	genesisValidator.GenTx = []byte(`{"body":{}}`)
+
+	invalidGentx := sample.GenesisValidator(0, sample.Address())
+	invalidGentx.GenTx = []byte("foo")
+	invalidAccount := sample.GenesisAccount(0, "foo")
+
+	t.Run("should generate a genesis", func(t *testing.T) {
+		genesis, err := types.NewLaunchGenesis(
+			chain,
+			[]types.GenesisAccount{genesisAccount},
+			[]types.VestingAccount{vestingAccount},
+			[]types.GenesisValidator{genesisValidator},
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, chain.GenesisChainID, genesis.ChainID)
+		require.EqualValues(t, chain.LaunchTimestamp, genesis.GenesisTime.Unix())
+		require.Len(t, genesis.Accounts, 2)
+
+		require.Len(t, genesis.Balances, 2)
+		var balance banktypes.Balance
+		require.NoError(t, sample.Codec().UnmarshalJSON(genesis.Balances[0], &balance))
+		require.EqualValues(t, genesisAccount.Address, balance.Address)
+		require.True(t, genesisAccount.Coins.IsEqual(balance.Coins))
+		require.NoError(t, sample.Codec().UnmarshalJSON(genesis.Balances[1], &balance))
+		require.EqualValues(t, vestingAccount.Address, balance.Address)
+		vesting, err := vestingAccount.VestingOptions.TotalVesting()
+		require.NoError(t, err)
+		vestingBalance := vestingAccount.StartingBalance.Add(vesting...)
+		require.True(t, vestingBalance.IsEqual(balance.Coins))
+		require.True(t, genesisAccount.Coins.Add(vestingBalance...).IsEqual(genesis.Supply))
+
+		require.Len(t, genesis.GenTxs, 1)
+		require.JSONEq(t, string(genesisValidator.GenTx), string(genesis.GenTxs[0]))
+	})
+
+	t.Run("should generate an empty genesis", func(t *testing.T) {
+		genesis, err := types.NewLaunchGenesis(sample.Chain(0, 0), nil, nil, nil)
+		require.NoError(t, err)
+		require.True(t, genesis.GenesisTime.IsZero())
+		require.Empty(t, genesis.Accounts)
+		require.Empty(t, genesis.Balances)
+		require.Empty(t, genesis.GenTxs)
+	})
+
+	t.Run("should prevent generating a genesis with an invalid gentx", func(t *testing.T) {
+		_, err := types.NewLaunchGenesis(chain, nil, nil, []types.GenesisValidator{invalidGentx})
+		require.Error(t, err)
+	})
+
+	t.Run("should prevent generating a genesis with an invalid account", func(t *testing.T) {
+		_, err := types.NewLaunchGenesis(chain, []types.GenesisAccount{invalidAccount}, nil, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestLaunchGenesis_Apply(t *testing.T) {
+	chain := sample.Chain(0, 0)
+	chain.LaunchTriggered = true
+	chain.LaunchTimestamp = 1000
+	accounts := []types.GenesisAccount{
+		sample.GenesisAccount(0, sample.Address()),
+		sample.GenesisAccount(0, sample.Address()),
+	}
+	validator := sample.GenesisValidator(0, sample.Address())
+	validator.GenTx = []byte(`{"body":{}}`)
+
+	genesis, err := types.NewLaunchGenesis(chain, accounts, nil, []types.GenesisValidator{validator})
+	require.NoError(t, err)
+	initialGenesis, err := types.DefaultGenesisDocument(chain.GenesisChainID)
+	require.NoError(t, err)
+
+	t.Run("should apply the launch genesis on the initial genesis", func(t *testing.T) {
+		genesisJSON, hash, err := genesis.Apply(initialGenesis)
+		require.NoError(t, err)
+		require.EqualValues(t, types.GenesisURLHash(genesisJSON), hash)
+
+		genesisDoc, err := tmtypes.GenesisDocFromJSON([]byte(genesisJSON))
+		require.NoError(t, err)
+		require.EqualValues(t, chain.GenesisChainID, genesisDoc.ChainID)
+		require.EqualValues(t, chain.LaunchTimestamp, genesisDoc.GenesisTime.Unix())
+
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(genesisDoc.AppState, &appState))
+
+		var auth authtypes.GenesisState
+		require.NoError(t, sample.Codec().UnmarshalJSON(appState[authtypes.ModuleName], &auth))
+		require.Len(t, auth.Accounts, 2)
+		require.NoError(t, auth.Params.Validate())
+
+		var bank banktypes.GenesisState
+		require.NoError(t, sample.Codec().UnmarshalJSON(appState[banktypes.ModuleName], &bank))
+		require.Len(t, bank.Balances, 2)
+		require.True(t, accounts[0].Coins.Add(accounts[1].Coins...).IsEqual(bank.Supply))
+		require.NoError(t, bank.Validate())
+
+		var genutil genutiltypes.GenesisState
+		require.NoError(t, sample.Codec().UnmarshalJSON(appState[genutiltypes.ModuleName], &genutil))
+		require.Len(t, genutil.GenTxs, 1)
+
+		// the state of the other modules is kept
+		require.Contains(t, appState, stakingtypes.ModuleName)
+	})
+
+	t.Run("should generate a deterministic genesis", func(t *testing.T) {
+		genesisJSON, hash, err := genesis.Apply(initialGenesis)
+		require.NoError(t, err)
+
+		genesis, err := types.NewLaunchGenesis(chain, accounts, nil, []types.GenesisValidator{validator})
+		require.NoError(t, err)
+		initialGenesis, err := types.DefaultGenesisDocument(chain.GenesisChainID)
+		require.NoError(t, err)
+		genesisJSON2, hash2, err := genesis.Apply(initialGenesis)
+		require.NoError(t, err)
+		require.EqualValues(t, genesisJSON, genesisJSON2)
+		require.EqualValues(t, hash, hash2)
+	})
+
+	t.Run("should prevent applying the launch genesis on an invalid initial genesis", func(t *testing.T) {
+		_, _, err := genesis.Apply([]byte("foo"))
+		require.Error(t, err)
+		_, _, err = genesis.Apply([]byte(`{"app_state":{"bank":{"balances":"foo"}}}`))
+		require.Error(t, err)
+	})
+}
+
+func TestVestingAccount_GenesisAccount(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		options types.VestingOptions
+		check   func(t *testing.T, acc authtypes.GenesisAccount, options types.VestingOptions)
+	}{
+		{
+			name:    "delayed vesting",
+			options: sample.VestingOptions(),
+			check: func(t *testing.T, acc authtypes.GenesisAccount, options types.VestingOptions) {
+				dva, ok := acc.(*vestingtypes.DelayedVestingAccount)
+				require.True(t, ok)
+				require.True(t, options.GetDelayedVesting().Vesting.IsEqual(dva.OriginalVesting))
+				require.EqualValues(t, options.GetDelayedVesting().EndTime, dva.EndTime)
+			},
+		},
+		{
+			name:    "continuous vesting",
+			options: sample.ContinuousVestingOptions(),
+			check: func(t *testing.T, acc authtypes.GenesisAccount, options types.VestingOptions) {
+				cva, ok := acc.(*vestingtypes.ContinuousVestingAccount)
+				require.True(t, ok)
+				require.True(t, options.GetContinuousVesting().Vesting.IsEqual(cva.OriginalVesting))
+				require.EqualValues(t, options.GetContinuousVesting().StartTime, cva.StartTime)
+				require.EqualValues(t, options.GetContinuousVesting().EndTime, cva.EndTime)
+			},
+		},
+		{
+			name:    "periodic vesting",
+			options: sample.PeriodicVestingOptions(),
+			check: func(t *testing.T, acc authtypes.GenesisAccount, options types.VestingOptions) {
+				pva, ok := acc.(*vestingtypes.PeriodicVestingAccount)
+				require.True(t, ok)
+				pv := options.GetPeriodicVesting()
+				require.EqualValues(t, pv.StartTime, pva.StartTime)
+				require.EqualValues(t, pv.VestingPeriods(), pva.VestingPeriods)
+				require.NoError(t, pva.Validate())
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			address := sample.AccAddress()
+			vestingAccount := sample.VestingAccount(0, address.String())
+			vestingAccount.VestingOptions = tc.options
+
+			acc, balance, err := vestingAccount.GenesisAccount(authtypes.NewBaseAccountWithAddress(address))
+			require.NoError(t, err)
+			require.EqualValues(t, address, acc.GetAddress())
+			require.NoError(t, acc.Validate())
+			tc.check(t, acc, tc.options)
+
+			vesting, err := tc.options.TotalVesting()
+			require.NoError(t, err)
+			require.True(t, balance.IsEqual(vestingAccount.StartingBalance.Add(vesting...)))
+		})
+	}
+
+	t.Run("unrecognized vesting options", func(t *testing.T) {
+		address := sample.AccAddress()
+		vestingAccount := sample.VestingAccount(0, address.String())
+		vestingAccount.VestingOptions = types.VestingOptions{}
+		_, _, err := vestingAccount.GenesisAccount(authtypes.NewBaseAccountWithAddress(address))
+		require.Error(t, err)
+	})
+}
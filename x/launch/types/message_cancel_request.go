@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelRequest = "cancel_request"
+
+var _ sdk.Msg = &MsgCancelRequest{}
+
+func NewMsgCancelRequest(creator string, launchID, requestID uint64) *MsgCancelRequest {
+	return &MsgCancelRequest{
+		Creator:   creator,
+		LaunchID:  launchID,
+		RequestID: requestID,
+	}
+}
+
+func (msg *MsgCancelRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelRequest) Type() string {
+	return TypeMsgCancelRequest
+}
+
+func (msg *MsgCancelRequest) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgCancelRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgCancelRequest
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: types.MsgCancelRequest{
+				Creator:   "invalid_address",
+				LaunchID:  0,
+				RequestID: 10,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgCancelRequest{
+				Creator:   sample.Address(),
+				LaunchID:  0,
+				RequestID: 10,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgClaimRewards = "claim_rewards"
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+func NewMsgClaimRewards(validator string, launchID uint64) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Validator: validator,
+		LaunchID:  launchID,
+	}
+}
+
+func (msg *MsgClaimRewards) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimRewards) Type() string {
+	return TypeMsgClaimRewards
+}
+
+func (msg *MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{validator}
+}
+
+func (msg *MsgClaimRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgClaimRewards_ValidateBasic(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		msg   types.MsgClaimRewards
+		valid bool
+	}{
+		{
+			desc:  "valid message",
+			msg:   *types.NewMsgClaimRewards(sample.Address(), 0),
+			valid: true,
+		},
+		{
+			desc:  "invalid validator address",
+			msg:   *types.NewMsgClaimRewards("invalid", 0),
+			valid: false,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ func NewMsgCreateChain(
 	genesisHash string,
 	hasCampaign bool,
 	campaignID uint64,
+	limits ChainLimits,
 ) *MsgCreateChain {
 	return &MsgCreateChain{
 		Coordinator:    coordinator,
@@ -30,6 +31,7 @@ func NewMsgCreateChain(
 		GenesisHash:    genesisHash,
 		HasCampaign:    hasCampaign,
 		CampaignID:     campaignID,
+		Limits:         limits,
 	}
 }
 
@@ -69,5 +71,9 @@ func (msg *MsgCreateChain) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInitialGenesis, "hash of custom genesis must be sha256")
 	}
 
+	if err := msg.Limits.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidChainLimits, err.Error())
+	}
+
 	return nil
 }
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
@@ -15,6 +16,12 @@ func TestMsgCreateChain_ValidateBasic(t *testing.T) {
 	invalidGenesisChainID := sample.MsgCreateChain(sample.Address(), "", false, 0)
 	invalidGenesisChainID.GenesisChainID = "invalid"
 
+	withLimits := sample.MsgCreateChain(sample.Address(), "", false, 0)
+	withLimits.Limits = sample.ChainLimits()
+
+	invalidLimits := sample.MsgCreateChain(sample.Address(), "", false, 0)
+	invalidLimits.Limits.MaxAccountCoins = sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}}
+
 	for _, tc := range []struct {
 		desc  string
 		msg   types.MsgCreateChain
@@ -30,6 +37,11 @@ func TestMsgCreateChain_ValidateBasic(t *testing.T) {
 			msg:   sample.MsgCreateChain(sample.Address(), "foo.com", false, 0),
 			valid: true,
 		},
+		{
+			desc:  "valid message with limits",
+			msg:   withLimits,
+			valid: true,
+		},
 		{
 			desc:  "invalid address",
 			msg:   sample.MsgCreateChain("invalid", "", false, 0),
@@ -45,6 +57,11 @@ func TestMsgCreateChain_ValidateBasic(t *testing.T) {
 			msg:   invalidGenesisChainID,
 			valid: false,
 		},
+		{
+			desc:  "invalid limits",
+			msg:   invalidLimits,
+			valid: false,
+		},
 	} {
 		tc := tc
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDistributeRewards = "distribute_rewards"
+
+var _ sdk.Msg = &MsgDistributeRewards{}
+
+func NewMsgDistributeRewards(signer string, launchID uint64, lastBlockHeight int64) *MsgDistributeRewards {
+	return &MsgDistributeRewards{
+		Signer:          signer,
+		LaunchID:        launchID,
+		LastBlockHeight: lastBlockHeight,
+	}
+}
+
+func (msg *MsgDistributeRewards) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDistributeRewards) Type() string {
+	return TypeMsgDistributeRewards
+}
+
+func (msg *MsgDistributeRewards) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgDistributeRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDistributeRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if msg.LastBlockHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidRewardHeight, "%d", msg.LastBlockHeight)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgDistributeRewards_ValidateBasic(t *testing.T) {
+	addr := sample.Address()
+	launchID := uint64(0)
+
+	for _, tc := range []struct {
+		desc  string
+		msg   types.MsgDistributeRewards
+		valid bool
+	}{
+		{
+			desc:  "valid message",
+			msg:   *types.NewMsgDistributeRewards(addr, launchID, 100),
+			valid: true,
+		},
+		{
+			desc:  "chain that produced no block",
+			msg:   *types.NewMsgDistributeRewards(addr, launchID, 0),
+			valid: true,
+		},
+		{
+			desc:  "invalid signer address",
+			msg:   *types.NewMsgDistributeRewards("invalid", launchID, 100),
+			valid: false,
+		},
+		{
+			desc:  "negative last block height",
+			msg:   *types.NewMsgDistributeRewards(addr, launchID, -1),
+			valid: false,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -18,14 +18,18 @@ func NewMsgEditChain(
 	sourceURL,
 	sourceHash string,
 	initialGenesis *InitialGenesis,
+	limits *ChainLimits,
+	autoApprovePolicy *AutoApprovePolicy,
 ) *MsgEditChain {
 	return &MsgEditChain{
-		Coordinator:    coordinator,
-		LaunchID:       launchID,
-		GenesisChainID: genesisChainID,
-		SourceURL:      sourceURL,
-		SourceHash:     sourceHash,
-		InitialGenesis: initialGenesis,
+		Coordinator:       coordinator,
+		LaunchID:          launchID,
+		GenesisChainID:    genesisChainID,
+		SourceURL:         sourceURL,
+		SourceHash:        sourceHash,
+		InitialGenesis:    initialGenesis,
+		Limits:            limits,
+		AutoApprovePolicy: autoApprovePolicy,
 	}
 }
 
@@ -62,7 +66,8 @@ func (msg *MsgEditChain) ValidateBasic() error {
 		}
 	}
 
-	if msg.GenesisChainID == "" && msg.SourceURL == "" && msg.InitialGenesis == nil {
+	if msg.GenesisChainID == "" && msg.SourceURL == "" && msg.InitialGenesis == nil &&
+		msg.Limits == nil && msg.AutoApprovePolicy == nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no value to edit")
 	}
 
@@ -72,5 +77,17 @@ func (msg *MsgEditChain) ValidateBasic() error {
 		}
 	}
 
+	if msg.Limits != nil {
+		if err := msg.Limits.Validate(); err != nil {
+			return sdkerrors.Wrap(ErrInvalidChainLimits, err.Error())
+		}
+	}
+
+	if msg.AutoApprovePolicy != nil {
+		if err := msg.AutoApprovePolicy.Validate(); err != nil {
+			return sdkerrors.Wrap(ErrInvalidAutoApprovePolicy, err.Error())
+		}
+	}
+
 	return nil
 }
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
@@ -32,6 +33,52 @@ func TestMsgEditChain_ValidateBasic(t *testing.T) {
 	)
 	msgInvalidGenesisChainID.GenesisChainID = "invalid"
 
+	msgNewLimits := sample.MsgEditChain(
+		sample.Address(),
+		launchID,
+		false,
+		false,
+		false,
+		false,
+	)
+	limits := sample.ChainLimits()
+	msgNewLimits.Limits = &limits
+
+	msgNewAutoApprovePolicy := sample.MsgEditChain(
+		sample.Address(),
+		launchID,
+		false,
+		false,
+		false,
+		false,
+	)
+	autoApprovePolicy := sample.AutoApprovePolicy()
+	msgNewAutoApprovePolicy.AutoApprovePolicy = &autoApprovePolicy
+
+	msgInvalidAutoApprovePolicy := sample.MsgEditChain(
+		sample.Address(),
+		launchID,
+		false,
+		false,
+		false,
+		false,
+	)
+	msgInvalidAutoApprovePolicy.AutoApprovePolicy = &types.AutoApprovePolicy{
+		AllowedAddresses: []string{"invalid"},
+	}
+
+	msgInvalidLimits := sample.MsgEditChain(
+		sample.Address(),
+		launchID,
+		false,
+		false,
+		false,
+		false,
+	)
+	msgInvalidLimits.Limits = &types.ChainLimits{
+		MaxAccountCoins: sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)}},
+	}
+
 	for _, tc := range []struct {
 		desc  string
 		msg   types.MsgEditChain
@@ -97,6 +144,16 @@ func TestMsgEditChain_ValidateBasic(t *testing.T) {
 			),
 			valid: true,
 		},
+		{
+			desc:  "valid message with new limits",
+			msg:   msgNewLimits,
+			valid: true,
+		},
+		{
+			desc:  "valid message with new auto approve policy",
+			msg:   msgNewAutoApprovePolicy,
+			valid: true,
+		},
 		{
 			desc: "invalid coordinator address",
 			msg: sample.MsgEditChain(
@@ -131,6 +188,16 @@ func TestMsgEditChain_ValidateBasic(t *testing.T) {
 			msg:   msgInvalidGenesisChainID,
 			valid: false,
 		},
+		{
+			desc:  "invalid limits",
+			msg:   msgInvalidLimits,
+			valid: false,
+		},
+		{
+			desc:  "invalid auto approve policy",
+			msg:   msgInvalidAutoApprovePolicy,
+			valid: false,
+		},
 	} {
 		tc := tc
 		t.Run(tc.desc, func(t *testing.T) {
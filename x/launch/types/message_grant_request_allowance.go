@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgGrantRequestAllowance = "grant_request_allowance"
+
+var _ sdk.Msg = &MsgGrantRequestAllowance{}
+
+func NewMsgGrantRequestAllowance(
+	coordinator string,
+	launchID uint64,
+	grantee string,
+	spendLimit sdk.Coins,
+	expiration int64,
+) *MsgGrantRequestAllowance {
+	return &MsgGrantRequestAllowance{
+		Coordinator: coordinator,
+		LaunchID:    launchID,
+		Grantee:     grantee,
+		SpendLimit:  spendLimit,
+		Expiration:  expiration,
+	}
+}
+
+func (msg *MsgGrantRequestAllowance) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgGrantRequestAllowance) Type() string {
+	return TypeMsgGrantRequestAllowance
+}
+
+func (msg *MsgGrantRequestAllowance) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgGrantRequestAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgGrantRequestAllowance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+
+	if msg.Coordinator == msg.Grantee {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "the coordinator can't grant an allowance to itself")
+	}
+
+	// The allowance must be bounded
+	if msg.SpendLimit.Empty() {
+		return sdkerrors.Wrap(ErrInvalidCoins, "the spend limit must not be empty")
+	}
+	if err := msg.SpendLimit.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidCoins, err.Error())
+	}
+
+	if msg.Expiration < 0 {
+		return sdkerrors.Wrapf(ErrInvalidExpiration, "%d", msg.Expiration)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgGrantRequestAllowance_ValidateBasic(t *testing.T) {
+	coordAddr := sample.Address()
+	grantee := sample.Address()
+	launchID := uint64(0)
+	invalidCoins := sdk.Coins{sdk.Coin{Denom: "invalid denom", Amount: sdk.ZeroInt()}}
+
+	for _, tc := range []struct {
+		desc  string
+		msg   types.MsgGrantRequestAllowance
+		valid bool
+	}{
+		{
+			desc:  "valid message",
+			msg:   *types.NewMsgGrantRequestAllowance(coordAddr, launchID, grantee, sample.Coins(), 1000),
+			valid: true,
+		},
+		{
+			desc:  "valid message without expiration",
+			msg:   *types.NewMsgGrantRequestAllowance(coordAddr, launchID, grantee, sample.Coins(), 0),
+			valid: true,
+		},
+		{
+			desc:  "invalid coordinator address",
+			msg:   *types.NewMsgGrantRequestAllowance("invalid", launchID, grantee, sample.Coins(), 1000),
+			valid: false,
+		},
+		{
+			desc:  "invalid grantee address",
+			msg:   *types.NewMsgGrantRequestAllowance(coordAddr, launchID, "invalid", sample.Coins(), 1000),
+			valid: false,
+		},
+		{
+			desc:  "grantee is the coordinator",
+			msg:   *types.NewMsgGrantRequestAllowance(coordAddr, launchID, coordAddr, sample.Coins(), 1000),
+			valid: false,
+		},
+		{
+			desc:  "empty spend limit",
+			msg:   *types.NewMsgGrantRequestAllowance(coordAddr, launchID, grantee, sdk.NewCoins(), 1000),
+			valid: false,
+		},
+		{
+			desc:  "invalid spend limit",
+			msg:   *types.NewMsgGrantRequestAllowance(coordAddr, launchID, grantee, invalidCoins, 1000),
+			valid: false,
+		},
+		{
+			desc:  "negative expiration",
+			msg:   *types.NewMsgGrantRequestAllowance(coordAddr, launchID, grantee, sample.Coins(), -1),
+			valid: false,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
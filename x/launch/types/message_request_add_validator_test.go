@@ -12,13 +12,15 @@ import (
 func TestMsgRequestAddValidator_ValidateBasic(t *testing.T) {
 	launchID := uint64(0)
 
-	validMsg := sample.MsgRequestAddValidator(sample.Address(), launchID)
+	validMsg := sample.MsgRequestAddValidator(sample.PrivKey(), launchID, sample.GenesisChainID())
+	invalidAddress := validMsg
+	invalidAddress.ValAddress = "invalid"
 	emptyConsPubKey := validMsg
 	emptyConsPubKey.ConsPubKey = []byte{}
 	emptyGentx := validMsg
 	emptyGentx.GenTx = []byte{}
 	emptyPeer := validMsg
-	emptyPeer.Peer = ""
+	emptyPeer.Peer = types.Peer{}
 	invalidSelfDelegation := validMsg
 	invalidSelfDelegation.SelfDelegation.Denom = ""
 	zeroDelegation := validMsg
@@ -36,7 +38,7 @@ func TestMsgRequestAddValidator_ValidateBasic(t *testing.T) {
 		},
 		{
 			name:  "invalid address",
-			msg:   sample.MsgRequestAddValidator("invalid", launchID),
+			msg:   invalidAddress,
 			valid: false,
 		},
 		{
@@ -53,6 +53,44 @@ func TestMsgRequestAddVestingAccount_ValidateBasic(t *testing.T) {
 			},
 			err: types.ErrInvalidVestingOption,
 		},
+		{
+			name: "invalid continuous vesting option",
+			msg: types.MsgRequestAddVestingAccount{
+				Address:         sample.Address(),
+				LaunchID:        launchID,
+				StartingBalance: sample.Coins(),
+				Options:         *types.NewContinuousVesting(sample.Coins(), time.Now().Unix(), 0),
+			},
+			err: types.ErrInvalidVestingOption,
+		},
+		{
+			name: "invalid periodic vesting option",
+			msg: types.MsgRequestAddVestingAccount{
+				Address:         sample.Address(),
+				LaunchID:        launchID,
+				StartingBalance: sample.Coins(),
+				Options:         *types.NewPeriodicVesting(time.Now().Unix(), nil),
+			},
+			err: types.ErrInvalidVestingOption,
+		},
+		{
+			name: "valid message with continuous vesting",
+			msg: types.MsgRequestAddVestingAccount{
+				Address:         sample.Address(),
+				LaunchID:        launchID,
+				StartingBalance: sample.Coins(),
+				Options:         sample.ContinuousVestingOptions(),
+			},
+		},
+		{
+			name: "valid message with periodic vesting",
+			msg: types.MsgRequestAddVestingAccount{
+				Address:         sample.Address(),
+				LaunchID:        launchID,
+				StartingBalance: sample.Coins(),
+				Options:         sample.PeriodicVestingOptions(),
+			},
+		},
 		{
 			name: "valid message",
 			msg: types.MsgRequestAddVestingAccount{
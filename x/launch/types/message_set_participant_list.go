@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetParticipantList = "set_participant_list"
+
+var _ sdk.Msg = &MsgSetParticipantList{}
+
+func NewMsgSetParticipantList(
+	coordinator string,
+	launchID uint64,
+	listType ParticipantList_ListType,
+	addresses []string,
+) *MsgSetParticipantList {
+	return &MsgSetParticipantList{
+		Coordinator: coordinator,
+		LaunchID:    launchID,
+		ListType:    listType,
+		Addresses:   addresses,
+	}
+}
+
+func (msg *MsgSetParticipantList) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetParticipantList) Type() string {
+	return TypeMsgSetParticipantList
+}
+
+func (msg *MsgSetParticipantList) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgSetParticipantList) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetParticipantList) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+
+	// An empty address list removes the participant list of the chain
+	if len(msg.Addresses) == 0 {
+		return nil
+	}
+	participantList := NewParticipantList(msg.LaunchID, msg.ListType, msg.Addresses)
+	if err := participantList.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParticipantList, err.Error())
+	}
+
+	return nil
+}
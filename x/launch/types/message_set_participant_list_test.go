@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgSetParticipantList_ValidateBasic(t *testing.T) {
+	var (
+		coordinator = sample.Address()
+		addr        = sample.Address()
+		launchID    = uint64(0)
+		addresses   = []string{sample.Address(), sample.Address()}
+	)
+
+	for _, tc := range []struct {
+		desc  string
+		msg   types.MsgSetParticipantList
+		valid bool
+	}{
+		{
+			desc:  "valid allowlist",
+			msg:   *types.NewMsgSetParticipantList(coordinator, launchID, types.ParticipantList_ALLOWLIST, addresses),
+			valid: true,
+		},
+		{
+			desc:  "valid denylist",
+			msg:   *types.NewMsgSetParticipantList(coordinator, launchID, types.ParticipantList_DENYLIST, addresses),
+			valid: true,
+		},
+		{
+			desc:  "empty addresses to remove the participant list",
+			msg:   *types.NewMsgSetParticipantList(coordinator, launchID, types.ParticipantList_ALLOWLIST, []string{}),
+			valid: true,
+		},
+		{
+			desc:  "unspecified list type",
+			msg:   *types.NewMsgSetParticipantList(coordinator, launchID, types.ParticipantList_LIST_TYPE_UNSPECIFIED, addresses),
+			valid: false,
+		},
+		{
+			desc:  "invalid coordinator address",
+			msg:   *types.NewMsgSetParticipantList("invalid", launchID, types.ParticipantList_ALLOWLIST, addresses),
+			valid: false,
+		},
+		{
+			desc:  "invalid participant address",
+			msg:   *types.NewMsgSetParticipantList(coordinator, launchID, types.ParticipantList_ALLOWLIST, []string{"invalid"}),
+			valid: false,
+		},
+		{
+			desc:  "duplicated participant address",
+			msg:   *types.NewMsgSetParticipantList(coordinator, launchID, types.ParticipantList_DENYLIST, []string{addr, addr}),
+			valid: false,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
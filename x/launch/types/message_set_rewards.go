@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetRewards = "set_rewards"
+
+var _ sdk.Msg = &MsgSetRewards{}
+
+func NewMsgSetRewards(coordinator string, launchID uint64, coins sdk.Coins, lastRewardHeight int64) *MsgSetRewards {
+	return &MsgSetRewards{
+		Coordinator:      coordinator,
+		LaunchID:         launchID,
+		Coins:            coins,
+		LastRewardHeight: lastRewardHeight,
+	}
+}
+
+func (msg *MsgSetRewards) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRewards) Type() string {
+	return TypeMsgSetRewards
+}
+
+func (msg *MsgSetRewards) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgSetRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+
+	if err := msg.Coins.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidCoins, err.Error())
+	}
+
+	// An empty coin list removes the reward pool of the chain
+	if msg.LastRewardHeight < 0 || (!msg.Coins.Empty() && msg.LastRewardHeight == 0) {
+		return sdkerrors.Wrapf(ErrInvalidRewardHeight, "%d", msg.LastRewardHeight)
+	}
+
+	return nil
+}
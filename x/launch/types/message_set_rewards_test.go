@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgSetRewards_ValidateBasic(t *testing.T) {
+	addr := sample.Address()
+	launchID := uint64(0)
+	invalidCoins := sdk.Coins{sdk.Coin{Denom: "invalid denom", Amount: sdk.ZeroInt()}}
+
+	for _, tc := range []struct {
+		desc  string
+		msg   types.MsgSetRewards
+		valid bool
+	}{
+		{
+			desc:  "valid message",
+			msg:   *types.NewMsgSetRewards(addr, launchID, sample.Coins(), 100),
+			valid: true,
+		},
+		{
+			desc:  "empty coins to remove rewards",
+			msg:   *types.NewMsgSetRewards(addr, launchID, sdk.NewCoins(), 0),
+			valid: true,
+		},
+		{
+			desc:  "invalid coordinator address",
+			msg:   *types.NewMsgSetRewards("invalid", launchID, sample.Coins(), 100),
+			valid: false,
+		},
+		{
+			desc:  "invalid coins",
+			msg:   *types.NewMsgSetRewards(addr, launchID, invalidCoins, 100),
+			valid: false,
+		},
+		{
+			desc:  "no last reward height",
+			msg:   *types.NewMsgSetRewards(addr, launchID, sample.Coins(), 0),
+			valid: false,
+		},
+		{
+			desc:  "negative last reward height",
+			msg:   *types.NewMsgSetRewards(addr, launchID, sdk.NewCoins(), -1),
+			valid: false,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -9,12 +9,19 @@ const TypeMsgSettleRequest = "settle_request"
 
 var _ sdk.Msg = &MsgSettleRequest{}
 
-func NewMsgSettleRequest(coordinator string, launchID uint64, requestID uint64, approve bool) *MsgSettleRequest {
+func NewMsgSettleRequest(
+	coordinator string,
+	launchID uint64,
+	requestID uint64,
+	approve bool,
+	rejectionReason string,
+) *MsgSettleRequest {
 	return &MsgSettleRequest{
-		Coordinator: coordinator,
-		LaunchID:    launchID,
-		RequestID:   requestID,
-		Approve:     approve,
+		Coordinator:     coordinator,
+		LaunchID:        launchID,
+		RequestID:       requestID,
+		Approve:         approve,
+		RejectionReason: rejectionReason,
 	}
 }
 
@@ -45,5 +52,5 @@ func (msg *MsgSettleRequest) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
 	}
 
-	return nil
+	return ValidateRejectionReason(msg.RejectionReason, !msg.Approve)
 }
@@ -26,6 +26,38 @@ func TestMsgSettleRequest_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "rejection reason for an approval",
+			msg: types.MsgSettleRequest{
+				Coordinator:     sample.Address(),
+				LaunchID:        launchID,
+				RequestID:       10,
+				Approve:         true,
+				RejectionReason: "invalid account",
+			},
+			err: types.ErrInvalidRejectionReason,
+		},
+		{
+			name: "too long rejection reason",
+			msg: types.MsgSettleRequest{
+				Coordinator:     sample.Address(),
+				LaunchID:        launchID,
+				RequestID:       10,
+				Approve:         false,
+				RejectionReason: sample.String(types.MaxRejectionReasonLength + 1),
+			},
+			err: types.ErrInvalidRejectionReason,
+		},
+		{
+			name: "valid rejection with a reason",
+			msg: types.MsgSettleRequest{
+				Coordinator:     sample.Address(),
+				LaunchID:        launchID,
+				RequestID:       10,
+				Approve:         false,
+				RejectionReason: "invalid account",
+			},
+		},
 		{
 			name: "valid message",
 			msg: types.MsgSettleRequest{
@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSettleRequests = "settle_requests"
+
+	// MaxSettledRequests is the maximum count of requests that can be settled in a single message
+	MaxSettledRequests = 100
+)
+
+var _ sdk.Msg = &MsgSettleRequests{}
+
+func NewMsgSettleRequests(
+	coordinator string,
+	launchID uint64,
+	approvedRequestIDs,
+	rejectedRequestIDs []uint64,
+	rejectionReason string,
+) *MsgSettleRequests {
+	return &MsgSettleRequests{
+		Coordinator:        coordinator,
+		LaunchID:           launchID,
+		ApprovedRequestIDs: approvedRequestIDs,
+		RejectedRequestIDs: rejectedRequestIDs,
+		RejectionReason:    rejectionReason,
+	}
+}
+
+func (msg *MsgSettleRequests) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSettleRequests) Type() string {
+	return TypeMsgSettleRequests
+}
+
+func (msg *MsgSettleRequests) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgSettleRequests) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSettleRequests) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+
+	if len(msg.ApprovedRequestIDs) == 0 && len(msg.RejectedRequestIDs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRequestIDs, "no request to settle")
+	}
+	if len(msg.ApprovedRequestIDs)+len(msg.RejectedRequestIDs) > MaxSettledRequests {
+		return sdkerrors.Wrapf(ErrInvalidRequestIDs, "more than %d requests to settle", MaxSettledRequests)
+	}
+
+	// A request can't be settled twice
+	requestIDs := make(map[uint64]struct{})
+	for _, list := range [][]uint64{msg.ApprovedRequestIDs, msg.RejectedRequestIDs} {
+		for _, requestID := range list {
+			if _, ok := requestIDs[requestID]; ok {
+				return sdkerrors.Wrapf(ErrInvalidRequestIDs, "request %d is duplicated", requestID)
+			}
+			requestIDs[requestID] = struct{}{}
+		}
+	}
+
+	return ValidateRejectionReason(msg.RejectionReason, len(msg.RejectedRequestIDs) > 0)
+}
@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgSettleRequests_ValidateBasic(t *testing.T) {
+	launchID := uint64(0)
+	maxRequestIDs := make([]uint64, types.MaxSettledRequests)
+	for i := range maxRequestIDs {
+		maxRequestIDs[i] = uint64(i)
+	}
+	tests := []struct {
+		name string
+		msg  types.MsgSettleRequests
+		err  error
+	}{
+		{
+			name: "invalid coordinator address",
+			msg: types.MsgSettleRequests{
+				Coordinator:        "invalid_address",
+				LaunchID:           launchID,
+				ApprovedRequestIDs: []uint64{1, 2},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no request to settle",
+			msg: types.MsgSettleRequests{
+				Coordinator: sample.Address(),
+				LaunchID:    launchID,
+			},
+			err: types.ErrInvalidRequestIDs,
+		},
+		{
+			name: "duplicated approved request",
+			msg: types.MsgSettleRequests{
+				Coordinator:        sample.Address(),
+				LaunchID:           launchID,
+				ApprovedRequestIDs: []uint64{1, 2, 1},
+			},
+			err: types.ErrInvalidRequestIDs,
+		},
+		{
+			name: "request both approved and rejected",
+			msg: types.MsgSettleRequests{
+				Coordinator:        sample.Address(),
+				LaunchID:           launchID,
+				ApprovedRequestIDs: []uint64{1, 2},
+				RejectedRequestIDs: []uint64{3, 2},
+			},
+			err: types.ErrInvalidRequestIDs,
+		},
+		{
+			name: "too many requests",
+			msg: types.MsgSettleRequests{
+				Coordinator:        sample.Address(),
+				LaunchID:           launchID,
+				ApprovedRequestIDs: maxRequestIDs,
+				RejectedRequestIDs: []uint64{types.MaxSettledRequests},
+			},
+			err: types.ErrInvalidRequestIDs,
+		},
+		{
+			name: "rejection reason without rejected request",
+			msg: types.MsgSettleRequests{
+				Coordinator:        sample.Address(),
+				LaunchID:           launchID,
+				ApprovedRequestIDs: []uint64{1, 2},
+				RejectionReason:    "invalid account",
+			},
+			err: types.ErrInvalidRejectionReason,
+		},
+		{
+			name: "too long rejection reason",
+			msg: types.MsgSettleRequests{
+				Coordinator:        sample.Address(),
+				LaunchID:           launchID,
+				RejectedRequestIDs: []uint64{3},
+				RejectionReason:    sample.String(types.MaxRejectionReasonLength + 1),
+			},
+			err: types.ErrInvalidRejectionReason,
+		},
+		{
+			name: "valid message with the maximum count of requests",
+			msg: types.MsgSettleRequests{
+				Coordinator:        sample.Address(),
+				LaunchID:           launchID,
+				ApprovedRequestIDs: maxRequestIDs,
+			},
+		},
+		{
+			name: "valid message",
+			msg: types.MsgSettleRequests{
+				Coordinator:        sample.Address(),
+				LaunchID:           launchID,
+				ApprovedRequestIDs: []uint64{1, 2},
+				RejectedRequestIDs: []uint64{3},
+				RejectionReason:    "invalid account",
+			},
+		},
+		{
+			name: "valid message with only rejected requests",
+			msg: types.MsgSettleRequests{
+				Coordinator:        sample.Address(),
+				LaunchID:           launchID,
+				RejectedRequestIDs: []uint64{3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -16,8 +17,16 @@ var (
 
 	MaxParametrableLaunchTime = uint64(time.Hour.Seconds() * 24 * 31)
 
-	KeyMinLaunchTime = []byte("MinLaunchTime")
-	KeyMaxLaunchTime = []byte("MaxLaunchTime")
+	// DefaultRequestDeposit is empty, no deposit is required to create a request
+	DefaultRequestDeposit = sdk.NewCoins()
+
+	// DefaultChainCreationFee is empty, no fee is charged to create a chain
+	DefaultChainCreationFee = sdk.NewCoins()
+
+	KeyMinLaunchTime    = []byte("MinLaunchTime")
+	KeyMaxLaunchTime    = []byte("MaxLaunchTime")
+	KeyRequestDeposit   = []byte("RequestDeposit")
+	KeyChainCreationFee = []byte("ChainCreationFee")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -28,10 +37,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(minLaunchTime, maxLaunchTime uint64) Params {
+func NewParams(minLaunchTime, maxLaunchTime uint64, requestDeposit, chainCreationFee sdk.Coins) Params {
 	return Params{
-		MinLaunchTime: minLaunchTime,
-		MaxLaunchTime: maxLaunchTime,
+		MinLaunchTime:    minLaunchTime,
+		MaxLaunchTime:    maxLaunchTime,
+		RequestDeposit:   requestDeposit,
+		ChainCreationFee: chainCreationFee,
 	}
 }
 
@@ -40,6 +51,8 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultMinLaunchTime,
 		DefaultMaxLaunchTime,
+		DefaultRequestDeposit,
+		DefaultChainCreationFee,
 	)
 }
 
@@ -48,6 +61,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMinLaunchTime, &p.MinLaunchTime, validateLaunchTime),
 		paramtypes.NewParamSetPair(KeyMaxLaunchTime, &p.MaxLaunchTime, validateLaunchTime),
+		paramtypes.NewParamSetPair(KeyRequestDeposit, &p.RequestDeposit, validateCoins),
+		paramtypes.NewParamSetPair(KeyChainCreationFee, &p.ChainCreationFee, validateCoins),
 	}
 }
 
@@ -59,7 +74,13 @@ func (p Params) Validate() error {
 	if err := validateLaunchTime(p.MinLaunchTime); err != nil {
 		return err
 	}
-	return validateLaunchTime(p.MaxLaunchTime)
+	if err := validateLaunchTime(p.MaxLaunchTime); err != nil {
+		return err
+	}
+	if err := validateCoins(p.RequestDeposit); err != nil {
+		return err
+	}
+	return validateCoins(p.ChainCreationFee)
 }
 
 // String implements the Stringer interface.
@@ -79,3 +100,12 @@ func validateLaunchTime(i interface{}) error {
 	}
 	return nil
 }
+
+func validateCoins(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +29,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	MinLaunchTime uint64 `protobuf:"varint,1,opt,name=minLaunchTime,proto3" json:"minLaunchTime,omitempty" yaml:"min_launch_time"`
 	MaxLaunchTime uint64 `protobuf:"varint,2,opt,name=maxLaunchTime,proto3" json:"maxLaunchTime,omitempty" yaml:"max_launch_time"`
+	// requestDeposit is the deposit escrowed from the creator of a request until the request is settled
+	RequestDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=requestDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"requestDeposit" yaml:"request_deposit"`
+	// chainCreationFee is the fee paid to the community pool by the coordinator to create a chain
+	ChainCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=chainCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"chainCreationFee" yaml:"chain_creation_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,6 +81,20 @@ func (m *Params) GetMaxLaunchTime() uint64 {
 	return 0
 }
 
+func (m *Params) GetRequestDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RequestDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetChainCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ChainCreationFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "tendermint.spn.launch.Params")
 }
@@ -82,21 +102,30 @@ func init() {
 func init() { proto.RegisterFile("launch/params.proto", fileDescriptor_b8f73d6645a211b2) }
 
 var fileDescriptor_b8f73d6645a211b2 = []byte{
-	// 217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xce, 0x49, 0x2c, 0xcd,
-	0x4b, 0xce, 0xd0, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x2d, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2b, 0x2e, 0xc8, 0xd3,
-	0x83, 0xa8, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0x95,
-	0x26, 0x31, 0x72, 0xb1, 0x05, 0x80, 0x75, 0x0b, 0x39, 0x70, 0xf1, 0xe6, 0x66, 0xe6, 0xf9, 0x80,
-	0x55, 0x87, 0x64, 0xe6, 0xa6, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x38, 0x49, 0x7d, 0xba, 0x27,
-	0x2f, 0x56, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x94, 0x9b, 0x99, 0x17, 0x0f, 0x31, 0x2d, 0xbe, 0x24,
-	0x33, 0x37, 0x55, 0x29, 0x08, 0x55, 0x03, 0xd8, 0x84, 0xc4, 0x0a, 0x24, 0x13, 0x98, 0x30, 0x4c,
-	0x48, 0xac, 0x40, 0x37, 0x01, 0x59, 0x83, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0x4e, 0x27,
-	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
-	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a,
-	0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0xf0, 0xa6, 0x7e, 0x71, 0x41, 0x9e, 0x7e, 0x85, 0x3e, 0x34,
-	0x30, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xfe, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xb4, 0x3f, 0x83, 0x16, 0x23, 0x01, 0x00, 0x00,
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbf, 0x4e, 0x2a, 0x41,
+	0x18, 0xc5, 0x77, 0x2f, 0x84, 0x62, 0x6f, 0xee, 0x8d, 0x59, 0xff, 0x04, 0x28, 0x76, 0xc9, 0x56,
+	0x34, 0xce, 0x04, 0xed, 0xa8, 0xcc, 0x62, 0x2c, 0x8c, 0x26, 0x86, 0x58, 0xd9, 0x6c, 0x66, 0x97,
+	0x4f, 0x98, 0xc8, 0xcc, 0xac, 0x3b, 0x83, 0x81, 0x87, 0x30, 0xb1, 0xb0, 0xb0, 0xb4, 0xf6, 0x0d,
+	0x7c, 0x03, 0x4a, 0x4a, 0x2b, 0x34, 0xf0, 0x06, 0x3e, 0x81, 0x61, 0x66, 0x13, 0x10, 0x0a, 0x63,
+	0x35, 0x93, 0xcc, 0x77, 0x7e, 0xe7, 0x7c, 0x93, 0xe3, 0x6c, 0xf7, 0xc9, 0x80, 0x27, 0x3d, 0x9c,
+	0x92, 0x8c, 0x30, 0x89, 0xd2, 0x4c, 0x28, 0xe1, 0xee, 0x2a, 0xe0, 0x1d, 0xc8, 0x18, 0xe5, 0x0a,
+	0xc9, 0x94, 0x23, 0x33, 0x53, 0xdd, 0xe9, 0x8a, 0xae, 0xd0, 0x13, 0x78, 0x71, 0x33, 0xc3, 0x55,
+	0x2f, 0x11, 0x92, 0x09, 0x89, 0x63, 0x22, 0x01, 0xdf, 0x35, 0x62, 0x50, 0xa4, 0x81, 0x13, 0x41,
+	0xb9, 0x79, 0x0f, 0x5e, 0x0b, 0x4e, 0xe9, 0x42, 0xd3, 0xdd, 0x23, 0xe7, 0x1f, 0xa3, 0xfc, 0x4c,
+	0xd3, 0x2e, 0x29, 0x83, 0xb2, 0x5d, 0xb3, 0xeb, 0xc5, 0xb0, 0xfa, 0x39, 0xf5, 0xf7, 0x46, 0x84,
+	0xf5, 0x9b, 0x01, 0xa3, 0x3c, 0x32, 0x6e, 0x91, 0xa2, 0x0c, 0x82, 0xf6, 0x77, 0x81, 0x26, 0x90,
+	0xe1, 0x0a, 0xe1, 0xcf, 0x06, 0x81, 0x0c, 0xd7, 0x09, 0xab, 0x02, 0xf7, 0xde, 0x76, 0xfe, 0x67,
+	0x70, 0x3b, 0x00, 0xa9, 0x8e, 0x21, 0x15, 0x92, 0xaa, 0x72, 0xa1, 0x56, 0xa8, 0xff, 0x3d, 0xa8,
+	0x20, 0xb3, 0x08, 0x5a, 0x2c, 0x82, 0xf2, 0x45, 0x50, 0x4b, 0x50, 0x1e, 0x9e, 0x8e, 0xa7, 0xbe,
+	0xb5, 0xb4, 0xc8, 0xe5, 0x51, 0xc7, 0xe8, 0x83, 0x97, 0x77, 0xbf, 0xde, 0xa5, 0xaa, 0x37, 0x88,
+	0x51, 0x22, 0x18, 0xce, 0xff, 0xc3, 0x1c, 0xfb, 0xb2, 0x73, 0x83, 0xd5, 0x28, 0x05, 0xa9, 0x51,
+	0xb2, 0xbd, 0x66, 0xee, 0x3e, 0xda, 0xce, 0x56, 0xd2, 0x23, 0x94, 0xb7, 0x32, 0x20, 0x8a, 0x0a,
+	0x7e, 0x02, 0x50, 0x2e, 0xfe, 0x94, 0xe8, 0x3c, 0x4f, 0x54, 0x31, 0x89, 0x34, 0x20, 0x4a, 0x72,
+	0x42, 0x74, 0x0d, 0xf0, 0xbb, 0x50, 0x1b, 0x09, 0x9a, 0xc5, 0xa7, 0x67, 0xdf, 0x0a, 0xc3, 0xf1,
+	0xcc, 0xb3, 0x27, 0x33, 0xcf, 0xfe, 0x98, 0x79, 0xf6, 0xc3, 0xdc, 0xb3, 0x26, 0x73, 0xcf, 0x7a,
+	0x9b, 0x7b, 0xd6, 0xd5, 0x2a, 0x7b, 0xd9, 0x16, 0x2c, 0x53, 0x8e, 0x87, 0x38, 0xef, 0x94, 0x76,
+	0x88, 0x4b, 0xba, 0x06, 0x87, 0x5f, 0x03, 0x00, 0xd7, 0x91, 0x77, 0xfc, 0x6a, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -119,6 +148,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChainCreationFee) > 0 {
+		for iNdEx := len(m.ChainCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RequestDeposit) > 0 {
+		for iNdEx := len(m.RequestDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.MaxLaunchTime != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxLaunchTime))
 		i--
@@ -155,6 +212,18 @@ func (m *Params) Size() (n int) {
 	if m.MaxLaunchTime != 0 {
 		n += 1 + sovParams(uint64(m.MaxLaunchTime))
 	}
+	if len(m.RequestDeposit) > 0 {
+		for _, e := range m.RequestDeposit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ChainCreationFee) > 0 {
+		for _, e := range m.ChainCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -231,6 +300,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestDeposit = append(m.RequestDeposit, types.Coin{})
+			if err := m.RequestDeposit[len(m.RequestDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainCreationFee = append(m.ChainCreationFee, types.Coin{})
+			if err := m.ChainCreationFee[len(m.ChainCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
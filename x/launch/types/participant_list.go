@@ -0,0 +1,76 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxParticipantListSize is the maximum count of addresses a participant list can contain
+	MaxParticipantListSize = 1000
+)
+
+// NewParticipantList returns a new participant list for a chain
+func NewParticipantList(launchID uint64, listType ParticipantList_ListType, addresses []string) ParticipantList {
+	return ParticipantList{
+		LaunchID:  launchID,
+		ListType:  listType,
+		Addresses: addresses,
+	}
+}
+
+// Validate checks the participant list is valid
+func (m ParticipantList) Validate() error {
+	if m.ListType != ParticipantList_ALLOWLIST && m.ListType != ParticipantList_DENYLIST {
+		return fmt.Errorf("invalid list type %d", m.ListType)
+	}
+	if len(m.Addresses) == 0 {
+		return errors.New("participant list is empty")
+	}
+	if len(m.Addresses) > MaxParticipantListSize {
+		return fmt.Errorf("participant list contains more than %d addresses", MaxParticipantListSize)
+	}
+
+	addresses := make(map[string]struct{})
+	for _, address := range m.Addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid address %s: %s", address, err.Error())
+		}
+		if _, ok := addresses[address]; ok {
+			return fmt.Errorf("duplicated address %s", address)
+		}
+		addresses[address] = struct{}{}
+	}
+	return nil
+}
+
+// IsAllowed returns true if the address is allowed to submit requests by the participant list
+func (m ParticipantList) IsAllowed(address string) bool {
+	listed := false
+	for _, listedAddress := range m.Addresses {
+		if listedAddress == address {
+			listed = true
+			break
+		}
+	}
+	switch m.ListType {
+	case ParticipantList_ALLOWLIST:
+		return listed
+	case ParticipantList_DENYLIST:
+		return !listed
+	default:
+		return true
+	}
+}
+
+// ParseParticipantListType returns the participant list type from its case insensitive name
+func ParseParticipantListType(listType string) (ParticipantList_ListType, error) {
+	value, ok := ParticipantList_ListType_value[strings.ToUpper(listType)]
+	if !ok || value == int32(ParticipantList_LIST_TYPE_UNSPECIFIED) {
+		return ParticipantList_LIST_TYPE_UNSPECIFIED, fmt.Errorf("invalid participant list type %s", listType)
+	}
+	return ParticipantList_ListType(value), nil
+}
@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: launch/participant_list.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ParticipantList_ListType int32
+
+const (
+	// default value of an unset list type, the chain is open to all addresses
+	ParticipantList_LIST_TYPE_UNSPECIFIED ParticipantList_ListType = 0
+	// only the addresses of the list can submit requests
+	ParticipantList_ALLOWLIST ParticipantList_ListType = 1
+	// the addresses of the list can't submit requests
+	ParticipantList_DENYLIST ParticipantList_ListType = 2
+)
+
+var ParticipantList_ListType_name = map[int32]string{
+	0: "LIST_TYPE_UNSPECIFIED",
+	1: "ALLOWLIST",
+	2: "DENYLIST",
+}
+
+var ParticipantList_ListType_value = map[string]int32{
+	"LIST_TYPE_UNSPECIFIED": 0,
+	"ALLOWLIST":             1,
+	"DENYLIST":              2,
+}
+
+func (x ParticipantList_ListType) String() string {
+	return proto.EnumName(ParticipantList_ListType_name, int32(x))
+}
+
+func (ParticipantList_ListType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_af53c0b7116b7d38, []int{0, 0}
+}
+
+// ParticipantList restricts the addresses that can request to add genesis accounts or validators to a chain
+type ParticipantList struct {
+	LaunchID  uint64                   `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	ListType  ParticipantList_ListType `protobuf:"varint,2,opt,name=listType,proto3,enum=tendermint.spn.launch.ParticipantList_ListType" json:"listType,omitempty"`
+	Addresses []string                 `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *ParticipantList) Reset()         { *m = ParticipantList{} }
+func (m *ParticipantList) String() string { return proto.CompactTextString(m) }
+func (*ParticipantList) ProtoMessage()    {}
+func (*ParticipantList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af53c0b7116b7d38, []int{0}
+}
+func (m *ParticipantList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantList.Merge(m, src)
+}
+func (m *ParticipantList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantList proto.InternalMessageInfo
+
+func (m *ParticipantList) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *ParticipantList) GetListType() ParticipantList_ListType {
+	if m != nil {
+		return m.ListType
+	}
+	return ParticipantList_LIST_TYPE_UNSPECIFIED
+}
+
+func (m *ParticipantList) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("tendermint.spn.launch.ParticipantList_ListType", ParticipantList_ListType_name, ParticipantList_ListType_value)
+	proto.RegisterType((*ParticipantList)(nil), "tendermint.spn.launch.ParticipantList")
+}
+
+func init() { proto.RegisterFile("launch/participant_list.proto", fileDescriptor_af53c0b7116b7d38) }
+
+var fileDescriptor_af53c0b7116b7d38 = []byte{
+	// 273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x49, 0x2c, 0xcd,
+	0x4b, 0xce, 0xd0, 0x2f, 0x48, 0x2c, 0x2a, 0xc9, 0x4c, 0xce, 0x2c, 0x48, 0xcc, 0x2b, 0x89, 0xcf,
+	0xc9, 0x2c, 0x2e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2d, 0x49, 0xcd, 0x4b, 0x49,
+	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2b, 0x2e, 0xc8, 0xd3, 0x83, 0xa8, 0x56, 0xba, 0xc3, 0xc8,
+	0xc5, 0x1f, 0x80, 0xd0, 0xe1, 0x93, 0x59, 0x5c, 0x22, 0x24, 0xc5, 0xc5, 0x01, 0x91, 0xf5, 0x74,
+	0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0x82, 0xf3, 0x85, 0xbc, 0xb9, 0x38, 0x40, 0x86, 0x86,
+	0x54, 0x16, 0xa4, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x19, 0xe9, 0xeb, 0x61, 0x35, 0x59, 0x0f,
+	0xcd, 0x54, 0x3d, 0x1f, 0xa8, 0xb6, 0x20, 0xb8, 0x01, 0x42, 0x32, 0x5c, 0x9c, 0x89, 0x29, 0x29,
+	0x45, 0xa9, 0xc5, 0xc5, 0xa9, 0xc5, 0x12, 0xcc, 0x0a, 0xcc, 0x1a, 0x9c, 0x41, 0x08, 0x01, 0x25,
+	0x27, 0x2e, 0x0e, 0x98, 0x1e, 0x21, 0x49, 0x2e, 0x51, 0x1f, 0xcf, 0xe0, 0x90, 0xf8, 0x90, 0xc8,
+	0x00, 0xd7, 0xf8, 0x50, 0xbf, 0xe0, 0x00, 0x57, 0x67, 0x4f, 0x37, 0x4f, 0x57, 0x17, 0x01, 0x06,
+	0x21, 0x5e, 0x2e, 0x4e, 0x47, 0x1f, 0x1f, 0xff, 0x70, 0x90, 0xbc, 0x00, 0xa3, 0x10, 0x0f, 0x17,
+	0x87, 0x8b, 0xab, 0x5f, 0x24, 0x98, 0xc7, 0xe4, 0xe4, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
+	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
+	0xc7, 0x72, 0x0c, 0x51, 0x1a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
+	0x08, 0x0f, 0xe8, 0x17, 0x17, 0xe4, 0xe9, 0x57, 0xe8, 0x43, 0x83, 0xb2, 0xa4, 0xb2, 0x20, 0xb5,
+	0x38, 0x89, 0x0d, 0x1c, 0x80, 0xc6, 0x80, 0x01, 0x00, 0x56, 0x3a, 0xc2, 0x7c, 0x61, 0x01, 0x00,
+	0x00,
+}
+
+func (m *ParticipantList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintParticipantList(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ListType != 0 {
+		i = encodeVarintParticipantList(dAtA, i, uint64(m.ListType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintParticipantList(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParticipantList(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParticipantList(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParticipantList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovParticipantList(uint64(m.LaunchID))
+	}
+	if m.ListType != 0 {
+		n += 1 + sovParticipantList(uint64(m.ListType))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovParticipantList(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParticipantList(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParticipantList(x uint64) (n int) {
+	return sovParticipantList(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParticipantList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipantList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListType", wireType)
+			}
+			m.ListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListType |= ParticipantList_ListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipantList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipantList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipantList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParticipantList(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParticipantList
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipantList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipantList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParticipantList
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParticipantList
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParticipantList
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParticipantList        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParticipantList          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParticipantList = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,152 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestParticipantList_Validate(t *testing.T) {
+	addr := sample.Address()
+	tooManyAddresses := make([]string, types.MaxParticipantListSize+1)
+	for i := range tooManyAddresses {
+		tooManyAddresses[i] = sample.Address()
+	}
+
+	for _, tc := range []struct {
+		desc            string
+		participantList types.ParticipantList
+		valid           bool
+	}{
+		{
+			desc:            "valid participant list",
+			participantList: sample.ParticipantList(0),
+			valid:           true,
+		},
+		{
+			desc:            "invalid list type",
+			participantList: types.NewParticipantList(0, types.ParticipantList_ListType(3), []string{addr}),
+			valid:           false,
+		},
+		{
+			desc:            "unspecified list type",
+			participantList: types.NewParticipantList(0, types.ParticipantList_LIST_TYPE_UNSPECIFIED, []string{addr}),
+			valid:           false,
+		},
+		{
+			desc:            "empty participant list",
+			participantList: types.NewParticipantList(0, types.ParticipantList_ALLOWLIST, []string{}),
+			valid:           false,
+		},
+		{
+			desc:            "invalid address",
+			participantList: types.NewParticipantList(0, types.ParticipantList_ALLOWLIST, []string{"invalid"}),
+			valid:           false,
+		},
+		{
+			desc:            "duplicated address",
+			participantList: types.NewParticipantList(0, types.ParticipantList_DENYLIST, []string{addr, addr}),
+			valid:           false,
+		},
+		{
+			desc:            "too many addresses",
+			participantList: types.NewParticipantList(0, types.ParticipantList_ALLOWLIST, tooManyAddresses),
+			valid:           false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.EqualValues(t, tc.valid, tc.participantList.Validate() == nil)
+		})
+	}
+}
+
+func TestParticipantList_IsAllowed(t *testing.T) {
+	listed := sample.Address()
+	addresses := []string{sample.Address(), listed}
+
+	for _, tc := range []struct {
+		desc            string
+		participantList types.ParticipantList
+		address         string
+		allowed         bool
+	}{
+		{
+			desc:            "address in allowlist",
+			participantList: types.NewParticipantList(0, types.ParticipantList_ALLOWLIST, addresses),
+			address:         listed,
+			allowed:         true,
+		},
+		{
+			desc:            "address not in allowlist",
+			participantList: types.NewParticipantList(0, types.ParticipantList_ALLOWLIST, addresses),
+			address:         sample.Address(),
+			allowed:         false,
+		},
+		{
+			desc:            "address in denylist",
+			participantList: types.NewParticipantList(0, types.ParticipantList_DENYLIST, addresses),
+			address:         listed,
+			allowed:         false,
+		},
+		{
+			desc:            "address not in denylist",
+			participantList: types.NewParticipantList(0, types.ParticipantList_DENYLIST, addresses),
+			address:         sample.Address(),
+			allowed:         true,
+		},
+		{
+			desc:            "unspecified list type",
+			participantList: types.NewParticipantList(0, types.ParticipantList_LIST_TYPE_UNSPECIFIED, addresses),
+			address:         sample.Address(),
+			allowed:         true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.EqualValues(t, tc.allowed, tc.participantList.IsAllowed(tc.address))
+		})
+	}
+}
+
+func TestParseParticipantListType(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		listType string
+		expected types.ParticipantList_ListType
+		valid    bool
+	}{
+		{
+			desc:     "allowlist",
+			listType: "allowlist",
+			expected: types.ParticipantList_ALLOWLIST,
+			valid:    true,
+		},
+		{
+			desc:     "denylist",
+			listType: "Denylist",
+			expected: types.ParticipantList_DENYLIST,
+			valid:    true,
+		},
+		{
+			desc:     "invalid list type",
+			listType: "foo",
+			valid:    false,
+		},
+		{
+			desc:     "unspecified list type",
+			listType: "list_type_unspecified",
+			valid:    false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			listType, err := types.ParseParticipantListType(tc.listType)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, listType)
+		})
+	}
+}
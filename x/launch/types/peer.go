@@ -0,0 +1,137 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+const (
+	// PeerIDSeparator separates the node ID from the address of a peer
+	PeerIDSeparator = "@"
+
+	// PersistentPeersSeparator separates the peers of the persistent_peers node configuration
+	PersistentPeersSeparator = ","
+)
+
+// NewPeerConn returns a peer reachable through a TCP connection
+func NewPeerConn(id, tcpAddress string) Peer {
+	return Peer{
+		Id: id,
+		Connection: &Peer_TcpAddress{
+			TcpAddress: tcpAddress,
+		},
+	}
+}
+
+// NewPeerTunnel returns a peer reachable through an HTTP tunnel
+func NewPeerTunnel(id, name, address string) Peer {
+	return Peer{
+		Id: id,
+		Connection: &Peer_HttpTunnel{
+			HttpTunnel: &Peer_HTTPTunnel{
+				Name:    name,
+				Address: address,
+			},
+		},
+	}
+}
+
+// ParsePeer parses a peer reachable through TCP from its nodeid@host:port representation
+func ParsePeer(peer string) (Peer, error) {
+	id, address, ok := splitPeer(peer)
+	if !ok {
+		return Peer{}, fmt.Errorf("peer %s must be formatted as nodeid@host:port", peer)
+	}
+	p := NewPeerConn(id, address)
+	return p, p.Validate()
+}
+
+// ParsePeerTunnel parses a peer reachable through an HTTP tunnel from its nodeid@address representation
+func ParsePeerTunnel(peer, name string) (Peer, error) {
+	id, address, ok := splitPeer(peer)
+	if !ok {
+		return Peer{}, fmt.Errorf("peer %s must be formatted as nodeid@address", peer)
+	}
+	p := NewPeerTunnel(id, name, address)
+	return p, p.Validate()
+}
+
+// Validate checks the peer is valid
+func (m Peer) Validate() error {
+	if err := validateNodeID(m.Id); err != nil {
+		return err
+	}
+
+	switch conn := m.Connection.(type) {
+	case *Peer_TcpAddress:
+		return validateTCPAddress(conn.TcpAddress)
+	case *Peer_HttpTunnel:
+		if conn.HttpTunnel == nil {
+			return errors.New("empty http tunnel")
+		}
+		if conn.HttpTunnel.Name == "" {
+			return errors.New("empty http tunnel name")
+		}
+		if conn.HttpTunnel.Address == "" {
+			return errors.New("empty http tunnel address")
+		}
+		return nil
+	default:
+		return errors.New("no peer connection")
+	}
+}
+
+// PersistentPeer returns the peer in the nodeid@host:port format of the persistent_peers node configuration
+// false is returned if the peer is not reachable through TCP
+func (m Peer) PersistentPeer() (string, bool) {
+	conn, ok := m.Connection.(*Peer_TcpAddress)
+	if !ok {
+		return "", false
+	}
+	return m.Id + PeerIDSeparator + conn.TcpAddress, true
+}
+
+func splitPeer(peer string) (id, address string, ok bool) {
+	parts := strings.Split(peer, PeerIDSeparator)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// validateNodeID checks the node ID is the hex encoded address of the node key
+func validateNodeID(id string) error {
+	if id == "" {
+		return errors.New("empty node id")
+	}
+	bz, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("node id %s is not hex encoded: %w", id, err)
+	}
+	if len(bz) != p2p.IDByteLength {
+		return fmt.Errorf("node id %s must be %d bytes long", id, p2p.IDByteLength)
+	}
+	return nil
+}
+
+// validateTCPAddress checks the address is a host:port address
+func validateTCPAddress(address string) error {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid tcp address %s: %w", address, err)
+	}
+	if host == "" {
+		return fmt.Errorf("tcp address %s has an empty host", address)
+	}
+	portNumber, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || portNumber == 0 {
+		return fmt.Errorf("tcp address %s has an invalid port", address)
+	}
+	return nil
+}
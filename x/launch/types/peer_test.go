@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestPeer_Validate(t *testing.T) {
+	nodeID := sample.NodeID()
+
+	for _, tc := range []struct {
+		desc  string
+		peer  types.Peer
+		valid bool
+	}{
+		{
+			desc:  "valid tcp peer",
+			peer:  types.NewPeerConn(nodeID, "foo.com:26656"),
+			valid: true,
+		},
+		{
+			desc:  "valid tcp peer with an ip",
+			peer:  types.NewPeerConn(nodeID, "10.0.0.1:26656"),
+			valid: true,
+		},
+		{
+			desc:  "valid tunnel peer",
+			peer:  types.NewPeerTunnel(nodeID, "chisel", "https://foo.com"),
+			valid: true,
+		},
+		{
+			desc: "empty node id",
+			peer: types.NewPeerConn("", "foo.com:26656"),
+		},
+		{
+			desc: "node id not hex encoded",
+			peer: types.NewPeerConn("foo", "foo.com:26656"),
+		},
+		{
+			desc: "node id with an invalid length",
+			peer: types.NewPeerConn("aabbcc", "foo.com:26656"),
+		},
+		{
+			desc: "no connection",
+			peer: types.Peer{Id: nodeID},
+		},
+		{
+			desc: "tcp address without port",
+			peer: types.NewPeerConn(nodeID, "foo.com"),
+		},
+		{
+			desc: "tcp address without host",
+			peer: types.NewPeerConn(nodeID, ":26656"),
+		},
+		{
+			desc: "tcp address with an invalid port",
+			peer: types.NewPeerConn(nodeID, "foo.com:100000"),
+		},
+		{
+			desc: "tunnel without name",
+			peer: types.NewPeerTunnel(nodeID, "", "https://foo.com"),
+		},
+		{
+			desc: "tunnel without address",
+			peer: types.NewPeerTunnel(nodeID, "chisel", ""),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.peer.Validate()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParsePeer(t *testing.T) {
+	nodeID := sample.NodeID()
+
+	peer, err := types.ParsePeer(nodeID + "@foo.com:26656")
+	require.NoError(t, err)
+	require.Equal(t, types.NewPeerConn(nodeID, "foo.com:26656"), peer)
+
+	peer, err = types.ParsePeerTunnel(nodeID+"@https://foo.com", "chisel")
+	require.NoError(t, err)
+	require.Equal(t, types.NewPeerTunnel(nodeID, "chisel", "https://foo.com"), peer)
+
+	_, err = types.ParsePeer("foo.com:26656")
+	require.Error(t, err)
+	_, err = types.ParsePeer(nodeID + "@foo.com")
+	require.Error(t, err)
+	_, err = types.ParsePeerTunnel(nodeID+"@", "chisel")
+	require.Error(t, err)
+}
+
+func TestPeer_PersistentPeer(t *testing.T) {
+	nodeID := sample.NodeID()
+
+	peer, ok := types.NewPeerConn(nodeID, "foo.com:26656").PersistentPeer()
+	require.True(t, ok)
+	require.Equal(t, nodeID+"@foo.com:26656", peer)
+
+	_, ok = types.NewPeerTunnel(nodeID, "chisel", "https://foo.com").PersistentPeer()
+	require.False(t, ok)
+}
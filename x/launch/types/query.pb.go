@@ -913,6 +913,10 @@ func (m *QueryGetRequestResponse) GetRequest() Request {
 type QueryAllRequestRequest struct {
 	LaunchID   uint64             `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// optional filters, requests are not filtered on a field if it is empty
+	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ContentType string `protobuf:"bytes,4,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Creator     string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
 func (m *QueryAllRequestRequest) Reset()         { *m = QueryAllRequestRequest{} }
@@ -962,6 +966,27 @@ func (m *QueryAllRequestRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllRequestRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryAllRequestRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *QueryAllRequestRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
 type QueryAllRequestResponse struct {
 	Request    []Request           `protobuf:"bytes,1,rep,name=request,proto3" json:"request"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -1014,6 +1039,213 @@ func (m *QueryAllRequestResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryGenesisRequest struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	// initialGenesis is the content of the genesis URL of a chain with a genesis URL, it must match the hash of the URL
+	// It is ignored for a chain with a default initial genesis
+	InitialGenesis string `protobuf:"bytes,2,opt,name=initialGenesis,proto3" json:"initialGenesis,omitempty"`
+}
+
+func (m *QueryGenesisRequest) Reset()         { *m = QueryGenesisRequest{} }
+func (m *QueryGenesisRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGenesisRequest) ProtoMessage()    {}
+func (*QueryGenesisRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{20}
+}
+func (m *QueryGenesisRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGenesisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGenesisRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGenesisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGenesisRequest.Merge(m, src)
+}
+func (m *QueryGenesisRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGenesisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGenesisRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGenesisRequest proto.InternalMessageInfo
+
+func (m *QueryGenesisRequest) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *QueryGenesisRequest) GetInitialGenesis() string {
+	if m != nil {
+		return m.InitialGenesis
+	}
+	return ""
+}
+
+type QueryGenesisResponse struct {
+	// initialGenesis is the genesis the generated genesis must be applied on
+	InitialGenesis InitialGenesis `protobuf:"bytes,1,opt,name=initialGenesis,proto3" json:"initialGenesis"`
+	// genesis is the JSON final genesis of the chain, the launch information applied on its initial genesis
+	Genesis string `protobuf:"bytes,2,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	// hash is the sha256 hash of the final genesis
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryGenesisResponse) Reset()         { *m = QueryGenesisResponse{} }
+func (m *QueryGenesisResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGenesisResponse) ProtoMessage()    {}
+func (*QueryGenesisResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{21}
+}
+func (m *QueryGenesisResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGenesisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGenesisResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGenesisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGenesisResponse.Merge(m, src)
+}
+func (m *QueryGenesisResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGenesisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGenesisResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGenesisResponse proto.InternalMessageInfo
+
+func (m *QueryGenesisResponse) GetInitialGenesis() InitialGenesis {
+	if m != nil {
+		return m.InitialGenesis
+	}
+	return InitialGenesis{}
+}
+
+func (m *QueryGenesisResponse) GetGenesis() string {
+	if m != nil {
+		return m.Genesis
+	}
+	return ""
+}
+
+func (m *QueryGenesisResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type QueryPersistentPeersRequest struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+}
+
+func (m *QueryPersistentPeersRequest) Reset()         { *m = QueryPersistentPeersRequest{} }
+func (m *QueryPersistentPeersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPersistentPeersRequest) ProtoMessage()    {}
+func (*QueryPersistentPeersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{22}
+}
+func (m *QueryPersistentPeersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPersistentPeersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPersistentPeersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPersistentPeersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPersistentPeersRequest.Merge(m, src)
+}
+func (m *QueryPersistentPeersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPersistentPeersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPersistentPeersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPersistentPeersRequest proto.InternalMessageInfo
+
+func (m *QueryPersistentPeersRequest) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+type QueryPersistentPeersResponse struct {
+	// persistentPeers is the comma separated list of the genesis validator peers reachable through TCP
+	// formatted for the persistent_peers option of the node configuration
+	PersistentPeers string `protobuf:"bytes,1,opt,name=persistentPeers,proto3" json:"persistentPeers,omitempty"`
+}
+
+func (m *QueryPersistentPeersResponse) Reset()         { *m = QueryPersistentPeersResponse{} }
+func (m *QueryPersistentPeersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPersistentPeersResponse) ProtoMessage()    {}
+func (*QueryPersistentPeersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{23}
+}
+func (m *QueryPersistentPeersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPersistentPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPersistentPeersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPersistentPeersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPersistentPeersResponse.Merge(m, src)
+}
+func (m *QueryPersistentPeersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPersistentPeersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPersistentPeersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPersistentPeersResponse proto.InternalMessageInfo
+
+func (m *QueryPersistentPeersResponse) GetPersistentPeers() string {
+	if m != nil {
+		return m.PersistentPeers
+	}
+	return ""
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -1022,7 +1254,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16d1d5d3029eb866, []int{20}
+	return fileDescriptor_16d1d5d3029eb866, []int{24}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1061,7 +1293,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16d1d5d3029eb866, []int{21}
+	return fileDescriptor_16d1d5d3029eb866, []int{25}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1097,867 +1329,1201 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryGetChainRequest)(nil), "tendermint.spn.launch.QueryGetChainRequest")
-	proto.RegisterType((*QueryGetChainResponse)(nil), "tendermint.spn.launch.QueryGetChainResponse")
-	proto.RegisterType((*QueryAllChainRequest)(nil), "tendermint.spn.launch.QueryAllChainRequest")
-	proto.RegisterType((*QueryAllChainResponse)(nil), "tendermint.spn.launch.QueryAllChainResponse")
-	proto.RegisterType((*QueryGetGenesisAccountRequest)(nil), "tendermint.spn.launch.QueryGetGenesisAccountRequest")
-	proto.RegisterType((*QueryGetGenesisAccountResponse)(nil), "tendermint.spn.launch.QueryGetGenesisAccountResponse")
-	proto.RegisterType((*QueryAllGenesisAccountRequest)(nil), "tendermint.spn.launch.QueryAllGenesisAccountRequest")
-	proto.RegisterType((*QueryAllGenesisAccountResponse)(nil), "tendermint.spn.launch.QueryAllGenesisAccountResponse")
-	proto.RegisterType((*QueryGetVestingAccountRequest)(nil), "tendermint.spn.launch.QueryGetVestingAccountRequest")
-	proto.RegisterType((*QueryGetVestingAccountResponse)(nil), "tendermint.spn.launch.QueryGetVestingAccountResponse")
-	proto.RegisterType((*QueryAllVestingAccountRequest)(nil), "tendermint.spn.launch.QueryAllVestingAccountRequest")
-	proto.RegisterType((*QueryAllVestingAccountResponse)(nil), "tendermint.spn.launch.QueryAllVestingAccountResponse")
-	proto.RegisterType((*QueryGetGenesisValidatorRequest)(nil), "tendermint.spn.launch.QueryGetGenesisValidatorRequest")
-	proto.RegisterType((*QueryGetGenesisValidatorResponse)(nil), "tendermint.spn.launch.QueryGetGenesisValidatorResponse")
-	proto.RegisterType((*QueryAllGenesisValidatorRequest)(nil), "tendermint.spn.launch.QueryAllGenesisValidatorRequest")
-	proto.RegisterType((*QueryAllGenesisValidatorResponse)(nil), "tendermint.spn.launch.QueryAllGenesisValidatorResponse")
-	proto.RegisterType((*QueryGetRequestRequest)(nil), "tendermint.spn.launch.QueryGetRequestRequest")
-	proto.RegisterType((*QueryGetRequestResponse)(nil), "tendermint.spn.launch.QueryGetRequestResponse")
-	proto.RegisterType((*QueryAllRequestRequest)(nil), "tendermint.spn.launch.QueryAllRequestRequest")
-	proto.RegisterType((*QueryAllRequestResponse)(nil), "tendermint.spn.launch.QueryAllRequestResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.spn.launch.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.spn.launch.QueryParamsResponse")
+type QueryGetRewardPoolRequest struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
 }
 
-func init() { proto.RegisterFile("launch/query.proto", fileDescriptor_16d1d5d3029eb866) }
-
-var fileDescriptor_16d1d5d3029eb866 = []byte{
-	// 1029 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0x33, 0xdd, 0xfc, 0x68, 0x1f, 0x52, 0x29, 0x93, 0x14, 0x2a, 0x2b, 0x71, 0x2a, 0x8b,
-	0x92, 0xa6, 0xa5, 0x36, 0x59, 0xd2, 0x1f, 0x2a, 0xa5, 0xd2, 0x86, 0xa8, 0x51, 0x6f, 0xad, 0x11,
-	0x45, 0xe5, 0x00, 0xf2, 0xee, 0x8e, 0x1c, 0x4b, 0x5e, 0xdb, 0x5d, 0x7b, 0x57, 0x54, 0x65, 0x2f,
-	0x20, 0xb8, 0x70, 0x41, 0xf4, 0xc6, 0x81, 0x03, 0x17, 0xfe, 0x07, 0x0e, 0x48, 0x70, 0x40, 0xe5,
-	0x56, 0x89, 0x0b, 0x27, 0x84, 0x12, 0xfe, 0x10, 0xb4, 0x33, 0xcf, 0xbb, 0x3b, 0x5e, 0xff, 0x4c,
-	0x36, 0xb7, 0xac, 0x67, 0xde, 0x9b, 0xcf, 0xf7, 0xfb, 0x9e, 0x3d, 0x4f, 0x01, 0xea, 0x5a, 0x3d,
-	0xaf, 0xb5, 0x6f, 0x3c, 0xe9, 0xb1, 0xee, 0x53, 0x3d, 0xe8, 0xfa, 0x91, 0x4f, 0xcf, 0x47, 0xcc,
-	0x6b, 0xb3, 0x6e, 0xc7, 0xf1, 0x22, 0x3d, 0x0c, 0x3c, 0x5d, 0x6c, 0x51, 0x56, 0x6c, 0xdf, 0xf6,
-	0xf9, 0x0e, 0x63, 0xf8, 0x97, 0xd8, 0xac, 0xac, 0xda, 0xbe, 0x6f, 0xbb, 0xcc, 0xb0, 0x02, 0xc7,
-	0xb0, 0x3c, 0xcf, 0x8f, 0xac, 0xc8, 0xf1, 0xbd, 0x10, 0x57, 0xaf, 0xb4, 0xfc, 0xb0, 0xe3, 0x87,
-	0x46, 0xd3, 0x0a, 0x99, 0x38, 0xc3, 0xe8, 0x6f, 0x35, 0x59, 0x64, 0x6d, 0x19, 0x81, 0x65, 0x3b,
-	0x1e, 0xdf, 0x8c, 0x7b, 0x57, 0x10, 0xa5, 0xcb, 0x9e, 0xf4, 0x58, 0x18, 0xc5, 0xf9, 0xf1, 0x69,
-	0x9f, 0x85, 0x91, 0xe3, 0xd9, 0x9f, 0x59, 0xad, 0x96, 0xdf, 0xf3, 0x92, 0xab, 0x36, 0xf3, 0x58,
-	0xe8, 0x84, 0x89, 0x55, 0x35, 0xb1, 0xda, 0xb7, 0x5c, 0xa7, 0x6d, 0x45, 0x7e, 0x17, 0xd7, 0x63,
-	0xf1, 0xad, 0x7d, 0xcb, 0x89, 0x29, 0x96, 0xf1, 0x59, 0x60, 0x75, 0xad, 0x0e, 0xca, 0xd0, 0xea,
-	0xb0, 0xf2, 0x70, 0x08, 0xbf, 0xc7, 0xa2, 0x0f, 0x86, 0x7b, 0x4d, 0x81, 0x48, 0x15, 0x38, 0x2d,
-	0xb6, 0xdf, 0xdf, 0xbd, 0x40, 0x2e, 0x92, 0xcb, 0xf3, 0xe6, 0xe8, 0xb7, 0xf6, 0x10, 0xce, 0x27,
-	0x62, 0xc2, 0xc0, 0xf7, 0x42, 0x46, 0x6f, 0xc1, 0x02, 0x3f, 0x90, 0x47, 0xbc, 0x52, 0x5f, 0xd5,
-	0x53, 0xed, 0xd6, 0x79, 0xd0, 0xce, 0xfc, 0x8b, 0x7f, 0xd6, 0xe7, 0x4c, 0x11, 0xa0, 0x7d, 0x8a,
-	0x18, 0x0d, 0xd7, 0x95, 0x30, 0xee, 0x01, 0x8c, 0xdd, 0xc4, 0xb4, 0x6f, 0xe9, 0xc2, 0x7a, 0x7d,
-	0x68, 0xbd, 0x2e, 0xca, 0x8b, 0xd6, 0xeb, 0x0f, 0x2c, 0x9b, 0x61, 0xac, 0x39, 0x11, 0xa9, 0xfd,
-	0x40, 0x90, 0x79, 0x7c, 0xc0, 0x34, 0x73, 0xad, 0x12, 0x33, 0xdd, 0x93, 0xd8, 0x4e, 0x71, 0xb6,
-	0x8d, 0x42, 0x36, 0x71, 0xac, 0x04, 0xf7, 0x11, 0xac, 0xc5, 0x7e, 0xee, 0x89, 0x7a, 0x36, 0x44,
-	0xb1, 0x4b, 0x14, 0x83, 0x5e, 0x80, 0x25, 0xab, 0xdd, 0xee, 0xb2, 0x30, 0xe4, 0x08, 0x67, 0xcc,
-	0xf8, 0xa7, 0xd6, 0x03, 0x35, 0x2b, 0x2d, 0x6a, 0xff, 0x10, 0xce, 0xda, 0xd2, 0x0a, 0x3a, 0x7c,
-	0x29, 0xc3, 0x04, 0x39, 0x0d, 0xba, 0x91, 0x48, 0xa1, 0x7d, 0x45, 0x50, 0x4e, 0xc3, 0x75, 0xab,
-	0xcb, 0xb9, 0x97, 0x62, 0xea, 0x51, 0x0a, 0xfe, 0x2b, 0x41, 0xf5, 0x29, 0x14, 0x39, 0xea, 0x6b,
-	0xc7, 0x54, 0x7f, 0x22, 0x4d, 0xf1, 0x48, 0x7c, 0x20, 0x66, 0xdd, 0x14, 0xc9, 0xb4, 0x63, 0x5b,
-	0xfa, 0xd2, 0x4a, 0x41, 0x53, 0xc8, 0x69, 0x62, 0x5b, 0xe4, 0x14, 0x52, 0x53, 0x54, 0x97, 0x73,
-	0x12, 0x4d, 0x51, 0x41, 0x7d, 0xed, 0x98, 0xea, 0x67, 0xd7, 0x14, 0x1f, 0xc3, 0x7a, 0xe2, 0x95,
-	0x7e, 0x14, 0x7f, 0xf8, 0x8f, 0xd7, 0x16, 0x03, 0xb8, 0x98, 0x9d, 0x18, 0xad, 0x79, 0x0c, 0xe7,
-	0xec, 0xc4, 0x1a, 0xb6, 0xc6, 0x46, 0xfe, 0x1b, 0x33, 0xda, 0x8e, 0xf6, 0x4c, 0xa5, 0xd1, 0xbe,
-	0x26, 0x28, 0x6c, 0xfc, 0xb6, 0x56, 0x12, 0x36, 0xab, 0x06, 0xf9, 0x83, 0xa0, 0x0f, 0xa9, 0x1c,
-	0xb9, 0x3e, 0xd4, 0x66, 0xe0, 0xc3, 0xec, 0x1a, 0xc5, 0x84, 0xd7, 0xe3, 0x7a, 0xc6, 0x3a, 0x4b,
-	0xd8, 0xb8, 0x0a, 0x67, 0x70, 0x44, 0xb9, 0xbf, 0xcb, 0x4f, 0x9f, 0x37, 0xc7, 0x0f, 0xb4, 0xc7,
-	0xf0, 0xc6, 0x54, 0x4e, 0xb4, 0xe4, 0x2e, 0x2c, 0xe1, 0x3e, 0xec, 0x08, 0x35, 0xc3, 0x09, 0x0c,
-	0x44, 0x03, 0xe2, 0x20, 0xed, 0x0b, 0xc4, 0x6d, 0xb8, 0x6e, 0x05, 0xdc, 0x59, 0x55, 0xfd, 0x27,
-	0x82, 0xca, 0x26, 0x8f, 0x4f, 0x53, 0x56, 0xab, 0xac, 0x6c, 0x76, 0x15, 0x5d, 0x01, 0xca, 0x19,
-	0x1f, 0xf0, 0xe9, 0x0d, 0x4f, 0xd3, 0x4c, 0x58, 0x96, 0x9e, 0x22, 0xf5, 0x7b, 0xb0, 0x28, 0xa6,
-	0x3c, 0x2c, 0xc7, 0x5a, 0x06, 0xb4, 0x08, 0x43, 0x66, 0x0c, 0xa9, 0x7f, 0xff, 0x2a, 0x2c, 0xf0,
-	0xa4, 0xf4, 0x39, 0x81, 0x05, 0x3e, 0xf8, 0xd0, 0xab, 0x19, 0x09, 0xd2, 0x66, 0x47, 0xe5, 0xed,
-	0x72, 0x9b, 0x05, 0xab, 0x66, 0x7c, 0xf9, 0xd7, 0x7f, 0xcf, 0x4f, 0x6d, 0xd2, 0x0d, 0x63, 0x1c,
-	0x65, 0x84, 0x81, 0x67, 0x4c, 0x8e, 0xb0, 0xc6, 0xb3, 0xb8, 0xea, 0x03, 0xfa, 0x2d, 0x81, 0xd3,
-	0x3c, 0x45, 0xc3, 0x75, 0xf3, 0xc1, 0x12, 0xd3, 0x64, 0x3e, 0x58, 0x72, 0x32, 0xd4, 0xde, 0xe4,
-	0x60, 0x2a, 0x5d, 0xcd, 0x03, 0xa3, 0xbf, 0x11, 0x38, 0x2b, 0x4f, 0x06, 0x74, 0xbb, 0x40, 0x7f,
-	0xea, 0x54, 0xa4, 0x5c, 0xaf, 0x18, 0x85, 0x94, 0x3b, 0x9c, 0xf2, 0x0e, 0xbd, 0x9d, 0x41, 0x29,
-	0xcf, 0x27, 0x13, 0x3e, 0x1a, 0xcf, 0xf0, 0xe3, 0x3f, 0xa0, 0xbf, 0x10, 0x78, 0x4d, 0x4e, 0x3f,
-	0xb4, 0x76, 0xbb, 0xc0, 0xad, 0x23, 0xc8, 0xc8, 0x1c, 0xc6, 0xb4, 0x5b, 0x5c, 0x46, 0x9d, 0xbe,
-	0x53, 0x55, 0x06, 0x2f, 0x80, 0x7c, 0x0b, 0x17, 0x16, 0x20, 0x75, 0x02, 0x29, 0x2c, 0x40, 0xfa,
-	0xc4, 0x50, 0x58, 0x00, 0x79, 0x16, 0xc8, 0x2e, 0x80, 0x9c, 0xbe, 0x4c, 0x01, 0x8e, 0x20, 0x23,
-	0x73, 0xf0, 0x29, 0x2c, 0x40, 0xa6, 0x0c, 0xfa, 0x27, 0x81, 0x73, 0xc9, 0x1b, 0x8e, 0xde, 0x28,
-	0xd7, 0xcd, 0xc9, 0x5b, 0x5e, 0xb9, 0x59, 0x39, 0x0e, 0xf9, 0x77, 0x39, 0xff, 0x5d, 0x7a, 0x27,
-	0xbf, 0x81, 0x46, 0x81, 0xe9, 0x85, 0xf8, 0x9d, 0xc0, 0x72, 0xf2, 0x88, 0x61, 0x29, 0x6e, 0x94,
-	0xeb, 0xea, 0x6a, 0x72, 0x72, 0x86, 0x0c, 0xed, 0x36, 0x97, 0xb3, 0x4d, 0xeb, 0xd5, 0xe5, 0xd0,
-	0x9f, 0x09, 0x2c, 0xc5, 0xf7, 0xe7, 0xb5, 0x02, 0x3f, 0xe5, 0xeb, 0x56, 0xd1, 0xcb, 0x6e, 0x47,
-	0xcc, 0xf7, 0x39, 0xe6, 0x4d, 0x7a, 0x3d, 0x03, 0x13, 0xaf, 0x41, 0xc9, 0xec, 0xd1, 0x44, 0x31,
-	0xa0, 0x3f, 0x12, 0x00, 0x4c, 0x39, 0x74, 0xf9, 0x5a, 0x81, 0x5b, 0x55, 0x60, 0xa7, 0xef, 0x72,
-	0x6d, 0x8b, 0xc3, 0x5e, 0xa5, 0x9b, 0xa5, 0x61, 0xe9, 0x37, 0x04, 0x16, 0xc5, 0x25, 0x49, 0x37,
-	0xf3, 0x4e, 0x93, 0x6e, 0x65, 0xe5, 0x4a, 0x99, 0xad, 0x08, 0x75, 0x89, 0x43, 0xad, 0xd3, 0xb5,
-	0x0c, 0x28, 0x71, 0x29, 0xef, 0xec, 0xbc, 0x38, 0x50, 0xc9, 0xcb, 0x03, 0x95, 0xfc, 0x7b, 0xa0,
-	0x92, 0xef, 0x0e, 0xd5, 0xb9, 0x97, 0x87, 0xea, 0xdc, 0xdf, 0x87, 0xea, 0xdc, 0x27, 0x97, 0x6d,
-	0x27, 0xda, 0xef, 0x35, 0xf5, 0x96, 0xdf, 0x49, 0xa6, 0xf8, 0x3c, 0x4e, 0x12, 0x3d, 0x0d, 0x58,
-	0xd8, 0x5c, 0xe4, 0xff, 0xf2, 0x79, 0xf7, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x5e, 0x22,
-	0xc0, 0x1a, 0x13, 0x00, 0x00,
+func (m *QueryGetRewardPoolRequest) Reset()         { *m = QueryGetRewardPoolRequest{} }
+func (m *QueryGetRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRewardPoolRequest) ProtoMessage()    {}
+func (*QueryGetRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{26}
+}
+func (m *QueryGetRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryGetRewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRewardPoolRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetRewardPoolRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a chain by index.
-	Chain(ctx context.Context, in *QueryGetChainRequest, opts ...grpc.CallOption) (*QueryGetChainResponse, error)
-	// Queries a list of chain items.
-	ChainAll(ctx context.Context, in *QueryAllChainRequest, opts ...grpc.CallOption) (*QueryAllChainResponse, error)
-	// Queries a genesisAccount by index.
-	GenesisAccount(ctx context.Context, in *QueryGetGenesisAccountRequest, opts ...grpc.CallOption) (*QueryGetGenesisAccountResponse, error)
-	// Queries a list of genesisAccount items.
-	GenesisAccountAll(ctx context.Context, in *QueryAllGenesisAccountRequest, opts ...grpc.CallOption) (*QueryAllGenesisAccountResponse, error)
-	// Queries a vestingAccount by index.
-	VestingAccount(ctx context.Context, in *QueryGetVestingAccountRequest, opts ...grpc.CallOption) (*QueryGetVestingAccountResponse, error)
-	// Queries a list of vestingAccount items.
-	VestingAccountAll(ctx context.Context, in *QueryAllVestingAccountRequest, opts ...grpc.CallOption) (*QueryAllVestingAccountResponse, error)
-	// Queries a genesisValidator by index.
-	GenesisValidator(ctx context.Context, in *QueryGetGenesisValidatorRequest, opts ...grpc.CallOption) (*QueryGetGenesisValidatorResponse, error)
-	// Queries a list of genesisValidator items.
-	GenesisValidatorAll(ctx context.Context, in *QueryAllGenesisValidatorRequest, opts ...grpc.CallOption) (*QueryAllGenesisValidatorResponse, error)
-	// Queries a request by index.
-	Request(ctx context.Context, in *QueryGetRequestRequest, opts ...grpc.CallOption) (*QueryGetRequestResponse, error)
-	// Queries a list of request for a chain.
-	RequestAll(ctx context.Context, in *QueryAllRequestRequest, opts ...grpc.CallOption) (*QueryAllRequestResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryGetRewardPoolRequest) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetRewardPoolResponse struct {
+	RewardPool RewardPool `protobuf:"bytes,1,opt,name=rewardPool,proto3" json:"rewardPool"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetRewardPoolResponse) Reset()         { *m = QueryGetRewardPoolResponse{} }
+func (m *QueryGetRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRewardPoolResponse) ProtoMessage()    {}
+func (*QueryGetRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{27}
 }
-
-func (c *queryClient) Chain(ctx context.Context, in *QueryGetChainRequest, opts ...grpc.CallOption) (*QueryGetChainResponse, error) {
-	out := new(QueryGetChainResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/Chain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRewardPoolResponse.Merge(m, src)
+}
+func (m *QueryGetRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRewardPoolResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ChainAll(ctx context.Context, in *QueryAllChainRequest, opts ...grpc.CallOption) (*QueryAllChainResponse, error) {
-	out := new(QueryAllChainResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/ChainAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetRewardPoolResponse proto.InternalMessageInfo
+
+func (m *QueryGetRewardPoolResponse) GetRewardPool() RewardPool {
+	if m != nil {
+		return m.RewardPool
 	}
-	return out, nil
+	return RewardPool{}
 }
 
-func (c *queryClient) GenesisAccount(ctx context.Context, in *QueryGetGenesisAccountRequest, opts ...grpc.CallOption) (*QueryGetGenesisAccountResponse, error) {
-	out := new(QueryGetGenesisAccountResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/GenesisAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllRewardPoolRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GenesisAccountAll(ctx context.Context, in *QueryAllGenesisAccountRequest, opts ...grpc.CallOption) (*QueryAllGenesisAccountResponse, error) {
-	out := new(QueryAllGenesisAccountResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/GenesisAccountAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllRewardPoolRequest) Reset()         { *m = QueryAllRewardPoolRequest{} }
+func (m *QueryAllRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRewardPoolRequest) ProtoMessage()    {}
+func (*QueryAllRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{28}
+}
+func (m *QueryAllRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryAllRewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRewardPoolRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) VestingAccount(ctx context.Context, in *QueryGetVestingAccountRequest, opts ...grpc.CallOption) (*QueryGetVestingAccountResponse, error) {
-	out := new(QueryGetVestingAccountResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/VestingAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllRewardPoolRequest proto.InternalMessageInfo
+
+func (m *QueryAllRewardPoolRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) VestingAccountAll(ctx context.Context, in *QueryAllVestingAccountRequest, opts ...grpc.CallOption) (*QueryAllVestingAccountResponse, error) {
-	out := new(QueryAllVestingAccountResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/VestingAccountAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllRewardPoolResponse struct {
+	RewardPool []RewardPool        `protobuf:"bytes,1,rep,name=rewardPool,proto3" json:"rewardPool"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GenesisValidator(ctx context.Context, in *QueryGetGenesisValidatorRequest, opts ...grpc.CallOption) (*QueryGetGenesisValidatorResponse, error) {
-	out := new(QueryGetGenesisValidatorResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/GenesisValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllRewardPoolResponse) Reset()         { *m = QueryAllRewardPoolResponse{} }
+func (m *QueryAllRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRewardPoolResponse) ProtoMessage()    {}
+func (*QueryAllRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{29}
+}
+func (m *QueryAllRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRewardPoolResponse.Merge(m, src)
+}
+func (m *QueryAllRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRewardPoolResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GenesisValidatorAll(ctx context.Context, in *QueryAllGenesisValidatorRequest, opts ...grpc.CallOption) (*QueryAllGenesisValidatorResponse, error) {
-	out := new(QueryAllGenesisValidatorResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/GenesisValidatorAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllRewardPoolResponse proto.InternalMessageInfo
+
+func (m *QueryAllRewardPoolResponse) GetRewardPool() []RewardPool {
+	if m != nil {
+		return m.RewardPool
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Request(ctx context.Context, in *QueryGetRequestRequest, opts ...grpc.CallOption) (*QueryGetRequestResponse, error) {
-	out := new(QueryGetRequestResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/Request", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllRewardPoolResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RequestAll(ctx context.Context, in *QueryAllRequestRequest, opts ...grpc.CallOption) (*QueryAllRequestResponse, error) {
-	out := new(QueryAllRequestResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/RequestAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetParticipantListRequest struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetParticipantListRequest) Reset()         { *m = QueryGetParticipantListRequest{} }
+func (m *QueryGetParticipantListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetParticipantListRequest) ProtoMessage()    {}
+func (*QueryGetParticipantListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{30}
+}
+func (m *QueryGetParticipantListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetParticipantListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetParticipantListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetParticipantListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetParticipantListRequest.Merge(m, src)
+}
+func (m *QueryGetParticipantListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetParticipantListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetParticipantListRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a chain by index.
-	Chain(context.Context, *QueryGetChainRequest) (*QueryGetChainResponse, error)
-	// Queries a list of chain items.
-	ChainAll(context.Context, *QueryAllChainRequest) (*QueryAllChainResponse, error)
-	// Queries a genesisAccount by index.
-	GenesisAccount(context.Context, *QueryGetGenesisAccountRequest) (*QueryGetGenesisAccountResponse, error)
-	// Queries a list of genesisAccount items.
-	GenesisAccountAll(context.Context, *QueryAllGenesisAccountRequest) (*QueryAllGenesisAccountResponse, error)
-	// Queries a vestingAccount by index.
-	VestingAccount(context.Context, *QueryGetVestingAccountRequest) (*QueryGetVestingAccountResponse, error)
-	// Queries a list of vestingAccount items.
-	VestingAccountAll(context.Context, *QueryAllVestingAccountRequest) (*QueryAllVestingAccountResponse, error)
-	// Queries a genesisValidator by index.
-	GenesisValidator(context.Context, *QueryGetGenesisValidatorRequest) (*QueryGetGenesisValidatorResponse, error)
-	// Queries a list of genesisValidator items.
-	GenesisValidatorAll(context.Context, *QueryAllGenesisValidatorRequest) (*QueryAllGenesisValidatorResponse, error)
-	// Queries a request by index.
-	Request(context.Context, *QueryGetRequestRequest) (*QueryGetRequestResponse, error)
-	// Queries a list of request for a chain.
-	RequestAll(context.Context, *QueryAllRequestRequest) (*QueryAllRequestResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryGetParticipantListRequest proto.InternalMessageInfo
+
+func (m *QueryGetParticipantListRequest) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryGetParticipantListResponse struct {
+	ParticipantList ParticipantList `protobuf:"bytes,1,opt,name=participantList,proto3" json:"participantList"`
 }
 
-func (*UnimplementedQueryServer) Chain(ctx context.Context, req *QueryGetChainRequest) (*QueryGetChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Chain not implemented")
+func (m *QueryGetParticipantListResponse) Reset()         { *m = QueryGetParticipantListResponse{} }
+func (m *QueryGetParticipantListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetParticipantListResponse) ProtoMessage()    {}
+func (*QueryGetParticipantListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{31}
 }
-func (*UnimplementedQueryServer) ChainAll(ctx context.Context, req *QueryAllChainRequest) (*QueryAllChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainAll not implemented")
+func (m *QueryGetParticipantListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GenesisAccount(ctx context.Context, req *QueryGetGenesisAccountRequest) (*QueryGetGenesisAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenesisAccount not implemented")
+func (m *QueryGetParticipantListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetParticipantListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GenesisAccountAll(ctx context.Context, req *QueryAllGenesisAccountRequest) (*QueryAllGenesisAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenesisAccountAll not implemented")
+func (m *QueryGetParticipantListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetParticipantListResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) VestingAccount(ctx context.Context, req *QueryGetVestingAccountRequest) (*QueryGetVestingAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VestingAccount not implemented")
+func (m *QueryGetParticipantListResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) VestingAccountAll(ctx context.Context, req *QueryAllVestingAccountRequest) (*QueryAllVestingAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VestingAccountAll not implemented")
+func (m *QueryGetParticipantListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetParticipantListResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GenesisValidator(ctx context.Context, req *QueryGetGenesisValidatorRequest) (*QueryGetGenesisValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenesisValidator not implemented")
+
+var xxx_messageInfo_QueryGetParticipantListResponse proto.InternalMessageInfo
+
+func (m *QueryGetParticipantListResponse) GetParticipantList() ParticipantList {
+	if m != nil {
+		return m.ParticipantList
+	}
+	return ParticipantList{}
 }
-func (*UnimplementedQueryServer) GenesisValidatorAll(ctx context.Context, req *QueryAllGenesisValidatorRequest) (*QueryAllGenesisValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenesisValidatorAll not implemented")
+
+type QueryAllParticipantListRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) Request(ctx context.Context, req *QueryGetRequestRequest) (*QueryGetRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
+
+func (m *QueryAllParticipantListRequest) Reset()         { *m = QueryAllParticipantListRequest{} }
+func (m *QueryAllParticipantListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllParticipantListRequest) ProtoMessage()    {}
+func (*QueryAllParticipantListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{32}
 }
-func (*UnimplementedQueryServer) RequestAll(ctx context.Context, req *QueryAllRequestRequest) (*QueryAllRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestAll not implemented")
+func (m *QueryAllParticipantListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllParticipantListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllParticipantListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAllParticipantListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllParticipantListRequest.Merge(m, src)
+}
+func (m *QueryAllParticipantListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllParticipantListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllParticipantListRequest.DiscardUnknown(m)
 }
 
-func _Query_Chain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Chain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/Chain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Chain(ctx, req.(*QueryGetChainRequest))
+var xxx_messageInfo_QueryAllParticipantListRequest proto.InternalMessageInfo
+
+func (m *QueryAllParticipantListRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ChainAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ChainAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/ChainAll",
+type QueryAllParticipantListResponse struct {
+	ParticipantList []ParticipantList   `protobuf:"bytes,1,rep,name=participantList,proto3" json:"participantList"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllParticipantListResponse) Reset()         { *m = QueryAllParticipantListResponse{} }
+func (m *QueryAllParticipantListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllParticipantListResponse) ProtoMessage()    {}
+func (*QueryAllParticipantListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{33}
+}
+func (m *QueryAllParticipantListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllParticipantListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllParticipantListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ChainAll(ctx, req.(*QueryAllChainRequest))
+}
+func (m *QueryAllParticipantListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllParticipantListResponse.Merge(m, src)
+}
+func (m *QueryAllParticipantListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllParticipantListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllParticipantListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllParticipantListResponse proto.InternalMessageInfo
+
+func (m *QueryAllParticipantListResponse) GetParticipantList() []ParticipantList {
+	if m != nil {
+		return m.ParticipantList
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GenesisAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGenesisAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GenesisAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/GenesisAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GenesisAccount(ctx, req.(*QueryGetGenesisAccountRequest))
+func (m *QueryAllParticipantListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GenesisAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGenesisAccountRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryGetChainRequest)(nil), "tendermint.spn.launch.QueryGetChainRequest")
+	proto.RegisterType((*QueryGetChainResponse)(nil), "tendermint.spn.launch.QueryGetChainResponse")
+	proto.RegisterType((*QueryAllChainRequest)(nil), "tendermint.spn.launch.QueryAllChainRequest")
+	proto.RegisterType((*QueryAllChainResponse)(nil), "tendermint.spn.launch.QueryAllChainResponse")
+	proto.RegisterType((*QueryGetGenesisAccountRequest)(nil), "tendermint.spn.launch.QueryGetGenesisAccountRequest")
+	proto.RegisterType((*QueryGetGenesisAccountResponse)(nil), "tendermint.spn.launch.QueryGetGenesisAccountResponse")
+	proto.RegisterType((*QueryAllGenesisAccountRequest)(nil), "tendermint.spn.launch.QueryAllGenesisAccountRequest")
+	proto.RegisterType((*QueryAllGenesisAccountResponse)(nil), "tendermint.spn.launch.QueryAllGenesisAccountResponse")
+	proto.RegisterType((*QueryGetVestingAccountRequest)(nil), "tendermint.spn.launch.QueryGetVestingAccountRequest")
+	proto.RegisterType((*QueryGetVestingAccountResponse)(nil), "tendermint.spn.launch.QueryGetVestingAccountResponse")
+	proto.RegisterType((*QueryAllVestingAccountRequest)(nil), "tendermint.spn.launch.QueryAllVestingAccountRequest")
+	proto.RegisterType((*QueryAllVestingAccountResponse)(nil), "tendermint.spn.launch.QueryAllVestingAccountResponse")
+	proto.RegisterType((*QueryGetGenesisValidatorRequest)(nil), "tendermint.spn.launch.QueryGetGenesisValidatorRequest")
+	proto.RegisterType((*QueryGetGenesisValidatorResponse)(nil), "tendermint.spn.launch.QueryGetGenesisValidatorResponse")
+	proto.RegisterType((*QueryAllGenesisValidatorRequest)(nil), "tendermint.spn.launch.QueryAllGenesisValidatorRequest")
+	proto.RegisterType((*QueryAllGenesisValidatorResponse)(nil), "tendermint.spn.launch.QueryAllGenesisValidatorResponse")
+	proto.RegisterType((*QueryGetRequestRequest)(nil), "tendermint.spn.launch.QueryGetRequestRequest")
+	proto.RegisterType((*QueryGetRequestResponse)(nil), "tendermint.spn.launch.QueryGetRequestResponse")
+	proto.RegisterType((*QueryAllRequestRequest)(nil), "tendermint.spn.launch.QueryAllRequestRequest")
+	proto.RegisterType((*QueryAllRequestResponse)(nil), "tendermint.spn.launch.QueryAllRequestResponse")
+	proto.RegisterType((*QueryGenesisRequest)(nil), "tendermint.spn.launch.QueryGenesisRequest")
+	proto.RegisterType((*QueryGenesisResponse)(nil), "tendermint.spn.launch.QueryGenesisResponse")
+	proto.RegisterType((*QueryPersistentPeersRequest)(nil), "tendermint.spn.launch.QueryPersistentPeersRequest")
+	proto.RegisterType((*QueryPersistentPeersResponse)(nil), "tendermint.spn.launch.QueryPersistentPeersResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.spn.launch.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.spn.launch.QueryParamsResponse")
+	proto.RegisterType((*QueryGetRewardPoolRequest)(nil), "tendermint.spn.launch.QueryGetRewardPoolRequest")
+	proto.RegisterType((*QueryGetRewardPoolResponse)(nil), "tendermint.spn.launch.QueryGetRewardPoolResponse")
+	proto.RegisterType((*QueryAllRewardPoolRequest)(nil), "tendermint.spn.launch.QueryAllRewardPoolRequest")
+	proto.RegisterType((*QueryAllRewardPoolResponse)(nil), "tendermint.spn.launch.QueryAllRewardPoolResponse")
+	proto.RegisterType((*QueryGetParticipantListRequest)(nil), "tendermint.spn.launch.QueryGetParticipantListRequest")
+	proto.RegisterType((*QueryGetParticipantListResponse)(nil), "tendermint.spn.launch.QueryGetParticipantListResponse")
+	proto.RegisterType((*QueryAllParticipantListRequest)(nil), "tendermint.spn.launch.QueryAllParticipantListRequest")
+	proto.RegisterType((*QueryAllParticipantListResponse)(nil), "tendermint.spn.launch.QueryAllParticipantListResponse")
+}
+
+func init() { proto.RegisterFile("launch/query.proto", fileDescriptor_16d1d5d3029eb866) }
+
+var fileDescriptor_16d1d5d3029eb866 = []byte{
+	// 1476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0xcf, 0x74, 0xf3, 0xa3, 0x7d, 0xd5, 0xb7, 0xed, 0x77, 0x92, 0xb6, 0xa9, 0x49, 0x36, 0xe9,
+	0x40, 0xdb, 0xa4, 0x3f, 0xec, 0x26, 0x4d, 0x7f, 0x52, 0x2a, 0xa5, 0x54, 0x0d, 0x95, 0x38, 0xa4,
+	0x0b, 0x14, 0x95, 0x03, 0x91, 0xb3, 0xb1, 0x76, 0x2d, 0x39, 0xb6, 0x6b, 0x7b, 0x03, 0x51, 0x95,
+	0x0b, 0x08, 0x2e, 0x5c, 0x90, 0x2a, 0x0e, 0x70, 0x00, 0x09, 0x0e, 0xdc, 0xb8, 0x20, 0x71, 0x00,
+	0x09, 0x89, 0x1e, 0x50, 0xb9, 0x55, 0xe2, 0xc2, 0x09, 0xa1, 0x96, 0x3f, 0x04, 0x79, 0xfc, 0xbc,
+	0xf6, 0x4c, 0xec, 0xb5, 0x9d, 0x6c, 0x6f, 0xeb, 0x99, 0xf7, 0xde, 0x7c, 0x3e, 0x9f, 0xf7, 0xc6,
+	0x7e, 0x2f, 0x01, 0x6a, 0xe9, 0x1d, 0xbb, 0xd9, 0xd6, 0x1e, 0x74, 0x0c, 0x6f, 0x53, 0x75, 0x3d,
+	0x27, 0x70, 0xe8, 0xe1, 0xc0, 0xb0, 0xd7, 0x0c, 0x6f, 0xdd, 0xb4, 0x03, 0xd5, 0x77, 0x6d, 0x35,
+	0x32, 0x51, 0xc6, 0x5a, 0x4e, 0xcb, 0xe1, 0x16, 0x5a, 0xf8, 0x2b, 0x32, 0x56, 0x26, 0x5a, 0x8e,
+	0xd3, 0xb2, 0x0c, 0x4d, 0x77, 0x4d, 0x4d, 0xb7, 0x6d, 0x27, 0xd0, 0x03, 0xd3, 0xb1, 0x7d, 0xdc,
+	0x3d, 0xdd, 0x74, 0xfc, 0x75, 0xc7, 0xd7, 0x56, 0x75, 0xdf, 0x88, 0xce, 0xd0, 0x36, 0xe6, 0x56,
+	0x8d, 0x40, 0x9f, 0xd3, 0x5c, 0xbd, 0x65, 0xda, 0xdc, 0x18, 0x6d, 0xc7, 0x10, 0x8a, 0x67, 0x3c,
+	0xe8, 0x18, 0x7e, 0x10, 0xc7, 0xc7, 0xd5, 0x0d, 0xc3, 0x0f, 0x4c, 0xbb, 0xb5, 0xa2, 0x37, 0x9b,
+	0x4e, 0xc7, 0x96, 0x77, 0x5b, 0x86, 0x6d, 0xf8, 0xa6, 0x2f, 0xed, 0xd6, 0xa5, 0xdd, 0x0d, 0xdd,
+	0x32, 0xd7, 0xf4, 0xc0, 0xf1, 0x70, 0x3f, 0x26, 0xdf, 0x6c, 0xeb, 0x66, 0x8c, 0x62, 0x14, 0xd7,
+	0x5c, 0xdd, 0xd3, 0xd7, 0x63, 0x1a, 0xe3, 0x5d, 0x68, 0x1f, 0xe8, 0xde, 0xda, 0x8a, 0xeb, 0x38,
+	0x16, 0xee, 0x4c, 0x26, 0xe6, 0x81, 0xd9, 0x34, 0x5d, 0xdd, 0x0e, 0x56, 0x2c, 0x33, 0x46, 0xcf,
+	0xe6, 0x61, 0xec, 0x6e, 0xc8, 0x7a, 0xc9, 0x08, 0x5e, 0x0f, 0x0f, 0x69, 0x44, 0xdc, 0xa8, 0x02,
+	0x7b, 0x23, 0xc7, 0x3b, 0xb7, 0xc6, 0xc9, 0x34, 0x99, 0x19, 0x6c, 0x74, 0x9f, 0xd9, 0x5d, 0x38,
+	0x2c, 0xf9, 0xf8, 0xae, 0x63, 0xfb, 0x06, 0xbd, 0x02, 0x43, 0x1c, 0x29, 0xf7, 0xd8, 0x3f, 0x3f,
+	0xa1, 0x66, 0xe6, 0x49, 0xe5, 0x4e, 0x37, 0x07, 0x9f, 0xfc, 0x3d, 0x35, 0xd0, 0x88, 0x1c, 0xd8,
+	0xfb, 0x08, 0x63, 0xd1, 0xb2, 0x04, 0x18, 0xb7, 0x01, 0x92, 0x34, 0x60, 0xd8, 0x93, 0x6a, 0x94,
+	0x33, 0x35, 0xcc, 0x99, 0x1a, 0xd5, 0x05, 0xe6, 0x4c, 0x5d, 0xd6, 0x5b, 0x06, 0xfa, 0x36, 0x52,
+	0x9e, 0xec, 0x2b, 0x02, 0x87, 0xa5, 0x03, 0xb6, 0x63, 0xae, 0x55, 0xc2, 0x4c, 0x97, 0x04, 0x6c,
+	0x7b, 0x38, 0xb6, 0x53, 0x85, 0xd8, 0xa2, 0x63, 0x05, 0x70, 0xef, 0xc0, 0x64, 0xac, 0xe7, 0x52,
+	0x54, 0x08, 0x8b, 0x51, 0x95, 0x94, 0x48, 0x06, 0x1d, 0x87, 0x11, 0x7d, 0x6d, 0xcd, 0x33, 0x7c,
+	0x9f, 0x43, 0xd8, 0xd7, 0x88, 0x1f, 0x59, 0x07, 0xea, 0x79, 0x61, 0x91, 0xfb, 0x5b, 0x70, 0xa0,
+	0x25, 0xec, 0xa0, 0xc2, 0x27, 0x72, 0x44, 0x10, 0xc3, 0xa0, 0x1a, 0x52, 0x08, 0xf6, 0x31, 0x41,
+	0x3a, 0x8b, 0x96, 0x55, 0x9d, 0xce, 0xed, 0x0c, 0x51, 0x77, 0x92, 0xf0, 0x5f, 0x09, 0xd4, 0xf3,
+	0x50, 0xf4, 0x60, 0x5f, 0xdb, 0x25, 0xfb, 0x17, 0x52, 0x14, 0xf7, 0xa2, 0x37, 0x4b, 0xbf, 0x8b,
+	0x42, 0x0e, 0x9b, 0xc8, 0xb2, 0x21, 0xec, 0x14, 0x14, 0x85, 0x18, 0x26, 0x96, 0x45, 0x0c, 0x21,
+	0x14, 0x45, 0x75, 0x3a, 0x2f, 0xa2, 0x28, 0x2a, 0xb0, 0xaf, 0xed, 0x92, 0x7d, 0xff, 0x8a, 0xe2,
+	0x5d, 0x98, 0x92, 0xae, 0xf4, 0xbd, 0xf8, 0x8b, 0xb1, 0xbb, 0xb2, 0xd8, 0x82, 0xe9, 0xfc, 0xc0,
+	0x28, 0xcd, 0x7d, 0x38, 0xd4, 0x92, 0xf6, 0xb0, 0x34, 0x4e, 0xf5, 0xbe, 0x31, 0x5d, 0x73, 0x94,
+	0x67, 0x5b, 0x18, 0xf6, 0x09, 0x81, 0x29, 0xe9, 0xb6, 0x56, 0x22, 0xd6, 0xaf, 0x02, 0xf9, 0x9d,
+	0xc0, 0x74, 0x3e, 0x8e, 0x9e, 0x3a, 0xd4, 0xfa, 0xa0, 0x43, 0xff, 0x0a, 0xa5, 0x01, 0x47, 0xe2,
+	0x7c, 0xc6, 0x3c, 0x4b, 0xc8, 0x38, 0x01, 0xfb, 0xb0, 0xb7, 0xb9, 0x73, 0x8b, 0x9f, 0x3e, 0xd8,
+	0x48, 0x16, 0xd8, 0x7d, 0x38, 0xba, 0x2d, 0x26, 0x4a, 0x72, 0x03, 0x46, 0xd0, 0x0e, 0x2b, 0xa2,
+	0x9e, 0xa3, 0x04, 0x3a, 0xa2, 0x00, 0xb1, 0x13, 0x7b, 0x4a, 0x10, 0xef, 0xa2, 0x65, 0x55, 0xc0,
+	0xdb, 0xa7, 0xb4, 0xd3, 0x23, 0x30, 0xec, 0x07, 0x7a, 0xd0, 0xf1, 0xc7, 0x6b, 0xfc, 0x5a, 0xe0,
+	0x13, 0x9d, 0x86, 0xfd, 0x4d, 0xc7, 0x0e, 0x0c, 0x3b, 0x78, 0x7b, 0xd3, 0x35, 0xc6, 0x07, 0xf9,
+	0x66, 0x7a, 0x29, 0xbc, 0x51, 0x4d, 0xcf, 0xe0, 0x25, 0x30, 0x14, 0xdd, 0x28, 0x7c, 0x64, 0xdf,
+	0x12, 0x38, 0xba, 0x8d, 0x52, 0x96, 0x5c, 0xb5, 0xca, 0x72, 0xf5, 0xaf, 0x4c, 0xee, 0xc3, 0x28,
+	0xa6, 0x94, 0x17, 0x62, 0x19, 0xcd, 0x4f, 0xc2, 0x01, 0xd3, 0x36, 0x03, 0x53, 0x8f, 0x2f, 0x08,
+	0xbe, 0x4a, 0xa4, 0x55, 0xf6, 0x25, 0xe9, 0x76, 0x96, 0x18, 0x3b, 0x79, 0xc3, 0x4a, 0x01, 0x7a,
+	0x7f, 0x5f, 0xee, 0x08, 0xc6, 0xf1, 0x1b, 0x56, 0x0c, 0x11, 0xe6, 0xa1, 0x25, 0xc0, 0x89, 0x1f,
+	0x29, 0x85, 0xc1, 0xb6, 0xee, 0xb7, 0x31, 0xb3, 0xfc, 0x37, 0xbb, 0x0a, 0x2f, 0x71, 0x68, 0xcb,
+	0x86, 0xe7, 0x9b, 0x7e, 0x98, 0xcc, 0x65, 0xc3, 0xf0, 0xca, 0xd0, 0x67, 0x6f, 0xc0, 0x44, 0xb6,
+	0x2b, 0xb2, 0x9b, 0x81, 0x83, 0xae, 0xb8, 0xc5, 0x43, 0xec, 0x6b, 0xc8, 0xcb, 0x6c, 0x0c, 0x68,
+	0x14, 0x89, 0xf7, 0xf1, 0x78, 0x36, 0x6b, 0xc0, 0xa8, 0xb0, 0x8a, 0x61, 0x5f, 0x85, 0xe1, 0xa8,
+	0xdf, 0x47, 0xb1, 0x26, 0x73, 0xc4, 0x8a, 0xdc, 0x50, 0x24, 0x74, 0x61, 0x97, 0xe1, 0x58, 0x72,
+	0x71, 0xc3, 0xf9, 0x60, 0xd9, 0x71, 0xac, 0x32, 0x64, 0x0d, 0x50, 0xb2, 0x1c, 0x11, 0xd3, 0x12,
+	0x80, 0xd7, 0x5d, 0x45, 0x5c, 0xc7, 0x73, 0x0b, 0x39, 0x36, 0x44, 0x6c, 0x29, 0x57, 0xd6, 0x84,
+	0x63, 0xc9, 0x4d, 0x91, 0xf1, 0xf5, 0x6b, 0x02, 0xf8, 0x81, 0x80, 0x92, 0x75, 0x4a, 0x0e, 0x99,
+	0xda, 0x0e, 0xc9, 0xf4, 0xef, 0x6e, 0x5e, 0x4f, 0x3a, 0xb5, 0xe5, 0x64, 0x76, 0x7b, 0xd3, 0x2c,
+	0xf5, 0x6a, 0x64, 0x9b, 0x30, 0x95, 0xeb, 0x8d, 0x94, 0xef, 0xc1, 0x41, 0x57, 0xdc, 0xea, 0xca,
+	0x9b, 0x5b, 0x5c, 0x69, 0x6b, 0x24, 0x2f, 0x07, 0x61, 0xed, 0xa4, 0xc9, 0xca, 0x01, 0xde, 0xaf,
+	0x9c, 0x3e, 0x4e, 0xb5, 0x0d, 0x95, 0x58, 0xd6, 0x76, 0xcd, 0xb2, 0x6f, 0x79, 0x9e, 0xff, 0xee,
+	0x28, 0x0c, 0x71, 0x12, 0xf4, 0x11, 0x81, 0x21, 0x3e, 0x67, 0xd2, 0x33, 0x39, 0xd8, 0xb2, 0x46,
+	0x75, 0xe5, 0x6c, 0x39, 0xe3, 0xe8, 0x68, 0xa6, 0x7d, 0xf4, 0xe7, 0xbf, 0x8f, 0xf6, 0xcc, 0xd2,
+	0x53, 0x5a, 0xe2, 0xa5, 0xf9, 0xae, 0xad, 0xa5, 0xff, 0xd4, 0xa0, 0x3d, 0x8c, 0x0b, 0x69, 0x8b,
+	0x7e, 0x46, 0x60, 0x2f, 0x0f, 0xb1, 0x68, 0x59, 0xbd, 0x81, 0x49, 0xc3, 0xbb, 0x72, 0xb6, 0x9c,
+	0x31, 0x02, 0x7b, 0x85, 0x03, 0xab, 0xd3, 0x89, 0x5e, 0xc0, 0xe8, 0x6f, 0x04, 0x0e, 0x88, 0x83,
+	0x18, 0x5d, 0x28, 0xe0, 0x9f, 0x39, 0x84, 0x2a, 0x17, 0x2b, 0x7a, 0x21, 0xca, 0x9b, 0x1c, 0xe5,
+	0x75, 0x7a, 0x2d, 0x07, 0xa5, 0x38, 0x0e, 0xa6, 0x74, 0xd4, 0x1e, 0x62, 0xaf, 0xbd, 0x45, 0x7f,
+	0x26, 0xf0, 0x7f, 0x31, 0x7c, 0x28, 0xed, 0x42, 0x81, 0x5a, 0x3b, 0xa0, 0x91, 0x3b, 0xfb, 0xb2,
+	0x2b, 0x9c, 0xc6, 0x3c, 0x3d, 0x5f, 0x95, 0x06, 0x4f, 0x80, 0x38, 0xf4, 0x14, 0x26, 0x20, 0x73,
+	0xe0, 0x53, 0x2e, 0x56, 0xf4, 0x2a, 0x99, 0x00, 0x71, 0xf4, 0xca, 0x4f, 0x80, 0x18, 0xbe, 0x4c,
+	0x02, 0x76, 0x40, 0x23, 0x77, 0xce, 0x2c, 0x4c, 0x40, 0x2e, 0x0d, 0xfa, 0x07, 0x81, 0x43, 0xf2,
+	0x40, 0x41, 0x2f, 0x95, 0xab, 0x66, 0x79, 0xa8, 0x52, 0x2e, 0x57, 0xf6, 0x43, 0xfc, 0xb7, 0x38,
+	0xfe, 0x1b, 0xf4, 0x7a, 0xef, 0x02, 0xea, 0x3a, 0x66, 0x27, 0xe2, 0x31, 0x81, 0x51, 0xf9, 0x88,
+	0x30, 0x15, 0x97, 0xca, 0x55, 0x75, 0x35, 0x3a, 0x3d, 0x66, 0x3a, 0x76, 0x8d, 0xd3, 0x59, 0xa0,
+	0xf3, 0xd5, 0xe9, 0xd0, 0xef, 0x09, 0x8c, 0xc4, 0x5f, 0xb6, 0x73, 0x05, 0x7a, 0x8a, 0xc3, 0x8d,
+	0xa2, 0x96, 0x35, 0x47, 0x98, 0xaf, 0x71, 0x98, 0x97, 0xe9, 0xc5, 0x1c, 0x98, 0x38, 0x20, 0x08,
+	0x62, 0x77, 0x07, 0xb8, 0x2d, 0xfa, 0x35, 0x01, 0xc0, 0x90, 0xa1, 0xca, 0xe7, 0x0a, 0xd4, 0xaa,
+	0x02, 0x76, 0xfb, 0x94, 0xc3, 0xe6, 0x38, 0xd8, 0x33, 0x74, 0xb6, 0x34, 0x58, 0xfa, 0x05, 0x81,
+	0x91, 0xb8, 0xa5, 0x3f, 0xdd, 0x5b, 0x9b, 0xf4, 0xc0, 0xa2, 0x9c, 0x29, 0x65, 0x5b, 0x12, 0x17,
+	0xe6, 0x3a, 0x8d, 0xeb, 0x27, 0x02, 0x07, 0xa5, 0x8e, 0x9f, 0xce, 0xf7, 0x3a, 0x33, 0x7b, 0xb2,
+	0x50, 0x2e, 0x54, 0xf2, 0x29, 0x59, 0x9b, 0xc9, 0x60, 0xb1, 0xe2, 0x86, 0x8e, 0x52, 0x6d, 0x42,
+	0xd2, 0xae, 0xd2, 0xf3, 0x85, 0xf5, 0x26, 0xb5, 0xdf, 0xca, 0x5c, 0x05, 0x0f, 0xc4, 0xbb, 0xc0,
+	0xf1, 0xaa, 0xf4, 0x6c, 0x6e, 0xde, 0x63, 0x97, 0x34, 0xd2, 0x6f, 0x08, 0xfc, 0x2f, 0x09, 0xb6,
+	0x68, 0x15, 0x80, 0xcd, 0x9a, 0x15, 0x94, 0xb9, 0x0a, 0x1e, 0x08, 0x76, 0x96, 0x83, 0x7d, 0x99,
+	0x1e, 0x2f, 0x04, 0x4b, 0x7f, 0x09, 0x8b, 0x40, 0xea, 0x02, 0x8b, 0x3e, 0x62, 0xd9, 0x0d, 0xb0,
+	0x72, 0xa9, 0xaa, 0x1b, 0xa2, 0xbd, 0xca, 0xd1, 0x5e, 0xa0, 0x73, 0x79, 0xa5, 0x20, 0xfa, 0xa5,
+	0xf5, 0xfd, 0x91, 0x00, 0x95, 0xc2, 0x86, 0x22, 0x17, 0x7d, 0xbe, 0x76, 0x42, 0x20, 0xbf, 0x1b,
+	0x67, 0x2a, 0x27, 0x30, 0x43, 0x4f, 0x96, 0x23, 0x40, 0x3f, 0x25, 0x30, 0x1c, 0xcd, 0xb4, 0x74,
+	0xb6, 0xe7, 0xdd, 0x49, 0x0f, 0xd1, 0xca, 0xe9, 0x32, 0xa6, 0x88, 0xe8, 0x04, 0x47, 0x34, 0x45,
+	0x27, 0xf3, 0x11, 0x85, 0x13, 0xf5, 0xcd, 0x27, 0xcf, 0xea, 0xe4, 0xe9, 0xb3, 0x3a, 0xf9, 0xe7,
+	0x59, 0x9d, 0x7c, 0xfe, 0xbc, 0x3e, 0xf0, 0xf4, 0x79, 0x7d, 0xe0, 0xaf, 0xe7, 0xf5, 0x81, 0xf7,
+	0x66, 0x5a, 0x66, 0xd0, 0xee, 0xac, 0xaa, 0x4d, 0x67, 0x5d, 0x0e, 0xf1, 0x61, 0x1c, 0x24, 0xd8,
+	0x74, 0x0d, 0x7f, 0x75, 0x98, 0xff, 0xcb, 0xed, 0xc2, 0x7f, 0x03, 0x00, 0x37, 0xfe, 0x43, 0x3d,
+	0xd3, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a chain by index.
+	Chain(ctx context.Context, in *QueryGetChainRequest, opts ...grpc.CallOption) (*QueryGetChainResponse, error)
+	// Queries a list of chain items.
+	ChainAll(ctx context.Context, in *QueryAllChainRequest, opts ...grpc.CallOption) (*QueryAllChainResponse, error)
+	// Queries a genesisAccount by index.
+	GenesisAccount(ctx context.Context, in *QueryGetGenesisAccountRequest, opts ...grpc.CallOption) (*QueryGetGenesisAccountResponse, error)
+	// Queries a list of genesisAccount items.
+	GenesisAccountAll(ctx context.Context, in *QueryAllGenesisAccountRequest, opts ...grpc.CallOption) (*QueryAllGenesisAccountResponse, error)
+	// Queries a vestingAccount by index.
+	VestingAccount(ctx context.Context, in *QueryGetVestingAccountRequest, opts ...grpc.CallOption) (*QueryGetVestingAccountResponse, error)
+	// Queries a list of vestingAccount items.
+	VestingAccountAll(ctx context.Context, in *QueryAllVestingAccountRequest, opts ...grpc.CallOption) (*QueryAllVestingAccountResponse, error)
+	// Queries a genesisValidator by index.
+	GenesisValidator(ctx context.Context, in *QueryGetGenesisValidatorRequest, opts ...grpc.CallOption) (*QueryGetGenesisValidatorResponse, error)
+	// Queries a list of genesisValidator items.
+	GenesisValidatorAll(ctx context.Context, in *QueryAllGenesisValidatorRequest, opts ...grpc.CallOption) (*QueryAllGenesisValidatorResponse, error)
+	// Queries a request by index.
+	Request(ctx context.Context, in *QueryGetRequestRequest, opts ...grpc.CallOption) (*QueryGetRequestResponse, error)
+	// Queries a list of request for a chain.
+	RequestAll(ctx context.Context, in *QueryAllRequestRequest, opts ...grpc.CallOption) (*QueryAllRequestResponse, error)
+	// Queries the genesis of a chain generated from its launch information.
+	Genesis(ctx context.Context, in *QueryGenesisRequest, opts ...grpc.CallOption) (*QueryGenesisResponse, error)
+	// Queries the persistent peers of the genesis validators of a chain.
+	PersistentPeers(ctx context.Context, in *QueryPersistentPeersRequest, opts ...grpc.CallOption) (*QueryPersistentPeersResponse, error)
+	// Queries a rewardPool by index.
+	RewardPool(ctx context.Context, in *QueryGetRewardPoolRequest, opts ...grpc.CallOption) (*QueryGetRewardPoolResponse, error)
+	// Queries a list of rewardPool items.
+	RewardPoolAll(ctx context.Context, in *QueryAllRewardPoolRequest, opts ...grpc.CallOption) (*QueryAllRewardPoolResponse, error)
+	// Queries a participantList by index.
+	ParticipantList(ctx context.Context, in *QueryGetParticipantListRequest, opts ...grpc.CallOption) (*QueryGetParticipantListResponse, error)
+	// Queries a list of participantList items.
+	ParticipantListAll(ctx context.Context, in *QueryAllParticipantListRequest, opts ...grpc.CallOption) (*QueryAllParticipantListResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Chain(ctx context.Context, in *QueryGetChainRequest, opts ...grpc.CallOption) (*QueryGetChainResponse, error) {
+	out := new(QueryGetChainResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/Chain", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GenesisAccountAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/GenesisAccountAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GenesisAccountAll(ctx, req.(*QueryAllGenesisAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_VestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetVestingAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ChainAll(ctx context.Context, in *QueryAllChainRequest, opts ...grpc.CallOption) (*QueryAllChainResponse, error) {
+	out := new(QueryAllChainResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/ChainAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).VestingAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/VestingAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VestingAccount(ctx, req.(*QueryGetVestingAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_VestingAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllVestingAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GenesisAccount(ctx context.Context, in *QueryGetGenesisAccountRequest, opts ...grpc.CallOption) (*QueryGetGenesisAccountResponse, error) {
+	out := new(QueryGetGenesisAccountResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/GenesisAccount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).VestingAccountAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/VestingAccountAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VestingAccountAll(ctx, req.(*QueryAllVestingAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GenesisValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGenesisValidatorRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GenesisAccountAll(ctx context.Context, in *QueryAllGenesisAccountRequest, opts ...grpc.CallOption) (*QueryAllGenesisAccountResponse, error) {
+	out := new(QueryAllGenesisAccountResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/GenesisAccountAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GenesisValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/GenesisValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GenesisValidator(ctx, req.(*QueryGetGenesisValidatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GenesisValidatorAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGenesisValidatorRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) VestingAccount(ctx context.Context, in *QueryGetVestingAccountRequest, opts ...grpc.CallOption) (*QueryGetVestingAccountResponse, error) {
+	out := new(QueryGetVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/VestingAccount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GenesisValidatorAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/GenesisValidatorAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GenesisValidatorAll(ctx, req.(*QueryAllGenesisValidatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRequestRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) VestingAccountAll(ctx context.Context, in *QueryAllVestingAccountRequest, opts ...grpc.CallOption) (*QueryAllVestingAccountResponse, error) {
+	out := new(QueryAllVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/VestingAccountAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Request(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/Request",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Request(ctx, req.(*QueryGetRequestRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RequestAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllRequestRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GenesisValidator(ctx context.Context, in *QueryGetGenesisValidatorRequest, opts ...grpc.CallOption) (*QueryGetGenesisValidatorResponse, error) {
+	out := new(QueryGetGenesisValidatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/GenesisValidator", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RequestAll(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) GenesisValidatorAll(ctx context.Context, in *QueryAllGenesisValidatorRequest, opts ...grpc.CallOption) (*QueryAllGenesisValidatorResponse, error) {
+	out := new(QueryAllGenesisValidatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/GenesisValidatorAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/RequestAll",
+	return out, nil
+}
+
+func (c *queryClient) Request(ctx context.Context, in *QueryGetRequestRequest, opts ...grpc.CallOption) (*QueryGetRequestResponse, error) {
+	out := new(QueryGetRequestResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/Request", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RequestAll(ctx, req.(*QueryAllRequestRequest))
+	return out, nil
+}
+
+func (c *queryClient) RequestAll(ctx context.Context, in *QueryAllRequestRequest, opts ...grpc.CallOption) (*QueryAllRequestResponse, error) {
+	out := new(QueryAllRequestResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/RequestAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Genesis(ctx context.Context, in *QueryGenesisRequest, opts ...grpc.CallOption) (*QueryGenesisResponse, error) {
+	out := new(QueryGenesisResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/Genesis", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) PersistentPeers(ctx context.Context, in *QueryPersistentPeersRequest, opts ...grpc.CallOption) (*QueryPersistentPeersResponse, error) {
+	out := new(QueryPersistentPeersResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/PersistentPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tendermint.spn.launch.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Chain",
-			Handler:    _Query_Chain_Handler,
-		},
-		{
-			MethodName: "ChainAll",
-			Handler:    _Query_ChainAll_Handler,
-		},
-		{
-			MethodName: "GenesisAccount",
-			Handler:    _Query_GenesisAccount_Handler,
-		},
-		{
-			MethodName: "GenesisAccountAll",
-			Handler:    _Query_GenesisAccountAll_Handler,
-		},
-		{
-			MethodName: "VestingAccount",
-			Handler:    _Query_VestingAccount_Handler,
-		},
-		{
-			MethodName: "VestingAccountAll",
-			Handler:    _Query_VestingAccountAll_Handler,
-		},
-		{
-			MethodName: "GenesisValidator",
-			Handler:    _Query_GenesisValidator_Handler,
-		},
-		{
-			MethodName: "GenesisValidatorAll",
-			Handler:    _Query_GenesisValidatorAll_Handler,
-		},
-		{
-			MethodName: "Request",
-			Handler:    _Query_Request_Handler,
-		},
-		{
-			MethodName: "RequestAll",
-			Handler:    _Query_RequestAll_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "launch/query.proto",
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryGetRewardPoolRequest, opts ...grpc.CallOption) (*QueryGetRewardPoolResponse, error) {
+	out := new(QueryGetRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/RewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RewardPoolAll(ctx context.Context, in *QueryAllRewardPoolRequest, opts ...grpc.CallOption) (*QueryAllRewardPoolResponse, error) {
+	out := new(QueryAllRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/RewardPoolAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ParticipantList(ctx context.Context, in *QueryGetParticipantListRequest, opts ...grpc.CallOption) (*QueryGetParticipantListResponse, error) {
+	out := new(QueryGetParticipantListResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/ParticipantList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LaunchID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ParticipantListAll(ctx context.Context, in *QueryAllParticipantListRequest, opts ...grpc.CallOption) (*QueryAllParticipantListResponse, error) {
+	out := new(QueryAllParticipantListResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/ParticipantListAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a chain by index.
+	Chain(context.Context, *QueryGetChainRequest) (*QueryGetChainResponse, error)
+	// Queries a list of chain items.
+	ChainAll(context.Context, *QueryAllChainRequest) (*QueryAllChainResponse, error)
+	// Queries a genesisAccount by index.
+	GenesisAccount(context.Context, *QueryGetGenesisAccountRequest) (*QueryGetGenesisAccountResponse, error)
+	// Queries a list of genesisAccount items.
+	GenesisAccountAll(context.Context, *QueryAllGenesisAccountRequest) (*QueryAllGenesisAccountResponse, error)
+	// Queries a vestingAccount by index.
+	VestingAccount(context.Context, *QueryGetVestingAccountRequest) (*QueryGetVestingAccountResponse, error)
+	// Queries a list of vestingAccount items.
+	VestingAccountAll(context.Context, *QueryAllVestingAccountRequest) (*QueryAllVestingAccountResponse, error)
+	// Queries a genesisValidator by index.
+	GenesisValidator(context.Context, *QueryGetGenesisValidatorRequest) (*QueryGetGenesisValidatorResponse, error)
+	// Queries a list of genesisValidator items.
+	GenesisValidatorAll(context.Context, *QueryAllGenesisValidatorRequest) (*QueryAllGenesisValidatorResponse, error)
+	// Queries a request by index.
+	Request(context.Context, *QueryGetRequestRequest) (*QueryGetRequestResponse, error)
+	// Queries a list of request for a chain.
+	RequestAll(context.Context, *QueryAllRequestRequest) (*QueryAllRequestResponse, error)
+	// Queries the genesis of a chain generated from its launch information.
+	Genesis(context.Context, *QueryGenesisRequest) (*QueryGenesisResponse, error)
+	// Queries the persistent peers of the genesis validators of a chain.
+	PersistentPeers(context.Context, *QueryPersistentPeersRequest) (*QueryPersistentPeersResponse, error)
+	// Queries a rewardPool by index.
+	RewardPool(context.Context, *QueryGetRewardPoolRequest) (*QueryGetRewardPoolResponse, error)
+	// Queries a list of rewardPool items.
+	RewardPoolAll(context.Context, *QueryAllRewardPoolRequest) (*QueryAllRewardPoolResponse, error)
+	// Queries a participantList by index.
+	ParticipantList(context.Context, *QueryGetParticipantListRequest) (*QueryGetParticipantListResponse, error)
+	// Queries a list of participantList items.
+	ParticipantListAll(context.Context, *QueryAllParticipantListRequest) (*QueryAllParticipantListResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryGetChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAllChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Chain(ctx context.Context, req *QueryGetChainRequest) (*QueryGetChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Chain not implemented")
 }
-
-func (m *QueryAllChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ChainAll(ctx context.Context, req *QueryAllChainRequest) (*QueryAllChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainAll not implemented")
+}
+func (*UnimplementedQueryServer) GenesisAccount(ctx context.Context, req *QueryGetGenesisAccountRequest) (*QueryGetGenesisAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenesisAccount not implemented")
+}
+func (*UnimplementedQueryServer) GenesisAccountAll(ctx context.Context, req *QueryAllGenesisAccountRequest) (*QueryAllGenesisAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenesisAccountAll not implemented")
+}
+func (*UnimplementedQueryServer) VestingAccount(ctx context.Context, req *QueryGetVestingAccountRequest) (*QueryGetVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccount not implemented")
+}
+func (*UnimplementedQueryServer) VestingAccountAll(ctx context.Context, req *QueryAllVestingAccountRequest) (*QueryAllVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccountAll not implemented")
+}
+func (*UnimplementedQueryServer) GenesisValidator(ctx context.Context, req *QueryGetGenesisValidatorRequest) (*QueryGetGenesisValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenesisValidator not implemented")
+}
+func (*UnimplementedQueryServer) GenesisValidatorAll(ctx context.Context, req *QueryAllGenesisValidatorRequest) (*QueryAllGenesisValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenesisValidatorAll not implemented")
+}
+func (*UnimplementedQueryServer) Request(ctx context.Context, req *QueryGetRequestRequest) (*QueryGetRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
+}
+func (*UnimplementedQueryServer) RequestAll(ctx context.Context, req *QueryAllRequestRequest) (*QueryAllRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAll not implemented")
+}
+func (*UnimplementedQueryServer) Genesis(ctx context.Context, req *QueryGenesisRequest) (*QueryGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Genesis not implemented")
+}
+func (*UnimplementedQueryServer) PersistentPeers(ctx context.Context, req *QueryPersistentPeersRequest) (*QueryPersistentPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PersistentPeers not implemented")
+}
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryGetRewardPoolRequest) (*QueryGetRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) RewardPoolAll(ctx context.Context, req *QueryAllRewardPoolRequest) (*QueryAllRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPoolAll not implemented")
+}
+func (*UnimplementedQueryServer) ParticipantList(ctx context.Context, req *QueryGetParticipantListRequest) (*QueryGetParticipantListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantList not implemented")
+}
+func (*UnimplementedQueryServer) ParticipantListAll(ctx context.Context, req *QueryAllParticipantListRequest) (*QueryAllParticipantListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantListAll not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *QueryAllChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Chain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Chain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/Chain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Chain(ctx, req.(*QueryGetChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ChainAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/ChainAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainAll(ctx, req.(*QueryAllChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_GenesisAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGenesisAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Chain) > 0 {
-		for iNdEx := len(m.Chain) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GenesisAccount(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/GenesisAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GenesisAccount(ctx, req.(*QueryGetGenesisAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGenesisAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GenesisAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGenesisAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetGenesisAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetGenesisAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GenesisAccountAll(ctx, in)
 	}
-	if m.LaunchID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/GenesisAccountAll",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GenesisAccountAll(ctx, req.(*QueryAllGenesisAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGenesisAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_VestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVestingAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/VestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccount(ctx, req.(*QueryGetVestingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGenesisAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_VestingAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVestingAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccountAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/VestingAccountAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccountAll(ctx, req.(*QueryAllVestingAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGenesisAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.GenesisAccount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_GenesisValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGenesisValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GenesisValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/GenesisValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GenesisValidator(ctx, req.(*QueryGetGenesisValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGenesisAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GenesisValidatorAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGenesisValidatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GenesisValidatorAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/GenesisValidatorAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GenesisValidatorAll(ctx, req.(*QueryAllGenesisValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGenesisAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Request(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/Request",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Request(ctx, req.(*QueryGetRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGenesisAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_RequestAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.LaunchID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).RequestAll(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/RequestAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestAll(ctx, req.(*QueryAllRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGenesisAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Genesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGenesisRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Genesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/Genesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Genesis(ctx, req.(*QueryGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGenesisAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PersistentPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPersistentPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PersistentPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/PersistentPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PersistentPeers(ctx, req.(*QueryPersistentPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGenesisAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.GenesisAccount) > 0 {
-		for iNdEx := len(m.GenesisAccount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GenesisAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryGetRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetVestingAccountRequest) Marshal() (dAtA []byte, err error) {
+func _Query_RewardPoolAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPoolAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/RewardPoolAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPoolAll(ctx, req.(*QueryAllRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetParticipantListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/ParticipantList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantList(ctx, req.(*QueryGetParticipantListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllParticipantListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/ParticipantListAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantListAll(ctx, req.(*QueryAllParticipantListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.spn.launch.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Chain",
+			Handler:    _Query_Chain_Handler,
+		},
+		{
+			MethodName: "ChainAll",
+			Handler:    _Query_ChainAll_Handler,
+		},
+		{
+			MethodName: "GenesisAccount",
+			Handler:    _Query_GenesisAccount_Handler,
+		},
+		{
+			MethodName: "GenesisAccountAll",
+			Handler:    _Query_GenesisAccountAll_Handler,
+		},
+		{
+			MethodName: "VestingAccount",
+			Handler:    _Query_VestingAccount_Handler,
+		},
+		{
+			MethodName: "VestingAccountAll",
+			Handler:    _Query_VestingAccountAll_Handler,
+		},
+		{
+			MethodName: "GenesisValidator",
+			Handler:    _Query_GenesisValidator_Handler,
+		},
+		{
+			MethodName: "GenesisValidatorAll",
+			Handler:    _Query_GenesisValidatorAll_Handler,
+		},
+		{
+			MethodName: "Request",
+			Handler:    _Query_Request_Handler,
+		},
+		{
+			MethodName: "RequestAll",
+			Handler:    _Query_RequestAll_Handler,
+		},
+		{
+			MethodName: "Genesis",
+			Handler:    _Query_Genesis_Handler,
+		},
+		{
+			MethodName: "PersistentPeers",
+			Handler:    _Query_PersistentPeers_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "RewardPoolAll",
+			Handler:    _Query_RewardPoolAll_Handler,
+		},
+		{
+			MethodName: "ParticipantList",
+			Handler:    _Query_ParticipantList_Handler,
+		},
+		{
+			MethodName: "ParticipantListAll",
+			Handler:    _Query_ParticipantListAll_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "launch/query.proto",
+}
+
+func (m *QueryGetChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1967,23 +2533,16 @@ func (m *QueryGetVestingAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetVestingAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetVestingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.LaunchID != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
 		i--
@@ -1992,7 +2551,7 @@ func (m *QueryGetVestingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2002,18 +2561,18 @@ func (m *QueryGetVestingAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.VestingAccount.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2025,7 +2584,7 @@ func (m *QueryGetVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllVestingAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2035,12 +2594,12 @@ func (m *QueryAllVestingAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllVestingAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllVestingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2055,17 +2614,12 @@ func (m *QueryAllVestingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.LaunchID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2075,12 +2629,12 @@ func (m *QueryAllVestingAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2097,10 +2651,10 @@ func (m *QueryAllVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.VestingAccount) > 0 {
-		for iNdEx := len(m.VestingAccount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Chain) > 0 {
+		for iNdEx := len(m.Chain) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.VestingAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Chain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2114,7 +2668,7 @@ func (m *QueryAllVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGenesisValidatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetGenesisAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2124,12 +2678,12 @@ func (m *QueryGetGenesisValidatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetGenesisValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetGenesisAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetGenesisValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetGenesisAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2149,7 +2703,7 @@ func (m *QueryGetGenesisValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGenesisValidatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetGenesisAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2159,18 +2713,18 @@ func (m *QueryGetGenesisValidatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetGenesisValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetGenesisAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetGenesisValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetGenesisAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.GenesisValidator.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.GenesisAccount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2182,7 +2736,7 @@ func (m *QueryGetGenesisValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGenesisValidatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllGenesisAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2192,12 +2746,12 @@ func (m *QueryAllGenesisValidatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGenesisValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllGenesisAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGenesisValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllGenesisAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2222,7 +2776,7 @@ func (m *QueryAllGenesisValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGenesisValidatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllGenesisAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2232,12 +2786,12 @@ func (m *QueryAllGenesisValidatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGenesisValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllGenesisAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGenesisValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllGenesisAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2254,10 +2808,10 @@ func (m *QueryAllGenesisValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (in
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.GenesisValidator) > 0 {
-		for iNdEx := len(m.GenesisValidator) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.GenesisAccount) > 0 {
+		for iNdEx := len(m.GenesisAccount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.GenesisValidator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.GenesisAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2271,7 +2825,7 @@ func (m *QueryAllGenesisValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRequestRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetVestingAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2281,20 +2835,22 @@ func (m *QueryGetRequestRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetVestingAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetVestingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RequestID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RequestID))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.LaunchID != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
@@ -2304,7 +2860,7 @@ func (m *QueryGetRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRequestResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetVestingAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2314,18 +2870,18 @@ func (m *QueryGetRequestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.VestingAccount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2337,7 +2893,7 @@ func (m *QueryGetRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllRequestRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllVestingAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2347,12 +2903,12 @@ func (m *QueryAllRequestRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllVestingAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllVestingAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2377,7 +2933,7 @@ func (m *QueryAllRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllRequestResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllVestingAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2387,12 +2943,12 @@ func (m *QueryAllRequestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2409,10 +2965,10 @@ func (m *QueryAllRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Request) > 0 {
-		for iNdEx := len(m.Request) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.VestingAccount) > 0 {
+		for iNdEx := len(m.VestingAccount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Request[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.VestingAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2426,7 +2982,7 @@ func (m *QueryAllRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetGenesisValidatorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2436,20 +2992,32 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetGenesisValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetGenesisValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetGenesisValidatorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2459,18 +3027,18 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetGenesisValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetGenesisValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.GenesisValidator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2482,346 +3050,2639 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAllGenesisValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAllGenesisValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGenesisValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllGenesisValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Chain.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllGenesisValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllGenesisValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Chain) > 0 {
-		for _, e := range m.Chain {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GenesisValidator) > 0 {
+		for iNdEx := len(m.GenesisValidator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GenesisValidator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGenesisAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetGenesisAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.GenesisAccount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGenesisAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
+	if m.RequestID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.LaunchID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGenesisAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GenesisAccount) > 0 {
-		for _, e := range m.GenesisAccount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetVestingAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.VestingAccount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllVestingAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *QueryAllVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if len(m.VestingAccount) > 0 {
-		for _, e := range m.VestingAccount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.LaunchID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGenesisValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Request) > 0 {
+		for iNdEx := len(m.Request) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Request[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGenesisValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGenesisRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.GenesisValidator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllGenesisValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGenesisRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGenesisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
+	if len(m.InitialGenesis) > 0 {
+		i -= len(m.InitialGenesis)
+		copy(dAtA[i:], m.InitialGenesis)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InitialGenesis)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.LaunchID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGenesisValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGenesisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGenesisResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGenesisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GenesisValidator) > 0 {
-		for _, e := range m.GenesisValidator {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Genesis) > 0 {
+		i -= len(m.Genesis)
+		copy(dAtA[i:], m.Genesis)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Genesis)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size, err := m.InitialGenesis.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPersistentPeersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPersistentPeersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPersistentPeersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
-	}
-	if m.RequestID != 0 {
-		n += 1 + sovQuery(uint64(m.RequestID))
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPersistentPeersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Request.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPersistentPeersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPersistentPeersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
+	if len(m.PersistentPeers) > 0 {
+		i -= len(m.PersistentPeers)
+		copy(dAtA[i:], m.PersistentPeers)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PersistentPeers)))
+		i--
+		dAtA[i] = 0xa
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Request) > 0 {
-		for _, e := range m.Request {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetParticipantListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetParticipantListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetParticipantListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetParticipantListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetParticipantListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetParticipantListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ParticipantList.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllParticipantListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllParticipantListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllParticipantListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllParticipantListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllParticipantListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllParticipantListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParticipantList) > 0 {
+		for iNdEx := len(m.ParticipantList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *QueryGetChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		for _, e := range m.Chain {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGenesisAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGenesisAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GenesisAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGenesisAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGenesisAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GenesisAccount) > 0 {
+		for _, e := range m.GenesisAccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVestingAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VestingAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllVestingAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VestingAccount) > 0 {
+		for _, e := range m.VestingAccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGenesisValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGenesisValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GenesisValidator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGenesisValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGenesisValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GenesisValidator) > 0 {
+		for _, e := range m.GenesisValidator {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	if m.RequestID != 0 {
+		n += 1 + sovQuery(uint64(m.RequestID))
+	}
+	return n
+}
+
+func (m *QueryGetRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Request) > 0 {
+		for _, e := range m.Request {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGenesisRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	l = len(m.InitialGenesis)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGenesisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialGenesis.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Genesis)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPersistentPeersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *QueryPersistentPeersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PersistentPeers)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *QueryGetRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetParticipantListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *QueryGetParticipantListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ParticipantList.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllParticipantListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllParticipantListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ParticipantList) > 0 {
+		for _, e := range m.ParticipantList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = append(m.Chain, Chain{})
+			if err := m.Chain[len(m.Chain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGenesisAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGenesisAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGenesisAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGenesisAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGenesisAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGenesisAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGenesisAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGenesisAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGenesisAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGenesisAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGenesisAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisAccount = append(m.GenesisAccount, GenesisAccount{})
+			if err := m.GenesisAccount[len(m.GenesisAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVestingAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVestingAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVestingAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAccount = append(m.VestingAccount, VestingAccount{})
+			if err := m.VestingAccount[len(m.VestingAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGenesisValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGenesisValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGenesisValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGenesisValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2844,17 +5705,156 @@ func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGenesisValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGenesisValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
 			}
-			m.LaunchID = 0
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGenesisValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGenesisValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisValidator = append(m.GenesisValidator, GenesisValidator{})
+			if err := m.GenesisValidator[len(m.GenesisValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2864,11 +5864,28 @@ func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2890,7 +5907,7 @@ func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2913,17 +5930,17 @@ func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
 			}
-			var msglen int
+			m.LaunchID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2933,25 +5950,30 @@ func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LaunchID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2973,7 +5995,7 @@ func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2996,15 +6018,15 @@ func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3031,10 +6053,7 @@ func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3059,7 +6078,7 @@ func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3082,17 +6101,17 @@ func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
 			}
-			var msglen int
+			m.LaunchID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3102,26 +6121,11 @@ func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LaunchID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chain = append(m.Chain, Chain{})
-			if err := m.Chain[len(m.Chain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -3152,67 +6156,49 @@ func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetGenesisAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGenesisAccountRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGenesisAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			m.LaunchID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3222,14 +6208,27 @@ func (m *QueryGetGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3257,7 +6256,7 @@ func (m *QueryGetGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3280,7 +6279,7 @@ func (m *QueryGetGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGenesisAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3290,28 +6289,62 @@ func (m *QueryGetGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Request = append(m.Request, Request{})
+			if err := m.Request[len(m.Request)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGenesisAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGenesisAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3338,7 +6371,10 @@ func (m *QueryGetGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GenesisAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3363,7 +6399,7 @@ func (m *QueryGetGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGenesisAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGenesisRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3386,10 +6422,10 @@ func (m *QueryAllGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGenesisAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGenesisRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGenesisAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGenesisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3413,9 +6449,9 @@ func (m *QueryAllGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialGenesis", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3425,27 +6461,23 @@ func (m *QueryAllGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InitialGenesis = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3468,7 +6500,7 @@ func (m *QueryAllGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGenesisResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3491,15 +6523,15 @@ func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGenesisAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGenesisResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGenesisAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialGenesis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3526,16 +6558,15 @@ func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisAccount = append(m.GenesisAccount, GenesisAccount{})
-			if err := m.GenesisAccount[len(m.GenesisAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialGenesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3545,27 +6576,55 @@ func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Genesis = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3588,7 +6647,7 @@ func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPersistentPeersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3611,10 +6670,10 @@ func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVestingAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPersistentPeersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPersistentPeersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3636,38 +6695,6 @@ func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3689,7 +6716,7 @@ func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPersistentPeersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3712,17 +6739,17 @@ func (m *QueryGetVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPersistentPeersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPersistentPeersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentPeers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3732,24 +6759,23 @@ func (m *QueryGetVestingAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PersistentPeers = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3772,7 +6798,7 @@ func (m *QueryGetVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVestingAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3795,67 +6821,12 @@ func (m *QueryAllVestingAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVestingAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
-			}
-			m.LaunchID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3877,7 +6848,7 @@ func (m *QueryAllVestingAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3900,49 +6871,15 @@ func (m *QueryAllVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAccount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VestingAccount = append(m.VestingAccount, VestingAccount{})
-			if err := m.VestingAccount[len(m.VestingAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3969,10 +6906,7 @@ func (m *QueryAllVestingAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3997,7 +6931,7 @@ func (m *QueryAllVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRewardPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4020,10 +6954,10 @@ func (m *QueryGetGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGenesisValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRewardPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGenesisValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4045,38 +6979,6 @@ func (m *QueryGetGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4098,7 +7000,7 @@ func (m *QueryGetGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRewardPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4121,15 +7023,15 @@ func (m *QueryGetGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGenesisValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRewardPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGenesisValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4156,7 +7058,7 @@ func (m *QueryGetGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GenesisValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4181,7 +7083,7 @@ func (m *QueryGetGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRewardPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4204,32 +7106,13 @@ func (m *QueryAllGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGenesisValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRewardPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGenesisValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
-			}
-			m.LaunchID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4286,7 +7169,7 @@ func (m *QueryAllGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRewardPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4309,15 +7192,15 @@ func (m *QueryAllGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGenesisValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRewardPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGenesisValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4344,8 +7227,8 @@ func (m *QueryAllGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisValidator = append(m.GenesisValidator, GenesisValidator{})
-			if err := m.GenesisValidator[len(m.GenesisValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardPool = append(m.RewardPool, RewardPool{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4406,7 +7289,7 @@ func (m *QueryAllGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetParticipantListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4429,10 +7312,10 @@ func (m *QueryGetRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetParticipantListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetParticipantListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4454,25 +7337,6 @@ func (m *QueryGetRequestRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
-			}
-			m.RequestID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4494,7 +7358,7 @@ func (m *QueryGetRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetParticipantListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4517,15 +7381,15 @@ func (m *QueryGetRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetParticipantListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetParticipantListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4552,7 +7416,7 @@ func (m *QueryGetRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParticipantList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4577,7 +7441,7 @@ func (m *QueryGetRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllParticipantListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4600,32 +7464,13 @@ func (m *QueryAllRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllParticipantListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllParticipantListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
-			}
-			m.LaunchID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4682,7 +7527,7 @@ func (m *QueryAllRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllParticipantListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4705,15 +7550,15 @@ func (m *QueryAllRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllParticipantListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllParticipantListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4740,8 +7585,8 @@ func (m *QueryAllRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = append(m.Request, Request{})
-			if err := m.Request[len(m.Request)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ParticipantList = append(m.ParticipantList, ParticipantList{})
+			if err := m.ParticipantList[len(m.ParticipantList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4802,139 +7647,6 @@ func (m *QueryAllRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	RequestContentTypeGenesisAccount   = "genesis_account"
+	RequestContentTypeVestingAccount   = "vesting_account"
+	RequestContentTypeGenesisValidator = "genesis_validator"
+	RequestContentTypeAccountRemoval   = "account_removal"
+	RequestContentTypeValidatorRemoval = "validator_removal"
+
+	// MaxRejectionReasonLength is the maximum length of the reason given for the rejection of requests
+	MaxRejectionReasonLength = 256
+)
+
+// Type returns the content type of the request content
+// An empty string is returned if the content is unrecognized
+func (m RequestContent) Type() string {
+	switch m.Content.(type) {
+	case *RequestContent_GenesisAccount:
+		return RequestContentTypeGenesisAccount
+	case *RequestContent_VestingAccount:
+		return RequestContentTypeVestingAccount
+	case *RequestContent_GenesisValidator:
+		return RequestContentTypeGenesisValidator
+	case *RequestContent_AccountRemoval:
+		return RequestContentTypeAccountRemoval
+	case *RequestContent_ValidatorRemoval:
+		return RequestContentTypeValidatorRemoval
+	default:
+		return ""
+	}
+}
+
+// IsValidRequestContentType checks the provided request content type exists
+func IsValidRequestContentType(contentType string) bool {
+	switch contentType {
+	case RequestContentTypeGenesisAccount,
+		RequestContentTypeVestingAccount,
+		RequestContentTypeGenesisValidator,
+		RequestContentTypeAccountRemoval,
+		RequestContentTypeValidatorRemoval:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseRequestStatus returns the request status from its case insensitive name
+func ParseRequestStatus(status string) (Request_Status, error) {
+	value, ok := Request_Status_value[strings.ToUpper(status)]
+	if !ok {
+		return Request_PENDING, fmt.Errorf("invalid request status %s", status)
+	}
+	return Request_Status(value), nil
+}
+
+// ValidateRejectionReason checks the reason given for the rejection of requests
+// The reason is optional and can only be provided if requests are rejected
+func ValidateRejectionReason(rejectionReason string, reject bool) error {
+	if rejectionReason == "" {
+		return nil
+	}
+	if !reject {
+		return sdkerrors.Wrap(ErrInvalidRejectionReason, "a rejection reason is provided without rejected request")
+	}
+	if len(rejectionReason) > MaxRejectionReasonLength {
+		return sdkerrors.Wrapf(ErrInvalidRejectionReason, "the rejection reason is longer than %d", MaxRejectionReasonLength)
+	}
+	return nil
+}
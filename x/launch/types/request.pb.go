@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -23,12 +25,62 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type Request_Status int32
+
+const (
+	// the request is waiting to be settled by the coordinator
+	Request_PENDING Request_Status = 0
+	// the request has been approved and its content has been applied
+	Request_APPROVED Request_Status = 1
+	// the request has been rejected by the coordinator
+	Request_REJECTED Request_Status = 2
+	// the request has been approved but its content can't be applied
+	Request_FAILED Request_Status = 3
+	// the request has been canceled by its creator before settlement
+	Request_CANCELED Request_Status = 4
+)
+
+var Request_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "APPROVED",
+	2: "REJECTED",
+	3: "FAILED",
+	4: "CANCELED",
+}
+
+var Request_Status_value = map[string]int32{
+	"PENDING":  0,
+	"APPROVED": 1,
+	"REJECTED": 2,
+	"FAILED":   3,
+	"CANCELED": 4,
+}
+
+func (x Request_Status) String() string {
+	return proto.EnumName(Request_Status_name, int32(x))
+}
+
+func (Request_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_028e4b0ce31bf039, []int{0, 0}
+}
+
 type Request struct {
 	LaunchID  uint64         `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
 	RequestID uint64         `protobuf:"varint,2,opt,name=requestID,proto3" json:"requestID,omitempty"`
 	Creator   string         `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 	CreatedAt int64          `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	Content   RequestContent `protobuf:"bytes,5,opt,name=content,proto3" json:"content"`
+	// status is the settlement status of the request
+	Status Request_Status `protobuf:"varint,6,opt,name=status,proto3,enum=tendermint.spn.launch.Request_Status" json:"status,omitempty"`
+	// settledHeight is the block height at which the request has been settled or canceled
+	SettledHeight int64 `protobuf:"varint,7,opt,name=settledHeight,proto3" json:"settledHeight,omitempty"`
+	// failureReason is the reason why the request content can't be applied if the status is FAILED
+	FailureReason string `protobuf:"bytes,8,opt,name=failureReason,proto3" json:"failureReason,omitempty"`
+	// deposit is the deposit escrowed from the creator of the request
+	// it is refunded when the request is approved or canceled and burned when the request is rejected
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// rejectionReason is the optional reason given by the coordinator if the status is REJECTED
+	RejectionReason string `protobuf:"bytes,10,opt,name=rejectionReason,proto3" json:"rejectionReason,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -99,6 +151,41 @@ func (m *Request) GetContent() RequestContent {
 	return RequestContent{}
 }
 
+func (m *Request) GetStatus() Request_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Request_PENDING
+}
+
+func (m *Request) GetSettledHeight() int64 {
+	if m != nil {
+		return m.SettledHeight
+	}
+	return 0
+}
+
+func (m *Request) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *Request) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Request) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
 type RequestContent struct {
 	// Types that are valid to be assigned to Content:
 	//	*RequestContent_GenesisAccount
@@ -312,6 +399,7 @@ func (m *ValidatorRemoval) GetValAddress() string {
 }
 
 func init() {
+	proto.RegisterEnum("tendermint.spn.launch.Request_Status", Request_Status_name, Request_Status_value)
 	proto.RegisterType((*Request)(nil), "tendermint.spn.launch.Request")
 	proto.RegisterType((*RequestContent)(nil), "tendermint.spn.launch.RequestContent")
 	proto.RegisterType((*AccountRemoval)(nil), "tendermint.spn.launch.AccountRemoval")
@@ -321,35 +409,48 @@ func init() {
 func init() { proto.RegisterFile("launch/request.proto", fileDescriptor_028e4b0ce31bf039) }
 
 var fileDescriptor_028e4b0ce31bf039 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcf, 0x8a, 0xd4, 0x30,
-	0x1c, 0x6e, 0x76, 0x66, 0x1d, 0x27, 0x0b, 0xc3, 0x12, 0x56, 0x28, 0xc3, 0x12, 0x87, 0x82, 0x58,
-	0x3c, 0xb4, 0x30, 0x3e, 0x41, 0xeb, 0x8a, 0xbb, 0x27, 0x21, 0xe0, 0x1c, 0xbc, 0x48, 0xb7, 0x0d,
-	0xdd, 0x42, 0x27, 0xa9, 0x4d, 0x5a, 0xf4, 0x2d, 0x7c, 0x20, 0x4f, 0x9e, 0xf6, 0xb8, 0x47, 0x4f,
-	0x22, 0x33, 0x2f, 0x22, 0x49, 0xd3, 0x8e, 0xa9, 0xee, 0xdc, 0x92, 0xdf, 0xef, 0xfb, 0xbe, 0x7c,
-	0xbf, 0x3f, 0x81, 0x17, 0x65, 0xd2, 0xb0, 0xf4, 0x2e, 0xac, 0xe9, 0xe7, 0x86, 0x0a, 0x19, 0x54,
-	0x35, 0x97, 0x1c, 0x3d, 0x93, 0x94, 0x65, 0xb4, 0xde, 0x16, 0x4c, 0x06, 0xa2, 0x62, 0x41, 0x07,
-	0x5a, 0x5e, 0xe4, 0x3c, 0xe7, 0x1a, 0x11, 0xaa, 0x53, 0x07, 0x5e, 0x5e, 0x1a, 0x89, 0x9c, 0x32,
-	0x2a, 0x0a, 0xf1, 0x29, 0x49, 0x53, 0xde, 0x30, 0x39, 0xca, 0xb6, 0x54, 0xc8, 0x82, 0xe5, 0xa3,
-	0x2c, 0x1e, 0x71, 0xdb, 0xa4, 0x2c, 0xb2, 0x44, 0xf2, 0xba, 0xcb, 0x7b, 0x3f, 0x00, 0x9c, 0x91,
-	0xce, 0x1a, 0x5a, 0xc2, 0xa7, 0x1d, 0xfa, 0xe6, 0xca, 0x05, 0x2b, 0xe0, 0x4f, 0xc9, 0x70, 0x47,
-	0x97, 0x70, 0x6e, 0x2a, 0xb8, 0xb9, 0x72, 0x4f, 0x74, 0xf2, 0x10, 0x40, 0x2e, 0x9c, 0xa5, 0x35,
-	0x55, 0xb2, 0xee, 0x64, 0x05, 0xfc, 0x39, 0xe9, 0xaf, 0x8a, 0xa7, 0x8f, 0x34, 0x8b, 0xa4, 0x3b,
-	0x5d, 0x01, 0x7f, 0x42, 0x0e, 0x01, 0xf4, 0x16, 0xce, 0x52, 0xce, 0x24, 0x65, 0xd2, 0x3d, 0x5d,
-	0x01, 0xff, 0x6c, 0xfd, 0x22, 0xf8, 0x6f, 0x63, 0x02, 0x63, 0xf1, 0x4d, 0x07, 0x8e, 0xa7, 0xf7,
-	0xbf, 0x9e, 0x3b, 0xa4, 0xe7, 0x7a, 0xdf, 0x27, 0x70, 0x61, 0x23, 0xd0, 0x7b, 0xb8, 0x30, 0x25,
-	0x47, 0x5d, 0x3f, 0x74, 0x45, 0x8f, 0x3f, 0xf0, 0xce, 0x02, 0x5f, 0x3b, 0x64, 0x44, 0x57, 0x82,
-	0xa6, 0xc3, 0xbd, 0xe0, 0xc9, 0x51, 0xc1, 0x8d, 0x05, 0x56, 0x82, 0x36, 0x1d, 0x7d, 0x80, 0xe7,
-	0xe6, 0x89, 0x4d, 0x3f, 0x13, 0xdd, 0xbc, 0xb3, 0xf5, 0xcb, 0xe3, 0x1e, 0x07, 0xf8, 0xb5, 0x43,
-	0xfe, 0x91, 0x50, 0x3e, 0xcd, 0x06, 0x10, 0xba, 0xe5, 0x6d, 0x52, 0xea, 0xae, 0x3f, 0xee, 0x33,
-	0xb2, 0xc0, 0xca, 0xa7, 0x4d, 0x57, 0x3e, 0x87, 0xa5, 0xe9, 0x25, 0x4f, 0x8f, 0xfa, 0xdc, 0x8c,
-	0xe0, 0xca, 0xe7, 0x58, 0x22, 0x9e, 0x0f, 0xa3, 0xf7, 0x5e, 0xc1, 0x85, 0xed, 0x42, 0xed, 0x53,
-	0x92, 0x65, 0x35, 0x15, 0x42, 0x8f, 0x6d, 0x4e, 0xfa, 0xab, 0xb7, 0x86, 0xe7, 0x63, 0x79, 0x84,
-	0x21, 0x6c, 0x93, 0x32, 0xb2, 0x08, 0x7f, 0x45, 0xe2, 0xf8, 0x7e, 0x87, 0xc1, 0xc3, 0x0e, 0x83,
-	0xdf, 0x3b, 0x0c, 0xbe, 0xed, 0xb1, 0xf3, 0xb0, 0xc7, 0xce, 0xcf, 0x3d, 0x76, 0x3e, 0xfa, 0x79,
-	0x21, 0xef, 0x9a, 0xdb, 0x20, 0xe5, 0xdb, 0xf0, 0x50, 0x4b, 0x28, 0x2a, 0x16, 0x7e, 0x09, 0xcd,
-	0xcf, 0x91, 0x5f, 0x2b, 0x2a, 0x6e, 0x9f, 0xe8, 0xef, 0xf2, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x08, 0x41, 0x10, 0x81, 0xcf, 0x03, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xb5, 0x9b, 0x34, 0x69, 0x36, 0x10, 0xa2, 0x55, 0x91, 0x4c, 0x55, 0xb9, 0x51, 0x04, 0xc2,
+	0x42, 0xc2, 0xa6, 0xe1, 0xcc, 0xc1, 0x49, 0x4c, 0x1b, 0x40, 0x6d, 0xb5, 0x40, 0x0e, 0x5c, 0x90,
+	0x63, 0x0f, 0xa9, 0x21, 0xd9, 0x0d, 0xde, 0x75, 0x04, 0x7f, 0xc1, 0x77, 0xc0, 0x2f, 0xf0, 0x01,
+	0x3d, 0xf6, 0xc8, 0x09, 0x50, 0xfb, 0x23, 0x68, 0xed, 0x75, 0x5a, 0x1b, 0x14, 0x4e, 0xf1, 0xcc,
+	0xbc, 0xf7, 0xfc, 0x26, 0x33, 0x63, 0xb4, 0x3d, 0xf3, 0x13, 0x1a, 0x9c, 0x3a, 0x31, 0x7c, 0x4c,
+	0x80, 0x0b, 0x7b, 0x11, 0x33, 0xc1, 0xf0, 0x6d, 0x01, 0x34, 0x84, 0x78, 0x1e, 0x51, 0x61, 0xf3,
+	0x05, 0xb5, 0x33, 0xd0, 0xce, 0xf6, 0x94, 0x4d, 0x59, 0x8a, 0x70, 0xe4, 0x53, 0x06, 0xde, 0x31,
+	0x03, 0xc6, 0xe7, 0x8c, 0x3b, 0x13, 0x9f, 0x83, 0xb3, 0xdc, 0x9f, 0x80, 0xf0, 0xf7, 0x9d, 0x80,
+	0x45, 0x54, 0xd5, 0x77, 0xd5, 0x2b, 0xa6, 0x40, 0x81, 0x47, 0xfc, 0xad, 0x1f, 0x04, 0x2c, 0xa1,
+	0xa2, 0x54, 0x5d, 0x02, 0x17, 0x11, 0x9d, 0x96, 0xaa, 0x66, 0x89, 0xbb, 0xf4, 0x67, 0x51, 0xe8,
+	0x0b, 0x16, 0x67, 0xf5, 0xee, 0xb7, 0x2a, 0xaa, 0x93, 0xcc, 0x3a, 0xde, 0x41, 0x5b, 0x19, 0x7a,
+	0x34, 0x34, 0xf4, 0x8e, 0x6e, 0x55, 0xc9, 0x2a, 0xc6, 0xbb, 0xa8, 0xa1, 0x3a, 0x1c, 0x0d, 0x8d,
+	0x8d, 0xb4, 0x78, 0x95, 0xc0, 0x06, 0xaa, 0x07, 0x31, 0x48, 0x59, 0xa3, 0xd2, 0xd1, 0xad, 0x06,
+	0xc9, 0x43, 0xc9, 0x4b, 0x1f, 0x21, 0x74, 0x85, 0x51, 0xed, 0xe8, 0x56, 0x85, 0x5c, 0x25, 0xb0,
+	0x87, 0xea, 0x01, 0xa3, 0x02, 0xa8, 0x30, 0x36, 0x3b, 0xba, 0xd5, 0xec, 0xdd, 0xb3, 0xff, 0xf9,
+	0xc7, 0xd9, 0xca, 0xe2, 0x20, 0x03, 0xf7, 0xab, 0x67, 0x3f, 0xf7, 0x34, 0x92, 0x73, 0xf1, 0x13,
+	0x54, 0xe3, 0xc2, 0x17, 0x09, 0x37, 0x6a, 0x1d, 0xdd, 0x6a, 0xfd, 0x4f, 0xc5, 0x7e, 0x99, 0x82,
+	0x89, 0x22, 0xe1, 0xbb, 0xe8, 0x26, 0x07, 0x21, 0x66, 0x10, 0x1e, 0x42, 0x34, 0x3d, 0x15, 0x46,
+	0x3d, 0xf5, 0x59, 0x4c, 0x4a, 0xd4, 0x3b, 0x3f, 0x9a, 0x25, 0x31, 0x10, 0xf0, 0x39, 0xa3, 0xc6,
+	0x56, 0xda, 0x69, 0x31, 0x89, 0x01, 0xd5, 0x43, 0x58, 0x30, 0x1e, 0x09, 0xa3, 0xd1, 0xa9, 0x58,
+	0xcd, 0xde, 0x1d, 0x3b, 0x9b, 0xae, 0x2d, 0xa7, 0x6b, 0xab, 0xe9, 0xda, 0x03, 0x16, 0xd1, 0xfe,
+	0x23, 0xd9, 0xc5, 0xd7, 0x5f, 0x7b, 0xd6, 0x34, 0x12, 0xa7, 0xc9, 0xc4, 0x0e, 0xd8, 0xdc, 0x51,
+	0xab, 0x90, 0xfd, 0x3c, 0xe4, 0xe1, 0x07, 0x47, 0x7c, 0x5e, 0x00, 0x4f, 0x09, 0x9c, 0xe4, 0xda,
+	0xd8, 0x42, 0xb7, 0x62, 0x78, 0x0f, 0x81, 0x88, 0x18, 0x55, 0x76, 0x50, 0x6a, 0xa7, 0x9c, 0xee,
+	0x3e, 0x47, 0xb5, 0xac, 0x5d, 0xdc, 0x44, 0xf5, 0x13, 0xef, 0x68, 0x38, 0x3a, 0x3a, 0x68, 0x6b,
+	0xf8, 0x06, 0xda, 0x72, 0x4f, 0x4e, 0xc8, 0xf1, 0xd8, 0x1b, 0xb6, 0x75, 0x19, 0x11, 0xef, 0x99,
+	0x37, 0x78, 0xe5, 0x0d, 0xdb, 0x1b, 0x18, 0xa1, 0xda, 0x53, 0x77, 0xf4, 0xc2, 0x1b, 0xb6, 0x2b,
+	0xb2, 0x32, 0x70, 0x8f, 0x06, 0x9e, 0x8c, 0xaa, 0xdd, 0xef, 0x15, 0xd4, 0x2a, 0x8e, 0x02, 0x1f,
+	0xa3, 0x96, 0xda, 0x2d, 0x37, 0x5b, 0x3c, 0x43, 0x5f, 0x3b, 0xc9, 0x83, 0x02, 0xf8, 0x50, 0x23,
+	0x25, 0xba, 0x14, 0x54, 0xab, 0x9c, 0x0b, 0x6e, 0xac, 0x15, 0x1c, 0x17, 0xc0, 0x52, 0xb0, 0x48,
+	0xc7, 0xaf, 0x51, 0x5b, 0xbd, 0x62, 0x9c, 0x2f, 0x7f, 0xba, 0xa5, 0xcd, 0xde, 0xfd, 0xf5, 0x1e,
+	0x57, 0xf0, 0x43, 0x8d, 0xfc, 0x25, 0x21, 0x7d, 0xaa, 0x53, 0x23, 0x30, 0x67, 0x4b, 0x7f, 0x66,
+	0x54, 0xd7, 0xfa, 0x74, 0x0b, 0x60, 0xe9, 0xb3, 0x48, 0x97, 0x3e, 0x57, 0xd7, 0x99, 0x4b, 0x6e,
+	0xae, 0xf5, 0x39, 0x2e, 0xc1, 0xa5, 0xcf, 0xb2, 0x44, 0xbf, 0xb1, 0xba, 0xb1, 0xee, 0x03, 0xd4,
+	0x2a, 0xba, 0x90, 0x87, 0xeb, 0x87, 0x61, 0x0c, 0x9c, 0xa7, 0x63, 0x6b, 0x90, 0x3c, 0xec, 0xf6,
+	0x50, 0xbb, 0x2c, 0x8f, 0x4d, 0x84, 0x96, 0xfe, 0xcc, 0x2d, 0x10, 0xae, 0x65, 0xfa, 0xfd, 0xb3,
+	0x0b, 0x53, 0x3f, 0xbf, 0x30, 0xf5, 0xdf, 0x17, 0xa6, 0xfe, 0xe5, 0xd2, 0xd4, 0xce, 0x2f, 0x4d,
+	0xed, 0xc7, 0xa5, 0xa9, 0xbd, 0xb9, 0xbe, 0xe2, 0x57, 0xbd, 0x38, 0x7c, 0x41, 0x9d, 0x4f, 0x8e,
+	0xfa, 0x44, 0xa5, 0x8b, 0x3e, 0xa9, 0xa5, 0xdf, 0xa5, 0xc7, 0x7f, 0x06, 0x00, 0x44, 0xda, 0x96,
+	0x28, 0x58, 0x05, 0x00, 0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -372,6 +473,44 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RejectionReason) > 0 {
+		i -= len(m.RejectionReason)
+		copy(dAtA[i:], m.RejectionReason)
+		i = encodeVarintRequest(dAtA, i, uint64(len(m.RejectionReason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintRequest(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SettledHeight != 0 {
+		i = encodeVarintRequest(dAtA, i, uint64(m.SettledHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintRequest(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -636,6 +775,26 @@ func (m *Request) Size() (n int) {
 	}
 	l = m.Content.Size()
 	n += 1 + l + sovRequest(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovRequest(uint64(m.Status))
+	}
+	if m.SettledHeight != 0 {
+		n += 1 + sovRequest(uint64(m.SettledHeight))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovRequest(uint64(l))
+		}
+	}
+	l = len(m.RejectionReason)
+	if l > 0 {
+		n += 1 + l + sovRequest(uint64(l))
+	}
 	return n
 }
 
@@ -894,6 +1053,142 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Request_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+			}
+			m.SettledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequest(dAtA[iNdEx:])
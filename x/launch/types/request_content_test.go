@@ -54,7 +54,7 @@ func TestNewGenesisValidator(t *testing.T) {
 	gentTx := sample.Bytes(300)
 	consPubKey := sample.Bytes(30)
 	selfDelegation := sample.Coin()
-	peer := sample.String(30)
+	peer := sample.Peer()
 	requestContent := types.NewGenesisValidator(launchID, address, gentTx, consPubKey, selfDelegation, peer)
 
 	genesisValidator := requestContent.GetGenesisValidator()
@@ -208,7 +208,7 @@ func TestGenesisValidator_Validate(t *testing.T) {
 				GenTx:          sample.Bytes(500),
 				ConsPubKey:     sample.Bytes(30),
 				SelfDelegation: sample.Coin(),
-				Peer:           sample.String(30),
+				Peer:           sample.Peer(),
 			},
 		},
 		{
@@ -219,7 +219,7 @@ func TestGenesisValidator_Validate(t *testing.T) {
 				GenTx:          sample.Bytes(500),
 				ConsPubKey:     sample.Bytes(30),
 				SelfDelegation: sample.Coin(),
-				Peer:           sample.String(30),
+				Peer:           sample.Peer(),
 			},
 			wantErr: true,
 		},
@@ -231,7 +231,7 @@ func TestGenesisValidator_Validate(t *testing.T) {
 				GenTx:          sample.Bytes(500),
 				ConsPubKey:     nil,
 				SelfDelegation: sample.Coin(),
-				Peer:           sample.String(30),
+				Peer:           sample.Peer(),
 			},
 			wantErr: true,
 		},
@@ -243,7 +243,7 @@ func TestGenesisValidator_Validate(t *testing.T) {
 				GenTx:          nil,
 				ConsPubKey:     sample.Bytes(30),
 				SelfDelegation: sample.Coin(),
-				Peer:           sample.String(30),
+				Peer:           sample.Peer(),
 			},
 			wantErr: true,
 		},
@@ -255,7 +255,19 @@ func TestGenesisValidator_Validate(t *testing.T) {
 				GenTx:          sample.Bytes(500),
 				ConsPubKey:     sample.Bytes(30),
 				SelfDelegation: sample.Coin(),
-				Peer:           "",
+				Peer:           types.Peer{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid peer",
+			content: types.GenesisValidator{
+				LaunchID:       launchID,
+				Address:        addr,
+				GenTx:          sample.Bytes(500),
+				ConsPubKey:     sample.Bytes(30),
+				SelfDelegation: sample.Coin(),
+				Peer:           types.NewPeerConn(sample.NodeID(), "invalid"),
 			},
 			wantErr: true,
 		},
@@ -270,7 +282,7 @@ func TestGenesisValidator_Validate(t *testing.T) {
 					Denom:  "",
 					Amount: sdk.NewInt(10),
 				},
-				Peer: sample.String(30),
+				Peer: sample.Peer(),
 			},
 			wantErr: true,
 		},
@@ -285,7 +297,7 @@ func TestGenesisValidator_Validate(t *testing.T) {
 					Denom:  "stake",
 					Amount: sdk.NewInt(0),
 				},
-				Peer: sample.String(30),
+				Peer: sample.Peer(),
 			},
 			wantErr: true,
 		},
@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestRequestContent_Type(t *testing.T) {
+	contents := sample.AllRequestContents(0, sample.Address(), sample.Address(), sample.Address())
+	for _, tc := range []struct {
+		desc     string
+		content  types.RequestContent
+		expected string
+	}{
+		{
+			desc:     "genesis account",
+			content:  contents[0],
+			expected: types.RequestContentTypeGenesisAccount,
+		},
+		{
+			desc:     "vesting account",
+			content:  contents[2],
+			expected: types.RequestContentTypeVestingAccount,
+		},
+		{
+			desc:     "account removal",
+			content:  contents[1],
+			expected: types.RequestContentTypeAccountRemoval,
+		},
+		{
+			desc:     "genesis validator",
+			content:  contents[4],
+			expected: types.RequestContentTypeGenesisValidator,
+		},
+		{
+			desc:     "validator removal",
+			content:  contents[5],
+			expected: types.RequestContentTypeValidatorRemoval,
+		},
+		{
+			desc:     "unrecognized content",
+			content:  types.RequestContent{},
+			expected: "",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.content.Type())
+			require.Equal(t, tc.expected != "", types.IsValidRequestContentType(tc.content.Type()))
+		})
+	}
+}
+
+func TestParseRequestStatus(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		status   string
+		expected types.Request_Status
+		valid    bool
+	}{
+		{
+			desc:     "pending",
+			status:   "pending",
+			expected: types.Request_PENDING,
+			valid:    true,
+		},
+		{
+			desc:     "approved",
+			status:   "APPROVED",
+			expected: types.Request_APPROVED,
+			valid:    true,
+		},
+		{
+			desc:     "rejected",
+			status:   "Rejected",
+			expected: types.Request_REJECTED,
+			valid:    true,
+		},
+		{
+			desc:     "failed",
+			status:   "failed",
+			expected: types.Request_FAILED,
+			valid:    true,
+		},
+		{
+			desc:   "invalid status",
+			status: "foo",
+			valid:  false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			status, err := types.ParseRequestStatus(tc.status)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, status)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRewardPool returns a new reward pool for a chain
+func NewRewardPool(launchID uint64, provider string, coins sdk.Coins, lastRewardHeight int64) RewardPool {
+	return RewardPool{
+		LaunchID:         launchID,
+		Provider:         provider,
+		Coins:            coins,
+		LastRewardHeight: lastRewardHeight,
+	}
+}
+
+// Validate checks the reward pool is valid
+func (m RewardPool) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Provider); err != nil {
+		return err
+	}
+	if err := m.Coins.Validate(); err != nil {
+		return err
+	}
+	if m.Coins.Empty() {
+		return errors.New("reward pool is empty")
+	}
+	if m.LastRewardHeight <= 0 {
+		return errors.New("last reward height must be positive")
+	}
+
+	claimed := make(map[string]struct{})
+	for _, validator := range m.Claimed {
+		if _, err := sdk.AccAddressFromBech32(validator); err != nil {
+			return err
+		}
+		if _, ok := claimed[validator]; ok {
+			return fmt.Errorf("rewards claimed twice by %s", validator)
+		}
+		claimed[validator] = struct{}{}
+	}
+	return nil
+}
+
+// IsClaimed returns true if the validator claimed its rewards
+func (m RewardPool) IsClaimed(validator string) bool {
+	for _, claimed := range m.Claimed {
+		if claimed == validator {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatorRewards returns the rewards earned by each of the validators of the chain
+// and the rewards that are refunded to the provider
+// The rewards are earned proportionally to the last block height reached by the chain
+// and equally shared between the validators
+func (m RewardPool) ValidatorRewards(lastBlockHeight int64, validatorNb int64) (rewards, refund sdk.Coins) {
+	if validatorNb <= 0 || lastBlockHeight <= 0 {
+		return sdk.NewCoins(), m.Coins
+	}
+	if lastBlockHeight > m.LastRewardHeight {
+		lastBlockHeight = m.LastRewardHeight
+	}
+
+	distributed := sdk.NewCoins()
+	for _, coin := range m.Coins {
+		earned := coin.Amount.MulRaw(lastBlockHeight).QuoRaw(m.LastRewardHeight)
+		reward := earned.QuoRaw(validatorNb)
+		if reward.IsPositive() {
+			rewards = rewards.Add(sdk.NewCoin(coin.Denom, reward))
+			distributed = distributed.Add(sdk.NewCoin(coin.Denom, reward.MulRaw(validatorNb)))
+		}
+	}
+
+	return sdk.NewCoins(rewards...), m.Coins.Sub(distributed)
+}
@@ -0,0 +1,519 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: launch/reward_pool.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardPool contains the rewards provided for the genesis validators of a chain
+type RewardPool struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	// provider is the address that funded the pool and that is refunded with the unearned rewards
+	Provider string                                   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Coins    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// lastRewardHeight is the block height the chain must reach for the validators to earn all the rewards
+	LastRewardHeight int64 `protobuf:"varint,4,opt,name=lastRewardHeight,proto3" json:"lastRewardHeight,omitempty"`
+	// claimed are the genesis validators that claimed their rewards once the distribution timeout was reached
+	Claimed []string `protobuf:"bytes,5,rep,name=claimed,proto3" json:"claimed,omitempty"`
+}
+
+func (m *RewardPool) Reset()         { *m = RewardPool{} }
+func (m *RewardPool) String() string { return proto.CompactTextString(m) }
+func (*RewardPool) ProtoMessage()    {}
+func (*RewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42e9317ff6558e16, []int{0}
+}
+func (m *RewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPool.Merge(m, src)
+}
+func (m *RewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardPool proto.InternalMessageInfo
+
+func (m *RewardPool) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *RewardPool) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *RewardPool) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *RewardPool) GetLastRewardHeight() int64 {
+	if m != nil {
+		return m.LastRewardHeight
+	}
+	return 0
+}
+
+func (m *RewardPool) GetClaimed() []string {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RewardPool)(nil), "tendermint.spn.launch.RewardPool")
+}
+
+func init() { proto.RegisterFile("launch/reward_pool.proto", fileDescriptor_42e9317ff6558e16) }
+
+var fileDescriptor_42e9317ff6558e16 = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x4e, 0xc3, 0x30,
+	0x14, 0xc6, 0xe3, 0xa6, 0x05, 0x6a, 0x16, 0x14, 0x81, 0x64, 0x3a, 0xb8, 0x11, 0x0b, 0x11, 0x12,
+	0xb6, 0x0a, 0x37, 0x28, 0x0c, 0xb0, 0xa1, 0x8c, 0x30, 0xa0, 0xfc, 0xb1, 0x52, 0x8b, 0xc4, 0x2f,
+	0x8a, 0xdd, 0x02, 0x77, 0x60, 0x60, 0xe5, 0x0a, 0x9c, 0xa4, 0x63, 0x47, 0xa6, 0x82, 0xda, 0x5b,
+	0x30, 0xa1, 0xc4, 0xa5, 0x20, 0xb1, 0x30, 0xd9, 0x9f, 0xdf, 0xe7, 0xf7, 0xfd, 0xec, 0x87, 0x49,
+	0x1e, 0x8d, 0x55, 0x32, 0xe2, 0x95, 0xb8, 0x8f, 0xaa, 0xf4, 0xb6, 0x04, 0xc8, 0x59, 0x59, 0x81,
+	0x01, 0x6f, 0xcf, 0x08, 0x95, 0x8a, 0xaa, 0x90, 0xca, 0x30, 0x5d, 0x2a, 0x66, 0x8d, 0xbd, 0xdd,
+	0x0c, 0x32, 0x68, 0x1c, 0xbc, 0xde, 0x59, 0x73, 0x8f, 0x26, 0xa0, 0x0b, 0xd0, 0x3c, 0x8e, 0xb4,
+	0xe0, 0x93, 0x41, 0x2c, 0x4c, 0x34, 0xe0, 0x09, 0x48, 0x65, 0xeb, 0x07, 0x2f, 0x2d, 0x8c, 0xc3,
+	0x26, 0xe2, 0x0a, 0x20, 0xf7, 0x7a, 0x78, 0xcb, 0xb6, 0xbb, 0x3c, 0x27, 0xc8, 0x47, 0x41, 0x3b,
+	0x5c, 0xeb, 0xba, 0x56, 0x56, 0x30, 0x91, 0xa9, 0xa8, 0x48, 0xcb, 0x47, 0x41, 0x37, 0x5c, 0x6b,
+	0xef, 0x09, 0xe1, 0x4e, 0xdd, 0x55, 0x13, 0xd7, 0x77, 0x83, 0xed, 0x93, 0x7d, 0x66, 0x73, 0x59,
+	0x9d, 0xcb, 0x56, 0xb9, 0xec, 0x0c, 0xa4, 0x1a, 0xde, 0x4c, 0xe7, 0x7d, 0xe7, 0x73, 0xde, 0x3f,
+	0xcc, 0xa4, 0x19, 0x8d, 0x63, 0x96, 0x40, 0xc1, 0x57, 0x90, 0x76, 0x39, 0xd6, 0xe9, 0x1d, 0x37,
+	0x8f, 0xa5, 0xd0, 0xcd, 0x85, 0xd7, 0xf7, 0x7e, 0xf0, 0x4f, 0xab, 0x0e, 0x2d, 0x84, 0x77, 0x84,
+	0x77, 0xf2, 0x48, 0x1b, 0xfb, 0xb0, 0x0b, 0x21, 0xb3, 0x91, 0x21, 0x6d, 0x1f, 0x05, 0x6e, 0xf8,
+	0xe7, 0xdc, 0x23, 0x78, 0x33, 0xc9, 0x23, 0x59, 0x88, 0x94, 0x74, 0x7c, 0x37, 0xe8, 0x86, 0xdf,
+	0x72, 0x38, 0x9c, 0x2e, 0x28, 0x9a, 0x2d, 0x28, 0xfa, 0x58, 0x50, 0xf4, 0xbc, 0xa4, 0xce, 0x6c,
+	0x49, 0x9d, 0xb7, 0x25, 0x75, 0xae, 0x7f, 0x03, 0xfd, 0x4c, 0x83, 0xeb, 0x52, 0xf1, 0x07, 0xbe,
+	0x1a, 0x5c, 0x83, 0x15, 0x6f, 0x34, 0xdf, 0x7c, 0xfa, 0x35, 0x00, 0x05, 0xe3, 0xfc, 0xc2, 0xcf,
+	0x01, 0x00, 0x00,
+}
+
+func (m *RewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Claimed[iNdEx])
+			copy(dAtA[i:], m.Claimed[iNdEx])
+			i = encodeVarintRewardPool(dAtA, i, uint64(len(m.Claimed[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LastRewardHeight != 0 {
+		i = encodeVarintRewardPool(dAtA, i, uint64(m.LastRewardHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintRewardPool(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintRewardPool(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewardPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewardPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovRewardPool(uint64(m.LaunchID))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovRewardPool(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovRewardPool(uint64(l))
+		}
+	}
+	if m.LastRewardHeight != 0 {
+		n += 1 + sovRewardPool(uint64(m.LastRewardHeight))
+	}
+	if len(m.Claimed) > 0 {
+		for _, s := range m.Claimed {
+			l = len(s)
+			n += 1 + l + sovRewardPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRewardPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewardPool(x uint64) (n int) {
+	return sovRewardPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardHeight", wireType)
+			}
+			m.LastRewardHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRewardHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewardPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewardPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewardPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewardPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewardPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewardPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewardPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewardPool = fmt.Errorf("proto: unexpected end of group")
+)
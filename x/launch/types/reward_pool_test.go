@@ -0,0 +1,175 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestRewardPool_Validate(t *testing.T) {
+	invalidCoins := sdk.Coins{sdk.Coin{Denom: "invalid denom", Amount: sdk.ZeroInt()}}
+
+	for _, tc := range []struct {
+		desc       string
+		rewardPool types.RewardPool
+		valid      bool
+	}{
+		{
+			desc:       "valid reward pool",
+			rewardPool: sample.RewardPool(0),
+			valid:      true,
+		},
+		{
+			desc:       "invalid provider address",
+			rewardPool: types.NewRewardPool(0, "invalid", sample.Coins(), 100),
+			valid:      false,
+		},
+		{
+			desc:       "invalid coins",
+			rewardPool: types.NewRewardPool(0, sample.Address(), invalidCoins, 100),
+			valid:      false,
+		},
+		{
+			desc:       "empty coins",
+			rewardPool: types.NewRewardPool(0, sample.Address(), sdk.NewCoins(), 100),
+			valid:      false,
+		},
+		{
+			desc:       "no last reward height",
+			rewardPool: types.NewRewardPool(0, sample.Address(), sample.Coins(), 0),
+			valid:      false,
+		},
+		{
+			desc: "rewards claimed by validators",
+			rewardPool: func() types.RewardPool {
+				rewardPool := sample.RewardPool(0)
+				rewardPool.Claimed = []string{sample.Address(), sample.Address()}
+				return rewardPool
+			}(),
+			valid: true,
+		},
+		{
+			desc: "invalid claimer address",
+			rewardPool: func() types.RewardPool {
+				rewardPool := sample.RewardPool(0)
+				rewardPool.Claimed = []string{"invalid"}
+				return rewardPool
+			}(),
+			valid: false,
+		},
+		{
+			desc: "rewards claimed twice",
+			rewardPool: func() types.RewardPool {
+				addr := sample.Address()
+				rewardPool := sample.RewardPool(0)
+				rewardPool.Claimed = []string{addr, addr}
+				return rewardPool
+			}(),
+			valid: false,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.rewardPool.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRewardPool_ValidatorRewards(t *testing.T) {
+	coins := sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(1000)),
+		sdk.NewCoin("bar", sdk.NewInt(10)),
+	)
+	rewardPool := types.NewRewardPool(0, sample.Address(), coins, 100)
+
+	for _, tc := range []struct {
+		desc            string
+		lastBlockHeight int64
+		validatorNb     int64
+		rewards         sdk.Coins
+		refund          sdk.Coins
+	}{
+		{
+			desc:            "all the rewards are earned",
+			lastBlockHeight: 100,
+			validatorNb:     2,
+			rewards: sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(500)),
+				sdk.NewCoin("bar", sdk.NewInt(5)),
+			),
+			refund: sdk.NewCoins(),
+		},
+		{
+			desc:            "last block height above the last reward height",
+			lastBlockHeight: 1000,
+			validatorNb:     2,
+			rewards: sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(500)),
+				sdk.NewCoin("bar", sdk.NewInt(5)),
+			),
+			refund: sdk.NewCoins(),
+		},
+		{
+			desc:            "chain stopped before the last reward height",
+			lastBlockHeight: 50,
+			validatorNb:     2,
+			rewards: sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(250)),
+				sdk.NewCoin("bar", sdk.NewInt(2)),
+			),
+			refund: sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(500)),
+				sdk.NewCoin("bar", sdk.NewInt(6)),
+			),
+		},
+		{
+			desc:            "remainder of the rewards is refunded",
+			lastBlockHeight: 100,
+			validatorNb:     3,
+			rewards: sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(333)),
+				sdk.NewCoin("bar", sdk.NewInt(3)),
+			),
+			refund: sdk.NewCoins(
+				sdk.NewCoin("foo", sdk.NewInt(1)),
+				sdk.NewCoin("bar", sdk.NewInt(1)),
+			),
+		},
+		{
+			desc:            "rewards too low to be shared",
+			lastBlockHeight: 100,
+			validatorNb:     20,
+			rewards:         sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(50))),
+			refund:          sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(10))),
+		},
+		{
+			desc:            "chain failed without producing blocks",
+			lastBlockHeight: 0,
+			validatorNb:     2,
+			rewards:         sdk.NewCoins(),
+			refund:          coins,
+		},
+		{
+			desc:            "no validator",
+			lastBlockHeight: 100,
+			validatorNb:     0,
+			rewards:         sdk.NewCoins(),
+			refund:          coins,
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			rewards, refund := rewardPool.ValidatorRewards(tc.lastBlockHeight, tc.validatorNb)
+			require.True(t, tc.rewards.IsEqual(rewards), "rewards %s, expected %s", rewards, tc.rewards)
+			require.True(t, tc.refund.IsEqual(refund), "refund %s, expected %s", refund, tc.refund)
+		})
+	}
+}
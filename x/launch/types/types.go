@@ -5,3 +5,15 @@ package types
 // This delay must be small be big enough to ensure nodes had the time to bootstrap\
 // This currently corresponds to 1 hour
 const RevertDelay int64 = 60 * 60
+
+// RewardDistributionDelay is the delay after the launch time when the rewards of the chain can be distributed
+// The coordinator reports the last block height reached by the chain once this delay is reached,
+// the unearned rewards can't be refunded to their provider right after the launch of the chain
+// This currently corresponds to 1 week
+const RewardDistributionDelay int64 = 7 * 24 * 60 * 60
+
+// RewardDistributionTimeout is the delay after the launch time when the rewards of the chain no longer depend on the coordinator
+// Once this timeout is reached, the genesis validators can claim their rewards and anyone can distribute the rewards
+// as if the chain reached the last reward height
+// This currently corresponds to 4 weeks
+const RewardDistributionTimeout int64 = 4 * 7 * 24 * 60 * 60
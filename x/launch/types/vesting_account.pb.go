@@ -96,6 +96,8 @@ func (m *VestingAccount) GetVestingOptions() VestingOptions {
 type VestingOptions struct {
 	// Types that are valid to be assigned to Options:
 	//	*VestingOptions_DelayedVesting
+	//	*VestingOptions_ContinuousVesting
+	//	*VestingOptions_PeriodicVesting
 	Options isVestingOptions_Options `protobuf_oneof:"options"`
 }
 
@@ -141,8 +143,16 @@ type isVestingOptions_Options interface {
 type VestingOptions_DelayedVesting struct {
 	DelayedVesting *DelayedVesting `protobuf:"bytes,1,opt,name=delayedVesting,proto3,oneof" json:"delayedVesting,omitempty"`
 }
+type VestingOptions_ContinuousVesting struct {
+	ContinuousVesting *ContinuousVesting `protobuf:"bytes,2,opt,name=continuousVesting,proto3,oneof" json:"continuousVesting,omitempty"`
+}
+type VestingOptions_PeriodicVesting struct {
+	PeriodicVesting *PeriodicVesting `protobuf:"bytes,3,opt,name=periodicVesting,proto3,oneof" json:"periodicVesting,omitempty"`
+}
 
-func (*VestingOptions_DelayedVesting) isVestingOptions_Options() {}
+func (*VestingOptions_DelayedVesting) isVestingOptions_Options()    {}
+func (*VestingOptions_ContinuousVesting) isVestingOptions_Options() {}
+func (*VestingOptions_PeriodicVesting) isVestingOptions_Options()   {}
 
 func (m *VestingOptions) GetOptions() isVestingOptions_Options {
 	if m != nil {
@@ -158,10 +168,26 @@ func (m *VestingOptions) GetDelayedVesting() *DelayedVesting {
 	return nil
 }
 
+func (m *VestingOptions) GetContinuousVesting() *ContinuousVesting {
+	if x, ok := m.GetOptions().(*VestingOptions_ContinuousVesting); ok {
+		return x.ContinuousVesting
+	}
+	return nil
+}
+
+func (m *VestingOptions) GetPeriodicVesting() *PeriodicVesting {
+	if x, ok := m.GetOptions().(*VestingOptions_PeriodicVesting); ok {
+		return x.PeriodicVesting
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*VestingOptions) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*VestingOptions_DelayedVesting)(nil),
+		(*VestingOptions_ContinuousVesting)(nil),
+		(*VestingOptions_PeriodicVesting)(nil),
 	}
 }
 
@@ -219,42 +245,224 @@ func (m *DelayedVesting) GetEndTime() int64 {
 	return 0
 }
 
+// ContinuousVesting represents options for continuous vesting
+// Continuous vesting is the type of vesting where vesting coins are vested linearly between start time and end time
+type ContinuousVesting struct {
+	Vesting   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=vesting,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting"`
+	StartTime int64                                    `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime   int64                                    `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (m *ContinuousVesting) Reset()         { *m = ContinuousVesting{} }
+func (m *ContinuousVesting) String() string { return proto.CompactTextString(m) }
+func (*ContinuousVesting) ProtoMessage()    {}
+func (*ContinuousVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afe88fc74ba91b11, []int{3}
+}
+func (m *ContinuousVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousVesting.Merge(m, src)
+}
+func (m *ContinuousVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousVesting proto.InternalMessageInfo
+
+func (m *ContinuousVesting) GetVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
+func (m *ContinuousVesting) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ContinuousVesting) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// PeriodicVesting represents options for periodic vesting
+// Periodic vesting is the type of vesting where the coins of each period are vested once the period ends
+// The periods are consecutive and the first period starts at start time
+type PeriodicVesting struct {
+	StartTime int64           `protobuf:"varint,1,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Periods   []VestingPeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *PeriodicVesting) Reset()         { *m = PeriodicVesting{} }
+func (m *PeriodicVesting) String() string { return proto.CompactTextString(m) }
+func (*PeriodicVesting) ProtoMessage()    {}
+func (*PeriodicVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afe88fc74ba91b11, []int{4}
+}
+func (m *PeriodicVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicVesting.Merge(m, src)
+}
+func (m *PeriodicVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicVesting proto.InternalMessageInfo
+
+func (m *PeriodicVesting) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *PeriodicVesting) GetPeriods() []VestingPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+// VestingPeriod is a period of a periodic vesting
+type VestingPeriod struct {
+	// length is the duration of the period in seconds
+	Length int64                                    `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *VestingPeriod) Reset()         { *m = VestingPeriod{} }
+func (m *VestingPeriod) String() string { return proto.CompactTextString(m) }
+func (*VestingPeriod) ProtoMessage()    {}
+func (*VestingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afe88fc74ba91b11, []int{5}
+}
+func (m *VestingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPeriod.Merge(m, src)
+}
+func (m *VestingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPeriod proto.InternalMessageInfo
+
+func (m *VestingPeriod) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *VestingPeriod) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*VestingAccount)(nil), "tendermint.spn.launch.VestingAccount")
 	proto.RegisterType((*VestingOptions)(nil), "tendermint.spn.launch.VestingOptions")
 	proto.RegisterType((*DelayedVesting)(nil), "tendermint.spn.launch.DelayedVesting")
+	proto.RegisterType((*ContinuousVesting)(nil), "tendermint.spn.launch.ContinuousVesting")
+	proto.RegisterType((*PeriodicVesting)(nil), "tendermint.spn.launch.PeriodicVesting")
+	proto.RegisterType((*VestingPeriod)(nil), "tendermint.spn.launch.VestingPeriod")
 }
 
 func init() { proto.RegisterFile("launch/vesting_account.proto", fileDescriptor_afe88fc74ba91b11) }
 
 var fileDescriptor_afe88fc74ba91b11 = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0x41, 0x6a, 0xdb, 0x40,
-	0x14, 0x86, 0x35, 0xb6, 0xa9, 0xeb, 0x31, 0xa8, 0x20, 0x5a, 0x50, 0x4d, 0x91, 0x85, 0xa1, 0x54,
-	0x9b, 0xce, 0x60, 0xf7, 0x04, 0x55, 0xbd, 0x68, 0x57, 0x06, 0xb5, 0x78, 0xd1, 0x2c, 0xc2, 0x48,
-	0x1a, 0x64, 0x11, 0x69, 0x46, 0x68, 0xc6, 0x26, 0xbe, 0x85, 0x8f, 0x90, 0x75, 0xee, 0x90, 0xac,
-	0xbd, 0xf4, 0x32, 0x2b, 0x27, 0xd8, 0xb7, 0xc8, 0x2a, 0x48, 0x23, 0x3b, 0xb1, 0x49, 0x20, 0xbb,
-	0xac, 0xa4, 0xa7, 0xf7, 0xbf, 0xf7, 0x7f, 0xfc, 0x3c, 0xc1, 0x2f, 0x09, 0x99, 0xb2, 0x60, 0x82,
-	0x67, 0x54, 0xc8, 0x98, 0x45, 0xa7, 0x24, 0x08, 0xf8, 0x94, 0x49, 0x94, 0xe5, 0x5c, 0x72, 0xe3,
-	0x93, 0xa4, 0x2c, 0xa4, 0x79, 0x1a, 0x33, 0x89, 0x44, 0xc6, 0x90, 0x12, 0x77, 0x3e, 0x46, 0x3c,
-	0xe2, 0xa5, 0x02, 0x17, 0x6f, 0x4a, 0xdc, 0xb1, 0x02, 0x2e, 0x52, 0x2e, 0xb0, 0x4f, 0x04, 0xc5,
-	0xb3, 0xbe, 0x4f, 0x25, 0xe9, 0xe3, 0x80, 0xc7, 0x4c, 0xf5, 0x7b, 0xd7, 0x35, 0xa8, 0x8f, 0x95,
-	0xcd, 0x4f, 0xe5, 0x62, 0x74, 0xe0, 0x7b, 0xb5, 0xf2, 0xcf, 0xd0, 0x04, 0x36, 0x70, 0x1a, 0xde,
-	0xbe, 0x36, 0x4c, 0xd8, 0x24, 0x61, 0x98, 0x53, 0x21, 0xcc, 0x9a, 0x0d, 0x9c, 0x96, 0xb7, 0x2b,
-	0x8d, 0x0b, 0x00, 0x3f, 0x08, 0x49, 0xf2, 0x62, 0x93, 0x4b, 0x12, 0xc2, 0x02, 0x6a, 0xd6, 0xed,
-	0xba, 0xd3, 0x1e, 0x7c, 0x46, 0x8a, 0x01, 0x15, 0x0c, 0xa8, 0x62, 0x40, 0xbf, 0x78, 0xcc, 0xdc,
-	0x93, 0xe5, 0xba, 0xab, 0xdd, 0xaf, 0xbb, 0xdf, 0xa2, 0x58, 0x4e, 0xa6, 0x3e, 0x0a, 0x78, 0x8a,
-	0x2b, 0x60, 0xf5, 0xf8, 0x2e, 0xc2, 0x33, 0x2c, 0xe7, 0x19, 0x15, 0xe5, 0xc0, 0xe5, 0x6d, 0xd7,
-	0x79, 0xa5, 0x54, 0x78, 0xc7, 0x38, 0xc6, 0x5f, 0xa8, 0x57, 0x89, 0x8e, 0x32, 0x19, 0x73, 0x26,
-	0xcc, 0x86, 0x0d, 0x9c, 0xf6, 0xe0, 0x2b, 0x7a, 0x36, 0x51, 0x34, 0x3e, 0x10, 0xbb, 0x8d, 0x02,
-	0xd6, 0x3b, 0x5a, 0xd1, 0x4b, 0xf6, 0xf9, 0x55, 0x5f, 0x8c, 0x11, 0xd4, 0x43, 0x9a, 0x90, 0x39,
-	0x0d, 0xab, 0x46, 0x99, 0xe2, 0xcb, 0x36, 0xc3, 0x03, 0xf1, 0x6f, 0xcd, 0x3b, 0x1a, 0x77, 0x5b,
-	0xb0, 0xc9, 0x2b, 0xb7, 0x2b, 0x00, 0xf5, 0x43, 0xbd, 0xb1, 0x00, 0xb0, 0x39, 0xdb, 0x1b, 0xbd,
-	0x65, 0xe0, 0x3b, 0x8c, 0xe2, 0x4a, 0x28, 0x0b, 0xff, 0xc5, 0x29, 0x2d, 0xaf, 0xa4, 0xee, 0xed,
-	0x4a, 0xd7, 0x5d, 0x6e, 0x2c, 0xb0, 0xda, 0x58, 0xe0, 0x6e, 0x63, 0x81, 0xc5, 0xd6, 0xd2, 0x56,
-	0x5b, 0x4b, 0xbb, 0xd9, 0x5a, 0xda, 0xff, 0xa7, 0x36, 0x8f, 0x39, 0x61, 0x91, 0x31, 0x7c, 0x8e,
-	0xab, 0xff, 0xa1, 0x34, 0xf3, 0xdf, 0x95, 0x97, 0xfb, 0xe3, 0x21, 0x00, 0x00, 0xff, 0xff, 0xcb,
-	0xee, 0x62, 0x76, 0x26, 0x03, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xe3, 0xb6, 0x6a, 0xa9, 0x27, 0x5a, 0xcd, 0x02, 0x14, 0xaa, 0x29, 0xad, 0x2a, 0xfe,
+	0xe4, 0x42, 0xac, 0x8d, 0x4f, 0x40, 0xd6, 0x43, 0x39, 0x0d, 0x05, 0x34, 0x21, 0x38, 0x20, 0x37,
+	0xb1, 0x5a, 0x8b, 0xd6, 0x8e, 0x6a, 0xa7, 0x62, 0x5f, 0x02, 0xed, 0xcc, 0x89, 0x33, 0x9f, 0x80,
+	0x0b, 0x9c, 0x77, 0xdc, 0x91, 0x53, 0x41, 0xed, 0xb7, 0xe0, 0x84, 0x12, 0x3b, 0x6b, 0x93, 0xb1,
+	0x89, 0xdb, 0x38, 0xb5, 0x4e, 0x1e, 0xff, 0xde, 0xe7, 0x7d, 0x62, 0xbf, 0x70, 0x6f, 0x4a, 0x12,
+	0x1e, 0x4e, 0xf0, 0x82, 0x4a, 0xc5, 0xf8, 0xf8, 0x1d, 0x09, 0x43, 0x91, 0x70, 0xe5, 0xc5, 0x73,
+	0xa1, 0x04, 0xba, 0xab, 0x28, 0x8f, 0xe8, 0x7c, 0xc6, 0xb8, 0xf2, 0x64, 0xcc, 0x3d, 0x2d, 0xee,
+	0xdc, 0x19, 0x8b, 0xb1, 0xc8, 0x14, 0x38, 0xfd, 0xa7, 0xc5, 0x1d, 0x27, 0x14, 0x72, 0x26, 0x24,
+	0x1e, 0x11, 0x49, 0xf1, 0x62, 0x7f, 0x44, 0x15, 0xd9, 0xc7, 0xa1, 0x60, 0x5c, 0xbf, 0xef, 0x7f,
+	0xaf, 0xc0, 0xd6, 0xb1, 0x2e, 0xf3, 0x4c, 0x57, 0x41, 0x1d, 0x78, 0x4b, 0x23, 0x9f, 0x0f, 0x6c,
+	0xd0, 0x03, 0x6e, 0x2d, 0xb8, 0x58, 0x23, 0x1b, 0x36, 0x48, 0x14, 0xcd, 0xa9, 0x94, 0x76, 0xa5,
+	0x07, 0xdc, 0x66, 0x90, 0x2f, 0xd1, 0x67, 0x00, 0xdb, 0x52, 0x91, 0x79, 0x4a, 0xf2, 0xc9, 0x94,
+	0xf0, 0x90, 0xda, 0xd5, 0x5e, 0xd5, 0xdd, 0x39, 0xb8, 0xef, 0x69, 0x0f, 0x5e, 0xea, 0xc1, 0x33,
+	0x1e, 0xbc, 0x43, 0xc1, 0xb8, 0xff, 0xf6, 0x6c, 0xd9, 0xb5, 0x7e, 0x2f, 0xbb, 0x8f, 0xc7, 0x4c,
+	0x4d, 0x92, 0x91, 0x17, 0x8a, 0x19, 0x36, 0x86, 0xf5, 0xcf, 0x13, 0x19, 0xbd, 0xc7, 0xea, 0x24,
+	0xa6, 0x32, 0xdb, 0xf0, 0xe5, 0x67, 0xd7, 0xfd, 0x47, 0xa9, 0x0c, 0xca, 0x76, 0xd0, 0x4b, 0xd8,
+	0x32, 0x89, 0x1e, 0xc5, 0x8a, 0x09, 0x2e, 0xed, 0x5a, 0x0f, 0xb8, 0x3b, 0x07, 0x0f, 0xbd, 0xbf,
+	0x26, 0xea, 0x1d, 0x17, 0xc4, 0x7e, 0x2d, 0x35, 0x1b, 0x94, 0x10, 0xfd, 0x4f, 0x9b, 0x00, 0xcd,
+	0x23, 0x74, 0x04, 0x5b, 0x11, 0x9d, 0x92, 0x13, 0x1a, 0x99, 0x17, 0x36, 0xb8, 0xb6, 0xce, 0xa0,
+	0x20, 0x1e, 0x5a, 0x41, 0x69, 0x3b, 0x7a, 0x0d, 0x77, 0x43, 0xc1, 0x15, 0xe3, 0x89, 0x48, 0x64,
+	0xce, 0xac, 0x64, 0x4c, 0xf7, 0x0a, 0xe6, 0x61, 0x59, 0x3f, 0xb4, 0x82, 0xcb, 0x10, 0x14, 0xc0,
+	0x76, 0x4c, 0xe7, 0x4c, 0x44, 0x2c, 0xcc, 0xb9, 0xd5, 0x8c, 0xfb, 0xe8, 0x0a, 0xee, 0x8b, 0xa2,
+	0x7a, 0x68, 0x05, 0x65, 0x80, 0xdf, 0x84, 0x0d, 0x61, 0xc2, 0xf9, 0x06, 0x60, 0xab, 0xd8, 0x1d,
+	0x3a, 0x05, 0xb0, 0xb1, 0xb8, 0x88, 0xe5, 0x26, 0xcf, 0x47, 0x6e, 0x23, 0x3d, 0xd4, 0x94, 0x47,
+	0xaf, 0xd8, 0x8c, 0x66, 0xa1, 0x56, 0x83, 0x7c, 0xd9, 0x5f, 0x02, 0xb8, 0x7b, 0x29, 0xc9, 0xff,
+	0xb1, 0x85, 0x3d, 0xd8, 0xcc, 0x4e, 0xfb, 0x56, 0x13, 0x9b, 0x07, 0xdb, 0x0d, 0x56, 0x8b, 0x0d,
+	0x26, 0xb0, 0x5d, 0xfa, 0xa2, 0x45, 0x14, 0x28, 0xa3, 0x06, 0xb0, 0xa1, 0xbf, 0x77, 0x3a, 0x00,
+	0xd2, 0xd6, 0x1f, 0x5c, 0x7f, 0x79, 0x34, 0xdd, 0xdc, 0x9d, 0x7c, 0x6b, 0xff, 0x2b, 0x80, 0xb7,
+	0x0b, 0x02, 0x74, 0x0f, 0xd6, 0xa7, 0x94, 0x8f, 0xd5, 0xc4, 0x94, 0x34, 0x2b, 0xf4, 0x11, 0xc0,
+	0x3a, 0x99, 0xa5, 0x73, 0xc9, 0xae, 0xdc, 0x68, 0xd4, 0xc6, 0x85, 0xef, 0x9f, 0xad, 0x1c, 0x70,
+	0xbe, 0x72, 0xc0, 0xaf, 0x95, 0x03, 0x4e, 0xd7, 0x8e, 0x75, 0xbe, 0x76, 0xac, 0x1f, 0x6b, 0xc7,
+	0x7a, 0xb3, 0xcd, 0xda, 0x64, 0x82, 0x65, 0xcc, 0xf1, 0x07, 0x6c, 0x26, 0x7a, 0x46, 0x1c, 0xd5,
+	0xb3, 0xd9, 0xfb, 0xf4, 0xcf, 0x00, 0x1c, 0x72, 0x94, 0xc1, 0xe8, 0x05, 0x00, 0x00,
 }
 
 func (m *VestingAccount) Marshal() (dAtA []byte, err error) {
@@ -369,6 +577,48 @@ func (m *VestingOptions_DelayedVesting) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *VestingOptions_ContinuousVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingOptions_ContinuousVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ContinuousVesting != nil {
+		{
+			size, err := m.ContinuousVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *VestingOptions_PeriodicVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingOptions_PeriodicVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PeriodicVesting != nil {
+		{
+			size, err := m.PeriodicVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DelayedVesting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -411,6 +661,137 @@ func (m *DelayedVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContinuousVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintVestingAccount(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVestingAccount(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Vesting) > 0 {
+		for iNdEx := len(m.Vesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodicVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVestingAccount(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Length != 0 {
+		i = encodeVarintVestingAccount(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVestingAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVestingAccount(v)
 	base := offset
@@ -470,17 +851,41 @@ func (m *VestingOptions_DelayedVesting) Size() (n int) {
 	}
 	return n
 }
-func (m *DelayedVesting) Size() (n int) {
+func (m *VestingOptions_ContinuousVesting) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Vesting) > 0 {
-		for _, e := range m.Vesting {
-			l = e.Size()
-			n += 1 + l + sovVestingAccount(uint64(l))
-		}
+	if m.ContinuousVesting != nil {
+		l = m.ContinuousVesting.Size()
+		n += 1 + l + sovVestingAccount(uint64(l))
+	}
+	return n
+}
+func (m *VestingOptions_PeriodicVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodicVesting != nil {
+		l = m.PeriodicVesting.Size()
+		n += 1 + l + sovVestingAccount(uint64(l))
+	}
+	return n
+}
+func (m *DelayedVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
 	}
 	if m.EndTime != 0 {
 		n += 1 + sovVestingAccount(uint64(m.EndTime))
@@ -488,6 +893,63 @@ func (m *DelayedVesting) Size() (n int) {
 	return n
 }
 
+func (m *ContinuousVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVestingAccount(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovVestingAccount(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *PeriodicVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		n += 1 + sovVestingAccount(uint64(m.StartTime))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovVestingAccount(uint64(m.Length))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVestingAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -726,6 +1188,76 @@ func (m *VestingOptions) Unmarshal(dAtA []byte) error {
 			}
 			m.Options = &VestingOptions_DelayedVesting{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ContinuousVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Options = &VestingOptions_ContinuousVesting{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PeriodicVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Options = &VestingOptions_PeriodicVesting{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVestingAccount(dAtA[iNdEx:])
@@ -850,6 +1382,334 @@ func (m *DelayedVesting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContinuousVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vesting = append(m.Vesting, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, VestingPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVestingAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
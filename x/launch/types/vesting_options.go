@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 func NewDelayedVesting(vesting sdk.Coins, endTime int64) *VestingOptions {
@@ -18,7 +19,30 @@ func NewDelayedVesting(vesting sdk.Coins, endTime int64) *VestingOptions {
 	}
 }
 
-// Validate check the DelayedVesting object
+func NewContinuousVesting(vesting sdk.Coins, startTime, endTime int64) *VestingOptions {
+	return &VestingOptions{
+		Options: &VestingOptions_ContinuousVesting{
+			ContinuousVesting: &ContinuousVesting{
+				Vesting:   vesting,
+				StartTime: startTime,
+				EndTime:   endTime,
+			},
+		},
+	}
+}
+
+func NewPeriodicVesting(startTime int64, periods []VestingPeriod) *VestingOptions {
+	return &VestingOptions{
+		Options: &VestingOptions_PeriodicVesting{
+			PeriodicVesting: &PeriodicVesting{
+				StartTime: startTime,
+				Periods:   periods,
+			},
+		},
+	}
+}
+
+// Validate check the VestingOptions object
 func (m VestingOptions) Validate() error {
 	switch vestionOptions := m.Options.(type) {
 	case *VestingOptions_DelayedVesting:
@@ -31,8 +55,71 @@ func (m VestingOptions) Validate() error {
 		if vestionOptions.DelayedVesting.EndTime == 0 {
 			return errors.New("end time for DelayedVesting cannot be 0")
 		}
+	case *VestingOptions_ContinuousVesting:
+		cv := vestionOptions.ContinuousVesting
+		if cv.Vesting.Empty() {
+			return errors.New("empty vesting coins for ContinuousVesting")
+		}
+		if !cv.Vesting.IsValid() {
+			return fmt.Errorf("invalid vesting coins for ContinuousVesting: %s", cv.Vesting.String())
+		}
+		if cv.StartTime == 0 {
+			return errors.New("start time for ContinuousVesting cannot be 0")
+		}
+		if cv.EndTime <= cv.StartTime {
+			return fmt.Errorf("end time for ContinuousVesting must be after start time: %d <= %d", cv.EndTime, cv.StartTime)
+		}
+	case *VestingOptions_PeriodicVesting:
+		pv := vestionOptions.PeriodicVesting
+		if pv.StartTime == 0 {
+			return errors.New("start time for PeriodicVesting cannot be 0")
+		}
+		if len(pv.Periods) == 0 {
+			return errors.New("no period for PeriodicVesting")
+		}
+		for i, period := range pv.Periods {
+			if period.Length <= 0 {
+				return fmt.Errorf("length of the period %d for PeriodicVesting must be positive", i)
+			}
+			if period.Amount.Empty() {
+				return fmt.Errorf("empty vesting coins for the period %d of PeriodicVesting", i)
+			}
+			if !period.Amount.IsValid() {
+				return fmt.Errorf("invalid vesting coins for the period %d of PeriodicVesting: %s", i, period.Amount.String())
+			}
+		}
 	default:
 		return errors.New("unrecognized vesting options")
 	}
 	return nil
 }
+
+// TotalVesting returns the total amount of vesting coins of the vesting options
+func (m VestingOptions) TotalVesting() (sdk.Coins, error) {
+	switch vestionOptions := m.Options.(type) {
+	case *VestingOptions_DelayedVesting:
+		return vestionOptions.DelayedVesting.Vesting, nil
+	case *VestingOptions_ContinuousVesting:
+		return vestionOptions.ContinuousVesting.Vesting, nil
+	case *VestingOptions_PeriodicVesting:
+		total := sdk.NewCoins()
+		for _, period := range vestionOptions.PeriodicVesting.Periods {
+			total = total.Add(period.Amount...)
+		}
+		return total, nil
+	default:
+		return nil, errors.New("unrecognized vesting options")
+	}
+}
+
+// VestingPeriods returns the periods of the periodic vesting as Cosmos SDK vesting periods
+func (m PeriodicVesting) VestingPeriods() vestingtypes.Periods {
+	periods := make(vestingtypes.Periods, len(m.Periods))
+	for i, period := range m.Periods {
+		periods[i] = vestingtypes.Period{
+			Length: period.Length,
+			Amount: period.Amount,
+		}
+	}
+	return periods
+}
@@ -69,3 +69,163 @@ func TestDelayedVesting_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewContinuousVesting(t *testing.T) {
+	vesting := sample.Coins()
+	startTime := time.Now().Unix()
+	endTime := startTime + 1000
+
+	vestingOptions := types.NewContinuousVesting(vesting, startTime, endTime)
+
+	continuousVesting := vestingOptions.GetContinuousVesting()
+	require.NotNil(t, continuousVesting)
+	require.True(t, vesting.IsEqual(continuousVesting.Vesting))
+	require.EqualValues(t, startTime, continuousVesting.StartTime)
+	require.EqualValues(t, endTime, continuousVesting.EndTime)
+}
+
+func TestNewPeriodicVesting(t *testing.T) {
+	startTime := time.Now().Unix()
+	periods := []types.VestingPeriod{
+		{Length: 1000, Amount: sample.Coins()},
+		{Length: 2000, Amount: sample.Coins()},
+	}
+
+	vestingOptions := types.NewPeriodicVesting(startTime, periods)
+
+	periodicVesting := vestingOptions.GetPeriodicVesting()
+	require.NotNil(t, periodicVesting)
+	require.EqualValues(t, startTime, periodicVesting.StartTime)
+	require.EqualValues(t, periods, periodicVesting.Periods)
+}
+
+func TestContinuousVesting_Validate(t *testing.T) {
+	now := time.Now().Unix()
+	tests := []struct {
+		name   string
+		option types.VestingOptions
+		valid  bool
+	}{
+		{
+			name:   "vesting without coins",
+			option: *types.NewContinuousVesting(nil, now, now+1000),
+			valid:  false,
+		}, {
+			name: "vesting with invalid coins",
+			option: *types.NewContinuousVesting(
+				sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.NewInt(10)}},
+				now,
+				now+1000,
+			),
+			valid: false,
+		}, {
+			name:   "vesting with no start time",
+			option: *types.NewContinuousVesting(sample.Coins(), 0, now),
+			valid:  false,
+		}, {
+			name:   "vesting with end time before start time",
+			option: *types.NewContinuousVesting(sample.Coins(), now, now-1000),
+			valid:  false,
+		}, {
+			name:   "vesting with end time equal to start time",
+			option: *types.NewContinuousVesting(sample.Coins(), now, now),
+			valid:  false,
+		}, {
+			name:   "valid account vesting",
+			option: *types.NewContinuousVesting(sample.Coins(), now, now+1000),
+			valid:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.option.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPeriodicVesting_Validate(t *testing.T) {
+	now := time.Now().Unix()
+	tests := []struct {
+		name   string
+		option types.VestingOptions
+		valid  bool
+	}{
+		{
+			name:   "vesting without periods",
+			option: *types.NewPeriodicVesting(now, nil),
+			valid:  false,
+		}, {
+			name: "vesting with no start time",
+			option: *types.NewPeriodicVesting(0, []types.VestingPeriod{
+				{Length: 1000, Amount: sample.Coins()},
+			}),
+			valid: false,
+		}, {
+			name: "vesting with a period without length",
+			option: *types.NewPeriodicVesting(now, []types.VestingPeriod{
+				{Length: 1000, Amount: sample.Coins()},
+				{Length: 0, Amount: sample.Coins()},
+			}),
+			valid: false,
+		}, {
+			name: "vesting with a period without coins",
+			option: *types.NewPeriodicVesting(now, []types.VestingPeriod{
+				{Length: 1000, Amount: sample.Coins()},
+				{Length: 1000, Amount: nil},
+			}),
+			valid: false,
+		}, {
+			name: "vesting with a period with invalid coins",
+			option: *types.NewPeriodicVesting(now, []types.VestingPeriod{
+				{Length: 1000, Amount: sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.NewInt(10)}}},
+			}),
+			valid: false,
+		}, {
+			name: "valid account vesting",
+			option: *types.NewPeriodicVesting(now, []types.VestingPeriod{
+				{Length: 1000, Amount: sample.Coins()},
+				{Length: 2000, Amount: sample.Coins()},
+			}),
+			valid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.option.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVestingOptions_TotalVesting(t *testing.T) {
+	now := time.Now().Unix()
+	coins1 := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10)), sdk.NewCoin("bar", sdk.NewInt(20)))
+	coins2 := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(30)))
+
+	total, err := types.NewDelayedVesting(coins1, now).TotalVesting()
+	require.NoError(t, err)
+	require.True(t, coins1.IsEqual(total))
+
+	total, err = types.NewContinuousVesting(coins1, now, now+1000).TotalVesting()
+	require.NoError(t, err)
+	require.True(t, coins1.IsEqual(total))
+
+	total, err = types.NewPeriodicVesting(now, []types.VestingPeriod{
+		{Length: 1000, Amount: coins1},
+		{Length: 1000, Amount: coins2},
+	}).TotalVesting()
+	require.NoError(t, err)
+	require.True(t, coins1.Add(coins2...).IsEqual(total))
+
+	_, err = types.VestingOptions{}.TotalVesting()
+	require.Error(t, err)
+}
@@ -24,6 +24,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowCoordinator())
 	cmd.AddCommand(CmdListCoordinator())
 	cmd.AddCommand(CmdShowCoordinatorByAddress())
+	cmd.AddCommand(CmdListCoordinatorOperator())
+	cmd.AddCommand(CmdShowCoordinatorOperator())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func CmdListCoordinatorOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-coordinator-operator [coordinator-id]",
+		Short: "list all operators of a coordinator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			coordinatorID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllCoordinatorOperatorRequest{
+				CoordinatorId: coordinatorID,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.CoordinatorOperatorAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowCoordinatorOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-coordinator-operator [coordinator-id] [address]",
+		Short: "shows an operator of a coordinator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			coordinatorID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetCoordinatorOperatorRequest{
+				CoordinatorId: coordinatorID,
+				Address:       args[1],
+			}
+
+			res, err := queryClient.CoordinatorOperator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
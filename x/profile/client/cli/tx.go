@@ -24,6 +24,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateCoordinatorDescription())
 	cmd.AddCommand(CmdUpdateCoordinatorAddress())
 	cmd.AddCommand(CmdDeleteCoordinator())
+	cmd.AddCommand(CmdSetOperator())
+	cmd.AddCommand(CmdRemoveOperator())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func CmdSetOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-operator [operator] [permissions]",
+		Short: "Set the permissions of an operator of the coordinator",
+		Long: `Set the permissions of an operator of the coordinator, replacing the existing ones.
+Permissions are a comma separated list of: SETTLE_REQUESTS, TRIGGER_LAUNCH, MANAGE_SHARES, MINT_VOUCHERS`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var permissions []types.CoordinatorOperator_Permission
+			for _, name := range strings.Split(args[1], ",") {
+				permission, ok := types.CoordinatorOperator_Permission_value[strings.ToUpper(strings.TrimSpace(name))]
+				if !ok {
+					return fmt.Errorf("unknown permission %s", name)
+				}
+				permissions = append(permissions, types.CoordinatorOperator_Permission(permission))
+			}
+
+			msg := types.NewMsgSetOperator(clientCtx.GetFromAddress().String(), args[0], permissions)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -27,6 +27,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.CoordinatorByAddressList {
 		k.SetCoordinatorByAddress(ctx, elem)
 	}
+
+	// Set all the coordinatorOperator
+	for _, elem := range genState.CoordinatorOperatorList {
+		k.SetCoordinatorOperator(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -37,6 +42,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.CoordinatorList = k.GetAllCoordinator(ctx)
 	genesis.CoordinatorCounter = k.GetCoordinatorCounter(ctx)
 	genesis.CoordinatorByAddressList = k.GetAllCoordinatorByAddress(ctx)
+	genesis.CoordinatorOperatorList = k.GetAllCoordinatorOperator(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -25,6 +25,7 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.ValidatorList, got.ValidatorList)
 	require.ElementsMatch(t, genesisState.CoordinatorList, got.CoordinatorList)
 	require.ElementsMatch(t, genesisState.CoordinatorByAddressList, got.CoordinatorByAddressList)
+	require.ElementsMatch(t, genesisState.CoordinatorOperatorList, got.CoordinatorOperatorList)
 	require.Equal(t, genesisState.CoordinatorCounter, got.CoordinatorCounter)
 
 	// this line is used by starport scaffolding # genesis/test/assert
@@ -32,6 +32,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err = msgServer.UpdateCoordinatorAddress(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgDeleteCoordinator:
 			res, err = msgServer.DeleteCoordinator(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgSetOperator:
+			res, err = msgServer.SetOperator(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgRemoveOperator:
+			res, err = msgServer.RemoveOperator(sdk.WrapSDKContext(ctx), msg)
 		// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+// SetCoordinatorOperator set a specific coordinatorOperator in the store from its index
+func (k Keeper) SetCoordinatorOperator(ctx sdk.Context, coordinatorOperator types.CoordinatorOperator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CoordinatorOperatorKeyPrefix))
+	b := k.cdc.MustMarshal(&coordinatorOperator)
+	store.Set(types.CoordinatorOperatorKey(
+		coordinatorOperator.CoordinatorId,
+		coordinatorOperator.Address,
+	), b)
+}
+
+// GetCoordinatorOperator returns a coordinatorOperator from its index
+func (k Keeper) GetCoordinatorOperator(
+	ctx sdk.Context,
+	coordinatorID uint64,
+	address string,
+) (val types.CoordinatorOperator, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CoordinatorOperatorKeyPrefix))
+
+	b := store.Get(types.CoordinatorOperatorKey(coordinatorID, address))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveCoordinatorOperator removes a coordinatorOperator from the store
+func (k Keeper) RemoveCoordinatorOperator(
+	ctx sdk.Context,
+	coordinatorID uint64,
+	address string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CoordinatorOperatorKeyPrefix))
+	store.Delete(types.CoordinatorOperatorKey(coordinatorID, address))
+}
+
+// GetAllCoordinatorOperator returns all coordinatorOperator
+func (k Keeper) GetAllCoordinatorOperator(ctx sdk.Context) (list []types.CoordinatorOperator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CoordinatorOperatorKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CoordinatorOperator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllCoordinatorOperatorByCoordinatorID returns all coordinatorOperator of a coordinator
+func (k Keeper) GetAllCoordinatorOperatorByCoordinatorID(
+	ctx sdk.Context,
+	coordinatorID uint64,
+) (list []types.CoordinatorOperator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CoordinatorOperatorAllKey(coordinatorID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CoordinatorOperator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CheckCoordinatorPermission checks the address can perform the action for the coordinator,
+// either being the address of the coordinator or an operator granted the permission
+func (k Keeper) CheckCoordinatorPermission(
+	ctx sdk.Context,
+	coordinatorID uint64,
+	address string,
+	permission types.CoordinatorOperator_Permission,
+) error {
+	// the coordinator has all permissions, even if its address was previously one of its operators
+	addressCoordinatorID, isCoordinator := k.CoordinatorIDFromAddress(ctx, address)
+	if isCoordinator && addressCoordinatorID == coordinatorID {
+		return nil
+	}
+
+	operator, found := k.GetCoordinatorOperator(ctx, coordinatorID, address)
+	if found {
+		if !operator.HasPermission(permission) {
+			return sdkerrors.Wrapf(types.ErrOperatorUnauthorized, "%s can't %s", address, permission.String())
+		}
+		return nil
+	}
+
+	if !isCoordinator {
+		return sdkerrors.Wrap(types.ErrCoordAddressNotFound, address)
+	}
+	return sdkerrors.Wrapf(types.ErrCoordInvalid, "coordinator is %d", coordinatorID)
+}
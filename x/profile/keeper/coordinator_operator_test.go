@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/keeper"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func createNCoordinatorOperatorForCoordinatorID(
+	keeper *keeper.Keeper,
+	ctx sdk.Context,
+	n int,
+	coordinatorID uint64,
+) []types.CoordinatorOperator {
+	items := make([]types.CoordinatorOperator, n)
+	for i := range items {
+		items[i] = sample.CoordinatorOperator(coordinatorID)
+		keeper.SetCoordinatorOperator(ctx, items[i])
+	}
+	return items
+}
+
+func createNCoordinatorOperator(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.CoordinatorOperator {
+	items := make([]types.CoordinatorOperator, n)
+	for i := range items {
+		items[i] = sample.CoordinatorOperator(uint64(i))
+		keeper.SetCoordinatorOperator(ctx, items[i])
+	}
+	return items
+}
+
+func TestCoordinatorOperatorGet(t *testing.T) {
+	keeper, ctx := testkeeper.Profile(t)
+	items := createNCoordinatorOperator(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetCoordinatorOperator(ctx, item.CoordinatorId, item.Address)
+		require.True(t, found)
+		require.Equal(t, item, rst)
+	}
+}
+
+func TestCoordinatorOperatorRemove(t *testing.T) {
+	keeper, ctx := testkeeper.Profile(t)
+	items := createNCoordinatorOperator(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveCoordinatorOperator(ctx, item.CoordinatorId, item.Address)
+		_, found := keeper.GetCoordinatorOperator(ctx, item.CoordinatorId, item.Address)
+		require.False(t, found)
+	}
+}
+
+func TestCoordinatorOperatorGetAll(t *testing.T) {
+	keeper, ctx := testkeeper.Profile(t)
+	items := createNCoordinatorOperator(keeper, ctx, 10)
+	require.ElementsMatch(t, items, keeper.GetAllCoordinatorOperator(ctx))
+}
+
+func TestCoordinatorOperatorGetAllByCoordinatorID(t *testing.T) {
+	keeper, ctx := testkeeper.Profile(t)
+	items := createNCoordinatorOperatorForCoordinatorID(keeper, ctx, 5, 0)
+	createNCoordinatorOperatorForCoordinatorID(keeper, ctx, 5, 1)
+	require.ElementsMatch(t, items, keeper.GetAllCoordinatorOperatorByCoordinatorID(ctx, 0))
+}
+
+func TestCheckCoordinatorPermission(t *testing.T) {
+	k, ctx := testkeeper.Profile(t)
+	coordAddress := sample.Address()
+	otherCoordAddress := sample.Address()
+	operator := sample.Address()
+	k.SetCoordinatorByAddress(ctx, types.CoordinatorByAddress{Address: coordAddress, CoordinatorId: 0})
+	k.SetCoordinatorByAddress(ctx, types.CoordinatorByAddress{Address: otherCoordAddress, CoordinatorId: 1})
+	k.SetCoordinatorOperator(ctx, types.NewCoordinatorOperator(0, operator, []types.CoordinatorOperator_Permission{
+		types.CoordinatorOperator_SETTLE_REQUESTS,
+	}))
+
+	// the address of the coordinator was previously one of its operators
+	k.SetCoordinatorOperator(ctx, types.NewCoordinatorOperator(0, coordAddress, []types.CoordinatorOperator_Permission{
+		types.CoordinatorOperator_SETTLE_REQUESTS,
+	}))
+
+	for _, tc := range []struct {
+		desc       string
+		address    string
+		permission types.CoordinatorOperator_Permission
+		err        error
+	}{
+		{
+			desc:       "coordinator has all permissions",
+			address:    coordAddress,
+			permission: types.CoordinatorOperator_MINT_VOUCHERS,
+		},
+		{
+			desc:       "operator with the permission",
+			address:    operator,
+			permission: types.CoordinatorOperator_SETTLE_REQUESTS,
+		},
+		{
+			desc:       "operator without the permission",
+			address:    operator,
+			permission: types.CoordinatorOperator_TRIGGER_LAUNCH,
+			err:        types.ErrOperatorUnauthorized,
+		},
+		{
+			desc:       "another coordinator",
+			address:    otherCoordAddress,
+			permission: types.CoordinatorOperator_SETTLE_REQUESTS,
+			err:        types.ErrCoordInvalid,
+		},
+		{
+			desc:       "neither a coordinator nor an operator",
+			address:    sample.Address(),
+			permission: types.CoordinatorOperator_SETTLE_REQUESTS,
+			err:        types.ErrCoordAddressNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := k.CheckCoordinatorPermission(ctx, 0, tc.address, tc.permission)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/spn/x/profile/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) CoordinatorOperatorAll(
+	c context.Context,
+	req *types.QueryAllCoordinatorOperatorRequest,
+) (*types.QueryAllCoordinatorOperatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var coordinatorOperators []types.CoordinatorOperator
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	coordinatorOperatorStore := prefix.NewStore(store, types.CoordinatorOperatorAllKey(req.CoordinatorId))
+
+	pageRes, err := query.Paginate(coordinatorOperatorStore, req.Pagination, func(key []byte, value []byte) error {
+		var coordinatorOperator types.CoordinatorOperator
+		if err := k.cdc.Unmarshal(value, &coordinatorOperator); err != nil {
+			return err
+		}
+
+		coordinatorOperators = append(coordinatorOperators, coordinatorOperator)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllCoordinatorOperatorResponse{
+		CoordinatorOperator: coordinatorOperators,
+		Pagination:          pageRes,
+	}, nil
+}
+
+func (k Keeper) CoordinatorOperator(
+	c context.Context,
+	req *types.QueryGetCoordinatorOperatorRequest,
+) (*types.QueryGetCoordinatorOperatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetCoordinatorOperator(ctx, req.CoordinatorId, req.Address)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryGetCoordinatorOperatorResponse{CoordinatorOperator: val}, nil
+}
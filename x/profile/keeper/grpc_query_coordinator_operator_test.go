@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/x/profile/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCoordinatorOperatorQuerySingle(t *testing.T) {
+	keeper, ctx := testkeeper.Profile(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNCoordinatorOperator(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetCoordinatorOperatorRequest
+		response *types.QueryGetCoordinatorOperatorResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetCoordinatorOperatorRequest{
+				CoordinatorId: msgs[0].CoordinatorId,
+				Address:       msgs[0].Address,
+			},
+			response: &types.QueryGetCoordinatorOperatorResponse{CoordinatorOperator: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetCoordinatorOperatorRequest{
+				CoordinatorId: msgs[1].CoordinatorId,
+				Address:       msgs[1].Address,
+			},
+			response: &types.QueryGetCoordinatorOperatorResponse{CoordinatorOperator: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetCoordinatorOperatorRequest{
+				CoordinatorId: msgs[1].CoordinatorId,
+				Address:       msgs[0].Address,
+			},
+			err: status.Error(codes.InvalidArgument, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.CoordinatorOperator(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestCoordinatorOperatorQueryPaginated(t *testing.T) {
+	keeper, ctx := testkeeper.Profile(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	coordinatorID := uint64(0)
+	msgs := createNCoordinatorOperatorForCoordinatorID(keeper, ctx, 5, coordinatorID)
+	createNCoordinatorOperatorForCoordinatorID(keeper, ctx, 5, coordinatorID+1)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllCoordinatorOperatorRequest {
+		return &types.QueryAllCoordinatorOperatorRequest{
+			CoordinatorId: coordinatorID,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.CoordinatorOperatorAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.CoordinatorOperator), step)
+			require.Subset(t, msgs, resp.CoordinatorOperator)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.CoordinatorOperatorAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.CoordinatorOperator), step)
+			require.Subset(t, msgs, resp.CoordinatorOperator)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.CoordinatorOperatorAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t, msgs, resp.CoordinatorOperator)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.CoordinatorOperatorAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+var _ types.ProfileHooks = Keeper{}
+
+// BeforeCoordinatorDeleted calls the registered hooks before a coordinator is deleted
+func (k Keeper) BeforeCoordinatorDeleted(ctx sdk.Context, coordinatorID uint64) error {
+	if k.hooks != nil {
+		return k.hooks.BeforeCoordinatorDeleted(ctx, coordinatorID)
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ type (
 		cdc      codec.BinaryCodec
 		storeKey sdk.StoreKey
 		memKey   sdk.StoreKey
+		hooks    types.ProfileHooks
 	}
 )
 
@@ -33,3 +34,12 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// SetHooks set the hooks of the module called by other modules
+func (k *Keeper) SetHooks(hooks types.ProfileHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set profile hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
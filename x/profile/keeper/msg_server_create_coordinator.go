@@ -34,5 +34,8 @@ func (k msgServer) CreateCoordinator(
 
 	return &types.MsgCreateCoordinatorResponse{
 		CoordinatorId: coordID,
-	}, nil
+	}, ctx.EventManager().EmitTypedEvent(&types.EventCoordinatorCreated{
+		CoordinatorID: coordID,
+		Address:       msg.Address,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/profile/types"
 )
@@ -54,6 +55,11 @@ func TestMsgCreateCoordinator(t *testing.T) {
 			require.EqualValues(t, tt.msg.Address, coord.Address)
 			require.EqualValues(t, tt.msg.Description, coord.Description)
 			require.EqualValues(t, coordByAddr.CoordinatorId, coord.CoordinatorId)
+
+			events.RequireLastTypedEvent(t, ctx, &types.EventCoordinatorCreated{
+				CoordinatorID: got.CoordinatorId,
+				Address:       tt.msg.Address,
+			})
 		})
 	}
 }
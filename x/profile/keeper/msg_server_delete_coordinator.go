@@ -29,9 +29,20 @@ func (k msgServer) DeleteCoordinator(
 				coordByAddress.CoordinatorId)
 	}
 
+	// Other modules can prevent the deletion if the coordinator still manages active objects
+	if err := k.BeforeCoordinatorDeleted(ctx, coord.CoordinatorId); err != nil {
+		return &types.MsgDeleteCoordinatorResponse{}, err
+	}
+
+	for _, operator := range k.GetAllCoordinatorOperatorByCoordinatorID(ctx, coord.CoordinatorId) {
+		k.RemoveCoordinatorOperator(ctx, coord.CoordinatorId, operator.Address)
+	}
 	k.RemoveCoordinatorByAddress(ctx, msg.Address)
 	k.RemoveCoordinator(ctx, coord.CoordinatorId)
 	return &types.MsgDeleteCoordinatorResponse{
 		CoordinatorId: coord.CoordinatorId,
-	}, nil
+	}, ctx.EventManager().EmitTypedEvent(&types.EventCoordinatorDeleted{
+		CoordinatorID: coord.CoordinatorId,
+		Address:       msg.Address,
+	})
 }
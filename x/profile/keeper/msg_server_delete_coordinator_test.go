@@ -1,11 +1,15 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
 	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/keeper"
 	"github.com/tendermint/spn/x/profile/types"
 )
 
@@ -19,6 +23,8 @@ func TestMsgDeleteCoordinator(t *testing.T) {
 	if _, err := srv.CreateCoordinator(wCtx, &msgCoord); err != nil {
 		t.Fatal(err)
 	}
+	_, err := srv.SetOperator(wCtx, types.NewMsgSetOperator(msgCoord.Address, sample.Address(), sample.OperatorPermissions()))
+	require.NoError(t, err)
 	tests := []struct {
 		name string
 		msg  types.MsgDeleteCoordinator
@@ -47,6 +53,40 @@ func TestMsgDeleteCoordinator(t *testing.T) {
 
 			_, found = k.GetCoordinator(ctx, got.CoordinatorId)
 			require.False(t, found, "coordinator id not removed")
+
+			operators := k.GetAllCoordinatorOperatorByCoordinatorID(ctx, got.CoordinatorId)
+			require.Empty(t, operators, "coordinator operators not removed")
+
+			events.RequireLastTypedEvent(t, ctx, &types.EventCoordinatorDeleted{
+				CoordinatorID: got.CoordinatorId,
+				Address:       tt.msg.Address,
+			})
 		})
 	}
 }
+
+// failingProfileHooks prevents the deletion of any coordinator
+type failingProfileHooks struct{}
+
+func (failingProfileHooks) BeforeCoordinatorDeleted(sdk.Context, uint64) error {
+	return errors.New("deletion prevented")
+}
+
+func TestMsgDeleteCoordinatorPreventedByHooks(t *testing.T) {
+	var (
+		msgCoord = sample.MsgCreateCoordinator(sample.Address())
+		k, ctx   = testkeeper.Profile(t)
+		srv      = keeper.NewMsgServerImpl(*k.SetHooks(failingProfileHooks{}))
+		wCtx     = sdk.WrapSDKContext(ctx)
+	)
+	res, err := srv.CreateCoordinator(wCtx, &msgCoord)
+	require.NoError(t, err)
+
+	_, err = srv.DeleteCoordinator(wCtx, &types.MsgDeleteCoordinator{Address: msgCoord.Address})
+	require.EqualError(t, err, "deletion prevented")
+
+	_, found := k.GetCoordinatorByAddress(ctx, msgCoord.Address)
+	require.True(t, found, "coordinator by address was removed")
+	_, found = k.GetCoordinator(ctx, res.CoordinatorId)
+	require.True(t, found, "coordinator was removed")
+}
@@ -19,5 +19,7 @@ func (k msgServer) DeleteValidator(goCtx context.Context, msg *types.MsgDeleteVa
 	}
 	k.RemoveValidator(ctx, msg.Address)
 
-	return &types.MsgDeleteValidatorResponse{}, nil
+	return &types.MsgDeleteValidatorResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventValidatorDeleted{
+		Address: msg.Address,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/profile/types"
 )
@@ -48,6 +49,10 @@ func TestMsgDeleteValidator(t *testing.T) {
 			require.NoError(t, err)
 			_, found := k.GetValidator(ctx, tt.msg.Address)
 			require.False(t, found, "validator was not removed")
+
+			events.RequireLastTypedEvent(t, ctx, &types.EventValidatorDeleted{
+				Address: tt.msg.Address,
+			})
 		})
 	}
 }
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) RemoveOperator(
+	goCtx context.Context,
+	msg *types.MsgRemoveOperator,
+) (*types.MsgRemoveOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	coordinatorID, found := k.CoordinatorIDFromAddress(ctx, msg.Address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrCoordAddressNotFound, msg.Address)
+	}
+
+	if _, found := k.GetCoordinatorOperator(ctx, coordinatorID, msg.Operator); !found {
+		return nil, sdkerrors.Wrapf(types.ErrOperatorNotFound, "%s", msg.Operator)
+	}
+	k.RemoveCoordinatorOperator(ctx, coordinatorID, msg.Operator)
+
+	return &types.MsgRemoveOperatorResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventCoordinatorOperatorRemoved{
+		CoordinatorID: coordinatorID,
+		Operator:      msg.Operator,
+	})
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgRemoveOperator(t *testing.T) {
+	var (
+		msgCoord    = sample.MsgCreateCoordinator(sample.Address())
+		operator    = sample.Address()
+		ctx, k, srv = setupMsgServer(t)
+		wCtx        = sdk.WrapSDKContext(ctx)
+	)
+	res, err := srv.CreateCoordinator(wCtx, &msgCoord)
+	require.NoError(t, err)
+	_, err = srv.SetOperator(wCtx, types.NewMsgSetOperator(msgCoord.Address, operator, sample.OperatorPermissions()))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		msg  types.MsgRemoveOperator
+		err  error
+	}{
+		{
+			name: "not found coordinator address",
+			msg:  types.MsgRemoveOperator{Address: sample.Address(), Operator: operator},
+			err:  types.ErrCoordAddressNotFound,
+		},
+		{
+			name: "not found operator",
+			msg:  types.MsgRemoveOperator{Address: msgCoord.Address, Operator: sample.Address()},
+			err:  types.ErrOperatorNotFound,
+		},
+		{
+			name: "remove operator",
+			msg:  types.MsgRemoveOperator{Address: msgCoord.Address, Operator: operator},
+		},
+		{
+			name: "operator already removed",
+			msg:  types.MsgRemoveOperator{Address: msgCoord.Address, Operator: operator},
+			err:  types.ErrOperatorNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.RemoveOperator(wCtx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			_, found := k.GetCoordinatorOperator(ctx, res.CoordinatorId, tt.msg.Operator)
+			require.False(t, found)
+
+			events.RequireLastTypedEvent(t, ctx, &types.EventCoordinatorOperatorRemoved{
+				CoordinatorID: res.CoordinatorId,
+				Operator:      tt.msg.Operator,
+			})
+		})
+	}
+}
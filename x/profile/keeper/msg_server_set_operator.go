@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) SetOperator(
+	goCtx context.Context,
+	msg *types.MsgSetOperator,
+) (*types.MsgSetOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only the coordinator itself can manage its operators
+	coordinatorID, found := k.CoordinatorIDFromAddress(ctx, msg.Address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrCoordAddressNotFound, msg.Address)
+	}
+
+	// The permissions of an existing operator are replaced
+	k.SetCoordinatorOperator(ctx, types.NewCoordinatorOperator(coordinatorID, msg.Operator, msg.Permissions))
+
+	return &types.MsgSetOperatorResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventCoordinatorOperatorSet{
+		CoordinatorID: coordinatorID,
+		Operator:      msg.Operator,
+		Permissions:   msg.Permissions,
+	})
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgSetOperator(t *testing.T) {
+	var (
+		msgCoord    = sample.MsgCreateCoordinator(sample.Address())
+		operator    = sample.Address()
+		ctx, k, srv = setupMsgServer(t)
+		wCtx        = sdk.WrapSDKContext(ctx)
+	)
+	res, err := srv.CreateCoordinator(wCtx, &msgCoord)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetOperator
+		err  error
+	}{
+		{
+			name: "set operator",
+			msg: types.MsgSetOperator{
+				Address:  msgCoord.Address,
+				Operator: operator,
+				Permissions: []types.CoordinatorOperator_Permission{
+					types.CoordinatorOperator_SETTLE_REQUESTS,
+				},
+			},
+		},
+		{
+			name: "replace permissions of operator",
+			msg: types.MsgSetOperator{
+				Address:  msgCoord.Address,
+				Operator: operator,
+				Permissions: []types.CoordinatorOperator_Permission{
+					types.CoordinatorOperator_TRIGGER_LAUNCH,
+					types.CoordinatorOperator_MINT_VOUCHERS,
+				},
+			},
+		},
+		{
+			name: "not found coordinator address",
+			msg: types.MsgSetOperator{
+				Address:     sample.Address(),
+				Operator:    operator,
+				Permissions: sample.OperatorPermissions(),
+			},
+			err: types.ErrCoordAddressNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.SetOperator(wCtx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			got, found := k.GetCoordinatorOperator(ctx, res.CoordinatorId, tt.msg.Operator)
+			require.True(t, found)
+			require.Equal(t, tt.msg.Permissions, got.Permissions)
+
+			events.RequireLastTypedEvent(t, ctx, &types.EventCoordinatorOperatorSet{
+				CoordinatorID: res.CoordinatorId,
+				Operator:      tt.msg.Operator,
+				Permissions:   tt.msg.Permissions,
+			})
+		})
+	}
+}
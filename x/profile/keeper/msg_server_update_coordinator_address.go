@@ -46,5 +46,8 @@ func (k msgServer) UpdateCoordinatorAddress(
 	})
 	k.SetCoordinator(ctx, coord)
 
-	return &types.MsgUpdateCoordinatorAddressResponse{}, nil
+	return &types.MsgUpdateCoordinatorAddressResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventCoordinatorAddressUpdated{
+		CoordinatorID: coord.CoordinatorId,
+		NewAddress:    msg.NewAddress,
+	})
 }
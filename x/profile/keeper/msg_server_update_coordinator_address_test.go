@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/profile/types"
 )
@@ -83,6 +84,11 @@ func TestMsgUpdateCoordinatorAddress(t *testing.T) {
 			require.True(t, found, "coordinator id not found")
 			require.EqualValues(t, tt.msg.NewAddress, coord.Address)
 			require.EqualValues(t, coordByAddr.CoordinatorId, coord.CoordinatorId)
+
+			events.RequireLastTypedEvent(t, ctx, &types.EventCoordinatorAddressUpdated{
+				CoordinatorID: coord.CoordinatorId,
+				NewAddress:    tt.msg.NewAddress,
+			})
 		})
 	}
 }
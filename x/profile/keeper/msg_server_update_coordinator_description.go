@@ -40,5 +40,8 @@ func (k msgServer) UpdateCoordinatorDescription(
 	}
 
 	k.SetCoordinator(ctx, coord)
-	return &types.MsgUpdateCoordinatorDescriptionResponse{}, nil
+	return &types.MsgUpdateCoordinatorDescriptionResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventCoordinatorDescriptionUpdated{
+		CoordinatorID: coord.CoordinatorId,
+		Address:       msg.Address,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/profile/types"
 )
@@ -91,6 +92,11 @@ func TestMsgUpdateCoordinatorDescription(t *testing.T) {
 			} else {
 				require.EqualValues(t, oldCoord.Description.Details, coord.Description.Details)
 			}
+
+			events.RequireLastTypedEvent(t, ctx, &types.EventCoordinatorDescriptionUpdated{
+				CoordinatorID: coord.CoordinatorId,
+				Address:       tt.msg.Address,
+			})
 		})
 	}
 }
@@ -40,5 +40,7 @@ func (k msgServer) UpdateValidatorDescription(
 
 	k.SetValidator(ctx, validator)
 
-	return &types.MsgUpdateValidatorDescriptionResponse{}, nil
+	return &types.MsgUpdateValidatorDescriptionResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventValidatorDescriptionUpdated{
+		Address: msg.Address,
+	})
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/events"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/profile/types"
 )
@@ -85,6 +86,10 @@ func TestMsgUpdateValidatorDescription(t *testing.T) {
 			} else if oldFound {
 				require.EqualValues(t, oldValidator.Description.SecurityContact, oldValidator.Description.SecurityContact)
 			}
+
+			events.RequireLastTypedEvent(t, ctx, &types.EventValidatorDescriptionUpdated{
+				Address: tt.msg.Address,
+			})
 		})
 	}
 }
@@ -19,6 +19,8 @@ const (
 	defaultWeightMsgUpdateCoordinatorDescription = 20
 	defaultWeightMsgUpdateCoordinatorAddress     = 20
 	defaultWeightMsgDeleteCoordinator            = 5
+	defaultWeightMsgSetOperator                  = 20
+	defaultWeightMsgRemoveOperator               = 10
 
 	opWeightMsgUpdateValidatorDescription   = "op_weight_msg_update_validator_description"
 	opWeightMsgDeleteValidator              = "op_weight_msg_delete_validator"
@@ -26,6 +28,8 @@ const (
 	opWeightMsgUpdateCoordinatorDescription = "op_weight_msg_update_coordinator_description"
 	opWeightMsgUpdateCoordinatorAddress     = "op_weight_msg_update_coordinator_address"
 	opWeightMsgDeleteCoordinator            = "op_weight_msg_delete_coordinator"
+	opWeightMsgSetOperator                  = "op_weight_msg_set_operator"
+	opWeightMsgRemoveOperator               = "op_weight_msg_remove_operator"
 )
 
 // GenerateGenesisState creates a randomized GenState of the module
@@ -60,6 +64,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgUpdateCoordinatorDescription int
 		weightMsgUpdateCoordinatorAddress     int
 		weightMsgDeleteCoordinator            int
+		weightMsgSetOperator                  int
+		weightMsgRemoveOperator               int
 	)
 
 	appParams := simState.AppParams
@@ -94,6 +100,16 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 			weightMsgDeleteCoordinator = defaultWeightMsgDeleteCoordinator
 		},
 	)
+	appParams.GetOrGenerate(cdc, opWeightMsgSetOperator, &weightMsgSetOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetOperator = defaultWeightMsgSetOperator
+		},
+	)
+	appParams.GetOrGenerate(cdc, opWeightMsgRemoveOperator, &weightMsgRemoveOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveOperator = defaultWeightMsgRemoveOperator
+		},
+	)
 
 	return []simtypes.WeightedOperation{
 		simulation.NewWeightedOperation(
@@ -120,5 +136,13 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 			weightMsgDeleteCoordinator,
 			profilesimulation.SimulateMsgDeleteCoordinator(am.accountKeeper, am.bankKeeper, am.keeper),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSetOperator,
+			profilesimulation.SimulateMsgSetOperator(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveOperator,
+			profilesimulation.SimulateMsgRemoveOperator(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
 	}
 }
@@ -238,6 +238,15 @@ func SimulateMsgDeleteCoordinator(ak types.AccountKeeper, bk types.BankKeeper, k
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteCoordinator, "skip update coordinator delete"), nil, nil
 		}
 
+		// No message if the coordinator still manages active objects
+		coordByAddress, found := k.GetCoordinatorByAddress(ctx, simAccount.Address.String())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteCoordinator, "coordinator not found"), nil, nil
+		}
+		if err := k.BeforeCoordinatorDeleted(ctx, coordByAddress.CoordinatorId); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteCoordinator, "coordinator can't be deleted"), nil, nil
+		}
+
 		msg := types.NewMsgDeleteCoordinator(simAccount.Address.String())
 		txCtx := simulation.OperationInput{
 			R:               r,
@@ -256,3 +265,86 @@ func SimulateMsgDeleteCoordinator(ak types.AccountKeeper, bk types.BankKeeper, k
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
+
+// SimulateMsgSetOperator simulates a MsgSetOperator message
+func SimulateMsgSetOperator(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// Find an account with coordinator associated
+		simAccount, found := FindCoordinatorAccount(r, ctx, k, accs, true)
+		if !found {
+			// No message if no coordinator
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetOperator, "skip set operator"), nil, nil
+		}
+		operator, _ := simtypes.RandomAcc(r, accs)
+		if operator.Address.Equals(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetOperator, "operator is the coordinator"), nil, nil
+		}
+
+		msg := types.NewMsgSetOperator(
+			simAccount.Address.String(),
+			operator.Address.String(),
+			sample.OperatorPermissions(),
+		)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgRemoveOperator simulates a MsgRemoveOperator message
+func SimulateMsgRemoveOperator(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// Find an operator of a coordinator with a simulation account
+		operators := k.GetAllCoordinatorOperator(ctx)
+		r.Shuffle(len(operators), func(i, j int) {
+			operators[i], operators[j] = operators[j], operators[i]
+		})
+		for _, operator := range operators {
+			coordAddress, found := k.GetCoordinatorAddressFromID(ctx, operator.CoordinatorId)
+			if !found {
+				continue
+			}
+			for _, acc := range accs {
+				if acc.Address.String() != coordAddress {
+					continue
+				}
+
+				msg := types.NewMsgRemoveOperator(coordAddress, operator.Address)
+				txCtx := simulation.OperationInput{
+					R:               r,
+					App:             app,
+					TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+					Cdc:             nil,
+					Msg:             msg,
+					MsgType:         msg.Type(),
+					Context:         ctx,
+					SimAccount:      acc,
+					AccountKeeper:   ak,
+					Bankkeeper:      bk,
+					ModuleName:      types.ModuleName,
+					CoinsSpentInMsg: sdk.NewCoins(),
+				}
+				return simulation.GenAndDeliverTxWithRandFees(txCtx)
+			}
+		}
+
+		// No message if no operator
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRemoveOperator, "skip remove operator"), nil, nil
+	}
+}
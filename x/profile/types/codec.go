@@ -16,6 +16,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateCoordinatorDescription{}, "profile/UpdateCoordinatorDescription", nil)
 	cdc.RegisterConcrete(&MsgUpdateCoordinatorAddress{}, "profile/UpdateCoordinatorAddress", nil)
 	cdc.RegisterConcrete(&MsgDeleteCoordinator{}, "profile/DeleteCoordinator", nil)
+	cdc.RegisterConcrete(&MsgSetOperator{}, "profile/SetOperator", nil)
+	cdc.RegisterConcrete(&MsgRemoveOperator{}, "profile/RemoveOperator", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -27,6 +29,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateCoordinatorDescription{},
 		&MsgUpdateCoordinatorAddress{},
 		&MsgDeleteCoordinator{},
+		&MsgSetOperator{},
+		&MsgRemoveOperator{},
 	)
 	// this line is used by starport scaffolding # 3
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
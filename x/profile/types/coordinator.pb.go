@@ -23,6 +23,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Permission defines an action of the coordinator an operator can be authorized to perform
+type CoordinatorOperator_Permission int32
+
+const (
+	// default value of an unset permission, never granted to an operator
+	CoordinatorOperator_PERMISSION_UNSPECIFIED CoordinatorOperator_Permission = 0
+	// settle the requests of the chains of the coordinator
+	CoordinatorOperator_SETTLE_REQUESTS CoordinatorOperator_Permission = 1
+	// trigger and revert the launch of the chains of the coordinator
+	CoordinatorOperator_TRIGGER_LAUNCH CoordinatorOperator_Permission = 2
+	// manage the shares of the campaigns of the coordinator
+	CoordinatorOperator_MANAGE_SHARES CoordinatorOperator_Permission = 3
+	// mint vouchers for the campaigns of the coordinator
+	CoordinatorOperator_MINT_VOUCHERS CoordinatorOperator_Permission = 4
+)
+
+var CoordinatorOperator_Permission_name = map[int32]string{
+	0: "PERMISSION_UNSPECIFIED",
+	1: "SETTLE_REQUESTS",
+	2: "TRIGGER_LAUNCH",
+	3: "MANAGE_SHARES",
+	4: "MINT_VOUCHERS",
+}
+
+var CoordinatorOperator_Permission_value = map[string]int32{
+	"PERMISSION_UNSPECIFIED": 0,
+	"SETTLE_REQUESTS":        1,
+	"TRIGGER_LAUNCH":         2,
+	"MANAGE_SHARES":          3,
+	"MINT_VOUCHERS":          4,
+}
+
+func (x CoordinatorOperator_Permission) String() string {
+	return proto.EnumName(CoordinatorOperator_Permission_name, int32(x))
+}
+
+func (CoordinatorOperator_Permission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8b9115302ae8cca0, []int{3, 0}
+}
+
 type Coordinator struct {
 	CoordinatorId uint64                 `protobuf:"varint,1,opt,name=coordinatorId,proto3" json:"coordinatorId,omitempty"`
 	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -195,35 +235,107 @@ func (m *CoordinatorByAddress) GetCoordinatorId() uint64 {
 	return 0
 }
 
+// CoordinatorOperator is an address authorized by a coordinator to perform some of its actions
+type CoordinatorOperator struct {
+	CoordinatorId uint64                           `protobuf:"varint,1,opt,name=coordinatorId,proto3" json:"coordinatorId,omitempty"`
+	Address       string                           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Permissions   []CoordinatorOperator_Permission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=tendermint.spn.profile.CoordinatorOperator_Permission" json:"permissions,omitempty"`
+}
+
+func (m *CoordinatorOperator) Reset()         { *m = CoordinatorOperator{} }
+func (m *CoordinatorOperator) String() string { return proto.CompactTextString(m) }
+func (*CoordinatorOperator) ProtoMessage()    {}
+func (*CoordinatorOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b9115302ae8cca0, []int{3}
+}
+func (m *CoordinatorOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoordinatorOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoordinatorOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoordinatorOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoordinatorOperator.Merge(m, src)
+}
+func (m *CoordinatorOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoordinatorOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoordinatorOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoordinatorOperator proto.InternalMessageInfo
+
+func (m *CoordinatorOperator) GetCoordinatorId() uint64 {
+	if m != nil {
+		return m.CoordinatorId
+	}
+	return 0
+}
+
+func (m *CoordinatorOperator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CoordinatorOperator) GetPermissions() []CoordinatorOperator_Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("tendermint.spn.profile.CoordinatorOperator_Permission", CoordinatorOperator_Permission_name, CoordinatorOperator_Permission_value)
 	proto.RegisterType((*Coordinator)(nil), "tendermint.spn.profile.Coordinator")
 	proto.RegisterType((*CoordinatorDescription)(nil), "tendermint.spn.profile.CoordinatorDescription")
 	proto.RegisterType((*CoordinatorByAddress)(nil), "tendermint.spn.profile.CoordinatorByAddress")
+	proto.RegisterType((*CoordinatorOperator)(nil), "tendermint.spn.profile.CoordinatorOperator")
 }
 
 func init() { proto.RegisterFile("profile/coordinator.proto", fileDescriptor_8b9115302ae8cca0) }
 
 var fileDescriptor_8b9115302ae8cca0 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xb1, 0x4e, 0xc3, 0x30,
-	0x14, 0x8c, 0x4b, 0x05, 0xd4, 0x15, 0x4b, 0x54, 0x55, 0xa1, 0x83, 0xa9, 0x22, 0x86, 0xb0, 0xd8,
-	0x12, 0x7c, 0x01, 0x29, 0x0b, 0x6b, 0x86, 0x0e, 0x6c, 0x49, 0x6c, 0x52, 0x4b, 0xad, 0x6d, 0xd9,
-	0x46, 0x90, 0xbf, 0xe0, 0x27, 0xf8, 0x97, 0x8e, 0x1d, 0x99, 0x10, 0x4a, 0x7e, 0x04, 0x25, 0x24,
-	0x24, 0x15, 0xd9, 0x7c, 0xf6, 0xf9, 0xde, 0xdd, 0x3d, 0x78, 0xa9, 0xb4, 0x7c, 0xe6, 0x5b, 0x46,
-	0x52, 0x29, 0x35, 0xe5, 0x22, 0xb6, 0x52, 0x63, 0xa5, 0xa5, 0x95, 0xee, 0xdc, 0x32, 0x41, 0x99,
-	0xde, 0x71, 0x61, 0xb1, 0x51, 0x02, 0x37, 0xcc, 0xc5, 0x2c, 0x93, 0x99, 0xac, 0x29, 0xa4, 0x3a,
-	0xfd, 0xb2, 0xfd, 0x0f, 0x00, 0xa7, 0xab, 0x4e, 0xc3, 0xbd, 0x86, 0x17, 0x3d, 0xc9, 0x47, 0xea,
-	0x81, 0x25, 0x08, 0xc6, 0xd1, 0xf1, 0xa5, 0xeb, 0xc1, 0xb3, 0x98, 0x52, 0xcd, 0x8c, 0xf1, 0x46,
-	0x4b, 0x10, 0x4c, 0xa2, 0x16, 0xba, 0x6b, 0x38, 0xa5, 0xcc, 0xa4, 0x9a, 0x2b, 0xcb, 0xa5, 0xf0,
-	0x4e, 0x96, 0x20, 0x98, 0xde, 0x62, 0x3c, 0xec, 0x09, 0xf7, 0x26, 0x3f, 0x74, 0xbf, 0xc2, 0xf1,
-	0xfe, 0xeb, 0xca, 0x89, 0xfa, 0x42, 0xfe, 0x06, 0xce, 0x87, 0xc9, 0xee, 0x02, 0x9e, 0x73, 0xca,
-	0x84, 0xe5, 0x36, 0xaf, 0xcd, 0x4e, 0xa2, 0x3f, 0x5c, 0xf9, 0x7c, 0x65, 0x89, 0xe1, 0x96, 0xb5,
-	0x3e, 0x1b, 0x58, 0xbd, 0x50, 0x66, 0x63, 0xbe, 0x35, 0xb5, 0xc7, 0x49, 0xd4, 0x42, 0x7f, 0x0d,
-	0x67, 0xbd, 0x49, 0x61, 0x7e, 0xdf, 0x24, 0xeb, 0x65, 0x06, 0xc7, 0x99, 0xff, 0x75, 0x36, 0x1a,
-	0xe8, 0x2c, 0x5c, 0xed, 0x0b, 0x04, 0x0e, 0x05, 0x02, 0xdf, 0x05, 0x02, 0xef, 0x25, 0x72, 0x0e,
-	0x25, 0x72, 0x3e, 0x4b, 0xe4, 0x3c, 0xdd, 0x64, 0xdc, 0x6e, 0x5e, 0x12, 0x9c, 0xca, 0x1d, 0xe9,
-	0x8a, 0x22, 0x46, 0x09, 0xf2, 0x46, 0xda, 0x45, 0xdb, 0x5c, 0x31, 0x93, 0x9c, 0xd6, 0x5b, 0xbb,
-	0xfb, 0x09, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x4d, 0x81, 0x82, 0x00, 0x02, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x8d, 0xdb, 0x0a, 0xa8, 0xab, 0x8d, 0xe0, 0x4d, 0x55, 0xe9, 0x21, 0x54, 0x11, 0x87, 0x72,
+	0x49, 0xa4, 0x21, 0x71, 0x4f, 0x33, 0xd3, 0x46, 0xda, 0xd2, 0x62, 0xa7, 0x15, 0xe2, 0x12, 0xb5,
+	0x8d, 0xe9, 0x2c, 0x6d, 0x71, 0x14, 0x1b, 0x8d, 0xfe, 0x0b, 0xfe, 0x04, 0x12, 0x3f, 0x65, 0xc7,
+	0x1d, 0x39, 0x21, 0xd4, 0xfe, 0x11, 0x94, 0xd0, 0x2c, 0xa9, 0xe8, 0x81, 0xc3, 0x6e, 0x79, 0xf6,
+	0xfb, 0xde, 0xf7, 0xde, 0x53, 0x0c, 0x5f, 0x26, 0xa9, 0xf8, 0xcc, 0xaf, 0x99, 0xbd, 0x14, 0x22,
+	0x8d, 0x78, 0x3c, 0x57, 0x22, 0xb5, 0x92, 0x54, 0x28, 0x81, 0xda, 0x8a, 0xc5, 0x11, 0x4b, 0x6f,
+	0x78, 0xac, 0x2c, 0x99, 0xc4, 0xd6, 0x8e, 0xd9, 0x3d, 0x5d, 0x89, 0x95, 0xc8, 0x29, 0x76, 0xf6,
+	0xf5, 0x97, 0x6d, 0x7e, 0x07, 0xb0, 0xe5, 0x96, 0x1a, 0xe8, 0x35, 0x3c, 0xaa, 0x48, 0x7a, 0x51,
+	0x07, 0xf4, 0x40, 0xbf, 0x41, 0xf6, 0x0f, 0x51, 0x07, 0x3e, 0x9d, 0x47, 0x51, 0xca, 0xa4, 0xec,
+	0xd4, 0x7a, 0xa0, 0xdf, 0x24, 0x05, 0x44, 0x33, 0xd8, 0x8a, 0x98, 0x5c, 0xa6, 0x3c, 0x51, 0x5c,
+	0xc4, 0x9d, 0x7a, 0x0f, 0xf4, 0x5b, 0x67, 0x96, 0x75, 0xd8, 0x93, 0x55, 0xd9, 0x7c, 0x5e, 0x4e,
+	0x0d, 0x1a, 0x77, 0xbf, 0x5e, 0x69, 0xa4, 0x2a, 0x64, 0x5e, 0xc1, 0xf6, 0x61, 0x32, 0xea, 0xc2,
+	0x67, 0x3c, 0x62, 0xb1, 0xe2, 0x6a, 0x9d, 0x9b, 0x6d, 0x92, 0x07, 0x9c, 0xf9, 0xbc, 0x65, 0x0b,
+	0xc9, 0x15, 0x2b, 0x7c, 0xee, 0x60, 0x76, 0x13, 0x31, 0x35, 0xe7, 0xd7, 0x32, 0xf7, 0xd8, 0x24,
+	0x05, 0x34, 0x67, 0xf0, 0xb4, 0xb2, 0x69, 0xb0, 0x76, 0x76, 0xc9, 0x2a, 0x99, 0xc1, 0x7e, 0xe6,
+	0x7f, 0x3a, 0xab, 0x1d, 0xe8, 0xcc, 0xfc, 0x51, 0x83, 0x27, 0x15, 0xe1, 0x71, 0xc2, 0xd2, 0x47,
+	0x69, 0xfc, 0x23, 0x6c, 0x25, 0x59, 0xb5, 0x52, 0x72, 0x11, 0x67, 0x69, 0xea, 0xfd, 0xe3, 0xb3,
+	0x77, 0xff, 0xd1, 0x78, 0xe1, 0xc0, 0x9a, 0x3c, 0x8c, 0x93, 0xaa, 0x94, 0x79, 0x0b, 0x61, 0x79,
+	0x85, 0xba, 0xb0, 0x3d, 0xc1, 0xe4, 0xd2, 0xa3, 0xd4, 0x1b, 0xfb, 0xe1, 0xd4, 0xa7, 0x13, 0xec,
+	0x7a, 0xef, 0x3d, 0x7c, 0xae, 0x6b, 0xe8, 0x04, 0x3e, 0xa7, 0x38, 0x08, 0x2e, 0x70, 0x48, 0xf0,
+	0x87, 0x29, 0xa6, 0x01, 0xd5, 0x01, 0x42, 0xf0, 0x38, 0x20, 0xde, 0x70, 0x88, 0x49, 0x78, 0xe1,
+	0x4c, 0x7d, 0x77, 0xa4, 0xd7, 0xd0, 0x0b, 0x78, 0x74, 0xe9, 0xf8, 0xce, 0x10, 0x87, 0x74, 0xe4,
+	0x10, 0x4c, 0xf5, 0x7a, 0x7e, 0xe4, 0xf9, 0x41, 0x38, 0x1b, 0x4f, 0xdd, 0x11, 0x26, 0x54, 0x6f,
+	0x0c, 0xdc, 0xbb, 0x8d, 0x01, 0xee, 0x37, 0x06, 0xf8, 0xbd, 0x31, 0xc0, 0xb7, 0xad, 0xa1, 0xdd,
+	0x6f, 0x0d, 0xed, 0xe7, 0xd6, 0xd0, 0x3e, 0xbd, 0x59, 0x71, 0x75, 0xf5, 0x65, 0x61, 0x2d, 0xc5,
+	0x8d, 0x5d, 0x26, 0xb4, 0x65, 0x12, 0xdb, 0x5f, 0xed, 0xe2, 0x4d, 0xa8, 0x75, 0xc2, 0xe4, 0xe2,
+	0x49, 0xfe, 0x83, 0xbf, 0xfd, 0x33, 0x00, 0x15, 0xff, 0x6d, 0xae, 0x2b, 0x03, 0x00, 0x00,
 }
 
 func (m *Coordinator) Marshal() (dAtA []byte, err error) {
@@ -350,6 +462,59 @@ func (m *CoordinatorByAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CoordinatorOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoordinatorOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoordinatorOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA3 := make([]byte, len(m.Permissions)*10)
+		var j2 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintCoordinator(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCoordinator(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CoordinatorId != 0 {
+		i = encodeVarintCoordinator(dAtA, i, uint64(m.CoordinatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCoordinator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCoordinator(v)
 	base := offset
@@ -416,6 +581,29 @@ func (m *CoordinatorByAddress) Size() (n int) {
 	return n
 }
 
+func (m *CoordinatorOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoordinatorId != 0 {
+		n += 1 + sovCoordinator(uint64(m.CoordinatorId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCoordinator(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovCoordinator(uint64(e))
+		}
+		n += 1 + sovCoordinator(uint64(l)) + l
+	}
+	return n
+}
+
 func sovCoordinator(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -803,6 +991,176 @@ func (m *CoordinatorByAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CoordinatorOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoordinator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoordinatorOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoordinatorOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorId", wireType)
+			}
+			m.CoordinatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v CoordinatorOperator_Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCoordinator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CoordinatorOperator_Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCoordinator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCoordinator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCoordinator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]CoordinatorOperator_Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CoordinatorOperator_Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCoordinator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CoordinatorOperator_Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoordinator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCoordinator(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCoordinatorOperator returns a new operator of a coordinator
+func NewCoordinatorOperator(
+	coordinatorID uint64,
+	address string,
+	permissions []CoordinatorOperator_Permission,
+) CoordinatorOperator {
+	return CoordinatorOperator{
+		CoordinatorId: coordinatorID,
+		Address:       address,
+		Permissions:   permissions,
+	}
+}
+
+// HasPermission returns true if the operator is authorized to perform the action
+func (m CoordinatorOperator) HasPermission(permission CoordinatorOperator_Permission) bool {
+	for _, p := range m.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the operator is valid
+func (m CoordinatorOperator) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return fmt.Errorf("invalid operator address: %s", err.Error())
+	}
+	return ValidatePermissions(m.Permissions)
+}
+
+// ValidatePermissions checks the list of permissions is not empty, contains only defined and specified permissions
+// and doesn't contain duplicates
+func ValidatePermissions(permissions []CoordinatorOperator_Permission) error {
+	if len(permissions) == 0 {
+		return errors.New("no permission")
+	}
+	permissionMap := make(map[CoordinatorOperator_Permission]struct{})
+	for _, p := range permissions {
+		if _, ok := CoordinatorOperator_Permission_name[int32(p)]; !ok {
+			return fmt.Errorf("undefined permission %d", p)
+		}
+		if p == CoordinatorOperator_PERMISSION_UNSPECIFIED {
+			return errors.New("unspecified permission")
+		}
+		if _, ok := permissionMap[p]; ok {
+			return fmt.Errorf("duplicated permission %s", p.String())
+		}
+		permissionMap[p] = struct{}{}
+	}
+	return nil
+}
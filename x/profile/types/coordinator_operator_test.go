@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestCoordinatorOperator_HasPermission(t *testing.T) {
+	operator := types.NewCoordinatorOperator(0, sample.Address(), []types.CoordinatorOperator_Permission{
+		types.CoordinatorOperator_SETTLE_REQUESTS,
+		types.CoordinatorOperator_MINT_VOUCHERS,
+	})
+	require.True(t, operator.HasPermission(types.CoordinatorOperator_SETTLE_REQUESTS))
+	require.True(t, operator.HasPermission(types.CoordinatorOperator_MINT_VOUCHERS))
+	require.False(t, operator.HasPermission(types.CoordinatorOperator_TRIGGER_LAUNCH))
+	require.False(t, operator.HasPermission(types.CoordinatorOperator_MANAGE_SHARES))
+}
+
+func TestCoordinatorOperator_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		operator types.CoordinatorOperator
+		valid    bool
+	}{
+		{
+			desc:     "valid operator",
+			operator: sample.CoordinatorOperator(0),
+			valid:    true,
+		},
+		{
+			desc: "invalid address",
+			operator: types.NewCoordinatorOperator(0, "invalid", []types.CoordinatorOperator_Permission{
+				types.CoordinatorOperator_SETTLE_REQUESTS,
+			}),
+			valid: false,
+		},
+		{
+			desc:     "no permission",
+			operator: types.NewCoordinatorOperator(0, sample.Address(), nil),
+			valid:    false,
+		},
+		{
+			desc: "undefined permission",
+			operator: types.NewCoordinatorOperator(0, sample.Address(), []types.CoordinatorOperator_Permission{
+				types.CoordinatorOperator_Permission(100),
+			}),
+			valid: false,
+		},
+		{
+			desc: "unspecified permission",
+			operator: types.NewCoordinatorOperator(0, sample.Address(), []types.CoordinatorOperator_Permission{
+				types.CoordinatorOperator_PERMISSION_UNSPECIFIED,
+			}),
+			valid: false,
+		},
+		{
+			desc: "duplicated permission",
+			operator: types.NewCoordinatorOperator(0, sample.Address(), []types.CoordinatorOperator_Permission{
+				types.CoordinatorOperator_TRIGGER_LAUNCH,
+				types.CoordinatorOperator_TRIGGER_LAUNCH,
+			}),
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.operator.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -13,4 +13,7 @@ var (
 	ErrCoordInvalid         = sdkerrors.Register(ModuleName, 4, "invalid coordinator")
 	ErrEmptyDescription     = sdkerrors.Register(ModuleName, 5, "you must provide at least one description parameter")
 	ErrValidatorNotFound    = sdkerrors.Register(ModuleName, 6, "validator address not found")
+	ErrInvalidPermissions   = sdkerrors.Register(ModuleName, 7, "invalid operator permissions")
+	ErrOperatorNotFound     = sdkerrors.Register(ModuleName, 8, "coordinator operator not found")
+	ErrOperatorUnauthorized = sdkerrors.Register(ModuleName, 9, "operator not authorized for the action")
 )
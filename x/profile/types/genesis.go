@@ -10,6 +10,7 @@ func DefaultGenesis() *GenesisState {
 		CoordinatorList:          []Coordinator{},
 		CoordinatorCounter:       1,
 		CoordinatorByAddressList: []CoordinatorByAddress{},
+		CoordinatorOperatorList:  []CoordinatorOperator{},
 	}
 }
 
@@ -22,7 +23,11 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.ValidateCoordinators()
+	if err := gs.ValidateCoordinators(); err != nil {
+		return err
+	}
+
+	return gs.ValidateCoordinatorOperators()
 }
 
 func (gs GenesisState) ValidateValidators() error {
@@ -76,3 +81,27 @@ func (gs GenesisState) ValidateCoordinators() error {
 	}
 	return nil
 }
+
+func (gs GenesisState) ValidateCoordinatorOperators() error {
+	coordinatorIDMap := make(map[uint64]struct{})
+	for _, elem := range gs.CoordinatorList {
+		coordinatorIDMap[elem.CoordinatorId] = struct{}{}
+	}
+
+	// Check for duplicated index in coordinatorOperator
+	coordinatorOperatorIndexMap := make(map[string]struct{})
+	for _, elem := range gs.CoordinatorOperatorList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid operator %s: %s", elem.Address, err.Error())
+		}
+		if _, ok := coordinatorIDMap[elem.CoordinatorId]; !ok {
+			return fmt.Errorf("coordinator %d not found for operator %s", elem.CoordinatorId, elem.Address)
+		}
+		index := string(CoordinatorOperatorKey(elem.CoordinatorId, elem.Address))
+		if _, ok := coordinatorOperatorIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for coordinatorOperator: %d %s", elem.CoordinatorId, elem.Address)
+		}
+		coordinatorOperatorIndexMap[index] = struct{}{}
+	}
+	return nil
+}
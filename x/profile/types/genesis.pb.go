@@ -29,6 +29,7 @@ type GenesisState struct {
 	CoordinatorList          []Coordinator          `protobuf:"bytes,2,rep,name=coordinatorList,proto3" json:"coordinatorList"`
 	CoordinatorCounter       uint64                 `protobuf:"varint,3,opt,name=coordinatorCounter,proto3" json:"coordinatorCounter,omitempty"`
 	CoordinatorByAddressList []CoordinatorByAddress `protobuf:"bytes,4,rep,name=coordinatorByAddressList,proto3" json:"coordinatorByAddressList"`
+	CoordinatorOperatorList  []CoordinatorOperator  `protobuf:"bytes,5,rep,name=coordinatorOperatorList,proto3" json:"coordinatorOperatorList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +93,13 @@ func (m *GenesisState) GetCoordinatorByAddressList() []CoordinatorByAddress {
 	return nil
 }
 
+func (m *GenesisState) GetCoordinatorOperatorList() []CoordinatorOperator {
+	if m != nil {
+		return m.CoordinatorOperatorList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "tendermint.spn.profile.GenesisState")
 }
@@ -99,13 +107,13 @@ func init() {
 func init() { proto.RegisterFile("profile/genesis.proto", fileDescriptor_db4bc1562021cf42) }
 
 var fileDescriptor_db4bc1562021cf42 = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
+	// 314 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2d, 0x28, 0xca, 0x4f,
 	0xcb, 0xcc, 0x49, 0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x12, 0x2b, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2b, 0x2e,
 	0xc8, 0xd3, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20,
 	0xaa, 0xa5, 0xc4, 0x61, 0x86, 0x94, 0x25, 0xe6, 0x64, 0xa6, 0x24, 0x96, 0xe4, 0x17, 0x41, 0x25,
-	0x24, 0x61, 0x12, 0xc9, 0xf9, 0xf9, 0x45, 0x29, 0x99, 0x79, 0x08, 0x29, 0xa5, 0x4b, 0x4c, 0x5c,
+	0x24, 0x61, 0x12, 0xc9, 0xf9, 0xf9, 0x45, 0x29, 0x99, 0x79, 0x08, 0x29, 0xa5, 0xfd, 0xcc, 0x5c,
 	0x3c, 0xee, 0x10, 0x3b, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x7c, 0xb9, 0x78, 0xe1, 0xda, 0x7d,
 	0x32, 0x8b, 0x4b, 0x24, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0x14, 0xf5, 0xb0, 0x3b, 0x45, 0x2f,
 	0x0c, 0xa6, 0xd8, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0x54, 0xdd, 0x42, 0xc1, 0x5c, 0xfc,
@@ -113,12 +121,13 @@ var fileDescriptor_db4bc1562021cf42 = []byte{
 	0xe8, 0x26, 0x08, 0xe9, 0x71, 0x09, 0x21, 0x09, 0x39, 0xe7, 0x97, 0xe6, 0x95, 0xa4, 0x16, 0x49,
 	0x30, 0x2b, 0x30, 0x6a, 0xb0, 0x04, 0x61, 0x91, 0x11, 0xca, 0xe3, 0x92, 0x40, 0x12, 0x75, 0xaa,
 	0x74, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x06, 0xbb, 0x86, 0x05, 0xec, 0x1a, 0x1d, 0x62, 0x5c,
-	0x03, 0xd3, 0x07, 0x75, 0x16, 0x4e, 0x33, 0x9d, 0x9c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x33, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f,
-	0x61, 0xa3, 0x7e, 0x71, 0x41, 0x9e, 0x7e, 0x85, 0x3e, 0x2c, 0x96, 0x4a, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x11, 0x64, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x47, 0x4c, 0x28, 0x1b,
-	0x02, 0x00, 0x00,
+	0x03, 0xd3, 0x07, 0x75, 0x16, 0x4e, 0x33, 0x85, 0xb2, 0xb9, 0xc4, 0x91, 0xe4, 0xfc, 0x0b, 0x52,
+	0x8b, 0xe0, 0x9e, 0x67, 0x05, 0x5b, 0xa7, 0x4d, 0x84, 0x75, 0x30, 0x6d, 0x50, 0xdb, 0x70, 0x99,
+	0xe8, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
+	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
+	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x08, 0xfb, 0xf4, 0x8b, 0x0b, 0xf2, 0xf4,
+	0x2b, 0xf4, 0x61, 0x49, 0xa2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x1a, 0x8c, 0x01,
+	0x03, 0x00, 0x41, 0x7c, 0xbd, 0x99, 0x88, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -141,6 +150,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CoordinatorOperatorList) > 0 {
+		for iNdEx := len(m.CoordinatorOperatorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoordinatorOperatorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.CoordinatorByAddressList) > 0 {
 		for iNdEx := len(m.CoordinatorByAddressList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -229,6 +252,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CoordinatorOperatorList) > 0 {
+		for _, e := range m.CoordinatorOperatorList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -388,6 +417,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorOperatorList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorOperatorList = append(m.CoordinatorOperatorList, CoordinatorOperator{})
+			if err := m.CoordinatorOperatorList[len(m.CoordinatorOperatorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -205,3 +205,74 @@ func TestGenesisStateValidateCoordinator(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisStateValidateCoordinatorOperator(t *testing.T) {
+	var (
+		addr1    = sample.Address()
+		addr2    = sample.Address()
+		operator = sample.Address()
+		coords   = []types.Coordinator{
+			{CoordinatorId: 0, Address: addr1},
+			{CoordinatorId: 1, Address: addr2},
+		}
+		permissions = []types.CoordinatorOperator_Permission{types.CoordinatorOperator_SETTLE_REQUESTS}
+	)
+	tests := []struct {
+		name     string
+		genState *types.GenesisState
+		err      error
+	}{
+		{
+			name: "valid operators",
+			genState: &types.GenesisState{
+				CoordinatorList: coords,
+				CoordinatorOperatorList: []types.CoordinatorOperator{
+					types.NewCoordinatorOperator(0, operator, permissions),
+					types.NewCoordinatorOperator(1, operator, permissions),
+				},
+			},
+		},
+		{
+			name: "duplicated operator",
+			genState: &types.GenesisState{
+				CoordinatorList: coords,
+				CoordinatorOperatorList: []types.CoordinatorOperator{
+					types.NewCoordinatorOperator(0, operator, permissions),
+					types.NewCoordinatorOperator(0, operator, permissions),
+				},
+			},
+			err: fmt.Errorf("duplicated index for coordinatorOperator: 0 %s", operator),
+		},
+		{
+			name: "operator of a non-existent coordinator",
+			genState: &types.GenesisState{
+				CoordinatorList: coords,
+				CoordinatorOperatorList: []types.CoordinatorOperator{
+					types.NewCoordinatorOperator(2, operator, permissions),
+				},
+			},
+			err: fmt.Errorf("coordinator 2 not found for operator %s", operator),
+		},
+		{
+			name: "operator without permission",
+			genState: &types.GenesisState{
+				CoordinatorList: coords,
+				CoordinatorOperatorList: []types.CoordinatorOperator{
+					types.NewCoordinatorOperator(0, operator, nil),
+				},
+			},
+			err: fmt.Errorf("invalid operator %s: no permission", operator),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.genState.ValidateCoordinatorOperators()
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.err.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProfileHooks defines the hooks other modules can register to react to profile events
+type ProfileHooks interface {
+	// BeforeCoordinatorDeleted is called before a coordinator is deleted,
+	// returning an error prevents the deletion
+	BeforeCoordinatorDeleted(ctx sdk.Context, coordinatorID uint64) error
+}
+
+var _ ProfileHooks = MultiProfileHooks{}
+
+// MultiProfileHooks combines multiple profile hooks, all hook functions are run in array sequence
+type MultiProfileHooks []ProfileHooks
+
+// NewMultiProfileHooks returns a new MultiProfileHooks
+func NewMultiProfileHooks(hooks ...ProfileHooks) MultiProfileHooks {
+	return hooks
+}
+
+// BeforeCoordinatorDeleted runs the hook of each registered module and stops at the first error
+func (h MultiProfileHooks) BeforeCoordinatorDeleted(ctx sdk.Context, coordinatorID uint64) error {
+	for i := range h {
+		if err := h[i].BeforeCoordinatorDeleted(ctx, coordinatorID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// CoordinatorOperatorKeyPrefix is the prefix to retrieve all CoordinatorOperator
+	CoordinatorOperatorKeyPrefix = "CoordinatorOperator/value/"
+)
+
+// CoordinatorOperatorKey returns the store key to retrieve a CoordinatorOperator from the index fields
+func CoordinatorOperatorKey(coordinatorID uint64, address string) []byte {
+	coordinatorIDBytes := append(sdk.Uint64ToBigEndian(coordinatorID), byte('/'))
+	addressBytes := append([]byte(address), byte('/'))
+	return append(coordinatorIDBytes, addressBytes...)
+}
+
+// CoordinatorOperatorAllKey returns the store key to retrieve all CoordinatorOperator of a coordinator
+func CoordinatorOperatorAllKey(coordinatorID uint64) []byte {
+	prefixBytes := []byte(CoordinatorOperatorKeyPrefix)
+	coordinatorIDBytes := append(sdk.Uint64ToBigEndian(coordinatorID), byte('/'))
+	return append(prefixBytes, coordinatorIDBytes...)
+}
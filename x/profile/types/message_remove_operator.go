@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveOperator = "remove_operator"
+
+var _ sdk.Msg = &MsgRemoveOperator{}
+
+func NewMsgRemoveOperator(address, operator string) *MsgRemoveOperator {
+	return &MsgRemoveOperator{
+		Address:  address,
+		Operator: operator,
+	}
+}
+
+func (msg *MsgRemoveOperator) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveOperator) Type() string {
+	return TypeMsgRemoveOperator
+}
+
+func (msg *MsgRemoveOperator) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveOperator) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return nil
+}
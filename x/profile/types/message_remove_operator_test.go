@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	profile "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgRemoveOperator_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  profile.MsgRemoveOperator
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: profile.MsgRemoveOperator{
+				Address:  sample.Address(),
+				Operator: sample.Address(),
+			},
+		},
+		{
+			name: "invalid address",
+			msg: profile.MsgRemoveOperator{
+				Address:  "invalid address",
+				Operator: sample.Address(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid operator address",
+			msg: profile.MsgRemoveOperator{
+				Address:  sample.Address(),
+				Operator: "invalid address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetOperator = "set_operator"
+
+var _ sdk.Msg = &MsgSetOperator{}
+
+func NewMsgSetOperator(
+	address,
+	operator string,
+	permissions []CoordinatorOperator_Permission,
+) *MsgSetOperator {
+	return &MsgSetOperator{
+		Address:     address,
+		Operator:    operator,
+		Permissions: permissions,
+	}
+}
+
+func (msg *MsgSetOperator) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetOperator) Type() string {
+	return TypeMsgSetOperator
+}
+
+func (msg *MsgSetOperator) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetOperator) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if msg.Address == msg.Operator {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress,
+			"the coordinator can't be its own operator (%s)", msg.Address)
+	}
+	if err := ValidatePermissions(msg.Permissions); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPermissions, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/spn/testutil/sample"
+	profile "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgSetOperator_ValidateBasic(t *testing.T) {
+	addr := sample.Address()
+	tests := []struct {
+		name string
+		msg  profile.MsgSetOperator
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: profile.MsgSetOperator{
+				Address:     sample.Address(),
+				Operator:    sample.Address(),
+				Permissions: sample.OperatorPermissions(),
+			},
+		},
+		{
+			name: "invalid address",
+			msg: profile.MsgSetOperator{
+				Address:     "invalid address",
+				Operator:    sample.Address(),
+				Permissions: sample.OperatorPermissions(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid operator address",
+			msg: profile.MsgSetOperator{
+				Address:     sample.Address(),
+				Operator:    "invalid address",
+				Permissions: sample.OperatorPermissions(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "coordinator as operator",
+			msg: profile.MsgSetOperator{
+				Address:     addr,
+				Operator:    addr,
+				Permissions: sample.OperatorPermissions(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no permission",
+			msg: profile.MsgSetOperator{
+				Address:  sample.Address(),
+				Operator: sample.Address(),
+			},
+			err: profile.ErrInvalidPermissions,
+		},
+		{
+			name: "unspecified permission",
+			msg: profile.MsgSetOperator{
+				Address:     sample.Address(),
+				Operator:    sample.Address(),
+				Permissions: []profile.CoordinatorOperator_Permission{profile.CoordinatorOperator_PERMISSION_UNSPECIFIED},
+			},
+			err: profile.ErrInvalidPermissions,
+		},
+		{
+			name: "duplicated permission",
+			msg: profile.MsgSetOperator{
+				Address:  sample.Address(),
+				Operator: sample.Address(),
+				Permissions: []profile.CoordinatorOperator_Permission{
+					profile.CoordinatorOperator_MANAGE_SHARES,
+					profile.CoordinatorOperator_MANAGE_SHARES,
+				},
+			},
+			err: profile.ErrInvalidPermissions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -486,6 +486,206 @@ func (m *QueryGetCoordinatorByAddressResponse) GetCoordinatorByAddress() Coordin
 	return CoordinatorByAddress{}
 }
 
+type QueryGetCoordinatorOperatorRequest struct {
+	CoordinatorId uint64 `protobuf:"varint,1,opt,name=coordinatorId,proto3" json:"coordinatorId,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetCoordinatorOperatorRequest) Reset()         { *m = QueryGetCoordinatorOperatorRequest{} }
+func (m *QueryGetCoordinatorOperatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCoordinatorOperatorRequest) ProtoMessage()    {}
+func (*QueryGetCoordinatorOperatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_882bdd73bbc62204, []int{10}
+}
+func (m *QueryGetCoordinatorOperatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCoordinatorOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCoordinatorOperatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCoordinatorOperatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCoordinatorOperatorRequest.Merge(m, src)
+}
+func (m *QueryGetCoordinatorOperatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCoordinatorOperatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCoordinatorOperatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCoordinatorOperatorRequest proto.InternalMessageInfo
+
+func (m *QueryGetCoordinatorOperatorRequest) GetCoordinatorId() uint64 {
+	if m != nil {
+		return m.CoordinatorId
+	}
+	return 0
+}
+
+func (m *QueryGetCoordinatorOperatorRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryGetCoordinatorOperatorResponse struct {
+	CoordinatorOperator CoordinatorOperator `protobuf:"bytes,1,opt,name=coordinatorOperator,proto3" json:"coordinatorOperator"`
+}
+
+func (m *QueryGetCoordinatorOperatorResponse) Reset()         { *m = QueryGetCoordinatorOperatorResponse{} }
+func (m *QueryGetCoordinatorOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCoordinatorOperatorResponse) ProtoMessage()    {}
+func (*QueryGetCoordinatorOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_882bdd73bbc62204, []int{11}
+}
+func (m *QueryGetCoordinatorOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCoordinatorOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCoordinatorOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCoordinatorOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCoordinatorOperatorResponse.Merge(m, src)
+}
+func (m *QueryGetCoordinatorOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCoordinatorOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCoordinatorOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCoordinatorOperatorResponse proto.InternalMessageInfo
+
+func (m *QueryGetCoordinatorOperatorResponse) GetCoordinatorOperator() CoordinatorOperator {
+	if m != nil {
+		return m.CoordinatorOperator
+	}
+	return CoordinatorOperator{}
+}
+
+type QueryAllCoordinatorOperatorRequest struct {
+	CoordinatorId uint64             `protobuf:"varint,1,opt,name=coordinatorId,proto3" json:"coordinatorId,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCoordinatorOperatorRequest) Reset()         { *m = QueryAllCoordinatorOperatorRequest{} }
+func (m *QueryAllCoordinatorOperatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCoordinatorOperatorRequest) ProtoMessage()    {}
+func (*QueryAllCoordinatorOperatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_882bdd73bbc62204, []int{12}
+}
+func (m *QueryAllCoordinatorOperatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCoordinatorOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCoordinatorOperatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllCoordinatorOperatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCoordinatorOperatorRequest.Merge(m, src)
+}
+func (m *QueryAllCoordinatorOperatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCoordinatorOperatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCoordinatorOperatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllCoordinatorOperatorRequest proto.InternalMessageInfo
+
+func (m *QueryAllCoordinatorOperatorRequest) GetCoordinatorId() uint64 {
+	if m != nil {
+		return m.CoordinatorId
+	}
+	return 0
+}
+
+func (m *QueryAllCoordinatorOperatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllCoordinatorOperatorResponse struct {
+	CoordinatorOperator []CoordinatorOperator `protobuf:"bytes,1,rep,name=coordinatorOperator,proto3" json:"coordinatorOperator"`
+	Pagination          *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCoordinatorOperatorResponse) Reset()         { *m = QueryAllCoordinatorOperatorResponse{} }
+func (m *QueryAllCoordinatorOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCoordinatorOperatorResponse) ProtoMessage()    {}
+func (*QueryAllCoordinatorOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_882bdd73bbc62204, []int{13}
+}
+func (m *QueryAllCoordinatorOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCoordinatorOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCoordinatorOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllCoordinatorOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCoordinatorOperatorResponse.Merge(m, src)
+}
+func (m *QueryAllCoordinatorOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCoordinatorOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCoordinatorOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllCoordinatorOperatorResponse proto.InternalMessageInfo
+
+func (m *QueryAllCoordinatorOperatorResponse) GetCoordinatorOperator() []CoordinatorOperator {
+	if m != nil {
+		return m.CoordinatorOperator
+	}
+	return nil
+}
+
+func (m *QueryAllCoordinatorOperatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGetValidatorRequest)(nil), "tendermint.spn.profile.QueryGetValidatorRequest")
 	proto.RegisterType((*QueryGetValidatorResponse)(nil), "tendermint.spn.profile.QueryGetValidatorResponse")
@@ -497,53 +697,66 @@ func init() {
 	proto.RegisterType((*QueryAllCoordinatorResponse)(nil), "tendermint.spn.profile.QueryAllCoordinatorResponse")
 	proto.RegisterType((*QueryGetCoordinatorByAddressRequest)(nil), "tendermint.spn.profile.QueryGetCoordinatorByAddressRequest")
 	proto.RegisterType((*QueryGetCoordinatorByAddressResponse)(nil), "tendermint.spn.profile.QueryGetCoordinatorByAddressResponse")
+	proto.RegisterType((*QueryGetCoordinatorOperatorRequest)(nil), "tendermint.spn.profile.QueryGetCoordinatorOperatorRequest")
+	proto.RegisterType((*QueryGetCoordinatorOperatorResponse)(nil), "tendermint.spn.profile.QueryGetCoordinatorOperatorResponse")
+	proto.RegisterType((*QueryAllCoordinatorOperatorRequest)(nil), "tendermint.spn.profile.QueryAllCoordinatorOperatorRequest")
+	proto.RegisterType((*QueryAllCoordinatorOperatorResponse)(nil), "tendermint.spn.profile.QueryAllCoordinatorOperatorResponse")
 }
 
 func init() { proto.RegisterFile("profile/query.proto", fileDescriptor_882bdd73bbc62204) }
 
 var fileDescriptor_882bdd73bbc62204 = []byte{
-	// 651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0x69, 0x7f, 0xfd, 0xa9, 0x57, 0xd4, 0xe1, 0xa8, 0x20, 0x35, 0xc8, 0x50, 0x87,
-	0x3f, 0xfd, 0x87, 0xaf, 0x49, 0x10, 0x42, 0x02, 0x81, 0x92, 0x0a, 0x3a, 0xb0, 0x40, 0x06, 0x06,
-	0x36, 0x3b, 0xbe, 0x1a, 0x23, 0xc7, 0xe7, 0xfa, 0x9c, 0x8a, 0xa8, 0xea, 0xc2, 0x0b, 0x40, 0x48,
-	0xec, 0x6c, 0x20, 0x24, 0x46, 0xde, 0x44, 0xc7, 0x22, 0x16, 0x26, 0x84, 0x12, 0x5e, 0x04, 0x23,
-	0xca, 0xf9, 0xec, 0x38, 0xc9, 0x05, 0x27, 0x51, 0xb7, 0x24, 0xf7, 0x7c, 0x9f, 0xfb, 0x7c, 0xbf,
-	0x77, 0xcf, 0x05, 0x9e, 0xf7, 0x03, 0xba, 0xef, 0xb8, 0x04, 0x1f, 0xb4, 0x48, 0xd0, 0xd6, 0xfd,
-	0x80, 0x86, 0x14, 0x5d, 0x08, 0x89, 0x67, 0x91, 0xa0, 0xe9, 0x78, 0xa1, 0xce, 0x7c, 0x4f, 0x17,
-	0x35, 0xca, 0x8a, 0x4d, 0x6d, 0xca, 0x4b, 0x70, 0xef, 0x53, 0x54, 0xad, 0x5c, 0xb6, 0x29, 0xb5,
-	0x5d, 0x82, 0x0d, 0xdf, 0xc1, 0x86, 0xe7, 0xd1, 0xd0, 0x08, 0x1d, 0xea, 0x31, 0xb1, 0xba, 0xd9,
-	0xa0, 0xac, 0x49, 0x19, 0x36, 0x0d, 0x26, 0x36, 0xc1, 0x87, 0x25, 0x93, 0x84, 0x46, 0x09, 0xfb,
-	0x86, 0xed, 0x78, 0xbc, 0x58, 0xd4, 0x5e, 0x8c, 0x61, 0x0e, 0x0d, 0xd7, 0xb1, 0x8c, 0x90, 0x06,
-	0x62, 0x61, 0x35, 0x5e, 0x68, 0x50, 0x1a, 0x58, 0x3d, 0x51, 0xbc, 0xa4, 0xdd, 0x86, 0x85, 0x67,
-	0xbd, 0xae, 0x7b, 0x24, 0x7c, 0x1e, 0xab, 0xea, 0xe4, 0xa0, 0x45, 0x58, 0x88, 0x0a, 0xf0, 0x7f,
-	0xc3, 0xb2, 0x02, 0xc2, 0x58, 0x01, 0x5c, 0x05, 0xeb, 0x8b, 0xf5, 0xf8, 0xab, 0x66, 0xc2, 0x55,
-	0x89, 0x8a, 0xf9, 0xd4, 0x63, 0x04, 0x3d, 0x82, 0x8b, 0x09, 0x00, 0x17, 0x2e, 0x95, 0xd7, 0x74,
-	0x79, 0x24, 0x7a, 0xa2, 0xae, 0xcd, 0x9f, 0xfc, 0xbc, 0x92, 0xab, 0xf7, 0x95, 0x9a, 0x29, 0xc8,
-	0xaa, 0xae, 0x3b, 0x42, 0xf6, 0x18, 0xc2, 0xbe, 0x7b, 0xb1, 0xc7, 0x0d, 0x3d, 0x8a, 0x4a, 0xef,
-	0x45, 0xa5, 0x47, 0xe7, 0x21, 0xa2, 0xd2, 0x9f, 0x1a, 0x36, 0x11, 0xda, 0x7a, 0x4a, 0xa9, 0x7d,
-	0x01, 0xc2, 0xc8, 0xe0, 0x26, 0x72, 0x23, 0x73, 0xb3, 0x19, 0x41, 0x7b, 0x03, 0xb0, 0x79, 0x0e,
-	0x7b, 0x33, 0x13, 0x36, 0x62, 0x18, 0xa0, 0xdd, 0x86, 0x4a, 0x9c, 0xfa, 0x6e, 0xff, 0x20, 0xe3,
-	0x4c, 0x96, 0x61, 0xde, 0xb1, 0x78, 0x16, 0xf3, 0xf5, 0xbc, 0x63, 0x69, 0xaf, 0xe0, 0x25, 0x69,
-	0xb5, 0x30, 0xf7, 0x04, 0x2e, 0xa5, 0x7e, 0x16, 0x19, 0x16, 0xc7, 0xd9, 0x4b, 0x95, 0x0a, 0x83,
-	0x69, 0xb5, 0x66, 0x09, 0xb2, 0xaa, 0xeb, 0x4a, 0xc8, 0xce, 0xea, 0xb4, 0xbe, 0x02, 0x61, 0x69,
-	0x78, 0x9b, 0x71, 0x96, 0xe6, 0x66, 0xb7, 0x74, 0x76, 0xa7, 0xf6, 0x10, 0x16, 0x25, 0xe7, 0x50,
-	0x6b, 0x57, 0xa3, 0x59, 0xca, 0x1e, 0xb6, 0xb7, 0x00, 0x5e, 0xfb, 0x77, 0x07, 0xe1, 0x7f, 0x1f,
-	0xae, 0x34, 0x24, 0xeb, 0x22, 0xf1, 0xed, 0x49, 0x82, 0x88, 0x35, 0x22, 0x11, 0x69, 0xbf, 0xf2,
-	0x9f, 0x05, 0xf8, 0x1f, 0x07, 0x42, 0x1f, 0x01, 0x5c, 0x4c, 0x6e, 0x3e, 0xda, 0x19, 0xb7, 0xc3,
-	0xb8, 0x17, 0x46, 0x29, 0x4d, 0xa1, 0x88, 0x4c, 0x6a, 0x95, 0x37, 0xdf, 0x7f, 0xbf, 0xcf, 0xdf,
-	0x42, 0x5b, 0xb8, 0x2f, 0xc5, 0xcc, 0xf7, 0xf0, 0xc8, 0xe3, 0x87, 0x8f, 0x44, 0x82, 0xc7, 0xe8,
-	0x03, 0x80, 0xe7, 0x92, 0x56, 0x55, 0xd7, 0xcd, 0x40, 0x95, 0x3c, 0x39, 0x19, 0xa8, 0xb2, 0xf7,
-	0x43, 0xdb, 0xe0, 0xa8, 0x45, 0xb4, 0x96, 0x89, 0x8a, 0x3e, 0x83, 0x81, 0xbb, 0x8b, 0xca, 0x59,
-	0xc1, 0x8c, 0x8e, 0x99, 0x52, 0x99, 0x4a, 0x23, 0x18, 0x77, 0x38, 0xe3, 0x26, 0x5a, 0x1f, 0xc7,
-	0x98, 0xba, 0x01, 0xf8, 0xc8, 0xb1, 0x8e, 0xd1, 0x27, 0x00, 0x97, 0x53, 0x9d, 0x7a, 0x69, 0x96,
-	0xb3, 0xb2, 0x99, 0x9a, 0x56, 0x3e, 0xe1, 0xda, 0x16, 0xa7, 0xbd, 0x8e, 0x8a, 0x13, 0xd0, 0xa2,
-	0x6f, 0x00, 0xae, 0xc8, 0xee, 0x36, 0xba, 0x37, 0x45, 0x50, 0xc3, 0x73, 0xaa, 0xdc, 0x9f, 0x4d,
-	0x2c, 0x0c, 0x3c, 0xe0, 0x06, 0xee, 0xa2, 0x3b, 0x13, 0x18, 0x48, 0xd4, 0xfd, 0x8b, 0x5c, 0xdb,
-	0x3d, 0xe9, 0xa8, 0xe0, 0xb4, 0xa3, 0x82, 0x5f, 0x1d, 0x15, 0xbc, 0xeb, 0xaa, 0xb9, 0xd3, 0xae,
-	0x9a, 0xfb, 0xd1, 0x55, 0x73, 0x2f, 0x36, 0x6c, 0x27, 0x7c, 0xd9, 0x32, 0xf5, 0x06, 0x6d, 0x0e,
-	0xf7, 0x7e, 0x9d, 0x74, 0x0f, 0xdb, 0x3e, 0x61, 0xe6, 0x02, 0xff, 0xeb, 0xaf, 0xfc, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x48, 0x08, 0x99, 0x84, 0xbd, 0x08, 0x00, 0x00,
+	// 791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x4f, 0x13, 0x4f,
+	0x18, 0xc6, 0x3b, 0x85, 0xef, 0xd7, 0xf0, 0xa2, 0x1c, 0x06, 0x82, 0xb0, 0x98, 0x2a, 0x5b, 0x54,
+	0x7e, 0xb9, 0x43, 0x8b, 0x31, 0x06, 0xfc, 0x91, 0x42, 0x80, 0x18, 0x63, 0xd4, 0x1e, 0x3c, 0x78,
+	0xdb, 0x76, 0x87, 0x75, 0xcd, 0xb2, 0xb3, 0xec, 0x2e, 0x44, 0x42, 0xb8, 0x78, 0xf4, 0x60, 0x8c,
+	0xde, 0xbd, 0x69, 0x4c, 0x3c, 0x19, 0xff, 0x09, 0x8e, 0x18, 0x12, 0xe3, 0x45, 0x63, 0xc0, 0x3f,
+	0xc4, 0x74, 0x3a, 0xdb, 0x6e, 0xe9, 0x94, 0xed, 0xd6, 0xde, 0x60, 0x67, 0x9e, 0x79, 0x9f, 0xcf,
+	0x33, 0xef, 0xcc, 0xa4, 0x30, 0xe8, 0x7a, 0x6c, 0xdd, 0xb2, 0x29, 0xd9, 0xdc, 0xa2, 0xde, 0x8e,
+	0xe6, 0x7a, 0x2c, 0x60, 0x78, 0x38, 0xa0, 0x8e, 0x41, 0xbd, 0x0d, 0xcb, 0x09, 0x34, 0xdf, 0x75,
+	0x34, 0x31, 0x47, 0x19, 0x32, 0x99, 0xc9, 0xf8, 0x14, 0x52, 0xf9, 0xab, 0x3a, 0x5b, 0xb9, 0x60,
+	0x32, 0x66, 0xda, 0x94, 0xe8, 0xae, 0x45, 0x74, 0xc7, 0x61, 0x81, 0x1e, 0x58, 0xcc, 0xf1, 0xc5,
+	0xe8, 0x74, 0x99, 0xf9, 0x1b, 0xcc, 0x27, 0x25, 0xdd, 0x17, 0x45, 0xc8, 0x76, 0xae, 0x44, 0x03,
+	0x3d, 0x47, 0x5c, 0xdd, 0xb4, 0x1c, 0x3e, 0x59, 0xcc, 0x3d, 0x1f, 0x9a, 0xd9, 0xd6, 0x6d, 0xcb,
+	0xd0, 0x03, 0xe6, 0x89, 0x81, 0xd1, 0x70, 0xa0, 0xcc, 0x98, 0x67, 0x58, 0x4e, 0x7d, 0x48, 0xbd,
+	0x0e, 0x23, 0x8f, 0x2b, 0xab, 0xae, 0xd1, 0xe0, 0x49, 0xa8, 0x2a, 0xd2, 0xcd, 0x2d, 0xea, 0x07,
+	0x78, 0x04, 0xce, 0xe8, 0x86, 0xe1, 0x51, 0xdf, 0x1f, 0x41, 0x97, 0xd0, 0x64, 0x5f, 0x31, 0xfc,
+	0x57, 0x2d, 0xc1, 0xa8, 0x44, 0xe5, 0xbb, 0xcc, 0xf1, 0x29, 0x5e, 0x81, 0xbe, 0x9a, 0x01, 0x2e,
+	0xec, 0xcf, 0x8f, 0x6b, 0xf2, 0x48, 0xb4, 0x9a, 0x7a, 0xa9, 0x77, 0xff, 0xd7, 0xc5, 0x54, 0xb1,
+	0xae, 0x54, 0x4b, 0xc2, 0x59, 0xc1, 0xb6, 0x9b, 0x9c, 0xad, 0x02, 0xd4, 0xe9, 0x45, 0x8d, 0x2b,
+	0x5a, 0x35, 0x2a, 0xad, 0x12, 0x95, 0x56, 0xdd, 0x0f, 0x11, 0x95, 0xf6, 0x48, 0x37, 0xa9, 0xd0,
+	0x16, 0x23, 0x4a, 0xf5, 0x33, 0x82, 0x51, 0x49, 0x11, 0x39, 0x48, 0x4f, 0x67, 0x20, 0x78, 0xad,
+	0xc1, 0x6c, 0x9a, 0x9b, 0xbd, 0x1a, 0x6b, 0xb6, 0xea, 0xa1, 0xc1, 0xed, 0x2c, 0x28, 0x61, 0xea,
+	0xcb, 0xf5, 0x8d, 0x0c, 0x33, 0x19, 0x80, 0xb4, 0x65, 0xf0, 0x2c, 0x7a, 0x8b, 0x69, 0xcb, 0x50,
+	0x9f, 0xc3, 0x98, 0x74, 0xb6, 0x80, 0xbb, 0x0f, 0xfd, 0x91, 0xcf, 0x22, 0xc3, 0x6c, 0x2b, 0xbc,
+	0xc8, 0x54, 0x01, 0x18, 0x55, 0xab, 0x86, 0x70, 0x56, 0xb0, 0x6d, 0x89, 0xb3, 0x6e, 0xed, 0xd6,
+	0x57, 0x04, 0x63, 0xd2, 0x32, 0xad, 0x90, 0x7a, 0x3a, 0x47, 0xea, 0xde, 0xae, 0xdd, 0x85, 0xac,
+	0x64, 0x1f, 0x96, 0x76, 0x0a, 0xd5, 0xb3, 0x14, 0x7f, 0xd8, 0x5e, 0x23, 0x98, 0x38, 0x7d, 0x05,
+	0xc1, 0xbf, 0x0e, 0x43, 0x65, 0xc9, 0xb8, 0x48, 0x7c, 0xb6, 0x9d, 0x20, 0x42, 0x8d, 0x48, 0x44,
+	0xba, 0x9e, 0x6a, 0x80, 0x2a, 0xf1, 0xf3, 0xd0, 0xa5, 0x5e, 0x74, 0xd7, 0x27, 0xe0, 0x5c, 0x44,
+	0x7d, 0x2f, 0x6c, 0xcd, 0xc6, 0x8f, 0x51, 0xec, 0x74, 0x23, 0xf6, 0x2b, 0x04, 0xd9, 0x53, 0xcb,
+	0x08, 0xea, 0x32, 0x0c, 0x96, 0x9b, 0x87, 0x05, 0xf4, 0x4c, 0x1b, 0xd0, 0xa1, 0x44, 0x30, 0xcb,
+	0x56, 0x53, 0xdf, 0x22, 0x50, 0x25, 0xad, 0xd7, 0x19, 0xf3, 0xaa, 0xa4, 0xb5, 0x3a, 0x39, 0x0f,
+	0x87, 0x61, 0x42, 0xad, 0x4c, 0xc5, 0x25, 0xd4, 0xd3, 0xbd, 0x84, 0xba, 0x76, 0x5e, 0xf2, 0x5f,
+	0x00, 0xfe, 0xe3, 0x54, 0xf8, 0x03, 0x82, 0xbe, 0xda, 0xbd, 0x8a, 0xe7, 0x5a, 0x19, 0x6d, 0xf5,
+	0x7e, 0x29, 0xb9, 0x04, 0x8a, 0xaa, 0x11, 0x75, 0xfe, 0xe5, 0xe1, 0x9f, 0x77, 0xe9, 0x6b, 0x78,
+	0x86, 0xd4, 0xa5, 0xc4, 0x77, 0x1d, 0xd2, 0xf4, 0xb4, 0x92, 0x5d, 0xd1, 0xa8, 0x7b, 0xf8, 0x3d,
+	0x82, 0xb3, 0xb5, 0xa5, 0x0a, 0xb6, 0x1d, 0x63, 0x55, 0xf2, 0xa0, 0x29, 0xb9, 0x04, 0x0a, 0x61,
+	0x75, 0x8a, 0x5b, 0xcd, 0xe2, 0xf1, 0x58, 0xab, 0xf8, 0x13, 0x6a, 0xb8, 0x19, 0x71, 0x3e, 0x2e,
+	0x98, 0xe6, 0x4b, 0x5c, 0x99, 0x4f, 0xa4, 0x11, 0x1e, 0xe7, 0xb8, 0xc7, 0x69, 0x3c, 0xd9, 0xca,
+	0x63, 0xa4, 0x93, 0xc8, 0xae, 0x65, 0xec, 0xe1, 0x8f, 0x08, 0x06, 0x22, 0x2b, 0x55, 0xd2, 0xcc,
+	0xc7, 0x65, 0x93, 0xd8, 0xad, 0xfc, 0xfd, 0x50, 0x67, 0xb8, 0xdb, 0xcb, 0x38, 0xdb, 0x86, 0x5b,
+	0xfc, 0x0d, 0xc1, 0x90, 0xec, 0xe6, 0xc4, 0x8b, 0x09, 0x82, 0x3a, 0xf9, 0x0a, 0x28, 0xb7, 0x3a,
+	0x13, 0x0b, 0x80, 0x3b, 0x1c, 0xe0, 0x26, 0xbe, 0xd1, 0x06, 0x40, 0x4d, 0x1d, 0x69, 0xe4, 0x9f,
+	0x08, 0x06, 0x25, 0xc7, 0x1e, 0x2f, 0x24, 0x70, 0x75, 0xe2, 0x4a, 0x54, 0x16, 0x3b, 0xd2, 0x0a,
+	0xa0, 0x07, 0x1c, 0x68, 0x0d, 0xaf, 0xb4, 0x01, 0x14, 0x8a, 0xc9, 0x6e, 0xc3, 0x6d, 0xbb, 0x17,
+	0xe1, 0xfb, 0x8e, 0x60, 0x58, 0x52, 0xae, 0xd2, 0x64, 0x0b, 0x09, 0x1a, 0x26, 0x19, 0xe2, 0xe9,
+	0x97, 0xb3, 0xba, 0xcc, 0x11, 0x6f, 0xe3, 0xc5, 0x7f, 0x40, 0x5c, 0x5a, 0xde, 0x3f, 0xca, 0xa0,
+	0x83, 0xa3, 0x0c, 0xfa, 0x7d, 0x94, 0x41, 0x6f, 0x8e, 0x33, 0xa9, 0x83, 0xe3, 0x4c, 0xea, 0xc7,
+	0x71, 0x26, 0xf5, 0x74, 0xca, 0xb4, 0x82, 0x67, 0x5b, 0x25, 0xad, 0xcc, 0x36, 0x4e, 0x16, 0x78,
+	0x51, 0x2b, 0x11, 0xec, 0xb8, 0xd4, 0x2f, 0xfd, 0xcf, 0x7f, 0x11, 0xcc, 0xff, 0x1d, 0x00, 0x5c,
+	0x23, 0x03, 0x92, 0xd4, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -569,6 +782,10 @@ type QueryClient interface {
 	CoordinatorAll(ctx context.Context, in *QueryAllCoordinatorRequest, opts ...grpc.CallOption) (*QueryAllCoordinatorResponse, error)
 	// Queries a coordinatorByAddress by index.
 	CoordinatorByAddress(ctx context.Context, in *QueryGetCoordinatorByAddressRequest, opts ...grpc.CallOption) (*QueryGetCoordinatorByAddressResponse, error)
+	// Queries an operator of a coordinator.
+	CoordinatorOperator(ctx context.Context, in *QueryGetCoordinatorOperatorRequest, opts ...grpc.CallOption) (*QueryGetCoordinatorOperatorResponse, error)
+	// Queries a list of the operators of a coordinator.
+	CoordinatorOperatorAll(ctx context.Context, in *QueryAllCoordinatorOperatorRequest, opts ...grpc.CallOption) (*QueryAllCoordinatorOperatorResponse, error)
 }
 
 type queryClient struct {
@@ -624,6 +841,24 @@ func (c *queryClient) CoordinatorByAddress(ctx context.Context, in *QueryGetCoor
 	return out, nil
 }
 
+func (c *queryClient) CoordinatorOperator(ctx context.Context, in *QueryGetCoordinatorOperatorRequest, opts ...grpc.CallOption) (*QueryGetCoordinatorOperatorResponse, error) {
+	out := new(QueryGetCoordinatorOperatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.profile.Query/CoordinatorOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CoordinatorOperatorAll(ctx context.Context, in *QueryAllCoordinatorOperatorRequest, opts ...grpc.CallOption) (*QueryAllCoordinatorOperatorResponse, error) {
+	out := new(QueryAllCoordinatorOperatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.profile.Query/CoordinatorOperatorAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// this line is used by starport scaffolding # 2
@@ -637,6 +872,10 @@ type QueryServer interface {
 	CoordinatorAll(context.Context, *QueryAllCoordinatorRequest) (*QueryAllCoordinatorResponse, error)
 	// Queries a coordinatorByAddress by index.
 	CoordinatorByAddress(context.Context, *QueryGetCoordinatorByAddressRequest) (*QueryGetCoordinatorByAddressResponse, error)
+	// Queries an operator of a coordinator.
+	CoordinatorOperator(context.Context, *QueryGetCoordinatorOperatorRequest) (*QueryGetCoordinatorOperatorResponse, error)
+	// Queries a list of the operators of a coordinator.
+	CoordinatorOperatorAll(context.Context, *QueryAllCoordinatorOperatorRequest) (*QueryAllCoordinatorOperatorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -658,6 +897,12 @@ func (*UnimplementedQueryServer) CoordinatorAll(ctx context.Context, req *QueryA
 func (*UnimplementedQueryServer) CoordinatorByAddress(ctx context.Context, req *QueryGetCoordinatorByAddressRequest) (*QueryGetCoordinatorByAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CoordinatorByAddress not implemented")
 }
+func (*UnimplementedQueryServer) CoordinatorOperator(ctx context.Context, req *QueryGetCoordinatorOperatorRequest) (*QueryGetCoordinatorOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoordinatorOperator not implemented")
+}
+func (*UnimplementedQueryServer) CoordinatorOperatorAll(ctx context.Context, req *QueryAllCoordinatorOperatorRequest) (*QueryAllCoordinatorOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoordinatorOperatorAll not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -753,6 +998,42 @@ func _Query_CoordinatorByAddress_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CoordinatorOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCoordinatorOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CoordinatorOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.profile.Query/CoordinatorOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CoordinatorOperator(ctx, req.(*QueryGetCoordinatorOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CoordinatorOperatorAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCoordinatorOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CoordinatorOperatorAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.profile.Query/CoordinatorOperatorAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CoordinatorOperatorAll(ctx, req.(*QueryAllCoordinatorOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tendermint.spn.profile.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -777,6 +1058,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CoordinatorByAddress",
 			Handler:    _Query_CoordinatorByAddress_Handler,
 		},
+		{
+			MethodName: "CoordinatorOperator",
+			Handler:    _Query_CoordinatorOperator_Handler,
+		},
+		{
+			MethodName: "CoordinatorOperatorAll",
+			Handler:    _Query_CoordinatorOperatorAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "profile/query.proto",
@@ -1137,74 +1426,231 @@ func (m *QueryGetCoordinatorByAddressResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetCoordinatorOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryGetCoordinatorOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCoordinatorOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.CoordinatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CoordinatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCoordinatorOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Validator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetCoordinatorOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCoordinatorOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Validator) > 0 {
-		for _, e := range m.Validator {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.CoordinatorOperator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCoordinatorRequest) Size() (n int) {
+func (m *QueryAllCoordinatorOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCoordinatorOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCoordinatorOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CoordinatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CoordinatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCoordinatorOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCoordinatorOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCoordinatorOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CoordinatorOperator) > 0 {
+		for iNdEx := len(m.CoordinatorOperator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoordinatorOperator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		for _, e := range m.Validator {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCoordinatorRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1283,13 +1729,446 @@ func (m *QueryGetCoordinatorByAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetCoordinatorOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoordinatorId != 0 {
+		n += 1 + sovQuery(uint64(m.CoordinatorId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCoordinatorOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoordinatorOperator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllCoordinatorOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoordinatorId != 0 {
+		n += 1 + sovQuery(uint64(m.CoordinatorId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCoordinatorOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CoordinatorOperator) > 0 {
+		for _, e := range m.CoordinatorOperator {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryGetValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator, Validator{})
+			if err := m.Validator[len(m.Validator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCoordinatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1312,17 +2191,17 @@ func (m *QueryGetValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCoordinatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCoordinatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1332,24 +2211,11 @@ func (m *QueryGetValidatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1371,7 +2237,7 @@ func (m *QueryGetValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCoordinatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1394,15 +2260,15 @@ func (m *QueryGetValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCoordinatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1429,7 +2295,7 @@ func (m *QueryGetValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coordinator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1454,7 +2320,7 @@ func (m *QueryGetValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCoordinatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1477,10 +2343,10 @@ func (m *QueryAllValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCoordinatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCoordinatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1540,7 +2406,7 @@ func (m *QueryAllValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCoordinatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1563,15 +2429,15 @@ func (m *QueryAllValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCoordinatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1598,8 +2464,8 @@ func (m *QueryAllValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = append(m.Validator, Validator{})
-			if err := m.Validator[len(m.Validator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coordinator = append(m.Coordinator, Coordinator{})
+			if err := m.Coordinator[len(m.Coordinator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1660,7 +2526,7 @@ func (m *QueryAllValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoordinatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCoordinatorByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1683,17 +2549,17 @@ func (m *QueryGetCoordinatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoordinatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCoordinatorByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoordinatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCoordinatorByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1703,11 +2569,24 @@ func (m *QueryGetCoordinatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1729,7 +2608,7 @@ func (m *QueryGetCoordinatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoordinatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCoordinatorByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1752,15 +2631,15 @@ func (m *QueryGetCoordinatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoordinatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCoordinatorByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCoordinatorByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorByAddress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1787,7 +2666,7 @@ func (m *QueryGetCoordinatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coordinator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoordinatorByAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1812,7 +2691,7 @@ func (m *QueryGetCoordinatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCoordinatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCoordinatorOperatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1835,17 +2714,36 @@ func (m *QueryAllCoordinatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCoordinatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCoordinatorOperatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCoordinatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCoordinatorOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorId", wireType)
+			}
+			m.CoordinatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1855,27 +2753,23 @@ func (m *QueryAllCoordinatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1898,7 +2792,7 @@ func (m *QueryAllCoordinatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCoordinatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCoordinatorOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1921,49 +2815,15 @@ func (m *QueryAllCoordinatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCoordinatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCoordinatorOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCoordinatorOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coordinator = append(m.Coordinator, Coordinator{})
-			if err := m.Coordinator[len(m.Coordinator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorOperator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1990,10 +2850,7 @@ func (m *QueryAllCoordinatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoordinatorOperator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2018,7 +2875,7 @@ func (m *QueryAllCoordinatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoordinatorByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCoordinatorOperatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2041,17 +2898,36 @@ func (m *QueryGetCoordinatorByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoordinatorByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCoordinatorOperatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoordinatorByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCoordinatorOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorId", wireType)
+			}
+			m.CoordinatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2061,23 +2937,27 @@ func (m *QueryGetCoordinatorByAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2100,7 +2980,7 @@ func (m *QueryGetCoordinatorByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoordinatorByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCoordinatorOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2123,15 +3003,15 @@ func (m *QueryGetCoordinatorByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoordinatorByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCoordinatorOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoordinatorByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCoordinatorOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorByAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorOperator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2158,7 +3038,44 @@ func (m *QueryGetCoordinatorByAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoordinatorByAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CoordinatorOperator = append(m.CoordinatorOperator, CoordinatorOperator{})
+			if err := m.CoordinatorOperator[len(m.CoordinatorOperator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
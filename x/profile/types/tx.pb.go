@@ -558,6 +558,190 @@ func (m *MsgDeleteCoordinatorResponse) GetCoordinatorId() uint64 {
 	return 0
 }
 
+type MsgSetOperator struct {
+	Address     string                           `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Operator    string                           `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Permissions []CoordinatorOperator_Permission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=tendermint.spn.profile.CoordinatorOperator_Permission" json:"permissions,omitempty"`
+}
+
+func (m *MsgSetOperator) Reset()         { *m = MsgSetOperator{} }
+func (m *MsgSetOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperator) ProtoMessage()    {}
+func (*MsgSetOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{12}
+}
+func (m *MsgSetOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperator.Merge(m, src)
+}
+func (m *MsgSetOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOperator proto.InternalMessageInfo
+
+func (m *MsgSetOperator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSetOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgSetOperator) GetPermissions() []CoordinatorOperator_Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type MsgSetOperatorResponse struct {
+}
+
+func (m *MsgSetOperatorResponse) Reset()         { *m = MsgSetOperatorResponse{} }
+func (m *MsgSetOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperatorResponse) ProtoMessage()    {}
+func (*MsgSetOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{13}
+}
+func (m *MsgSetOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperatorResponse.Merge(m, src)
+}
+func (m *MsgSetOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOperatorResponse proto.InternalMessageInfo
+
+type MsgRemoveOperator struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgRemoveOperator) Reset()         { *m = MsgRemoveOperator{} }
+func (m *MsgRemoveOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOperator) ProtoMessage()    {}
+func (*MsgRemoveOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{14}
+}
+func (m *MsgRemoveOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOperator.Merge(m, src)
+}
+func (m *MsgRemoveOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOperator proto.InternalMessageInfo
+
+func (m *MsgRemoveOperator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRemoveOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+type MsgRemoveOperatorResponse struct {
+}
+
+func (m *MsgRemoveOperatorResponse) Reset()         { *m = MsgRemoveOperatorResponse{} }
+func (m *MsgRemoveOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOperatorResponse) ProtoMessage()    {}
+func (*MsgRemoveOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{15}
+}
+func (m *MsgRemoveOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOperatorResponse.Merge(m, src)
+}
+func (m *MsgRemoveOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOperatorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateValidatorDescription)(nil), "tendermint.spn.profile.MsgUpdateValidatorDescription")
 	proto.RegisterType((*MsgUpdateValidatorDescriptionResponse)(nil), "tendermint.spn.profile.MsgUpdateValidatorDescriptionResponse")
@@ -571,45 +755,55 @@ func init() {
 	proto.RegisterType((*MsgUpdateCoordinatorAddressResponse)(nil), "tendermint.spn.profile.MsgUpdateCoordinatorAddressResponse")
 	proto.RegisterType((*MsgDeleteCoordinator)(nil), "tendermint.spn.profile.MsgDeleteCoordinator")
 	proto.RegisterType((*MsgDeleteCoordinatorResponse)(nil), "tendermint.spn.profile.MsgDeleteCoordinatorResponse")
+	proto.RegisterType((*MsgSetOperator)(nil), "tendermint.spn.profile.MsgSetOperator")
+	proto.RegisterType((*MsgSetOperatorResponse)(nil), "tendermint.spn.profile.MsgSetOperatorResponse")
+	proto.RegisterType((*MsgRemoveOperator)(nil), "tendermint.spn.profile.MsgRemoveOperator")
+	proto.RegisterType((*MsgRemoveOperatorResponse)(nil), "tendermint.spn.profile.MsgRemoveOperatorResponse")
 }
 
 func init() { proto.RegisterFile("profile/tx.proto", fileDescriptor_a471fea62152592e) }
 
 var fileDescriptor_a471fea62152592e = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x61, 0x1a, 0xda, 0x9b, 0x10, 0x10, 0x4d, 0x50, 0x4c, 0xc9, 0xa6, 0xc0, 0xb4, 0x0d,
-	0x55, 0x09, 0xea, 0x40, 0x1c, 0x10, 0x42, 0xac, 0xbd, 0x70, 0xe8, 0x25, 0x82, 0x21, 0x71, 0xcb,
-	0x1a, 0x13, 0x22, 0x75, 0xb6, 0xb1, 0x0d, 0x1b, 0xff, 0x80, 0x03, 0x12, 0x08, 0x2e, 0xfc, 0x05,
-	0xfe, 0xc9, 0x8e, 0x3b, 0x72, 0x42, 0xa8, 0xfd, 0x23, 0x88, 0x2c, 0x71, 0x43, 0x93, 0x38, 0x6d,
-	0x2f, 0xbb, 0xb5, 0xf1, 0xf7, 0xbe, 0xf7, 0xbd, 0xf7, 0xf9, 0x93, 0xe1, 0x2a, 0x17, 0xec, 0x4d,
-	0x3c, 0x24, 0x9e, 0x3a, 0x76, 0xb9, 0x60, 0x8a, 0x59, 0xd7, 0x15, 0xa1, 0x21, 0x11, 0x87, 0x31,
-	0x55, 0xae, 0xe4, 0xd4, 0x4d, 0x01, 0x78, 0x2d, 0x62, 0x11, 0x4b, 0x20, 0xde, 0xbf, 0x5f, 0x67,
-	0x68, 0x7c, 0x33, 0xab, 0x1f, 0x30, 0x26, 0xc2, 0x98, 0x06, 0x8a, 0x89, 0xf4, 0xe8, 0x46, 0x76,
-	0xf4, 0x21, 0x18, 0xc6, 0xe1, 0xe4, 0xc0, 0xf9, 0x82, 0xe0, 0x76, 0x5f, 0x46, 0x2f, 0x79, 0x18,
-	0x28, 0xb2, 0x9f, 0x1d, 0xf6, 0x88, 0x1c, 0x88, 0x98, 0xab, 0x98, 0x51, 0xab, 0x09, 0x97, 0x82,
-	0x30, 0x14, 0x44, 0xca, 0x26, 0xda, 0x40, 0xdb, 0x2b, 0x7e, 0xf6, 0xd7, 0x7a, 0x01, 0xab, 0xe1,
-	0x04, 0xd8, 0xbc, 0xb0, 0x81, 0xb6, 0x57, 0x3b, 0x6d, 0xb7, 0x5c, 0xb3, 0x5b, 0x46, 0xbe, 0xb7,
-	0x74, 0xf2, 0x7b, 0xbd, 0xe1, 0xe7, 0x69, 0x9c, 0x2d, 0xd8, 0x34, 0x0a, 0xf2, 0x89, 0xe4, 0x8c,
-	0x4a, 0xe2, 0xb8, 0x60, 0xf5, 0x65, 0xd4, 0x23, 0x43, 0x92, 0x03, 0x56, 0xcb, 0x75, 0x5a, 0x80,
-	0x8b, 0x78, 0xcd, 0xf6, 0x09, 0xc1, 0x5a, 0x5f, 0x46, 0x5d, 0x41, 0x02, 0x45, 0xba, 0x93, 0x05,
-	0x1a, 0xe6, 0xdf, 0x2f, 0x9b, 0xdf, 0xad, 0x9a, 0x3f, 0xc7, 0x59, 0xb3, 0x81, 0x1e, 0xb4, 0xca,
-	0x94, 0x64, 0x52, 0xad, 0xbb, 0x70, 0x39, 0xe7, 0xf0, 0xf3, 0x30, 0xd1, 0xb5, 0xe4, 0xff, 0xff,
-	0xd1, 0xf9, 0x8e, 0x60, 0x5d, 0x2f, 0xb2, 0xbc, 0xf9, 0x39, 0xcc, 0xb6, 0x03, 0x5b, 0x35, 0xa2,
-	0xb4, 0x23, 0xaf, 0xe0, 0x56, 0x19, 0xf4, 0x59, 0xaa, 0xb0, 0x5a, 0xbb, 0x0d, 0x40, 0xc9, 0x51,
-	0x8a, 0x4b, 0xa4, 0xaf, 0xf8, 0xb9, 0x2f, 0xce, 0x26, 0xdc, 0x31, 0x10, 0xeb, 0xfe, 0xf7, 0x93,
-	0x0b, 0x71, 0x76, 0x5f, 0x66, 0xba, 0x10, 0xa9, 0x71, 0x85, 0x8a, 0xf9, 0x8c, 0xeb, 0xfc, 0x5c,
-	0x86, 0x8b, 0x7d, 0x19, 0x59, 0xdf, 0x10, 0x60, 0x43, 0x2e, 0x1f, 0x56, 0x99, 0x61, 0x4c, 0x0f,
-	0x7e, 0xb2, 0x50, 0x99, 0x1e, 0xe1, 0x1d, 0x5c, 0x99, 0x4e, 0xdc, 0x3d, 0x03, 0xe3, 0x14, 0x16,
-	0x77, 0x66, 0xc7, 0xea, 0x96, 0x47, 0x70, 0xad, 0x98, 0xca, 0xb6, 0x81, 0xa8, 0x80, 0xc6, 0x0f,
-	0xe6, 0x41, 0xeb, 0xc6, 0x3f, 0x10, 0xb4, 0x8c, 0xf1, 0x79, 0x54, 0xbb, 0xcb, 0xf2, 0x42, 0xfc,
-	0x74, 0xc1, 0x42, 0x2d, 0xed, 0x33, 0x82, 0x66, 0x65, 0x32, 0x76, 0xe7, 0x61, 0x4f, 0x8b, 0xf0,
-	0xe3, 0x05, 0x8a, 0xf2, 0x16, 0x15, 0x73, 0xd2, 0xae, 0xf5, 0x7a, 0x56, 0x8b, 0x2a, 0x13, 0xb5,
-	0xd7, 0x3d, 0x19, 0xd9, 0xe8, 0x74, 0x64, 0xa3, 0x3f, 0x23, 0x1b, 0x7d, 0x1d, 0xdb, 0x8d, 0xd3,
-	0xb1, 0xdd, 0xf8, 0x35, 0xb6, 0x1b, 0xaf, 0x77, 0xa2, 0x58, 0xbd, 0x7d, 0x7f, 0xe0, 0x0e, 0xd8,
-	0xa1, 0x37, 0x61, 0xf6, 0x24, 0xa7, 0xde, 0xb1, 0xa7, 0x1f, 0xda, 0x8f, 0x9c, 0xc8, 0x83, 0xe5,
-	0xe4, 0x29, 0xdc, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xc5, 0xca, 0xf7, 0x80, 0x07, 0x00,
-	0x00,
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x3f, 0x6f, 0xd3, 0x5e,
+	0x14, 0xcd, 0xfb, 0xa5, 0xfa, 0xd1, 0xde, 0x88, 0x40, 0xad, 0xa8, 0xb8, 0xaf, 0xc1, 0x8d, 0x0c,
+	0xa5, 0x09, 0x8a, 0x6c, 0x48, 0xf9, 0x33, 0x20, 0x84, 0x68, 0xb2, 0x74, 0xb0, 0x40, 0x06, 0x0a,
+	0x62, 0x4b, 0xe3, 0x87, 0xb1, 0x94, 0xf8, 0x19, 0x3f, 0xd3, 0x96, 0x8d, 0x91, 0x01, 0x09, 0x04,
+	0x0b, 0x23, 0x1f, 0xa7, 0x63, 0x47, 0x26, 0x84, 0x92, 0x2f, 0x82, 0x1a, 0xec, 0x17, 0x27, 0xfe,
+	0x9b, 0x30, 0xb0, 0xc5, 0x7e, 0xe7, 0x9e, 0x7b, 0xee, 0x3d, 0x2f, 0x47, 0x86, 0x8b, 0x8e, 0x4b,
+	0x5f, 0x59, 0x7d, 0xa2, 0x7a, 0xc7, 0x8a, 0xe3, 0x52, 0x8f, 0x0a, 0x6b, 0x1e, 0xb1, 0x0d, 0xe2,
+	0x0e, 0x2c, 0xdb, 0x53, 0x98, 0x63, 0x2b, 0x3e, 0x00, 0x57, 0x4c, 0x6a, 0xd2, 0x31, 0x44, 0x3d,
+	0xfb, 0xf5, 0x07, 0x8d, 0xd7, 0x83, 0xfa, 0x1e, 0xa5, 0xae, 0x61, 0xd9, 0x5d, 0x8f, 0xba, 0xfe,
+	0xd1, 0xa5, 0xe0, 0xe8, 0xb0, 0xdb, 0xb7, 0x8c, 0xc9, 0x81, 0xfc, 0x09, 0xc1, 0x65, 0x8d, 0x99,
+	0xcf, 0x1c, 0xa3, 0xeb, 0x91, 0xfd, 0xe0, 0xb0, 0x43, 0x58, 0xcf, 0xb5, 0x1c, 0xcf, 0xa2, 0xb6,
+	0x20, 0xc2, 0xb9, 0xae, 0x61, 0xb8, 0x84, 0x31, 0x11, 0xd5, 0x50, 0x7d, 0x45, 0x0f, 0x1e, 0x85,
+	0xa7, 0x50, 0x32, 0x26, 0x40, 0xf1, 0xbf, 0x1a, 0xaa, 0x97, 0x5a, 0x4d, 0x25, 0x5e, 0xb3, 0x12,
+	0x47, 0xbe, 0xbb, 0x74, 0xf2, 0x73, 0xb3, 0xa0, 0x87, 0x69, 0xe4, 0x6d, 0xd8, 0x4a, 0x15, 0xa4,
+	0x13, 0xe6, 0x50, 0x9b, 0x11, 0x59, 0x01, 0x41, 0x63, 0x66, 0x87, 0xf4, 0x49, 0x08, 0x98, 0x2c,
+	0x57, 0xae, 0x02, 0x8e, 0xe2, 0x39, 0xdb, 0x07, 0x04, 0x15, 0x8d, 0x99, 0x6d, 0x97, 0x74, 0x3d,
+	0xd2, 0x9e, 0x2c, 0x30, 0x65, 0xfe, 0xfd, 0xb8, 0xf9, 0x95, 0xa4, 0xf9, 0x43, 0x9c, 0x19, 0x1b,
+	0xe8, 0x40, 0x35, 0x4e, 0x49, 0x20, 0x55, 0xb8, 0x0a, 0xe7, 0x43, 0x0e, 0xef, 0x19, 0x63, 0x5d,
+	0x4b, 0xfa, 0xf4, 0x4b, 0xf9, 0x2b, 0x82, 0x4d, 0xbe, 0xc8, 0xf8, 0xe6, 0xff, 0x60, 0xb6, 0x06,
+	0x6c, 0x67, 0x88, 0xe2, 0x8e, 0x3c, 0x87, 0x8d, 0x38, 0xe8, 0x43, 0x5f, 0x61, 0xb2, 0x76, 0x09,
+	0xc0, 0x26, 0x47, 0x3e, 0x6e, 0x2c, 0x7d, 0x45, 0x0f, 0xbd, 0x91, 0xb7, 0xe0, 0x4a, 0x0a, 0x31,
+	0xef, 0x7f, 0x03, 0x2a, 0xfc, 0xbe, 0xe4, 0xba, 0x10, 0xbe, 0x71, 0x91, 0x8a, 0x39, 0x8d, 0xfb,
+	0x8e, 0xa0, 0xac, 0x31, 0xf3, 0x09, 0xf1, 0x1e, 0x39, 0xc4, 0xcd, 0xb8, 0x83, 0x18, 0x96, 0xa9,
+	0x8f, 0xf2, 0x27, 0xe5, 0xcf, 0xc2, 0x0b, 0x28, 0x39, 0x67, 0x66, 0x31, 0x66, 0x51, 0x9b, 0x89,
+	0xc5, 0x5a, 0xb1, 0x5e, 0x6e, 0xdd, 0xc9, 0xe1, 0x61, 0xd0, 0x57, 0x79, 0xcc, 0xcb, 0xf5, 0x30,
+	0x95, 0x2c, 0xc2, 0xda, 0xb4, 0x42, 0xbe, 0xb4, 0x3d, 0x58, 0xd5, 0x98, 0xa9, 0x93, 0x01, 0x3d,
+	0x24, 0x7f, 0x27, 0x5f, 0xde, 0x80, 0xf5, 0x08, 0x55, 0xd0, 0xa7, 0xf5, 0x7e, 0x19, 0x8a, 0x1a,
+	0x33, 0x85, 0x2f, 0x08, 0x70, 0x4a, 0x78, 0xdd, 0x4e, 0x9a, 0x36, 0x35, 0x62, 0xf0, 0xfd, 0x85,
+	0xca, 0xb8, 0xcf, 0x6f, 0xe0, 0xc2, 0x6c, 0x2c, 0x5d, 0x4f, 0x61, 0x9c, 0xc1, 0xe2, 0x56, 0x7e,
+	0x2c, 0x6f, 0x79, 0x04, 0xab, 0xd1, 0xe8, 0x6a, 0xa6, 0x10, 0x45, 0xd0, 0xf8, 0xd6, 0x3c, 0x68,
+	0xde, 0xf8, 0x1b, 0x82, 0x6a, 0x6a, 0xc6, 0xdc, 0xcd, 0xdc, 0x65, 0x7c, 0x21, 0x7e, 0xb0, 0x60,
+	0x21, 0x97, 0xf6, 0x11, 0x81, 0x98, 0x18, 0x1f, 0x3b, 0xf3, 0xb0, 0xfb, 0x45, 0xf8, 0xde, 0x02,
+	0x45, 0x61, 0x8b, 0xa2, 0x61, 0xd2, 0xcc, 0xf4, 0x3a, 0xaf, 0x45, 0xc9, 0xb1, 0x43, 0xa0, 0x14,
+	0x0e, 0x93, 0x6b, 0x29, 0x24, 0x21, 0x1c, 0x56, 0xf2, 0xe1, 0x78, 0x1b, 0x1b, 0xca, 0x33, 0xff,
+	0xfb, 0x46, 0x0a, 0xc3, 0x34, 0x14, 0xdf, 0xcc, 0x0d, 0x0d, 0xfa, 0xed, 0xb6, 0x4f, 0x86, 0x12,
+	0x3a, 0x1d, 0x4a, 0xe8, 0xd7, 0x50, 0x42, 0x9f, 0x47, 0x52, 0xe1, 0x74, 0x24, 0x15, 0x7e, 0x8c,
+	0xa4, 0xc2, 0xcb, 0x86, 0x69, 0x79, 0xaf, 0xdf, 0x1e, 0x28, 0x3d, 0x3a, 0x50, 0x27, 0xb4, 0x2a,
+	0x73, 0x6c, 0xf5, 0x58, 0xe5, 0x1f, 0x59, 0xef, 0x1c, 0xc2, 0x0e, 0xfe, 0x1f, 0x7f, 0x06, 0xed,
+	0xfc, 0x1e, 0x00, 0xb4, 0x12, 0xb3, 0x55, 0x7c, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -630,6 +824,8 @@ type MsgClient interface {
 	UpdateCoordinatorDescription(ctx context.Context, in *MsgUpdateCoordinatorDescription, opts ...grpc.CallOption) (*MsgUpdateCoordinatorDescriptionResponse, error)
 	UpdateCoordinatorAddress(ctx context.Context, in *MsgUpdateCoordinatorAddress, opts ...grpc.CallOption) (*MsgUpdateCoordinatorAddressResponse, error)
 	DeleteCoordinator(ctx context.Context, in *MsgDeleteCoordinator, opts ...grpc.CallOption) (*MsgDeleteCoordinatorResponse, error)
+	SetOperator(ctx context.Context, in *MsgSetOperator, opts ...grpc.CallOption) (*MsgSetOperatorResponse, error)
+	RemoveOperator(ctx context.Context, in *MsgRemoveOperator, opts ...grpc.CallOption) (*MsgRemoveOperatorResponse, error)
 }
 
 type msgClient struct {
@@ -694,6 +890,24 @@ func (c *msgClient) DeleteCoordinator(ctx context.Context, in *MsgDeleteCoordina
 	return out, nil
 }
 
+func (c *msgClient) SetOperator(ctx context.Context, in *MsgSetOperator, opts ...grpc.CallOption) (*MsgSetOperatorResponse, error) {
+	out := new(MsgSetOperatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.profile.Msg/SetOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveOperator(ctx context.Context, in *MsgRemoveOperator, opts ...grpc.CallOption) (*MsgRemoveOperatorResponse, error) {
+	out := new(MsgRemoveOperatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.profile.Msg/RemoveOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateValidatorDescription(context.Context, *MsgUpdateValidatorDescription) (*MsgUpdateValidatorDescriptionResponse, error)
@@ -702,6 +916,8 @@ type MsgServer interface {
 	UpdateCoordinatorDescription(context.Context, *MsgUpdateCoordinatorDescription) (*MsgUpdateCoordinatorDescriptionResponse, error)
 	UpdateCoordinatorAddress(context.Context, *MsgUpdateCoordinatorAddress) (*MsgUpdateCoordinatorAddressResponse, error)
 	DeleteCoordinator(context.Context, *MsgDeleteCoordinator) (*MsgDeleteCoordinatorResponse, error)
+	SetOperator(context.Context, *MsgSetOperator) (*MsgSetOperatorResponse, error)
+	RemoveOperator(context.Context, *MsgRemoveOperator) (*MsgRemoveOperatorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -726,6 +942,12 @@ func (*UnimplementedMsgServer) UpdateCoordinatorAddress(ctx context.Context, req
 func (*UnimplementedMsgServer) DeleteCoordinator(ctx context.Context, req *MsgDeleteCoordinator) (*MsgDeleteCoordinatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCoordinator not implemented")
 }
+func (*UnimplementedMsgServer) SetOperator(ctx context.Context, req *MsgSetOperator) (*MsgSetOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOperator not implemented")
+}
+func (*UnimplementedMsgServer) RemoveOperator(ctx context.Context, req *MsgRemoveOperator) (*MsgRemoveOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOperator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -839,6 +1061,42 @@ func _Msg_DeleteCoordinator_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.profile.Msg/SetOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOperator(ctx, req.(*MsgSetOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.profile.Msg/RemoveOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveOperator(ctx, req.(*MsgRemoveOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tendermint.spn.profile.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -867,6 +1125,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCoordinator",
 			Handler:    _Msg_DeleteCoordinator_Handler,
 		},
+		{
+			MethodName: "SetOperator",
+			Handler:    _Msg_SetOperator_Handler,
+		},
+		{
+			MethodName: "RemoveOperator",
+			Handler:    _Msg_RemoveOperator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "profile/tx.proto",
@@ -1237,75 +1503,213 @@ func (m *MsgDeleteCoordinatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateValidatorDescription) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Permissions) > 0 {
+		dAtA5 := make([]byte, len(m.Permissions)*10)
+		var j4 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateValidatorDescriptionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateCoordinator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateValidatorDescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateValidatorDescriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateCoordinator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1396,6 +1800,65 @@ func (m *MsgDeleteCoordinatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSetOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1428,7 +1891,304 @@ func (m *MsgUpdateValidatorDescription) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgUpdateValidatorDescription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateValidatorDescriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorDescriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCoordinator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCoordinator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1517,7 +2277,7 @@ func (m *MsgUpdateValidatorDescription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValidatorDescriptionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateCoordinatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1540,12 +2300,31 @@ func (m *MsgUpdateValidatorDescriptionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorDescriptionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateCoordinatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorId", wireType)
+			}
+			m.CoordinatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1567,7 +2346,7 @@ func (m *MsgUpdateValidatorDescriptionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCoordinatorDescription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1590,10 +2369,10 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCoordinatorDescription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCoordinatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1628,6 +2407,39 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1649,7 +2461,7 @@ func (m *MsgDeleteValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCoordinatorDescriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1672,10 +2484,10 @@ func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCoordinatorDescriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCoordinatorDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1699,7 +2511,7 @@ func (m *MsgDeleteValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1722,10 +2534,10 @@ func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCoordinator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCoordinatorAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCoordinator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCoordinatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1762,9 +2574,9 @@ func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1774,24 +2586,23 @@ func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1814,7 +2625,7 @@ func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCoordinatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCoordinatorAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1837,31 +2648,12 @@ func (m *MsgCreateCoordinatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCoordinatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCoordinatorAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCoordinatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorId", wireType)
-			}
-			m.CoordinatorId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CoordinatorId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1883,7 +2675,7 @@ func (m *MsgCreateCoordinatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCoordinatorDescription) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteCoordinator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1906,10 +2698,10 @@ func (m *MsgUpdateCoordinatorDescription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorDescription: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteCoordinator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteCoordinator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1944,39 +2736,6 @@ func (m *MsgUpdateCoordinatorDescription) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1998,7 +2757,7 @@ func (m *MsgUpdateCoordinatorDescription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCoordinatorDescriptionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteCoordinatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2021,12 +2780,31 @@ func (m *MsgUpdateCoordinatorDescriptionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorDescriptionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteCoordinatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorId", wireType)
+			}
+			m.CoordinatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2048,7 +2826,7 @@ func (m *MsgUpdateCoordinatorDescriptionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2071,10 +2849,10 @@ func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2111,7 +2889,7 @@ func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2139,8 +2917,77 @@ func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v CoordinatorOperator_Permission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CoordinatorOperator_Permission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]CoordinatorOperator_Permission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CoordinatorOperator_Permission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CoordinatorOperator_Permission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2162,7 +3009,7 @@ func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCoordinatorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2185,10 +3032,10 @@ func (m *MsgUpdateCoordinatorAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2212,7 +3059,7 @@ func (m *MsgUpdateCoordinatorAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteCoordinator) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2235,10 +3082,10 @@ func (m *MsgDeleteCoordinator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteCoordinator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteCoordinator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2273,6 +3120,38 @@ func (m *MsgDeleteCoordinator) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2294,7 +3173,7 @@ func (m *MsgDeleteCoordinator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteCoordinatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2317,31 +3196,12 @@ func (m *MsgDeleteCoordinatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteCoordinatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorId", wireType)
-			}
-			m.CoordinatorId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CoordinatorId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])